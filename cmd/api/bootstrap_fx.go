@@ -15,6 +15,9 @@ func newFXAppWithOptions(extraOptions ...fx.Option) lifecycleApp {
 		fxapp.InfraModule,
 		fxapp.ApplicationModule,
 		fxapp.RealtimeModule,
+		fxapp.RecordProjectorModule,
+		fxapp.RecordTrashModule,
+		fxapp.EmbeddedOutboxPublisherModule,
 		fxapp.ServerModule,
 	}
 	options = append(options, extraOptions...)
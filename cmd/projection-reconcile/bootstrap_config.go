@@ -0,0 +1,90 @@
+// Package main boots the record projection drift check and rebuild command.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	envBootstrapStartTimeout = "BOOTSTRAP_START_TIMEOUT"
+	envBootstrapStopTimeout  = "BOOTSTRAP_STOP_TIMEOUT"
+)
+
+const (
+	defaultStartTimeout = 20 * time.Second
+	defaultStopTimeout  = 20 * time.Second
+	defaultGrace        = time.Minute
+	defaultRunTimeout   = 10 * time.Minute
+)
+
+var ErrInvalidBootstrapConfig = errors.New("invalid bootstrap config")
+
+type bootstrapConfig struct {
+	StartTimeout time.Duration
+	StopTimeout  time.Duration
+	RunTimeout   time.Duration
+	UserID       uint64
+	Repair       bool
+	Rebuild      bool
+	Grace        time.Duration
+}
+
+// loadBootstrapConfig reads the command flags and the bootstrap timeouts shared with the other entrypoints.
+func loadBootstrapConfig(args []string, getenv func(string) string, output io.Writer) (bootstrapConfig, error) {
+	cfg := bootstrapConfig{}
+
+	flags := flag.NewFlagSet("projection-reconcile", flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.Uint64Var(&cfg.UserID, "user", 0, "check or rebuild only this user id (default: every user)")
+	flags.BoolVar(&cfg.Repair, "repair", false, "re-enqueue a record outbox event for every drifted record")
+	flags.BoolVar(&cfg.Rebuild, "rebuild", false, "delete the user's projections and re-enqueue all their records (requires -user)")
+	flags.DurationVar(&cfg.Grace, "grace", defaultGrace, "skip rows written this recently, whose events may still be in flight")
+	flags.DurationVar(&cfg.RunTimeout, "timeout", defaultRunTimeout, "abort the check or rebuild after this long")
+	if err := flags.Parse(args); err != nil {
+		return bootstrapConfig{}, fmt.Errorf("%w: %w", ErrInvalidBootstrapConfig, err)
+	}
+
+	switch {
+	case flags.NArg() > 0:
+		return bootstrapConfig{}, fmt.Errorf("%w: unexpected arguments %v", ErrInvalidBootstrapConfig, flags.Args())
+	case cfg.Rebuild && cfg.UserID == 0:
+		return bootstrapConfig{}, fmt.Errorf("%w: -rebuild requires -user", ErrInvalidBootstrapConfig)
+	case cfg.Rebuild && cfg.Repair:
+		return bootstrapConfig{}, fmt.Errorf("%w: -rebuild and -repair are mutually exclusive", ErrInvalidBootstrapConfig)
+	case cfg.Grace < 0:
+		return bootstrapConfig{}, fmt.Errorf("%w: -grace must not be negative", ErrInvalidBootstrapConfig)
+	case cfg.RunTimeout <= 0:
+		return bootstrapConfig{}, fmt.Errorf("%w: -timeout must be greater than 0", ErrInvalidBootstrapConfig)
+	}
+
+	var err error
+	if cfg.StartTimeout, err = readDurationEnv(getenv, envBootstrapStartTimeout, defaultStartTimeout); err != nil {
+		return bootstrapConfig{}, err
+	}
+	if cfg.StopTimeout, err = readDurationEnv(getenv, envBootstrapStopTimeout, defaultStopTimeout); err != nil {
+		return bootstrapConfig{}, err
+	}
+	return cfg, nil
+}
+
+func readDurationEnv(getenv func(string) string, key string, defaultValue time.Duration) (time.Duration, error) {
+	raw := strings.TrimSpace(getenv(key))
+	if raw == "" {
+		return defaultValue, nil
+	}
+
+	value, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid %s: %w", ErrInvalidBootstrapConfig, key, err)
+	}
+	if value <= 0 {
+		return 0, fmt.Errorf("%w: invalid %s: must be greater than 0", ErrInvalidBootstrapConfig, key)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestLoadBootstrapConfigDefaults(t *testing.T) {
+	cfg, err := loadBootstrapConfig(nil, func(string) string { return "" }, io.Discard)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.UserID != 0 || cfg.Repair || cfg.Rebuild {
+		t.Fatalf("unexpected mode: %+v", cfg)
+	}
+	if cfg.Grace != defaultGrace || cfg.RunTimeout != defaultRunTimeout {
+		t.Fatalf("unexpected durations: %+v", cfg)
+	}
+	if cfg.StartTimeout != defaultStartTimeout || cfg.StopTimeout != defaultStopTimeout {
+		t.Fatalf("unexpected bootstrap timeouts: %+v", cfg)
+	}
+}
+
+func TestLoadBootstrapConfigFlags(t *testing.T) {
+	env := map[string]string{envBootstrapStopTimeout: "5s"}
+	cfg, err := loadBootstrapConfig(
+		[]string{"-user", "7", "-repair", "-grace", "30s"},
+		func(key string) string { return env[key] },
+		io.Discard,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.UserID != 7 || !cfg.Repair || cfg.Grace != 30*time.Second || cfg.StopTimeout != 5*time.Second {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestLoadBootstrapConfigRejectsInvalidModes(t *testing.T) {
+	cases := map[string][]string{
+		"rebuild without user": {"-rebuild"},
+		"rebuild and repair":   {"-user", "7", "-rebuild", "-repair"},
+		"negative grace":       {"-grace", "-1s"},
+		"zero timeout":         {"-timeout", "0s"},
+		"positional argument":  {"7"},
+		"unknown flag":         {"-force"},
+	}
+	for name, args := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := loadBootstrapConfig(args, func(string) string { return "" }, io.Discard)
+			if !errors.Is(err, ErrInvalidBootstrapConfig) {
+				t.Fatalf("expected ErrInvalidBootstrapConfig, got %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadBootstrapConfigRejectsInvalidTimeoutEnv(t *testing.T) {
+	_, err := loadBootstrapConfig(nil, func(key string) string {
+		if key == envBootstrapStartTimeout {
+			return "soon"
+		}
+		return ""
+	}, io.Discard)
+	if !errors.Is(err, ErrInvalidBootstrapConfig) {
+		t.Fatalf("expected ErrInvalidBootstrapConfig, got %v", err)
+	}
+}
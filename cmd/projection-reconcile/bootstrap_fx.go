@@ -0,0 +1,16 @@
+package main
+
+import (
+	"github.com/lechitz/aion-api/internal/platform/fxapp"
+	recordInput "github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"go.uber.org/fx"
+)
+
+func newFXApp(reconciler *recordInput.RecordProjectionReconciler) lifecycleApp {
+	return fx.New(
+		fx.NopLogger,
+		fxapp.InfraModule,
+		fxapp.ProjectionReconcileModule,
+		fx.Populate(reconciler),
+	)
+}
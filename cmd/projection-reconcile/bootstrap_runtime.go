@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	recordInput "github.com/lechitz/aion-api/internal/record/core/ports/input"
+)
+
+// Exit codes let a scheduler alert on drift without parsing the report.
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitDrift   = 2
+)
+
+type lifecycleApp interface {
+	Start(context.Context) error
+	Stop(context.Context) error
+}
+
+type appFactory func(*recordInput.RecordProjectionReconciler) lifecycleApp
+
+func defaultBootstrapLogf(level slog.Level, msg string, args ...any) {
+	slog.Default().Log(context.Background(), level, msg, args...)
+}
+
+// runWithDeps boots the infrastructure, runs one check or rebuild and stops the app again, so
+// buffered drift metrics are flushed before the process exits.
+func runWithDeps(
+	args []string,
+	factory appFactory,
+	getenv func(string) string,
+	stdout io.Writer,
+	logf func(slog.Level, string, ...any),
+) int {
+	if logf == nil {
+		logf = defaultBootstrapLogf
+	}
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+
+	cfg, err := loadBootstrapConfig(args, getenv, os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		logf(slog.LevelError, "failed to load bootstrap config", "error", err)
+		return exitFailure
+	}
+	if factory == nil {
+		logf(slog.LevelError, "invalid bootstrap runtime")
+		return exitFailure
+	}
+
+	var reconciler recordInput.RecordProjectionReconciler
+	app := factory(&reconciler)
+
+	startCtx, cancelStart := context.WithTimeout(context.Background(), cfg.StartTimeout)
+	defer cancelStart()
+	if err := app.Start(startCtx); err != nil {
+		logf(slog.LevelError, "failed to start bootstrap app", "error", err)
+		return exitFailure
+	}
+
+	signalCtx, stopSignal := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	runCtx, cancelRun := context.WithTimeout(signalCtx, cfg.RunTimeout)
+	code := execute(runCtx, reconciler, cfg, stdout, logf)
+	cancelRun()
+	stopSignal()
+
+	stopCtx, cancelStop := context.WithTimeout(context.Background(), cfg.StopTimeout)
+	defer cancelStop()
+	if err := app.Stop(stopCtx); err != nil {
+		logf(slog.LevelError, "failed to stop bootstrap app", "error", err)
+		return exitFailure
+	}
+	return code
+}
+
+func execute(
+	ctx context.Context,
+	reconciler recordInput.RecordProjectionReconciler,
+	cfg bootstrapConfig,
+	stdout io.Writer,
+	logf func(slog.Level, string, ...any),
+) int {
+	if cfg.Rebuild {
+		enqueued, err := reconciler.Rebuild(ctx, cfg.UserID)
+		_, _ = fmt.Fprintf(stdout, "rebuild user=%d enqueued=%d\n", cfg.UserID, enqueued)
+		if err != nil {
+			logf(slog.LevelError, "projection rebuild failed", "error", err)
+			return exitFailure
+		}
+		return exitOK
+	}
+
+	reports, err := reconciler.Reconcile(ctx, recordInput.ReconcileOptions{
+		UserID: cfg.UserID,
+		Repair: cfg.Repair,
+		Grace:  cfg.Grace,
+	})
+	drift := writeReport(stdout, reports)
+	if err != nil {
+		logf(slog.LevelError, "projection reconcile failed", "error", err)
+		return exitFailure
+	}
+	if drift > 0 && !cfg.Repair {
+		return exitDrift
+	}
+	return exitOK
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	recordDomain "github.com/lechitz/aion-api/internal/record/core/domain"
+	recordInput "github.com/lechitz/aion-api/internal/record/core/ports/input"
+)
+
+type fakeLifecycleApp struct {
+	startErr    error
+	stopErr     error
+	stopCalled  bool
+	startCalled bool
+}
+
+func (f *fakeLifecycleApp) Start(context.Context) error {
+	f.startCalled = true
+	return f.startErr
+}
+
+func (f *fakeLifecycleApp) Stop(context.Context) error {
+	f.stopCalled = true
+	return f.stopErr
+}
+
+type fakeReconciler struct {
+	reports    []recordDomain.ProjectionDriftReport
+	err        error
+	opts       recordInput.ReconcileOptions
+	rebuiltFor uint64
+}
+
+func (f *fakeReconciler) Reconcile(_ context.Context, opts recordInput.ReconcileOptions) ([]recordDomain.ProjectionDriftReport, error) {
+	f.opts = opts
+	return f.reports, f.err
+}
+
+func (f *fakeReconciler) Rebuild(_ context.Context, userID uint64) (int, error) {
+	f.rebuiltFor = userID
+	return 3, f.err
+}
+
+func factoryFor(app *fakeLifecycleApp, reconciler *fakeReconciler) appFactory {
+	return func(target *recordInput.RecordProjectionReconciler) lifecycleApp {
+		*target = reconciler
+		return app
+	}
+}
+
+func noEnv(string) string { return "" }
+
+func noLog(slog.Level, string, ...any) {}
+
+func driftReport() recordDomain.ProjectionDriftReport {
+	return recordDomain.ProjectionDriftReport{
+		UserID:      7,
+		Records:     2,
+		Projections: 2,
+		Drifts: []recordDomain.ProjectionDrift{
+			{Kind: recordDomain.DriftMismatch, RecordID: 3, Fields: []string{"tag_id", "value"}},
+			{Kind: recordDomain.DriftOrphaned, RecordID: 9},
+		},
+	}
+}
+
+func TestRunReportsDriftWithExitCode(t *testing.T) {
+	app := &fakeLifecycleApp{}
+	reconciler := &fakeReconciler{reports: []recordDomain.ProjectionDriftReport{
+		driftReport(),
+		{UserID: 8, Records: 5, Projections: 5},
+	}}
+	var out bytes.Buffer
+
+	code := runWithDeps([]string{"-grace", "0s"}, factoryFor(app, reconciler), noEnv, &out, noLog)
+	if code != exitDrift {
+		t.Fatalf("expected exit %d, got %d", exitDrift, code)
+	}
+	if !app.startCalled || !app.stopCalled {
+		t.Fatal("expected app to be started and stopped")
+	}
+	if reconciler.opts.UserID != 0 || reconciler.opts.Repair || reconciler.opts.Grace != 0 {
+		t.Fatalf("unexpected options: %+v", reconciler.opts)
+	}
+
+	want := strings.Join([]string{
+		"user=7 records=2 projections=2 missing=0 stale=0 mismatch=1 orphaned=1 repaired=0",
+		"  mismatch record_id=3 fields=tag_id,value",
+		"  orphaned record_id=9",
+		"total users=2 records=7 projections=7 missing=0 stale=0 mismatch=1 orphaned=1 repaired=0",
+		"",
+	}, "\n")
+	if out.String() != want {
+		t.Fatalf("unexpected report:\n%s", out.String())
+	}
+}
+
+func TestRunRepairExitsCleanly(t *testing.T) {
+	report := driftReport()
+	report.Repaired = 2
+	reconciler := &fakeReconciler{reports: []recordDomain.ProjectionDriftReport{report}}
+
+	code := runWithDeps([]string{"-user", "7", "-repair"}, factoryFor(&fakeLifecycleApp{}, reconciler), noEnv, &bytes.Buffer{}, noLog)
+	if code != exitOK {
+		t.Fatalf("expected exit %d, got %d", exitOK, code)
+	}
+	if reconciler.opts.UserID != 7 || !reconciler.opts.Repair {
+		t.Fatalf("unexpected options: %+v", reconciler.opts)
+	}
+}
+
+func TestRunRebuild(t *testing.T) {
+	reconciler := &fakeReconciler{}
+	var out bytes.Buffer
+
+	code := runWithDeps([]string{"-user", "7", "-rebuild"}, factoryFor(&fakeLifecycleApp{}, reconciler), noEnv, &out, noLog)
+	if code != exitOK {
+		t.Fatalf("expected exit %d, got %d", exitOK, code)
+	}
+	if reconciler.rebuiltFor != 7 || out.String() != "rebuild user=7 enqueued=3\n" {
+		t.Fatalf("unexpected rebuild: user=%d output=%q", reconciler.rebuiltFor, out.String())
+	}
+}
+
+func TestRunFailures(t *testing.T) {
+	t.Run("reconcile error", func(t *testing.T) {
+		app := &fakeLifecycleApp{}
+		reconciler := &fakeReconciler{err: errors.New("db down")}
+		if code := runWithDeps(nil, factoryFor(app, reconciler), noEnv, &bytes.Buffer{}, noLog); code != exitFailure {
+			t.Fatalf("expected exit %d, got %d", exitFailure, code)
+		}
+		if !app.stopCalled {
+			t.Fatal("expected app to be stopped after a failed check")
+		}
+	})
+
+	t.Run("start error", func(t *testing.T) {
+		app := &fakeLifecycleApp{startErr: errors.New("no database")}
+		if code := runWithDeps(nil, factoryFor(app, &fakeReconciler{}), noEnv, &bytes.Buffer{}, noLog); code != exitFailure {
+			t.Fatalf("expected exit %d, got %d", exitFailure, code)
+		}
+	})
+
+	t.Run("stop error", func(t *testing.T) {
+		app := &fakeLifecycleApp{stopErr: errors.New("flush failed")}
+		if code := runWithDeps(nil, factoryFor(app, &fakeReconciler{}), noEnv, &bytes.Buffer{}, noLog); code != exitFailure {
+			t.Fatalf("expected exit %d, got %d", exitFailure, code)
+		}
+	})
+
+	t.Run("invalid flags", func(t *testing.T) {
+		app := &fakeLifecycleApp{}
+		if code := runWithDeps([]string{"-rebuild"}, factoryFor(app, &fakeReconciler{}), noEnv, &bytes.Buffer{}, noLog); code != exitFailure {
+			t.Fatalf("expected exit %d, got %d", exitFailure, code)
+		}
+		if app.startCalled {
+			t.Fatal("expected invalid flags to fail before boot")
+		}
+	})
+}
@@ -0,0 +1,11 @@
+package main
+
+import "os"
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	return runWithDeps(os.Args[1:], newFXApp, os.Getenv, os.Stdout, nil)
+}
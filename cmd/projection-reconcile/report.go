@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	recordDomain "github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// writeReport prints one block per user with drift followed by a total line, and returns the
+// number of drifted records. Users without drift are only counted in the total.
+func writeReport(w io.Writer, reports []recordDomain.ProjectionDriftReport) int {
+	total := recordDomain.ProjectionDriftReport{}
+	for _, report := range reports {
+		total.Records += report.Records
+		total.Projections += report.Projections
+		total.Repaired += report.Repaired
+		total.Drifts = append(total.Drifts, report.Drifts...)
+
+		if len(report.Drifts) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "user=%d %s\n", report.UserID, summary(report))
+		for _, drift := range report.Drifts {
+			line := fmt.Sprintf("  %s record_id=%d", drift.Kind, drift.RecordID)
+			if len(drift.Fields) > 0 {
+				line += " fields=" + strings.Join(drift.Fields, ",")
+			}
+			_, _ = fmt.Fprintln(w, line)
+		}
+	}
+
+	_, _ = fmt.Fprintf(w, "total users=%d %s\n", len(reports), summary(total))
+	return len(total.Drifts)
+}
+
+func summary(report recordDomain.ProjectionDriftReport) string {
+	counts := report.Counts()
+	parts := []string{
+		fmt.Sprintf("records=%d", report.Records),
+		fmt.Sprintf("projections=%d", report.Projections),
+	}
+	for _, kind := range recordDomain.DriftKinds() {
+		parts = append(parts, fmt.Sprintf("%s=%d", kind, counts[kind]))
+	}
+	parts = append(parts, fmt.Sprintf("repaired=%d", report.Repaired))
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := run(os.Stdout, payloadBindings()); err != nil {
+		fmt.Fprintf(os.Stderr, "event schema check failed: %v\n", err)
+		os.Exit(1)
+	}
+}
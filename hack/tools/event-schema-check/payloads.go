@@ -0,0 +1,48 @@
+package main
+
+import (
+	"reflect"
+
+	categorydomain "github.com/lechitz/aion-api/internal/category/core/domain"
+	category "github.com/lechitz/aion-api/internal/category/core/usecase"
+	recorddomain "github.com/lechitz/aion-api/internal/record/core/domain"
+	record "github.com/lechitz/aion-api/internal/record/core/usecase"
+	tagdomain "github.com/lechitz/aion-api/internal/tag/core/domain"
+	tag "github.com/lechitz/aion-api/internal/tag/core/usecase"
+	userdomain "github.com/lechitz/aion-api/internal/user/core/domain"
+	user "github.com/lechitz/aion-api/internal/user/core/usecase"
+)
+
+// payloadKey identifies one event type at one version.
+type payloadKey struct {
+	eventType    string
+	eventVersion string
+}
+
+// payloadBindings maps every event the producer contexts enqueue to the Go struct they marshal as its payload.
+// A new event type or version needs a row here and in the schema registry catalog.
+func payloadBindings() map[payloadKey]reflect.Type {
+	recordPayload := reflect.TypeFor[recorddomain.RecordEventPayloadV1]()
+	metricDefinitionPayload := reflect.TypeFor[recorddomain.MetricDefinitionEventPayloadV1]()
+	tagPayload := reflect.TypeFor[tagdomain.TagEventPayloadV1]()
+	categoryPayload := reflect.TypeFor[categorydomain.CategoryEventPayloadV1]()
+	userPayload := reflect.TypeFor[userdomain.UserEventPayloadV1]()
+
+	return map[payloadKey]reflect.Type{
+		{record.RecordEventTypeCreatedV1, record.RecordEventVersionV1}:                     recordPayload,
+		{record.RecordEventTypeUpdatedV1, record.RecordEventVersionV1}:                     recordPayload,
+		{record.RecordEventTypeDeletedV1, record.RecordEventVersionV1}:                     recordPayload,
+		{record.RecordEventTypeRestoredV1, record.RecordEventVersionV1}:                    recordPayload,
+		{record.MetricDefinitionEventTypeCreatedV1, record.MetricDefinitionEventVersionV1}: metricDefinitionPayload,
+		{record.MetricDefinitionEventTypeUpdatedV1, record.MetricDefinitionEventVersionV1}: metricDefinitionPayload,
+		{tag.TagEventTypeCreatedV1, tag.TagEventVersionV1}:                                 tagPayload,
+		{tag.TagEventTypeUpdatedV1, tag.TagEventVersionV1}:                                 tagPayload,
+		{tag.TagEventTypeDeletedV1, tag.TagEventVersionV1}:                                 tagPayload,
+		{category.CategoryEventTypeCreatedV1, category.CategoryEventVersionV1}:             categoryPayload,
+		{category.CategoryEventTypeUpdatedV1, category.CategoryEventVersionV1}:             categoryPayload,
+		{category.CategoryEventTypeDeletedV1, category.CategoryEventVersionV1}:             categoryPayload,
+		{user.UserEventTypeCreatedV1, user.UserEventVersionV1}:                             userPayload,
+		{user.UserEventTypeUpdatedV1, user.UserEventVersionV1}:                             userPayload,
+		{user.UserEventTypeDeletedV1, user.UserEventVersionV1}:                             userPayload,
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/schemaregistry"
+)
+
+var errSchemaDrift = errors.New("payload structs drifted from their schemas")
+
+// run checks that every catalog entry has a payload struct, that every payload struct has a catalog
+// entry, and that each struct still matches its schema. Differences are written to out, one per line.
+func run(out io.Writer, bindings map[payloadKey]reflect.Type) error {
+	registry, err := schemaregistry.NewDefault()
+	if err != nil {
+		return err
+	}
+
+	var drift []string
+	catalogued := make(map[payloadKey]bool)
+	for _, entry := range schemaregistry.Catalog() {
+		key := payloadKey{entry.EventType, entry.EventVersion}
+		catalogued[key] = true
+
+		payloadType, ok := bindings[key]
+		if !ok {
+			drift = append(drift, fmt.Sprintf("%s %s: no payload struct is bound to %s", key.eventType, key.eventVersion, entry.SchemaFile))
+			continue
+		}
+
+		differences, err := registry.Drift(key.eventType, key.eventVersion, payloadType)
+		if err != nil {
+			return err
+		}
+		for _, difference := range differences {
+			drift = append(drift, fmt.Sprintf("%s %s: %s", key.eventType, key.eventVersion, difference))
+		}
+	}
+	for key := range bindings {
+		if !catalogued[key] {
+			drift = append(drift, fmt.Sprintf("%s %s: payload struct has no schema in the registry catalog", key.eventType, key.eventVersion))
+		}
+	}
+
+	slices.Sort(drift)
+	for _, line := range drift {
+		_, _ = fmt.Fprintln(out, line)
+	}
+	if len(drift) > 0 {
+		return fmt.Errorf("%w: %d difference(s)", errSchemaDrift, len(drift))
+	}
+
+	_, _ = fmt.Fprintf(out, "%d event schemas match their payload structs\n", len(catalogued))
+	return nil
+}
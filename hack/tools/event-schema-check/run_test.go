@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRunPassesForShippedPayloads(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	if err := run(&out, payloadBindings()); err != nil {
+		t.Fatalf("expected shipped payloads to match their schemas, got %v:\n%s", err, out.String())
+	}
+}
+
+type driftedTagPayload struct {
+	Name  string `json:"name"`
+	TagID uint64 `json:"tag_id"`
+}
+
+func TestRunReportsDrift(t *testing.T) {
+	t.Parallel()
+
+	bindings := payloadBindings()
+	bindings[payloadKey{"tag.created", "v1"}] = reflect.TypeFor[driftedTagPayload]()
+	delete(bindings, payloadKey{"user.deleted", "v1"})
+	bindings[payloadKey{"tag.archived", "v1"}] = reflect.TypeFor[driftedTagPayload]()
+
+	var out bytes.Buffer
+	err := run(&out, bindings)
+	if !errors.Is(err, errSchemaDrift) {
+		t.Fatalf("expected errSchemaDrift, got %v", err)
+	}
+
+	report := out.String()
+	for _, want := range []string{
+		`tag.created v1: driftedTagPayload: tag.v1.json property "user_id" has no struct field`,
+		"user.deleted v1: no payload struct is bound to user.v1.json",
+		"tag.archived v1: payload struct has no schema in the registry catalog",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("expected %q in report:\n%s", want, report)
+		}
+	}
+}
@@ -16,7 +16,7 @@ func loadConfig(args []string) config {
 	fs := flag.NewFlagSet("outbox-diagnose", flag.ContinueOnError)
 	cfg := config{}
 
-	fs.IntVar(&cfg.sampleLimit, "sample-limit", defaultSampleLimit, "sample size for pending and failed outbox rows")
+	fs.IntVar(&cfg.sampleLimit, "sample-limit", defaultSampleLimit, "sample size for pending, retrying, and dead-lettered outbox rows")
 	fs.StringVar(&cfg.envFile, "env-file", defaultEnvFile, "optional env file loaded before reading app config")
 	_ = fs.Parse(args)
 	return cfg
@@ -45,14 +45,19 @@ func run(cfg config) error {
 	if err != nil {
 		return err
 	}
-	failed, err := repo.ListFailed(backgroundContext(), cfg.sampleLimit)
+	retrying, err := repo.ListFailed(backgroundContext(), cfg.sampleLimit)
+	if err != nil {
+		return err
+	}
+	deadLettered, err := repo.ListByStatus(backgroundContext(), "failed", cfg.sampleLimit)
 	if err != nil {
 		return err
 	}
 
 	printStats(stats)
 	printSample("pending sample", pending)
-	printSample("failed sample", failed)
+	printSample("retrying sample", retrying)
+	printSample("dead-letter sample", deadLettered)
 	return nil
 }
 
@@ -88,9 +93,11 @@ func printStats(stats domain.Stats) {
 		oldestPendingAge = time.Since(stats.OldestPendingAtUTC.UTC()).Round(time.Second).String()
 	}
 
-	_, _ = fmt.Fprintf(os.Stdout, "pending_count=%d published_count=%d failed_count=%d oldest_pending_age=%s\n",
+	_, _ = fmt.Fprintf(os.Stdout, "pending_count=%d leased_count=%d published_count=%d retrying_count=%d dead_letter_count=%d oldest_pending_age=%s\n",
 		stats.PendingCount,
+		stats.LeasedCount,
 		stats.PublishedCount,
+		stats.RetryingCount,
 		stats.FailedCount,
 		oldestPendingAge,
 	)
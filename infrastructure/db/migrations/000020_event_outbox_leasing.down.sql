@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS aion_api.idx_event_outbox_publishing_lease;
+
+UPDATE aion_api.event_outbox
+SET status = 'pending'
+WHERE status = 'publishing';
+
+ALTER TABLE aion_api.event_outbox
+    DROP COLUMN IF EXISTS lease_expires_at_utc,
+    DROP COLUMN IF EXISTS lease_owner;
@@ -0,0 +1,13 @@
+-- Migration: 000020_event_outbox_leasing
+-- Description: Add publisher leases so several outbox publishers can claim rows without double publication
+
+ALTER TABLE aion_api.event_outbox
+    ADD COLUMN IF NOT EXISTS lease_owner VARCHAR(128),
+    ADD COLUMN IF NOT EXISTS lease_expires_at_utc TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_publishing_lease
+    ON aion_api.event_outbox(lease_expires_at_utc ASC)
+    WHERE status = 'publishing';
+
+COMMENT ON COLUMN aion_api.event_outbox.lease_owner IS 'Publisher instance currently holding the row while status is publishing';
+COMMENT ON COLUMN aion_api.event_outbox.lease_expires_at_utc IS 'Instant after which another publisher may reclaim a publishing row';
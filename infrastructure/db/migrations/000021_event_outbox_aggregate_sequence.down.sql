@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS aion_api.idx_event_outbox_aggregate_sequence;
+
+ALTER TABLE aion_api.event_outbox
+    DROP COLUMN IF EXISTS aggregate_sequence;
+
+DROP TABLE IF EXISTS aion_api.event_outbox_aggregate_sequences;
@@ -0,0 +1,36 @@
+-- Migration: 000021_event_outbox_aggregate_sequence
+-- Description: Number outbox events per aggregate so the publisher can deliver them in order and consumers can detect gaps
+
+CREATE TABLE IF NOT EXISTS aion_api.event_outbox_aggregate_sequences (
+    aggregate_type VARCHAR(64) NOT NULL,
+    aggregate_id VARCHAR(128) NOT NULL,
+    last_sequence BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (aggregate_type, aggregate_id)
+);
+
+ALTER TABLE aion_api.event_outbox
+    ADD COLUMN IF NOT EXISTS aggregate_sequence BIGINT NOT NULL DEFAULT 0;
+
+WITH numbered AS (
+    SELECT id,
+           ROW_NUMBER() OVER (PARTITION BY aggregate_type, aggregate_id ORDER BY id ASC) AS sequence
+    FROM aion_api.event_outbox
+)
+UPDATE aion_api.event_outbox AS o
+SET aggregate_sequence = numbered.sequence
+FROM numbered
+WHERE o.id = numbered.id;
+
+INSERT INTO aion_api.event_outbox_aggregate_sequences (aggregate_type, aggregate_id, last_sequence)
+SELECT aggregate_type, aggregate_id, MAX(aggregate_sequence)
+FROM aion_api.event_outbox
+GROUP BY aggregate_type, aggregate_id
+ON CONFLICT (aggregate_type, aggregate_id) DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_aggregate_sequence
+    ON aion_api.event_outbox(aggregate_type, aggregate_id, aggregate_sequence ASC);
+
+COMMENT ON TABLE aion_api.event_outbox_aggregate_sequences IS 'Last outbox sequence number assigned per aggregate';
+COMMENT ON COLUMN aion_api.event_outbox.aggregate_sequence IS 'Monotonic per-aggregate sequence number, assigned in the enqueueing transaction';
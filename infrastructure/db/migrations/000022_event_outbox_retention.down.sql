@@ -0,0 +1 @@
+DROP INDEX IF EXISTS aion_api.idx_event_outbox_published_at;
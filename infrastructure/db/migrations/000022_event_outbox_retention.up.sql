@@ -0,0 +1,6 @@
+-- Migration: 000022_event_outbox_retention
+-- Description: Index published outbox rows by publication time so the retention job can purge them in batches
+
+CREATE INDEX IF NOT EXISTS idx_event_outbox_published_at
+    ON aion_api.event_outbox(published_at_utc ASC, id ASC)
+    WHERE status = 'published';
@@ -0,0 +1,3 @@
+ALTER TABLE aion_api.event_outbox
+    DROP COLUMN IF EXISTS trace_state,
+    DROP COLUMN IF EXISTS trace_parent;
@@ -0,0 +1,6 @@
+-- Migration: 000023_event_outbox_trace_context
+-- Description: Keep the W3C trace context of the enqueueing span so publication continues the producer trace
+
+ALTER TABLE aion_api.event_outbox
+    ADD COLUMN IF NOT EXISTS trace_parent VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS trace_state VARCHAR(512) NOT NULL DEFAULT '';
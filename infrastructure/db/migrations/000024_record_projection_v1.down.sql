@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS aion_derived.idx_record_projection_v1_user_consumed;
+DROP INDEX IF EXISTS aion_derived.idx_record_projection_v1_user_event_time;
+DROP TABLE IF EXISTS aion_derived.record_projection_v1;
@@ -0,0 +1,37 @@
+-- Migration: 000024_record_projection_v1
+-- Description: Create the derived record projection read model so the built-in projector can run without aion-streams;
+-- IF NOT EXISTS keeps the migration a no-op where aion-streams already created the table
+
+CREATE SCHEMA IF NOT EXISTS aion_derived;
+
+CREATE TABLE IF NOT EXISTS aion_derived.record_projection_v1 (
+    record_id BIGINT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    tag_id BIGINT NOT NULL,
+    description TEXT,
+    event_time_utc TIMESTAMPTZ NOT NULL,
+    recorded_at_utc TIMESTAMPTZ,
+    status VARCHAR(32),
+    timezone VARCHAR(64),
+    duration_seconds INTEGER,
+    value DOUBLE PRECISION,
+    source VARCHAR(64),
+    last_event_id VARCHAR(64) NOT NULL,
+    last_event_type VARCHAR(128) NOT NULL,
+    last_event_version VARCHAR(16) NOT NULL,
+    last_trace_id VARCHAR(64),
+    last_request_id VARCHAR(128),
+    last_kafka_topic VARCHAR(255) NOT NULL,
+    last_kafka_partition INTEGER NOT NULL,
+    last_kafka_offset BIGINT NOT NULL,
+    last_consumed_at_utc TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    payload_json JSONB NOT NULL,
+    created_at_utc TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at_utc TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_record_projection_v1_user_event_time
+    ON aion_derived.record_projection_v1 (user_id, event_time_utc DESC, record_id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_record_projection_v1_user_consumed
+    ON aion_derived.record_projection_v1 (user_id, last_consumed_at_utc DESC, record_id DESC);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS aion_api.idx_records_trash_deleted_at;
+DROP INDEX IF EXISTS aion_api.idx_records_trash_user;
@@ -0,0 +1,10 @@
+-- Migration: 000025_record_trash
+-- Description: Index soft-deleted records so the trash can be paged per user and the purge job can delete them in batches
+
+CREATE INDEX IF NOT EXISTS idx_records_trash_user
+    ON aion_api.records(user_id, deleted_at DESC, id DESC)
+    WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_records_trash_deleted_at
+    ON aion_api.records(deleted_at ASC, id ASC)
+    WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS aion_api.idx_record_revisions_user_record;
+DROP TABLE IF EXISTS aion_api.record_revisions;
@@ -0,0 +1,20 @@
+-- Migration: 000026_record_revisions
+-- Description: Keep a field-level change log of record updates, soft deletes and restores;
+-- revisions go with their record when the trash purge hard-deletes it
+
+CREATE TABLE IF NOT EXISTS aion_api.record_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    record_id BIGINT NOT NULL REFERENCES aion_api.records(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    operation VARCHAR(16) NOT NULL,
+    old_values JSONB NOT NULL DEFAULT '{}'::jsonb,
+    new_values JSONB NOT NULL DEFAULT '{}'::jsonb,
+    actor_user_id BIGINT NOT NULL,
+    actor_service VARCHAR(64),
+    source VARCHAR(32) NOT NULL,
+    request_id VARCHAR(128),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_record_revisions_user_record
+    ON aion_api.record_revisions (user_id, record_id, created_at DESC, id DESC);
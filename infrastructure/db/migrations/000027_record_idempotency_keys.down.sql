@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS aion_api.ux_records_user_idempotency_key;
+
+ALTER TABLE aion_api.records
+    DROP COLUMN IF EXISTS idempotency_fingerprint,
+    DROP COLUMN IF EXISTS idempotency_key;
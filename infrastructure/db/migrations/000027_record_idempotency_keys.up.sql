@@ -0,0 +1,11 @@
+-- Migration: 000027_record_idempotency_keys
+-- Description: Store the client idempotency key of a record and a fingerprint of its create payload,
+-- so retried creates return the original record; keys stay bound while the record sits in the trash
+
+ALTER TABLE aion_api.records
+    ADD COLUMN IF NOT EXISTS idempotency_key VARCHAR(128),
+    ADD COLUMN IF NOT EXISTS idempotency_fingerprint CHAR(64);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_records_user_idempotency_key
+    ON aion_api.records(user_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
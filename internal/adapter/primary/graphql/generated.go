@@ -49,6 +49,7 @@ type Config struct {
 type ResolverRoot interface {
 	Mutation() MutationResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -109,6 +110,18 @@ type ComplexityRoot struct {
 		UserID        func(childComplexity int) int
 	}
 
+	CreateRecordResult struct {
+		Error  func(childComplexity int) int
+		Index  func(childComplexity int) int
+		Record func(childComplexity int) int
+	}
+
+	CreateRecordsPayload struct {
+		CreatedCount func(childComplexity int) int
+		FailedCount  func(childComplexity int) int
+		Results      func(childComplexity int) int
+	}
+
 	DashboardChecklist struct {
 		CompletedCount  func(childComplexity int) int
 		CompletionRatio func(childComplexity int) int
@@ -237,11 +250,14 @@ type ComplexityRoot struct {
 		CreateDashboardView     func(childComplexity int, input model.CreateDashboardViewInput) int
 		CreateMetricAndWidget   func(childComplexity int, input model.CreateMetricAndWidgetInput) int
 		CreateRecord            func(childComplexity int, input model.CreateRecordInput) int
+		CreateRecords           func(childComplexity int, input []*model.CreateRecordInput, mode *model.CreateRecordsMode) int
 		CreateTag               func(childComplexity int, input model.CreateTagInput) int
 		DeleteDashboardWidget   func(childComplexity int, input model.DeleteDashboardWidgetInput) int
 		DeleteGoalTemplate      func(childComplexity int, input model.DeleteGoalTemplateInput) int
 		Empty                   func(childComplexity int) int
 		ReorderDashboardWidgets func(childComplexity int, input model.ReorderDashboardWidgetsInput) int
+		RestoreRecord           func(childComplexity int, input model.RestoreRecordInput) int
+		RestoreRecords          func(childComplexity int, input model.RestoreRecordsInput) int
 		SetDefaultDashboardView func(childComplexity int, input model.SetDefaultDashboardViewInput) int
 		SoftDeleteAllRecords    func(childComplexity int) int
 		SoftDeleteCategory      func(childComplexity int, input model.DeleteCategoryInput) int
@@ -267,10 +283,12 @@ type ComplexityRoot struct {
 		DashboardView            func(childComplexity int, id string) int
 		DashboardViews           func(childComplexity int) int
 		DashboardWidgetCatalog   func(childComplexity int) int
+		DeletedRecords           func(childComplexity int, limit *int32, afterDeletedAt *string, afterID *string) int
 		Empty                    func(childComplexity int) int
 		InsightFeed              func(childComplexity int, window model.InsightWindow, limit *int32, date *string, timezone *string, categoryID *string, tagIds []string) int
 		MetricDefinitions        func(childComplexity int) int
 		RecordByID               func(childComplexity int, id string) int
+		RecordHistory            func(childComplexity int, id string, limit *int32) int
 		RecordProjectionByID     func(childComplexity int, id string) int
 		RecordProjections        func(childComplexity int, limit *int32, afterEventTime *string, afterID *string) int
 		RecordProjectionsLatest  func(childComplexity int, limit *int32) int
@@ -293,6 +311,7 @@ type ComplexityRoot struct {
 
 	Record struct {
 		CreatedAt       func(childComplexity int) int
+		DeletedAt       func(childComplexity int) int
 		Description     func(childComplexity int) int
 		DurationSeconds func(childComplexity int) int
 		EventTime       func(childComplexity int) int
@@ -307,6 +326,12 @@ type ComplexityRoot struct {
 		Value           func(childComplexity int) int
 	}
 
+	RecordFieldChange struct {
+		Field    func(childComplexity int) int
+		NewValue func(childComplexity int) int
+		OldValue func(childComplexity int) int
+	}
+
 	RecordProjection struct {
 		CreatedAtUtc       func(childComplexity int) int
 		Description        func(childComplexity int) int
@@ -333,6 +358,29 @@ type ComplexityRoot struct {
 		Value              func(childComplexity int) int
 	}
 
+	RecordProjectionChange struct {
+		Action         func(childComplexity int) int
+		EventID        func(childComplexity int) int
+		ProjectedAtUtc func(childComplexity int) int
+		RecordID       func(childComplexity int) int
+		RequestID      func(childComplexity int) int
+		SourceEventID  func(childComplexity int) int
+		TraceID        func(childComplexity int) int
+		UserID         func(childComplexity int) int
+	}
+
+	RecordRevision struct {
+		ActorService func(childComplexity int) int
+		ActorUserID  func(childComplexity int) int
+		Changes      func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Operation    func(childComplexity int) int
+		RecordID     func(childComplexity int) int
+		RequestID    func(childComplexity int) int
+		Source       func(childComplexity int) int
+	}
+
 	RecordStats struct {
 		AvgDurationSeconds   func(childComplexity int) int
 		AvgValue             func(childComplexity int) int
@@ -344,6 +392,11 @@ type ComplexityRoot struct {
 		TotalRecords         func(childComplexity int) int
 	}
 
+	Subscription struct {
+		Empty                   func(childComplexity int) int
+		RecordProjectionChanged func(childComplexity int, filter *model.RecordProjectionChangedFilter) int
+	}
+
 	Tag struct {
 		CategoryID  func(childComplexity int) int
 		CreatedAt   func(childComplexity int) int
@@ -378,9 +431,12 @@ type MutationResolver interface {
 	UpdateCategory(ctx context.Context, input model.UpdateCategoryInput) (*model.Category, error)
 	SoftDeleteCategory(ctx context.Context, input model.DeleteCategoryInput) (bool, error)
 	CreateRecord(ctx context.Context, input model.CreateRecordInput) (*model.Record, error)
+	CreateRecords(ctx context.Context, input []*model.CreateRecordInput, mode *model.CreateRecordsMode) (*model.CreateRecordsPayload, error)
 	UpdateRecord(ctx context.Context, input model.UpdateRecordInput) (*model.Record, error)
 	SoftDeleteRecord(ctx context.Context, input model.DeleteRecordInput) (bool, error)
 	SoftDeleteAllRecords(ctx context.Context) (bool, error)
+	RestoreRecord(ctx context.Context, input model.RestoreRecordInput) (*model.Record, error)
+	RestoreRecords(ctx context.Context, input model.RestoreRecordsInput) ([]*model.Record, error)
 	UpsertMetricDefinition(ctx context.Context, input model.UpsertMetricDefinitionInput) (*model.MetricDefinition, error)
 	UpsertGoalTemplate(ctx context.Context, input model.UpsertGoalTemplateInput) (*model.GoalTemplate, error)
 	DeleteGoalTemplate(ctx context.Context, input model.DeleteGoalTemplateInput) (bool, error)
@@ -407,6 +463,8 @@ type QueryResolver interface {
 	Records(ctx context.Context, limit *int32, afterEventTime *string, afterID *string) ([]*model.Record, error)
 	RecordProjections(ctx context.Context, limit *int32, afterEventTime *string, afterID *string) ([]*model.RecordProjection, error)
 	RecordsLatest(ctx context.Context, limit *int32) ([]*model.Record, error)
+	DeletedRecords(ctx context.Context, limit *int32, afterDeletedAt *string, afterID *string) ([]*model.Record, error)
+	RecordHistory(ctx context.Context, id string, limit *int32) ([]*model.RecordRevision, error)
 	RecordProjectionsLatest(ctx context.Context, limit *int32) ([]*model.RecordProjection, error)
 	RecordsByTag(ctx context.Context, tagID string, limit *int32) ([]*model.Record, error)
 	RecordsByCategory(ctx context.Context, categoryID string, limit *int32) ([]*model.Record, error)
@@ -429,6 +487,10 @@ type QueryResolver interface {
 	TagsByCategoryID(ctx context.Context, categoryID string) ([]*model.Tag, error)
 	UserStats(ctx context.Context) (*model.UserStats, error)
 }
+type SubscriptionResolver interface {
+	Empty(ctx context.Context) (<-chan *bool, error)
+	RecordProjectionChanged(ctx context.Context, filter *model.RecordProjectionChangedFilter) (<-chan *model.RecordProjectionChange, error)
+}
 
 type executableSchema struct {
 	schema     *ast.Schema
@@ -648,6 +710,44 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ChatMessage.UserID(childComplexity), true
 
+	case "CreateRecordResult.error":
+		if e.complexity.CreateRecordResult.Error == nil {
+			break
+		}
+
+		return e.complexity.CreateRecordResult.Error(childComplexity), true
+	case "CreateRecordResult.index":
+		if e.complexity.CreateRecordResult.Index == nil {
+			break
+		}
+
+		return e.complexity.CreateRecordResult.Index(childComplexity), true
+	case "CreateRecordResult.record":
+		if e.complexity.CreateRecordResult.Record == nil {
+			break
+		}
+
+		return e.complexity.CreateRecordResult.Record(childComplexity), true
+
+	case "CreateRecordsPayload.createdCount":
+		if e.complexity.CreateRecordsPayload.CreatedCount == nil {
+			break
+		}
+
+		return e.complexity.CreateRecordsPayload.CreatedCount(childComplexity), true
+	case "CreateRecordsPayload.failedCount":
+		if e.complexity.CreateRecordsPayload.FailedCount == nil {
+			break
+		}
+
+		return e.complexity.CreateRecordsPayload.FailedCount(childComplexity), true
+	case "CreateRecordsPayload.results":
+		if e.complexity.CreateRecordsPayload.Results == nil {
+			break
+		}
+
+		return e.complexity.CreateRecordsPayload.Results(childComplexity), true
+
 	case "DashboardChecklist.completedCount":
 		if e.complexity.DashboardChecklist.CompletedCount == nil {
 			break
@@ -1226,6 +1326,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.CreateRecord(childComplexity, args["input"].(model.CreateRecordInput)), true
+	case "Mutation.createRecords":
+		if e.complexity.Mutation.CreateRecords == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createRecords_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateRecords(childComplexity, args["input"].([]*model.CreateRecordInput), args["mode"].(*model.CreateRecordsMode)), true
 	case "Mutation.createTag":
 		if e.complexity.Mutation.CreateTag == nil {
 			break
@@ -1276,6 +1387,28 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.ReorderDashboardWidgets(childComplexity, args["input"].(model.ReorderDashboardWidgetsInput)), true
+	case "Mutation.restoreRecord":
+		if e.complexity.Mutation.RestoreRecord == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreRecord_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreRecord(childComplexity, args["input"].(model.RestoreRecordInput)), true
+	case "Mutation.restoreRecords":
+		if e.complexity.Mutation.RestoreRecords == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreRecords_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreRecords(childComplexity, args["input"].(model.RestoreRecordsInput)), true
 	case "Mutation.setDefaultDashboardView":
 		if e.complexity.Mutation.SetDefaultDashboardView == nil {
 			break
@@ -1494,6 +1627,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.DashboardWidgetCatalog(childComplexity), true
+	case "Query.deletedRecords":
+		if e.complexity.Query.DeletedRecords == nil {
+			break
+		}
+
+		args, err := ec.field_Query_deletedRecords_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DeletedRecords(childComplexity, args["limit"].(*int32), args["afterDeletedAt"].(*string), args["afterId"].(*string)), true
 	case "Query._empty":
 		if e.complexity.Query.Empty == nil {
 			break
@@ -1528,6 +1672,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.RecordByID(childComplexity, args["id"].(string)), true
+	case "Query.recordHistory":
+		if e.complexity.Query.RecordHistory == nil {
+			break
+		}
+
+		args, err := ec.field_Query_recordHistory_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RecordHistory(childComplexity, args["id"].(string), args["limit"].(*int32)), true
 	case "Query.recordProjectionById":
 		if e.complexity.Query.RecordProjectionByID == nil {
 			break
@@ -1723,6 +1878,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Record.CreatedAt(childComplexity), true
+	case "Record.deletedAt":
+		if e.complexity.Record.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Record.DeletedAt(childComplexity), true
 	case "Record.description":
 		if e.complexity.Record.Description == nil {
 			break
@@ -1796,6 +1957,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Record.Value(childComplexity), true
 
+	case "RecordFieldChange.field":
+		if e.complexity.RecordFieldChange.Field == nil {
+			break
+		}
+
+		return e.complexity.RecordFieldChange.Field(childComplexity), true
+	case "RecordFieldChange.newValue":
+		if e.complexity.RecordFieldChange.NewValue == nil {
+			break
+		}
+
+		return e.complexity.RecordFieldChange.NewValue(childComplexity), true
+	case "RecordFieldChange.oldValue":
+		if e.complexity.RecordFieldChange.OldValue == nil {
+			break
+		}
+
+		return e.complexity.RecordFieldChange.OldValue(childComplexity), true
+
 	case "RecordProjection.createdAtUTC":
 		if e.complexity.RecordProjection.CreatedAtUtc == nil {
 			break
@@ -1935,6 +2115,110 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.RecordProjection.Value(childComplexity), true
 
+	case "RecordProjectionChange.action":
+		if e.complexity.RecordProjectionChange.Action == nil {
+			break
+		}
+
+		return e.complexity.RecordProjectionChange.Action(childComplexity), true
+	case "RecordProjectionChange.eventId":
+		if e.complexity.RecordProjectionChange.EventID == nil {
+			break
+		}
+
+		return e.complexity.RecordProjectionChange.EventID(childComplexity), true
+	case "RecordProjectionChange.projectedAtUTC":
+		if e.complexity.RecordProjectionChange.ProjectedAtUtc == nil {
+			break
+		}
+
+		return e.complexity.RecordProjectionChange.ProjectedAtUtc(childComplexity), true
+	case "RecordProjectionChange.recordId":
+		if e.complexity.RecordProjectionChange.RecordID == nil {
+			break
+		}
+
+		return e.complexity.RecordProjectionChange.RecordID(childComplexity), true
+	case "RecordProjectionChange.requestId":
+		if e.complexity.RecordProjectionChange.RequestID == nil {
+			break
+		}
+
+		return e.complexity.RecordProjectionChange.RequestID(childComplexity), true
+	case "RecordProjectionChange.sourceEventId":
+		if e.complexity.RecordProjectionChange.SourceEventID == nil {
+			break
+		}
+
+		return e.complexity.RecordProjectionChange.SourceEventID(childComplexity), true
+	case "RecordProjectionChange.traceId":
+		if e.complexity.RecordProjectionChange.TraceID == nil {
+			break
+		}
+
+		return e.complexity.RecordProjectionChange.TraceID(childComplexity), true
+	case "RecordProjectionChange.userId":
+		if e.complexity.RecordProjectionChange.UserID == nil {
+			break
+		}
+
+		return e.complexity.RecordProjectionChange.UserID(childComplexity), true
+
+	case "RecordRevision.actorService":
+		if e.complexity.RecordRevision.ActorService == nil {
+			break
+		}
+
+		return e.complexity.RecordRevision.ActorService(childComplexity), true
+	case "RecordRevision.actorUserId":
+		if e.complexity.RecordRevision.ActorUserID == nil {
+			break
+		}
+
+		return e.complexity.RecordRevision.ActorUserID(childComplexity), true
+	case "RecordRevision.changes":
+		if e.complexity.RecordRevision.Changes == nil {
+			break
+		}
+
+		return e.complexity.RecordRevision.Changes(childComplexity), true
+	case "RecordRevision.createdAt":
+		if e.complexity.RecordRevision.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.RecordRevision.CreatedAt(childComplexity), true
+	case "RecordRevision.id":
+		if e.complexity.RecordRevision.ID == nil {
+			break
+		}
+
+		return e.complexity.RecordRevision.ID(childComplexity), true
+	case "RecordRevision.operation":
+		if e.complexity.RecordRevision.Operation == nil {
+			break
+		}
+
+		return e.complexity.RecordRevision.Operation(childComplexity), true
+	case "RecordRevision.recordId":
+		if e.complexity.RecordRevision.RecordID == nil {
+			break
+		}
+
+		return e.complexity.RecordRevision.RecordID(childComplexity), true
+	case "RecordRevision.requestId":
+		if e.complexity.RecordRevision.RequestID == nil {
+			break
+		}
+
+		return e.complexity.RecordRevision.RequestID(childComplexity), true
+	case "RecordRevision.source":
+		if e.complexity.RecordRevision.Source == nil {
+			break
+		}
+
+		return e.complexity.RecordRevision.Source(childComplexity), true
+
 	case "RecordStats.avgDurationSeconds":
 		if e.complexity.RecordStats.AvgDurationSeconds == nil {
 			break
@@ -1984,6 +2268,24 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.RecordStats.TotalRecords(childComplexity), true
 
+	case "Subscription._empty":
+		if e.complexity.Subscription.Empty == nil {
+			break
+		}
+
+		return e.complexity.Subscription.Empty(childComplexity), true
+	case "Subscription.recordProjectionChanged":
+		if e.complexity.Subscription.RecordProjectionChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_recordProjectionChanged_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.RecordProjectionChanged(childComplexity, args["filter"].(*model.RecordProjectionChangedFilter)), true
+
 	case "Tag.categoryId":
 		if e.complexity.Tag.CategoryID == nil {
 			break
@@ -2113,9 +2415,12 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputDeleteGoalTemplateInput,
 		ec.unmarshalInputDeleteRecordInput,
 		ec.unmarshalInputDeleteTagInput,
+		ec.unmarshalInputRecordProjectionChangedFilter,
 		ec.unmarshalInputRecordStatsFilters,
 		ec.unmarshalInputReorderDashboardWidgetItemInput,
 		ec.unmarshalInputReorderDashboardWidgetsInput,
+		ec.unmarshalInputRestoreRecordInput,
+		ec.unmarshalInputRestoreRecordsInput,
 		ec.unmarshalInputSearchFilters,
 		ec.unmarshalInputSetDefaultDashboardViewInput,
 		ec.unmarshalInputUpdateCategoryInput,
@@ -2169,6 +2474,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -2220,7 +2542,7 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
 }
 
-//go:embed "schema/modules/category.graphqls" "schema/modules/chat.graphqls" "schema/modules/record.graphqls" "schema/modules/tags.graphqls" "schema/modules/user.graphqls" "schema/root.graphqls"
+//go:embed "schema/modules/category.graphqls" "schema/modules/chat.graphqls" "schema/modules/realtime.graphqls" "schema/modules/record.graphqls" "schema/modules/tags.graphqls" "schema/modules/user.graphqls" "schema/root.graphqls"
 var sourcesFS embed.FS
 
 func sourceData(filename string) string {
@@ -2234,6 +2556,7 @@ func sourceData(filename string) string {
 var sources = []*ast.Source{
 	{Name: "schema/modules/category.graphqls", Input: sourceData("schema/modules/category.graphqls"), BuiltIn: false},
 	{Name: "schema/modules/chat.graphqls", Input: sourceData("schema/modules/chat.graphqls"), BuiltIn: false},
+	{Name: "schema/modules/realtime.graphqls", Input: sourceData("schema/modules/realtime.graphqls"), BuiltIn: false},
 	{Name: "schema/modules/record.graphqls", Input: sourceData("schema/modules/record.graphqls"), BuiltIn: false},
 	{Name: "schema/modules/tags.graphqls", Input: sourceData("schema/modules/tags.graphqls"), BuiltIn: false},
 	{Name: "schema/modules/user.graphqls", Input: sourceData("schema/modules/user.graphqls"), BuiltIn: false},
@@ -2300,6 +2623,22 @@ func (ec *executionContext) field_Mutation_createRecord_args(ctx context.Context
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_createRecords_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateRecordInput2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordInputᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "mode", ec.unmarshalOCreateRecordsMode2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordsMode)
+	if err != nil {
+		return nil, err
+	}
+	args["mode"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createTag_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -2344,6 +2683,28 @@ func (ec *executionContext) field_Mutation_reorderDashboardWidgets_args(ctx cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_restoreRecord_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRestoreRecordInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRestoreRecordInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_restoreRecords_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRestoreRecordsInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRestoreRecordsInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_setDefaultDashboardView_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -2582,6 +2943,27 @@ func (ec *executionContext) field_Query_dashboardView_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_deletedRecords_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "afterDeletedAt", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["afterDeletedAt"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "afterId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["afterId"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_insightFeed_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -2629,6 +3011,22 @@ func (ec *executionContext) field_Query_recordById_args(ctx context.Context, raw
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_recordHistory_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_recordProjectionById_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -2850,6 +3248,17 @@ func (ec *executionContext) field_Query_tagsByCategoryId_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_recordProjectionChanged_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalORecordProjectionChangedFilter2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionChangedFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -3648,6 +4057,8 @@ func (ec *executionContext) fieldContext_ChatDataPack_recentRecords(_ context.Co
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -3932,68 +4343,280 @@ func (ec *executionContext) fieldContext_ChatMessage_updatedAt(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _DashboardChecklist_metricKey(ctx context.Context, field graphql.CollectedField, obj *model.DashboardChecklist) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateRecordResult_index(ctx context.Context, field graphql.CollectedField, obj *model.CreateRecordResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DashboardChecklist_metricKey,
+		ec.fieldContext_CreateRecordResult_index,
 		func(ctx context.Context) (any, error) {
-			return obj.MetricKey, nil
+			return obj.Index, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_DashboardChecklist_metricKey(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateRecordResult_index(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DashboardChecklist",
+		Object:     "CreateRecordResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DashboardChecklist_label(ctx context.Context, field graphql.CollectedField, obj *model.DashboardChecklist) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateRecordResult_record(ctx context.Context, field graphql.CollectedField, obj *model.CreateRecordResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DashboardChecklist_label,
+		ec.fieldContext_CreateRecordResult_record,
 		func(ctx context.Context) (any, error) {
-			return obj.Label, nil
+			return obj.Record, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalORecord2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecord,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DashboardChecklist_label(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateRecordResult_record(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DashboardChecklist",
+		Object:     "CreateRecordResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _DashboardChecklist_completedCount(ctx context.Context, field graphql.CollectedField, obj *model.DashboardChecklist) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Record_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Record_userId(ctx, field)
+			case "tagId":
+				return ec.fieldContext_Record_tagId(ctx, field)
+			case "description":
+				return ec.fieldContext_Record_description(ctx, field)
+			case "eventTime":
+				return ec.fieldContext_Record_eventTime(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Record_recordedAt(ctx, field)
+			case "durationSeconds":
+				return ec.fieldContext_Record_durationSeconds(ctx, field)
+			case "value":
+				return ec.fieldContext_Record_value(ctx, field)
+			case "source":
+				return ec.fieldContext_Record_source(ctx, field)
+			case "timezone":
+				return ec.fieldContext_Record_timezone(ctx, field)
+			case "status":
+				return ec.fieldContext_Record_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Record_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreateRecordResult_error(ctx context.Context, field graphql.CollectedField, obj *model.CreateRecordResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreateRecordResult_error,
+		func(ctx context.Context) (any, error) {
+			return obj.Error, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreateRecordResult_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreateRecordResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreateRecordsPayload_results(ctx context.Context, field graphql.CollectedField, obj *model.CreateRecordsPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreateRecordsPayload_results,
+		func(ctx context.Context) (any, error) {
+			return obj.Results, nil
+		},
+		nil,
+		ec.marshalNCreateRecordResult2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordResultᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreateRecordsPayload_results(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreateRecordsPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "index":
+				return ec.fieldContext_CreateRecordResult_index(ctx, field)
+			case "record":
+				return ec.fieldContext_CreateRecordResult_record(ctx, field)
+			case "error":
+				return ec.fieldContext_CreateRecordResult_error(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CreateRecordResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreateRecordsPayload_createdCount(ctx context.Context, field graphql.CollectedField, obj *model.CreateRecordsPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreateRecordsPayload_createdCount,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedCount, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreateRecordsPayload_createdCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreateRecordsPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreateRecordsPayload_failedCount(ctx context.Context, field graphql.CollectedField, obj *model.CreateRecordsPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreateRecordsPayload_failedCount,
+		func(ctx context.Context) (any, error) {
+			return obj.FailedCount, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreateRecordsPayload_failedCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreateRecordsPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DashboardChecklist_metricKey(ctx context.Context, field graphql.CollectedField, obj *model.DashboardChecklist) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DashboardChecklist_metricKey,
+		func(ctx context.Context) (any, error) {
+			return obj.MetricKey, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DashboardChecklist_metricKey(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DashboardChecklist",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DashboardChecklist_label(ctx context.Context, field graphql.CollectedField, obj *model.DashboardChecklist) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DashboardChecklist_label,
+		func(ctx context.Context) (any, error) {
+			return obj.Label, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DashboardChecklist_label(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DashboardChecklist",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DashboardChecklist_completedCount(ctx context.Context, field graphql.CollectedField, obj *model.DashboardChecklist) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
 		field,
 		ec.fieldContext_DashboardChecklist_completedCount,
 		func(ctx context.Context) (any, error) {
@@ -6842,6 +7465,8 @@ func (ec *executionContext) fieldContext_Mutation_createRecord(ctx context.Conte
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -6860,6 +7485,73 @@ func (ec *executionContext) fieldContext_Mutation_createRecord(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_createRecords(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createRecords,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateRecords(ctx, fc.Args["input"].([]*model.CreateRecordInput), fc.Args["mode"].(*model.CreateRecordsMode))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalOString2ᚖstring(ctx, "user")
+				if err != nil {
+					var zeroVal *model.CreateRecordsPayload
+					return zeroVal, err
+				}
+				if ec.directives.Auth == nil {
+					var zeroVal *model.CreateRecordsPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNCreateRecordsPayload2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordsPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createRecords(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "results":
+				return ec.fieldContext_CreateRecordsPayload_results(ctx, field)
+			case "createdCount":
+				return ec.fieldContext_CreateRecordsPayload_createdCount(ctx, field)
+			case "failedCount":
+				return ec.fieldContext_CreateRecordsPayload_failedCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CreateRecordsPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createRecords_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_updateRecord(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -6929,6 +7621,8 @@ func (ec *executionContext) fieldContext_Mutation_updateRecord(ctx context.Conte
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -7053,15 +7747,15 @@ func (ec *executionContext) fieldContext_Mutation_softDeleteAllRecords(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_upsertMetricDefinition(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_restoreRecord(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_upsertMetricDefinition,
+		ec.fieldContext_Mutation_restoreRecord,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpsertMetricDefinition(ctx, fc.Args["input"].(model.UpsertMetricDefinitionInput))
+			return ec.resolvers.Mutation().RestoreRecord(ctx, fc.Args["input"].(model.RestoreRecordInput))
 		},
 		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
 			directive0 := next
@@ -7069,11 +7763,11 @@ func (ec *executionContext) _Mutation_upsertMetricDefinition(ctx context.Context
 			directive1 := func(ctx context.Context) (any, error) {
 				roles, err := ec.unmarshalOString2ᚖstring(ctx, "user")
 				if err != nil {
-					var zeroVal *model.MetricDefinition
+					var zeroVal *model.Record
 					return zeroVal, err
 				}
 				if ec.directives.Auth == nil {
-					var zeroVal *model.MetricDefinition
+					var zeroVal *model.Record
 					return zeroVal, errors.New("directive auth is not implemented")
 				}
 				return ec.directives.Auth(ctx, nil, directive0, roles)
@@ -7082,13 +7776,13 @@ func (ec *executionContext) _Mutation_upsertMetricDefinition(ctx context.Context
 			next = directive1
 			return next
 		},
-		ec.marshalNMetricDefinition2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐMetricDefinition,
+		ec.marshalNRecord2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecord,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_upsertMetricDefinition(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_restoreRecord(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
@@ -7097,8 +7791,186 @@ func (ec *executionContext) fieldContext_Mutation_upsertMetricDefinition(ctx con
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_MetricDefinition_id(ctx, field)
-			case "metricKey":
+				return ec.fieldContext_Record_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Record_userId(ctx, field)
+			case "tagId":
+				return ec.fieldContext_Record_tagId(ctx, field)
+			case "description":
+				return ec.fieldContext_Record_description(ctx, field)
+			case "eventTime":
+				return ec.fieldContext_Record_eventTime(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Record_recordedAt(ctx, field)
+			case "durationSeconds":
+				return ec.fieldContext_Record_durationSeconds(ctx, field)
+			case "value":
+				return ec.fieldContext_Record_value(ctx, field)
+			case "source":
+				return ec.fieldContext_Record_source(ctx, field)
+			case "timezone":
+				return ec.fieldContext_Record_timezone(ctx, field)
+			case "status":
+				return ec.fieldContext_Record_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Record_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_restoreRecord_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_restoreRecords(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_restoreRecords,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RestoreRecords(ctx, fc.Args["input"].(model.RestoreRecordsInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalOString2ᚖstring(ctx, "user")
+				if err != nil {
+					var zeroVal []*model.Record
+					return zeroVal, err
+				}
+				if ec.directives.Auth == nil {
+					var zeroVal []*model.Record
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNRecord2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_restoreRecords(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Record_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Record_userId(ctx, field)
+			case "tagId":
+				return ec.fieldContext_Record_tagId(ctx, field)
+			case "description":
+				return ec.fieldContext_Record_description(ctx, field)
+			case "eventTime":
+				return ec.fieldContext_Record_eventTime(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Record_recordedAt(ctx, field)
+			case "durationSeconds":
+				return ec.fieldContext_Record_durationSeconds(ctx, field)
+			case "value":
+				return ec.fieldContext_Record_value(ctx, field)
+			case "source":
+				return ec.fieldContext_Record_source(ctx, field)
+			case "timezone":
+				return ec.fieldContext_Record_timezone(ctx, field)
+			case "status":
+				return ec.fieldContext_Record_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Record_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_restoreRecords_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_upsertMetricDefinition(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_upsertMetricDefinition,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpsertMetricDefinition(ctx, fc.Args["input"].(model.UpsertMetricDefinitionInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalOString2ᚖstring(ctx, "user")
+				if err != nil {
+					var zeroVal *model.MetricDefinition
+					return zeroVal, err
+				}
+				if ec.directives.Auth == nil {
+					var zeroVal *model.MetricDefinition
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNMetricDefinition2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐMetricDefinition,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_upsertMetricDefinition(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_MetricDefinition_id(ctx, field)
+			case "metricKey":
 				return ec.fieldContext_MetricDefinition_metricKey(ctx, field)
 			case "displayName":
 				return ec.fieldContext_MetricDefinition_displayName(ctx, field)
@@ -8445,6 +9317,8 @@ func (ec *executionContext) fieldContext_Query_recordById(ctx context.Context, f
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -8639,6 +9513,8 @@ func (ec *executionContext) fieldContext_Query_records(ctx context.Context, fiel
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -8833,6 +9709,8 @@ func (ec *executionContext) fieldContext_Query_recordsLatest(ctx context.Context
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -8851,15 +9729,15 @@ func (ec *executionContext) fieldContext_Query_recordsLatest(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_recordProjectionsLatest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_deletedRecords(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_recordProjectionsLatest,
+		ec.fieldContext_Query_deletedRecords,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().RecordProjectionsLatest(ctx, fc.Args["limit"].(*int32))
+			return ec.resolvers.Query().DeletedRecords(ctx, fc.Args["limit"].(*int32), fc.Args["afterDeletedAt"].(*string), fc.Args["afterId"].(*string))
 		},
 		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
 			directive0 := next
@@ -8867,11 +9745,11 @@ func (ec *executionContext) _Query_recordProjectionsLatest(ctx context.Context,
 			directive1 := func(ctx context.Context) (any, error) {
 				roles, err := ec.unmarshalOString2ᚖstring(ctx, "user")
 				if err != nil {
-					var zeroVal []*model.RecordProjection
+					var zeroVal []*model.Record
 					return zeroVal, err
 				}
 				if ec.directives.Auth == nil {
-					var zeroVal []*model.RecordProjection
+					var zeroVal []*model.Record
 					return zeroVal, errors.New("directive auth is not implemented")
 				}
 				return ec.directives.Auth(ctx, nil, directive0, roles)
@@ -8880,13 +9758,13 @@ func (ec *executionContext) _Query_recordProjectionsLatest(ctx context.Context,
 			next = directive1
 			return next
 		},
-		ec.marshalNRecordProjection2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionᚄ,
+		ec.marshalNRecord2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_recordProjectionsLatest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_deletedRecords(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -8894,54 +9772,36 @@ func (ec *executionContext) fieldContext_Query_recordProjectionsLatest(ctx conte
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "recordId":
-				return ec.fieldContext_RecordProjection_recordId(ctx, field)
+			case "id":
+				return ec.fieldContext_Record_id(ctx, field)
 			case "userId":
-				return ec.fieldContext_RecordProjection_userId(ctx, field)
+				return ec.fieldContext_Record_userId(ctx, field)
 			case "tagId":
-				return ec.fieldContext_RecordProjection_tagId(ctx, field)
+				return ec.fieldContext_Record_tagId(ctx, field)
 			case "description":
-				return ec.fieldContext_RecordProjection_description(ctx, field)
-			case "eventTimeUTC":
-				return ec.fieldContext_RecordProjection_eventTimeUTC(ctx, field)
-			case "recordedAtUTC":
-				return ec.fieldContext_RecordProjection_recordedAtUTC(ctx, field)
-			case "status":
-				return ec.fieldContext_RecordProjection_status(ctx, field)
-			case "timezone":
-				return ec.fieldContext_RecordProjection_timezone(ctx, field)
+				return ec.fieldContext_Record_description(ctx, field)
+			case "eventTime":
+				return ec.fieldContext_Record_eventTime(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Record_recordedAt(ctx, field)
 			case "durationSeconds":
-				return ec.fieldContext_RecordProjection_durationSeconds(ctx, field)
+				return ec.fieldContext_Record_durationSeconds(ctx, field)
 			case "value":
-				return ec.fieldContext_RecordProjection_value(ctx, field)
+				return ec.fieldContext_Record_value(ctx, field)
 			case "source":
-				return ec.fieldContext_RecordProjection_source(ctx, field)
-			case "lastEventId":
-				return ec.fieldContext_RecordProjection_lastEventId(ctx, field)
-			case "lastEventType":
-				return ec.fieldContext_RecordProjection_lastEventType(ctx, field)
-			case "lastEventVersion":
-				return ec.fieldContext_RecordProjection_lastEventVersion(ctx, field)
-			case "lastTraceId":
-				return ec.fieldContext_RecordProjection_lastTraceId(ctx, field)
-			case "lastRequestId":
-				return ec.fieldContext_RecordProjection_lastRequestId(ctx, field)
-			case "lastKafkaTopic":
-				return ec.fieldContext_RecordProjection_lastKafkaTopic(ctx, field)
-			case "lastKafkaPartition":
-				return ec.fieldContext_RecordProjection_lastKafkaPartition(ctx, field)
-			case "lastKafkaOffset":
-				return ec.fieldContext_RecordProjection_lastKafkaOffset(ctx, field)
-			case "lastConsumedAtUTC":
-				return ec.fieldContext_RecordProjection_lastConsumedAtUTC(ctx, field)
-			case "payloadJSON":
-				return ec.fieldContext_RecordProjection_payloadJSON(ctx, field)
-			case "createdAtUTC":
-				return ec.fieldContext_RecordProjection_createdAtUTC(ctx, field)
-			case "updatedAtUTC":
-				return ec.fieldContext_RecordProjection_updatedAtUTC(ctx, field)
+				return ec.fieldContext_Record_source(ctx, field)
+			case "timezone":
+				return ec.fieldContext_Record_timezone(ctx, field)
+			case "status":
+				return ec.fieldContext_Record_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Record_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RecordProjection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
 	}
 	defer func() {
@@ -8951,22 +9811,22 @@ func (ec *executionContext) fieldContext_Query_recordProjectionsLatest(ctx conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_recordProjectionsLatest_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_deletedRecords_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_recordsByTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_recordHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_recordsByTag,
+		ec.fieldContext_Query_recordHistory,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().RecordsByTag(ctx, fc.Args["tagId"].(string), fc.Args["limit"].(*int32))
+			return ec.resolvers.Query().RecordHistory(ctx, fc.Args["id"].(string), fc.Args["limit"].(*int32))
 		},
 		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
 			directive0 := next
@@ -8974,11 +9834,11 @@ func (ec *executionContext) _Query_recordsByTag(ctx context.Context, field graph
 			directive1 := func(ctx context.Context) (any, error) {
 				roles, err := ec.unmarshalOString2ᚖstring(ctx, "user")
 				if err != nil {
-					var zeroVal []*model.Record
+					var zeroVal []*model.RecordRevision
 					return zeroVal, err
 				}
 				if ec.directives.Auth == nil {
-					var zeroVal []*model.Record
+					var zeroVal []*model.RecordRevision
 					return zeroVal, errors.New("directive auth is not implemented")
 				}
 				return ec.directives.Auth(ctx, nil, directive0, roles)
@@ -8987,13 +9847,13 @@ func (ec *executionContext) _Query_recordsByTag(ctx context.Context, field graph
 			next = directive1
 			return next
 		},
-		ec.marshalNRecord2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordᚄ,
+		ec.marshalNRecordRevision2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordRevisionᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_recordsByTag(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_recordHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -9002,12 +9862,198 @@ func (ec *executionContext) fieldContext_Query_recordsByTag(ctx context.Context,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Record_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_Record_userId(ctx, field)
-			case "tagId":
-				return ec.fieldContext_Record_tagId(ctx, field)
-			case "description":
+				return ec.fieldContext_RecordRevision_id(ctx, field)
+			case "recordId":
+				return ec.fieldContext_RecordRevision_recordId(ctx, field)
+			case "operation":
+				return ec.fieldContext_RecordRevision_operation(ctx, field)
+			case "changes":
+				return ec.fieldContext_RecordRevision_changes(ctx, field)
+			case "actorUserId":
+				return ec.fieldContext_RecordRevision_actorUserId(ctx, field)
+			case "actorService":
+				return ec.fieldContext_RecordRevision_actorService(ctx, field)
+			case "source":
+				return ec.fieldContext_RecordRevision_source(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RecordRevision_requestId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RecordRevision_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecordRevision", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_recordHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_recordProjectionsLatest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_recordProjectionsLatest,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().RecordProjectionsLatest(ctx, fc.Args["limit"].(*int32))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalOString2ᚖstring(ctx, "user")
+				if err != nil {
+					var zeroVal []*model.RecordProjection
+					return zeroVal, err
+				}
+				if ec.directives.Auth == nil {
+					var zeroVal []*model.RecordProjection
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNRecordProjection2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_recordProjectionsLatest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "recordId":
+				return ec.fieldContext_RecordProjection_recordId(ctx, field)
+			case "userId":
+				return ec.fieldContext_RecordProjection_userId(ctx, field)
+			case "tagId":
+				return ec.fieldContext_RecordProjection_tagId(ctx, field)
+			case "description":
+				return ec.fieldContext_RecordProjection_description(ctx, field)
+			case "eventTimeUTC":
+				return ec.fieldContext_RecordProjection_eventTimeUTC(ctx, field)
+			case "recordedAtUTC":
+				return ec.fieldContext_RecordProjection_recordedAtUTC(ctx, field)
+			case "status":
+				return ec.fieldContext_RecordProjection_status(ctx, field)
+			case "timezone":
+				return ec.fieldContext_RecordProjection_timezone(ctx, field)
+			case "durationSeconds":
+				return ec.fieldContext_RecordProjection_durationSeconds(ctx, field)
+			case "value":
+				return ec.fieldContext_RecordProjection_value(ctx, field)
+			case "source":
+				return ec.fieldContext_RecordProjection_source(ctx, field)
+			case "lastEventId":
+				return ec.fieldContext_RecordProjection_lastEventId(ctx, field)
+			case "lastEventType":
+				return ec.fieldContext_RecordProjection_lastEventType(ctx, field)
+			case "lastEventVersion":
+				return ec.fieldContext_RecordProjection_lastEventVersion(ctx, field)
+			case "lastTraceId":
+				return ec.fieldContext_RecordProjection_lastTraceId(ctx, field)
+			case "lastRequestId":
+				return ec.fieldContext_RecordProjection_lastRequestId(ctx, field)
+			case "lastKafkaTopic":
+				return ec.fieldContext_RecordProjection_lastKafkaTopic(ctx, field)
+			case "lastKafkaPartition":
+				return ec.fieldContext_RecordProjection_lastKafkaPartition(ctx, field)
+			case "lastKafkaOffset":
+				return ec.fieldContext_RecordProjection_lastKafkaOffset(ctx, field)
+			case "lastConsumedAtUTC":
+				return ec.fieldContext_RecordProjection_lastConsumedAtUTC(ctx, field)
+			case "payloadJSON":
+				return ec.fieldContext_RecordProjection_payloadJSON(ctx, field)
+			case "createdAtUTC":
+				return ec.fieldContext_RecordProjection_createdAtUTC(ctx, field)
+			case "updatedAtUTC":
+				return ec.fieldContext_RecordProjection_updatedAtUTC(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecordProjection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_recordProjectionsLatest_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_recordsByTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_recordsByTag,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().RecordsByTag(ctx, fc.Args["tagId"].(string), fc.Args["limit"].(*int32))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalOString2ᚖstring(ctx, "user")
+				if err != nil {
+					var zeroVal []*model.Record
+					return zeroVal, err
+				}
+				if ec.directives.Auth == nil {
+					var zeroVal []*model.Record
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNRecord2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_recordsByTag(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Record_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Record_userId(ctx, field)
+			case "tagId":
+				return ec.fieldContext_Record_tagId(ctx, field)
+			case "description":
 				return ec.fieldContext_Record_description(ctx, field)
 			case "eventTime":
 				return ec.fieldContext_Record_eventTime(ctx, field)
@@ -9027,6 +10073,8 @@ func (ec *executionContext) fieldContext_Query_recordsByTag(ctx context.Context,
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -9114,6 +10162,8 @@ func (ec *executionContext) fieldContext_Query_recordsByCategory(ctx context.Con
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -9201,6 +10251,8 @@ func (ec *executionContext) fieldContext_Query_recordsByDay(ctx context.Context,
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -9288,6 +10340,8 @@ func (ec *executionContext) fieldContext_Query_recordsUntil(ctx context.Context,
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -9375,6 +10429,8 @@ func (ec *executionContext) fieldContext_Query_recordsBetween(ctx context.Contex
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -9462,6 +10518,8 @@ func (ec *executionContext) fieldContext_Query_searchRecords(ctx context.Context
 				return ec.fieldContext_Record_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Record_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_Record_deletedAt(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -10959,101 +12017,101 @@ func (ec *executionContext) fieldContext_Record_updatedAt(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordProjection_recordId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+func (ec *executionContext) _Record_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.Record) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordProjection_recordId,
+		ec.fieldContext_Record_deletedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.RecordID, nil
+			return obj.DeletedAt, nil
 		},
 		nil,
-		ec.marshalNID2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordProjection_recordId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Record_deletedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordProjection",
+		Object:     "Record",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordProjection_userId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordFieldChange_field(ctx context.Context, field graphql.CollectedField, obj *model.RecordFieldChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordProjection_userId,
+		ec.fieldContext_RecordFieldChange_field,
 		func(ctx context.Context) (any, error) {
-			return obj.UserID, nil
+			return obj.Field, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordProjection_userId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordFieldChange_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordProjection",
+		Object:     "RecordFieldChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordProjection_tagId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordFieldChange_oldValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordFieldChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordProjection_tagId,
+		ec.fieldContext_RecordFieldChange_oldValue,
 		func(ctx context.Context) (any, error) {
-			return obj.TagID, nil
+			return obj.OldValue, nil
 		},
 		nil,
-		ec.marshalNID2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordProjection_tagId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordFieldChange_oldValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordProjection",
+		Object:     "RecordFieldChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordProjection_description(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordFieldChange_newValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordFieldChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordProjection_description,
+		ec.fieldContext_RecordFieldChange_newValue,
 		func(ctx context.Context) (any, error) {
-			return obj.Description, nil
+			return obj.NewValue, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -11062,9 +12120,9 @@ func (ec *executionContext) _RecordProjection_description(ctx context.Context, f
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordProjection_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordFieldChange_newValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordProjection",
+		Object:     "RecordFieldChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11075,99 +12133,215 @@ func (ec *executionContext) fieldContext_RecordProjection_description(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordProjection_eventTimeUTC(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjection_recordId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordProjection_eventTimeUTC,
+		ec.fieldContext_RecordProjection_recordId,
 		func(ctx context.Context) (any, error) {
-			return obj.EventTimeUtc, nil
+			return obj.RecordID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordProjection_eventTimeUTC(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordProjection_recordId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "RecordProjection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordProjection_recordedAtUTC(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjection_userId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordProjection_recordedAtUTC,
+		ec.fieldContext_RecordProjection_userId,
 		func(ctx context.Context) (any, error) {
-			return obj.RecordedAtUtc, nil
+			return obj.UserID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordProjection_recordedAtUTC(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordProjection_userId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "RecordProjection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordProjection_status(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjection_tagId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordProjection_status,
+		ec.fieldContext_RecordProjection_tagId,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.TagID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordProjection_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordProjection_tagId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "RecordProjection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordProjection_timezone(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjection_description(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordProjection_timezone,
+		ec.fieldContext_RecordProjection_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordProjection_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordProjection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordProjection_eventTimeUTC(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordProjection_eventTimeUTC,
+		func(ctx context.Context) (any, error) {
+			return obj.EventTimeUtc, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordProjection_eventTimeUTC(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordProjection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordProjection_recordedAtUTC(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordProjection_recordedAtUTC,
+		func(ctx context.Context) (any, error) {
+			return obj.RecordedAtUtc, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordProjection_recordedAtUTC(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordProjection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordProjection_status(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordProjection_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordProjection_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordProjection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordProjection_timezone(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordProjection_timezone,
 		func(ctx context.Context) (any, error) {
 			return obj.Timezone, nil
 		},
@@ -11626,235 +12800,842 @@ func (ec *executionContext) fieldContext_RecordProjection_updatedAtUTC(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordStats_totalRecords(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjectionChange_eventId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjectionChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordStats_totalRecords,
+		ec.fieldContext_RecordProjectionChange_eventId,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalRecords, nil
+			return obj.EventID, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordStats_totalRecords(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordProjectionChange_eventId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordStats",
+		Object:     "RecordProjectionChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordStats_recordsWithValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjectionChange_recordId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjectionChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordStats_recordsWithValue,
+		ec.fieldContext_RecordProjectionChange_recordId,
 		func(ctx context.Context) (any, error) {
-			return obj.RecordsWithValue, nil
+			return obj.RecordID, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordStats_recordsWithValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordProjectionChange_recordId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordStats",
+		Object:     "RecordProjectionChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordStats_totalDurationSeconds(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjectionChange_userId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjectionChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordStats_totalDurationSeconds,
+		ec.fieldContext_RecordProjectionChange_userId,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalDurationSeconds, nil
+			return obj.UserID, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordStats_totalDurationSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordProjectionChange_userId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordStats",
+		Object:     "RecordProjectionChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordStats_sumValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjectionChange_action(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjectionChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordStats_sumValue,
+		ec.fieldContext_RecordProjectionChange_action,
 		func(ctx context.Context) (any, error) {
-			return obj.SumValue, nil
+			return obj.Action, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalNRecordProjectionAction2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionAction,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordStats_sumValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordProjectionChange_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordStats",
+		Object:     "RecordProjectionChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type RecordProjectionAction does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordStats_avgValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjectionChange_projectedAtUTC(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjectionChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordStats_avgValue,
+		ec.fieldContext_RecordProjectionChange_projectedAtUTC,
 		func(ctx context.Context) (any, error) {
-			return obj.AvgValue, nil
+			return obj.ProjectedAtUtc, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordStats_avgValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordProjectionChange_projectedAtUTC(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordStats",
+		Object:     "RecordProjectionChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordStats_avgDurationSeconds(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjectionChange_sourceEventId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjectionChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordStats_avgDurationSeconds,
+		ec.fieldContext_RecordProjectionChange_sourceEventId,
 		func(ctx context.Context) (any, error) {
-			return obj.AvgDurationSeconds, nil
+			return obj.SourceEventID, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordProjectionChange_sourceEventId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordProjectionChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordProjectionChange_traceId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjectionChange) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordProjectionChange_traceId,
+		func(ctx context.Context) (any, error) {
+			return obj.TraceID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordStats_avgDurationSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordProjectionChange_traceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordStats",
+		Object:     "RecordProjectionChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordStats_minValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _RecordProjectionChange_requestId(ctx context.Context, field graphql.CollectedField, obj *model.RecordProjectionChange) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordStats_minValue,
+		ec.fieldContext_RecordProjectionChange_requestId,
 		func(ctx context.Context) (any, error) {
-			return obj.MinValue, nil
+			return obj.RequestID, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordStats_minValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecordProjectionChange_requestId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordStats",
+		Object:     "RecordProjectionChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordRevision_id(ctx context.Context, field graphql.CollectedField, obj *model.RecordRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordRevision_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordRevision_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordRevision",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordRevision_recordId(ctx context.Context, field graphql.CollectedField, obj *model.RecordRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordRevision_recordId,
+		func(ctx context.Context) (any, error) {
+			return obj.RecordID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordRevision_recordId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordRevision",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordRevision_operation(ctx context.Context, field graphql.CollectedField, obj *model.RecordRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordRevision_operation,
+		func(ctx context.Context) (any, error) {
+			return obj.Operation, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordRevision_operation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordRevision",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordRevision_changes(ctx context.Context, field graphql.CollectedField, obj *model.RecordRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordRevision_changes,
+		func(ctx context.Context) (any, error) {
+			return obj.Changes, nil
+		},
+		nil,
+		ec.marshalNRecordFieldChange2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordFieldChangeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordRevision_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordRevision",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_RecordFieldChange_field(ctx, field)
+			case "oldValue":
+				return ec.fieldContext_RecordFieldChange_oldValue(ctx, field)
+			case "newValue":
+				return ec.fieldContext_RecordFieldChange_newValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecordFieldChange", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordRevision_actorUserId(ctx context.Context, field graphql.CollectedField, obj *model.RecordRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordRevision_actorUserId,
+		func(ctx context.Context) (any, error) {
+			return obj.ActorUserID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordRevision_actorUserId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordRevision",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordRevision_actorService(ctx context.Context, field graphql.CollectedField, obj *model.RecordRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordRevision_actorService,
+		func(ctx context.Context) (any, error) {
+			return obj.ActorService, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordRevision_actorService(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordRevision",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordRevision_source(ctx context.Context, field graphql.CollectedField, obj *model.RecordRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordRevision_source,
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordRevision_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordRevision",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordRevision_requestId(ctx context.Context, field graphql.CollectedField, obj *model.RecordRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordRevision_requestId,
+		func(ctx context.Context) (any, error) {
+			return obj.RequestID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordRevision_requestId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordRevision",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordRevision_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RecordRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordRevision_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordRevision_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordRevision",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordStats_totalRecords(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordStats_totalRecords,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalRecords, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordStats_totalRecords(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordStats_recordsWithValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordStats_recordsWithValue,
+		func(ctx context.Context) (any, error) {
+			return obj.RecordsWithValue, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordStats_recordsWithValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordStats_totalDurationSeconds(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordStats_totalDurationSeconds,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalDurationSeconds, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordStats_totalDurationSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordStats_sumValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordStats_sumValue,
+		func(ctx context.Context) (any, error) {
+			return obj.SumValue, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordStats_sumValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordStats_avgValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordStats_avgValue,
+		func(ctx context.Context) (any, error) {
+			return obj.AvgValue, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordStats_avgValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordStats_avgDurationSeconds(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordStats_avgDurationSeconds,
+		func(ctx context.Context) (any, error) {
+			return obj.AvgDurationSeconds, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordStats_avgDurationSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordStats_minValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordStats_minValue,
+		func(ctx context.Context) (any, error) {
+			return obj.MinValue, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordStats_minValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordStats_maxValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordStats_maxValue,
+		func(ctx context.Context) (any, error) {
+			return obj.MaxValue, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordStats_maxValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription__empty(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription__empty,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Subscription().Empty(ctx)
+		},
+		nil,
+		ec.marshalOBoolean2ᚖbool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecordStats_maxValue(ctx context.Context, field graphql.CollectedField, obj *model.RecordStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+func (ec *executionContext) _Subscription_recordProjectionChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecordStats_maxValue,
+		ec.fieldContext_Subscription_recordProjectionChanged,
 		func(ctx context.Context) (any, error) {
-			return obj.MaxValue, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().RecordProjectionChanged(ctx, fc.Args["filter"].(*model.RecordProjectionChangedFilter))
 		},
-		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				roles, err := ec.unmarshalOString2ᚖstring(ctx, "user")
+				if err != nil {
+					var zeroVal *model.RecordProjectionChange
+					return zeroVal, err
+				}
+				if ec.directives.Auth == nil {
+					var zeroVal *model.RecordProjectionChange
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0, roles)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNRecordProjectionChange2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionChange,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecordStats_maxValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_recordProjectionChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecordStats",
+		Object:     "Subscription",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "eventId":
+				return ec.fieldContext_RecordProjectionChange_eventId(ctx, field)
+			case "recordId":
+				return ec.fieldContext_RecordProjectionChange_recordId(ctx, field)
+			case "userId":
+				return ec.fieldContext_RecordProjectionChange_userId(ctx, field)
+			case "action":
+				return ec.fieldContext_RecordProjectionChange_action(ctx, field)
+			case "projectedAtUTC":
+				return ec.fieldContext_RecordProjectionChange_projectedAtUTC(ctx, field)
+			case "sourceEventId":
+				return ec.fieldContext_RecordProjectionChange_sourceEventId(ctx, field)
+			case "traceId":
+				return ec.fieldContext_RecordProjectionChange_traceId(ctx, field)
+			case "requestId":
+				return ec.fieldContext_RecordProjectionChange_requestId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecordProjectionChange", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_recordProjectionChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
@@ -13965,7 +15746,7 @@ func (ec *executionContext) unmarshalInputCreateRecordInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"tagId", "description", "eventTime", "recordedAt", "durationSeconds", "value", "source", "timezone", "status"}
+	fieldsInOrder := [...]string{"tagId", "description", "eventTime", "recordedAt", "durationSeconds", "value", "source", "timezone", "status", "idempotencyKey"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -14035,6 +15816,13 @@ func (ec *executionContext) unmarshalInputCreateRecordInput(ctx context.Context,
 				return it, err
 			}
 			it.Status = data
+		case "idempotencyKey":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("idempotencyKey"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IdempotencyKey = data
 		}
 	}
 
@@ -14224,6 +16012,47 @@ func (ec *executionContext) unmarshalInputDeleteTagInput(ctx context.Context, ob
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputRecordProjectionChangedFilter(ctx context.Context, obj any) (model.RecordProjectionChangedFilter, error) {
+	var it model.RecordProjectionChangedFilter
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"tagIds", "categoryId", "actions"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "tagIds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tagIds"))
+			data, err := ec.unmarshalOID2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TagIds = data
+		case "categoryId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("categoryId"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CategoryID = data
+		case "actions":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actions"))
+			data, err := ec.unmarshalORecordProjectionAction2ᚕgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionActionᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Actions = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputRecordStatsFilters(ctx context.Context, obj any) (model.RecordStatsFilters, error) {
 	var it model.RecordStatsFilters
 	asMap := map[string]any{}
@@ -14354,6 +16183,60 @@ func (ec *executionContext) unmarshalInputReorderDashboardWidgetsInput(ctx conte
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputRestoreRecordInput(ctx context.Context, obj any) (model.RestoreRecordInput, error) {
+	var it model.RestoreRecordInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"id"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRestoreRecordsInput(ctx context.Context, obj any) (model.RestoreRecordsInput, error) {
+	var it model.RestoreRecordsInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"ids"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "ids":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ids"))
+			data, err := ec.unmarshalNID2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Ids = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputSearchFilters(ctx context.Context, obj any) (model.SearchFilters, error) {
 	var it model.SearchFilters
 	asMap := map[string]any{}
@@ -15281,6 +17164,98 @@ func (ec *executionContext) _ChatMessage(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
+var createRecordResultImplementors = []string{"CreateRecordResult"}
+
+func (ec *executionContext) _CreateRecordResult(ctx context.Context, sel ast.SelectionSet, obj *model.CreateRecordResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createRecordResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateRecordResult")
+		case "index":
+			out.Values[i] = ec._CreateRecordResult_index(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "record":
+			out.Values[i] = ec._CreateRecordResult_record(ctx, field, obj)
+		case "error":
+			out.Values[i] = ec._CreateRecordResult_error(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var createRecordsPayloadImplementors = []string{"CreateRecordsPayload"}
+
+func (ec *executionContext) _CreateRecordsPayload(ctx context.Context, sel ast.SelectionSet, obj *model.CreateRecordsPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createRecordsPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateRecordsPayload")
+		case "results":
+			out.Values[i] = ec._CreateRecordsPayload_results(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdCount":
+			out.Values[i] = ec._CreateRecordsPayload_createdCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "failedCount":
+			out.Values[i] = ec._CreateRecordsPayload_failedCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var dashboardChecklistImplementors = []string{"DashboardChecklist"}
 
 func (ec *executionContext) _DashboardChecklist(ctx context.Context, sel ast.SelectionSet, obj *model.DashboardChecklist) graphql.Marshaler {
@@ -16142,6 +18117,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "createRecords":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createRecords(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "updateRecord":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_updateRecord(ctx, field)
@@ -16163,6 +18145,20 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "restoreRecord":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreRecord(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreRecords":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreRecords(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "upsertMetricDefinition":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_upsertMetricDefinition(ctx, field)
@@ -16412,19 +18408,57 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "chatDataPack":
+		case "chatDataPack":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_chatDataPack(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "recordById":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_recordById(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "recordProjectionById":
 			field := field
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_chatDataPack(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Query_recordProjectionById(ctx, field)
 				return res
 			}
 
@@ -16434,16 +18468,19 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "recordById":
+		case "records":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_recordById(ctx, field)
+				res = ec._Query_records(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -16453,16 +18490,19 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "recordProjectionById":
+		case "recordProjections":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_recordProjectionById(ctx, field)
+				res = ec._Query_recordProjections(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -16472,7 +18512,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "records":
+		case "recordsLatest":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -16481,7 +18521,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_records(ctx, field)
+				res = ec._Query_recordsLatest(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -16494,7 +18534,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "recordProjections":
+		case "deletedRecords":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -16503,7 +18543,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_recordProjections(ctx, field)
+				res = ec._Query_deletedRecords(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -16516,7 +18556,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "recordsLatest":
+		case "recordHistory":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -16525,7 +18565,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_recordsLatest(ctx, field)
+				res = ec._Query_recordHistory(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -17077,6 +19117,51 @@ func (ec *executionContext) _Record(ctx context.Context, sel ast.SelectionSet, o
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "deletedAt":
+			out.Values[i] = ec._Record_deletedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var recordFieldChangeImplementors = []string{"RecordFieldChange"}
+
+func (ec *executionContext) _RecordFieldChange(ctx context.Context, sel ast.SelectionSet, obj *model.RecordFieldChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, recordFieldChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RecordFieldChange")
+		case "field":
+			out.Values[i] = ec._RecordFieldChange_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "oldValue":
+			out.Values[i] = ec._RecordFieldChange_oldValue(ctx, field, obj)
+		case "newValue":
+			out.Values[i] = ec._RecordFieldChange_newValue(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -17222,6 +19307,141 @@ func (ec *executionContext) _RecordProjection(ctx context.Context, sel ast.Selec
 	return out
 }
 
+var recordProjectionChangeImplementors = []string{"RecordProjectionChange"}
+
+func (ec *executionContext) _RecordProjectionChange(ctx context.Context, sel ast.SelectionSet, obj *model.RecordProjectionChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, recordProjectionChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RecordProjectionChange")
+		case "eventId":
+			out.Values[i] = ec._RecordProjectionChange_eventId(ctx, field, obj)
+		case "recordId":
+			out.Values[i] = ec._RecordProjectionChange_recordId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "userId":
+			out.Values[i] = ec._RecordProjectionChange_userId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "action":
+			out.Values[i] = ec._RecordProjectionChange_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "projectedAtUTC":
+			out.Values[i] = ec._RecordProjectionChange_projectedAtUTC(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sourceEventId":
+			out.Values[i] = ec._RecordProjectionChange_sourceEventId(ctx, field, obj)
+		case "traceId":
+			out.Values[i] = ec._RecordProjectionChange_traceId(ctx, field, obj)
+		case "requestId":
+			out.Values[i] = ec._RecordProjectionChange_requestId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var recordRevisionImplementors = []string{"RecordRevision"}
+
+func (ec *executionContext) _RecordRevision(ctx context.Context, sel ast.SelectionSet, obj *model.RecordRevision) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, recordRevisionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RecordRevision")
+		case "id":
+			out.Values[i] = ec._RecordRevision_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recordId":
+			out.Values[i] = ec._RecordRevision_recordId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "operation":
+			out.Values[i] = ec._RecordRevision_operation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changes":
+			out.Values[i] = ec._RecordRevision_changes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actorUserId":
+			out.Values[i] = ec._RecordRevision_actorUserId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actorService":
+			out.Values[i] = ec._RecordRevision_actorService(ctx, field, obj)
+		case "source":
+			out.Values[i] = ec._RecordRevision_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requestId":
+			out.Values[i] = ec._RecordRevision_requestId(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._RecordRevision_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var recordStatsImplementors = []string{"RecordStats"}
 
 func (ec *executionContext) _RecordStats(ctx context.Context, sel ast.SelectionSet, obj *model.RecordStats) graphql.Marshaler {
@@ -17290,6 +19510,28 @@ func (ec *executionContext) _RecordStats(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		graphql.AddErrorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "_empty":
+		return ec._Subscription__empty(ctx, fields[0])
+	case "recordProjectionChanged":
+		return ec._Subscription_recordProjectionChanged(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var tagImplementors = []string{"Tag"}
 
 func (ec *executionContext) _Tag(ctx context.Context, sel ast.SelectionSet, obj *model.Tag) graphql.Marshaler {
@@ -17961,21 +20203,115 @@ func (ec *executionContext) marshalNChatContext2ᚖgithubᚗcomᚋlechitzᚋaion
 	return ec._ChatContext(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNChatDataPack2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐChatDataPack(ctx context.Context, sel ast.SelectionSet, v model.ChatDataPack) graphql.Marshaler {
-	return ec._ChatDataPack(ctx, sel, &v)
+func (ec *executionContext) marshalNChatDataPack2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐChatDataPack(ctx context.Context, sel ast.SelectionSet, v model.ChatDataPack) graphql.Marshaler {
+	return ec._ChatDataPack(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNChatDataPack2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐChatDataPack(ctx context.Context, sel ast.SelectionSet, v *model.ChatDataPack) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ChatDataPack(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNChatMessage2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐChatMessageᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ChatMessage) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNChatMessage2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐChatMessage(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNChatMessage2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐChatMessage(ctx context.Context, sel ast.SelectionSet, v *model.ChatMessage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ChatMessage(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCreateCategoryInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateCategoryInput(ctx context.Context, v any) (model.CreateCategoryInput, error) {
+	res, err := ec.unmarshalInputCreateCategoryInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateDashboardViewInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateDashboardViewInput(ctx context.Context, v any) (model.CreateDashboardViewInput, error) {
+	res, err := ec.unmarshalInputCreateDashboardViewInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateMetricAndWidgetInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateMetricAndWidgetInput(ctx context.Context, v any) (model.CreateMetricAndWidgetInput, error) {
+	res, err := ec.unmarshalInputCreateMetricAndWidgetInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNChatDataPack2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐChatDataPack(ctx context.Context, sel ast.SelectionSet, v *model.ChatDataPack) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) unmarshalNCreateRecordInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordInput(ctx context.Context, v any) (model.CreateRecordInput, error) {
+	res, err := ec.unmarshalInputCreateRecordInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateRecordInput2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordInputᚄ(ctx context.Context, v any) ([]*model.CreateRecordInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.CreateRecordInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCreateRecordInput2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	return ec._ChatDataPack(ctx, sel, v)
+	return res, nil
 }
 
-func (ec *executionContext) marshalNChatMessage2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐChatMessageᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ChatMessage) graphql.Marshaler {
+func (ec *executionContext) unmarshalNCreateRecordInput2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordInput(ctx context.Context, v any) (*model.CreateRecordInput, error) {
+	res, err := ec.unmarshalInputCreateRecordInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCreateRecordResult2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CreateRecordResult) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -17999,7 +20335,7 @@ func (ec *executionContext) marshalNChatMessage2ᚕᚖgithubᚗcomᚋlechitzᚋa
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNChatMessage2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐChatMessage(ctx, sel, v[i])
+			ret[i] = ec.marshalNCreateRecordResult2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordResult(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -18019,34 +20355,28 @@ func (ec *executionContext) marshalNChatMessage2ᚕᚖgithubᚗcomᚋlechitzᚋa
 	return ret
 }
 
-func (ec *executionContext) marshalNChatMessage2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐChatMessage(ctx context.Context, sel ast.SelectionSet, v *model.ChatMessage) graphql.Marshaler {
+func (ec *executionContext) marshalNCreateRecordResult2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordResult(ctx context.Context, sel ast.SelectionSet, v *model.CreateRecordResult) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ChatMessage(ctx, sel, v)
+	return ec._CreateRecordResult(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCreateCategoryInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateCategoryInput(ctx context.Context, v any) (model.CreateCategoryInput, error) {
-	res, err := ec.unmarshalInputCreateCategoryInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNCreateDashboardViewInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateDashboardViewInput(ctx context.Context, v any) (model.CreateDashboardViewInput, error) {
-	res, err := ec.unmarshalInputCreateDashboardViewInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNCreateRecordsPayload2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordsPayload(ctx context.Context, sel ast.SelectionSet, v model.CreateRecordsPayload) graphql.Marshaler {
+	return ec._CreateRecordsPayload(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNCreateMetricAndWidgetInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateMetricAndWidgetInput(ctx context.Context, v any) (model.CreateMetricAndWidgetInput, error) {
-	res, err := ec.unmarshalInputCreateMetricAndWidgetInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNCreateRecordInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordInput(ctx context.Context, v any) (model.CreateRecordInput, error) {
-	res, err := ec.unmarshalInputCreateRecordInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNCreateRecordsPayload2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordsPayload(ctx context.Context, sel ast.SelectionSet, v *model.CreateRecordsPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CreateRecordsPayload(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNCreateTagInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateTagInput(ctx context.Context, v any) (model.CreateTagInput, error) {
@@ -18849,6 +21179,60 @@ func (ec *executionContext) marshalNRecord2ᚖgithubᚗcomᚋlechitzᚋaionᚑap
 	return ec._Record(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNRecordFieldChange2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordFieldChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RecordFieldChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRecordFieldChange2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordFieldChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRecordFieldChange2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordFieldChange(ctx context.Context, sel ast.SelectionSet, v *model.RecordFieldChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RecordFieldChange(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNRecordProjection2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RecordProjection) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -18903,6 +21287,84 @@ func (ec *executionContext) marshalNRecordProjection2ᚖgithubᚗcomᚋlechitz
 	return ec._RecordProjection(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNRecordProjectionAction2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionAction(ctx context.Context, v any) (model.RecordProjectionAction, error) {
+	var res model.RecordProjectionAction
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRecordProjectionAction2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionAction(ctx context.Context, sel ast.SelectionSet, v model.RecordProjectionAction) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNRecordProjectionChange2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionChange(ctx context.Context, sel ast.SelectionSet, v model.RecordProjectionChange) graphql.Marshaler {
+	return ec._RecordProjectionChange(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRecordProjectionChange2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionChange(ctx context.Context, sel ast.SelectionSet, v *model.RecordProjectionChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RecordProjectionChange(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRecordRevision2ᚕᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordRevisionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RecordRevision) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRecordRevision2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordRevision(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRecordRevision2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordRevision(ctx context.Context, sel ast.SelectionSet, v *model.RecordRevision) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RecordRevision(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNRecordStats2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordStats(ctx context.Context, sel ast.SelectionSet, v model.RecordStats) graphql.Marshaler {
 	return ec._RecordStats(ctx, sel, &v)
 }
@@ -18942,6 +21404,16 @@ func (ec *executionContext) unmarshalNReorderDashboardWidgetsInput2githubᚗcom
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNRestoreRecordInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRestoreRecordInput(ctx context.Context, v any) (model.RestoreRecordInput, error) {
+	res, err := ec.unmarshalInputRestoreRecordInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRestoreRecordsInput2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRestoreRecordsInput(ctx context.Context, v any) (model.RestoreRecordsInput, error) {
+	res, err := ec.unmarshalInputRestoreRecordsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNSearchFilters2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐSearchFilters(ctx context.Context, v any) (model.SearchFilters, error) {
 	res, err := ec.unmarshalInputSearchFilters(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -19407,6 +21879,22 @@ func (ec *executionContext) marshalOCategoryCount2ᚖgithubᚗcomᚋlechitzᚋai
 	return ec._CategoryCount(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOCreateRecordsMode2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordsMode(ctx context.Context, v any) (*model.CreateRecordsMode, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.CreateRecordsMode)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOCreateRecordsMode2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐCreateRecordsMode(ctx context.Context, sel ast.SelectionSet, v *model.CreateRecordsMode) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalODashboardChecklist2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐDashboardChecklist(ctx context.Context, sel ast.SelectionSet, v *model.DashboardChecklist) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -19542,6 +22030,79 @@ func (ec *executionContext) marshalORecordProjection2ᚖgithubᚗcomᚋlechitz
 	return ec._RecordProjection(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalORecordProjectionAction2ᚕgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionActionᚄ(ctx context.Context, v any) ([]model.RecordProjectionAction, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.RecordProjectionAction, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRecordProjectionAction2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionAction(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalORecordProjectionAction2ᚕgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionActionᚄ(ctx context.Context, sel ast.SelectionSet, v []model.RecordProjectionAction) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRecordProjectionAction2githubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionAction(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalORecordProjectionChangedFilter2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordProjectionChangedFilter(ctx context.Context, v any) (*model.RecordProjectionChangedFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRecordProjectionChangedFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalORecordStatsFilters2ᚖgithubᚗcomᚋlechitzᚋaionᚑapiᚋinternalᚋadapterᚋprimaryᚋgraphqlᚋmodelᚐRecordStatsFilters(ctx context.Context, v any) (*model.RecordStatsFilters, error) {
 	if v == nil {
 		return nil, nil
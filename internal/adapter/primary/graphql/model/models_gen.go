@@ -89,6 +89,19 @@ type CreateRecordInput struct {
 	Source          *string  `json:"source,omitempty"`
 	Timezone        *string  `json:"timezone,omitempty"`
 	Status          *string  `json:"status,omitempty"`
+	IdempotencyKey  *string  `json:"idempotencyKey,omitempty"`
+}
+
+type CreateRecordResult struct {
+	Index  int32   `json:"index"`
+	Record *Record `json:"record,omitempty"`
+	Error  *string `json:"error,omitempty"`
+}
+
+type CreateRecordsPayload struct {
+	Results      []*CreateRecordResult `json:"results"`
+	CreatedCount int32                 `json:"createdCount"`
+	FailedCount  int32                 `json:"failedCount"`
 }
 
 type CreateTagInput struct {
@@ -261,6 +274,13 @@ type Record struct {
 	Status          *string  `json:"status,omitempty"`
 	CreatedAt       string   `json:"createdAt"`
 	UpdatedAt       string   `json:"updatedAt"`
+	DeletedAt       *string  `json:"deletedAt,omitempty"`
+}
+
+type RecordFieldChange struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"oldValue,omitempty"`
+	NewValue *string `json:"newValue,omitempty"`
 }
 
 type RecordProjection struct {
@@ -289,6 +309,35 @@ type RecordProjection struct {
 	UpdatedAtUtc       string   `json:"updatedAtUTC"`
 }
 
+type RecordProjectionChange struct {
+	EventID        *string                `json:"eventId,omitempty"`
+	RecordID       string                 `json:"recordId"`
+	UserID         string                 `json:"userId"`
+	Action         RecordProjectionAction `json:"action"`
+	ProjectedAtUtc string                 `json:"projectedAtUTC"`
+	SourceEventID  *string                `json:"sourceEventId,omitempty"`
+	TraceID        *string                `json:"traceId,omitempty"`
+	RequestID      *string                `json:"requestId,omitempty"`
+}
+
+type RecordProjectionChangedFilter struct {
+	TagIds     []string                 `json:"tagIds,omitempty"`
+	CategoryID *string                  `json:"categoryId,omitempty"`
+	Actions    []RecordProjectionAction `json:"actions,omitempty"`
+}
+
+type RecordRevision struct {
+	ID           string               `json:"id"`
+	RecordID     string               `json:"recordId"`
+	Operation    string               `json:"operation"`
+	Changes      []*RecordFieldChange `json:"changes"`
+	ActorUserID  string               `json:"actorUserId"`
+	ActorService *string              `json:"actorService,omitempty"`
+	Source       string               `json:"source"`
+	RequestID    *string              `json:"requestId,omitempty"`
+	CreatedAt    string               `json:"createdAt"`
+}
+
 type RecordStats struct {
 	TotalRecords         int32    `json:"totalRecords"`
 	RecordsWithValue     int32    `json:"recordsWithValue"`
@@ -319,6 +368,14 @@ type ReorderDashboardWidgetsInput struct {
 	Items  []*ReorderDashboardWidgetItemInput `json:"items"`
 }
 
+type RestoreRecordInput struct {
+	ID string `json:"id"`
+}
+
+type RestoreRecordsInput struct {
+	Ids []string `json:"ids"`
+}
+
 type SearchFilters struct {
 	Query       string   `json:"query"`
 	CategoryIds []string `json:"categoryIds,omitempty"`
@@ -333,6 +390,9 @@ type SetDefaultDashboardViewInput struct {
 	ViewID string `json:"viewId"`
 }
 
+type Subscription struct {
+}
+
 type Tag struct {
 	ID          string  `json:"id"`
 	UserID      string  `json:"userId"`
@@ -425,6 +485,61 @@ type UserStats struct {
 	MostUsedTag      *TagCount      `json:"mostUsedTag,omitempty"`
 }
 
+type CreateRecordsMode string
+
+const (
+	CreateRecordsModeAllOrNothing CreateRecordsMode = "ALL_OR_NOTHING"
+	CreateRecordsModeBestEffort   CreateRecordsMode = "BEST_EFFORT"
+)
+
+var AllCreateRecordsMode = []CreateRecordsMode{
+	CreateRecordsModeAllOrNothing,
+	CreateRecordsModeBestEffort,
+}
+
+func (e CreateRecordsMode) IsValid() bool {
+	switch e {
+	case CreateRecordsModeAllOrNothing, CreateRecordsModeBestEffort:
+		return true
+	}
+	return false
+}
+
+func (e CreateRecordsMode) String() string {
+	return string(e)
+}
+
+func (e *CreateRecordsMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CreateRecordsMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CreateRecordsMode", str)
+	}
+	return nil
+}
+
+func (e CreateRecordsMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CreateRecordsMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CreateRecordsMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type DashboardWidgetSize string
 
 const (
@@ -599,3 +714,60 @@ func (e InsightWindow) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type RecordProjectionAction string
+
+const (
+	RecordProjectionActionCreated RecordProjectionAction = "CREATED"
+	RecordProjectionActionUpdated RecordProjectionAction = "UPDATED"
+	RecordProjectionActionDeleted RecordProjectionAction = "DELETED"
+)
+
+var AllRecordProjectionAction = []RecordProjectionAction{
+	RecordProjectionActionCreated,
+	RecordProjectionActionUpdated,
+	RecordProjectionActionDeleted,
+}
+
+func (e RecordProjectionAction) IsValid() bool {
+	switch e {
+	case RecordProjectionActionCreated, RecordProjectionActionUpdated, RecordProjectionActionDeleted:
+		return true
+	}
+	return false
+}
+
+func (e RecordProjectionAction) String() string {
+	return string(e)
+}
+
+func (e *RecordProjectionAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RecordProjectionAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RecordProjectionAction", str)
+	}
+	return nil
+}
+
+func (e RecordProjectionAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RecordProjectionAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RecordProjectionAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,31 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lechitz/aion-api/internal/adapter/primary/graphql/model"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+)
+
+// RecordProjectionChanged is the resolver for the recordProjectionChanged field.
+// Each subscription holds one slot of the connection limit until it ends.
+func (s *subscriptionResolver) RecordProjectionChanged(ctx context.Context, filter *model.RecordProjectionChangedFilter) (<-chan *model.RecordProjectionChange, error) {
+	if s.RealtimeService == nil {
+		return nil, errors.New(errRealtimeUnavailable)
+	}
+
+	release, err := acquireSubscription(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, _ := ctx.Value(ctxkeys.UserID).(uint64)
+	changes, err := s.RealtimeController().RecordProjectionChanged(ctx, filter, uid)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	context.AfterFunc(ctx, release)
+	return changes, nil
+}
@@ -14,6 +14,16 @@ func (m *mutationResolver) CreateRecord(ctx context.Context, input model.CreateR
 	return m.RecordController().Create(ctx, input, uid)
 }
 
+// CreateRecords is the resolver for the createRecords field.
+func (m *mutationResolver) CreateRecords(ctx context.Context, input []*model.CreateRecordInput, mode *model.CreateRecordsMode) (*model.CreateRecordsPayload, error) {
+	uid, _ := ctx.Value(ctxkeys.UserID).(uint64)
+	batchMode := model.CreateRecordsModeAllOrNothing
+	if mode != nil {
+		batchMode = *mode
+	}
+	return m.RecordController().CreateMany(ctx, input, batchMode, uid)
+}
+
 // RecordByID is the resolver for the recordByID field.
 func (q *queryResolver) RecordByID(ctx context.Context, recordID string) (*model.Record, error) {
 	id, err := strconv.ParseUint(recordID, 10, 64)
@@ -190,6 +200,63 @@ func (m *mutationResolver) SoftDeleteAllRecords(ctx context.Context) (bool, erro
 	return true, nil
 }
 
+// DeletedRecords is the resolver for the deletedRecords field (trash, with optional cursors).
+func (q *queryResolver) DeletedRecords(ctx context.Context, limit *int32, afterDeletedAt *string, afterID *string) ([]*model.Record, error) {
+	uid, _ := ctx.Value(ctxkeys.UserID).(uint64)
+	lim := 50
+	if limit != nil && *limit > 0 {
+		lim = int(*limit)
+	}
+	var afterIDInt *int64
+	if afterID != nil && *afterID != "" {
+		if v, err := strconv.ParseInt(*afterID, 10, 64); err == nil {
+			afterIDInt = &v
+		}
+	}
+
+	return q.RecordController().ListDeleted(ctx, uid, lim, afterDeletedAt, afterIDInt)
+}
+
+// RecordHistory is the resolver for the recordHistory field (newest revision first).
+func (q *queryResolver) RecordHistory(ctx context.Context, id string, limit *int32) ([]*model.RecordRevision, error) {
+	recordID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, _ := ctx.Value(ctxkeys.UserID).(uint64)
+	lim := 50
+	if limit != nil && *limit > 0 {
+		lim = int(*limit)
+	}
+
+	return q.RecordController().History(ctx, recordID, uid, lim)
+}
+
+// RestoreRecord is the resolver for the restoreRecord field.
+func (m *mutationResolver) RestoreRecord(ctx context.Context, input model.RestoreRecordInput) (*model.Record, error) {
+	uid, _ := ctx.Value(ctxkeys.UserID).(uint64)
+	id, err := strconv.ParseUint(input.ID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return m.RecordController().Restore(ctx, id, uid)
+}
+
+// RestoreRecords is the resolver for the restoreRecords field.
+func (m *mutationResolver) RestoreRecords(ctx context.Context, input model.RestoreRecordsInput) ([]*model.Record, error) {
+	uid, _ := ctx.Value(ctxkeys.UserID).(uint64)
+	ids := make([]uint64, len(input.Ids))
+	for i, raw := range input.Ids {
+		id, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return m.RecordController().RestoreMany(ctx, ids, uid)
+}
+
 // SearchRecords is the resolver for the searchRecords field.
 func (q *queryResolver) SearchRecords(ctx context.Context, filters model.SearchFilters) ([]*model.Record, error) {
 	uid, _ := ctx.Value(ctxkeys.UserID).(uint64)
@@ -3,12 +3,14 @@ package graphql
 import (
 	categoryController "github.com/lechitz/aion-api/internal/category/adapter/primary/graphql/controller"
 	chatController "github.com/lechitz/aion-api/internal/chat/adapter/primary/graphql/controller"
+	realtimeController "github.com/lechitz/aion-api/internal/realtime/adapter/primary/graphql/controller"
 	recordController "github.com/lechitz/aion-api/internal/record/adapter/primary/graphql/controller"
 	tagController "github.com/lechitz/aion-api/internal/tag/adapter/primary/graphql/controller"
 
 	categoryInput "github.com/lechitz/aion-api/internal/category/core/ports/input"
 	chatInput "github.com/lechitz/aion-api/internal/chat/core/ports/input"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	realtimeInput "github.com/lechitz/aion-api/internal/realtime/core/ports/input"
 	recordInput "github.com/lechitz/aion-api/internal/record/core/ports/input"
 	tagInput "github.com/lechitz/aion-api/internal/tag/core/ports/input"
 	userInput "github.com/lechitz/aion-api/internal/user/core/ports/input"
@@ -21,6 +23,7 @@ type Resolver struct {
 	RecordService   recordInput.RecordService
 	ChatService     chatInput.ChatService
 	UserService     userInput.UserService
+	RealtimeService realtimeInput.Service
 	Logger          logger.ContextLogger
 }
 
@@ -43,3 +46,8 @@ func (r *Resolver) RecordController() recordController.RecordController {
 func (r *Resolver) ChatController() chatController.ChatController {
 	return chatController.NewController(r.ChatService, r.Logger)
 }
+
+// RealtimeController returns the Realtime adapter controller (interface).
+func (r *Resolver) RealtimeController() realtimeController.RealtimeController {
+	return realtimeController.NewController(r.RealtimeService, r.Logger)
+}
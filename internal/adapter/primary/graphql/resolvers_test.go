@@ -97,6 +97,14 @@ func (recordSvcStub) Create(context.Context, recordinput.CreateRecordCommand) (r
 	return recorddomain.Record{ID: 1, UserID: 1, TagID: 1, EventTime: now, CreatedAt: now, UpdatedAt: now}, nil
 }
 
+func (recordSvcStub) CreateMany(_ context.Context, cmd recordinput.CreateRecordsCommand) ([]recordinput.CreateRecordResult, error) {
+	results := make([]recordinput.CreateRecordResult, len(cmd.Items))
+	for i := range results {
+		results[i] = recordinput.CreateRecordResult{Index: i, Record: recorddomain.Record{ID: uint64(i + 1), UserID: 1, TagID: 1}}
+	}
+	return results, nil
+}
+
 func (recordSvcStub) GetByID(context.Context, uint64, uint64) (recorddomain.Record, error) {
 	now := time.Now().UTC()
 	return recorddomain.Record{ID: 1, UserID: 1, TagID: 1, EventTime: now, CreatedAt: now, UpdatedAt: now}, nil
@@ -161,6 +169,18 @@ func (recordSvcStub) Update(context.Context, uint64, uint64, recordinput.UpdateR
 }
 func (recordSvcStub) Delete(context.Context, uint64, uint64) error { return nil }
 func (recordSvcStub) DeleteAll(context.Context, uint64) error      { return nil }
+func (recordSvcStub) ListDeleted(context.Context, uint64, int, *string, *int64) ([]recorddomain.Record, error) {
+	return []recorddomain.Record{}, nil
+}
+func (recordSvcStub) Restore(context.Context, uint64, uint64) (recorddomain.Record, error) {
+	return recorddomain.Record{}, nil
+}
+func (recordSvcStub) RestoreMany(context.Context, []uint64, uint64) ([]recorddomain.Record, error) {
+	return []recorddomain.Record{}, nil
+}
+func (recordSvcStub) History(context.Context, uint64, uint64, int) ([]recorddomain.RecordRevision, error) {
+	return []recorddomain.RecordRevision{}, nil
+}
 func (recordSvcStub) SearchRecords(context.Context, uint64, recorddomain.SearchFilters) ([]recorddomain.Record, error) {
 	return []recorddomain.Record{}, nil
 }
@@ -406,6 +426,9 @@ func TestRecordResolvers_SuccessPaths(t *testing.T) {
 
 	_, err := m.CreateRecord(ctx, gmodel.CreateRecordInput{TagID: "1"})
 	require.NoError(t, err)
+	batch, err := m.CreateRecords(ctx, []*gmodel.CreateRecordInput{{TagID: "1"}, {TagID: "1"}}, nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), batch.CreatedCount)
 	_, err = q.RecordByID(ctx, "1")
 	require.NoError(t, err)
 	_, err = q.RecordsByTag(ctx, "1", &limit)
@@ -432,6 +455,14 @@ func TestRecordResolvers_SuccessPaths(t *testing.T) {
 	deleted, err = m.SoftDeleteAllRecords(ctx)
 	require.NoError(t, err)
 	require.True(t, deleted)
+	_, err = q.DeletedRecords(ctx, &limit, &until, &after)
+	require.NoError(t, err)
+	_, err = m.RestoreRecord(ctx, gmodel.RestoreRecordInput{ID: "1"})
+	require.NoError(t, err)
+	_, err = m.RestoreRecords(ctx, gmodel.RestoreRecordsInput{Ids: []string{"1", "2"}})
+	require.NoError(t, err)
+	_, err = q.RecordHistory(ctx, "1", &limit)
+	require.NoError(t, err)
 	_, err = q.SearchRecords(ctx, gmodel.SearchFilters{Query: "q"})
 	require.NoError(t, err)
 	_, err = q.RecordStats(ctx, nil)
@@ -455,6 +486,12 @@ func TestRecordResolvers_ParseErrors(t *testing.T) {
 	require.Error(t, err)
 	_, err = m.SoftDeleteRecord(ctx, gmodel.DeleteRecordInput{ID: bad})
 	require.Error(t, err)
+	_, err = m.RestoreRecord(ctx, gmodel.RestoreRecordInput{ID: bad})
+	require.Error(t, err)
+	_, err = m.RestoreRecords(ctx, gmodel.RestoreRecordsInput{Ids: []string{"1", bad}})
+	require.Error(t, err)
+	_, err = q.RecordHistory(ctx, bad, nil)
+	require.Error(t, err)
 }
 
 func TestChatResolversAndUserStats(t *testing.T) {
@@ -494,7 +531,7 @@ func (userSvcErrStub) GetUserStats(context.Context, uint64) (userdomain.UserStat
 }
 
 func TestNewGraphqlHandler(t *testing.T) {
-	h, err := NewGraphqlHandler(nil, categorySvcStub{}, tagSvcStub{}, recordSvcStub{}, chatSvcStub{}, userSvcStub{}, gqlLoggerStub{}, &config.Config{})
+	h, err := NewGraphqlHandler(nil, categorySvcStub{}, tagSvcStub{}, recordSvcStub{}, chatSvcStub{}, userSvcStub{}, nil, gqlLoggerStub{}, &config.Config{})
 	require.NoError(t, err)
 	require.NotNil(t, h)
 
@@ -15,9 +15,13 @@ func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type (
-	mutationResolver struct{ *Resolver }
-	queryResolver    struct{ *Resolver }
+	mutationResolver     struct{ *Resolver }
+	queryResolver        struct{ *Resolver }
+	subscriptionResolver struct{ *Resolver }
 )
 
 // Empty is a placeholder resolver to avoid gqlgen errors when no other resolvers are defined.
@@ -29,3 +33,8 @@ func (m *mutationResolver) Empty(_ context.Context) (*bool, error) {
 func (q *queryResolver) Empty(_ context.Context) (*bool, error) {
 	return nil, errors.New("not implemented")
 }
+
+// Empty is a placeholder resolver to avoid gqlgen errors when no other resolvers are defined.
+func (s *subscriptionResolver) Empty(_ context.Context) (<-chan *bool, error) {
+	return nil, errors.New("not implemented")
+}
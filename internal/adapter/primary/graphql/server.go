@@ -13,6 +13,7 @@ import (
 	authInput "github.com/lechitz/aion-api/internal/auth/core/ports/input"
 	categoryInput "github.com/lechitz/aion-api/internal/category/core/ports/input"
 	chatInput "github.com/lechitz/aion-api/internal/chat/core/ports/input"
+	realtimeInput "github.com/lechitz/aion-api/internal/realtime/core/ports/input"
 	recordInput "github.com/lechitz/aion-api/internal/record/core/ports/input"
 	tagInput "github.com/lechitz/aion-api/internal/tag/core/ports/input"
 	userInput "github.com/lechitz/aion-api/internal/user/core/ports/input"
@@ -31,6 +32,7 @@ func NewGraphqlHandler(
 	recordService recordInput.RecordService,
 	chatService chatInput.ChatService,
 	userService userInput.UserService,
+	realtimeService realtimeInput.Service,
 	log logger.ContextLogger,
 	cfg *config.Config,
 ) (http.Handler, error) {
@@ -45,6 +47,7 @@ func NewGraphqlHandler(
 		RecordService:   recordService,
 		ChatService:     chatService,
 		UserService:     userService,
+		RealtimeService: realtimeService,
 		Logger:          log,
 	}
 
@@ -55,6 +58,11 @@ func NewGraphqlHandler(
 		},
 	})
 
+	var auth *authmw.AuthMiddleware
+	if authService != nil {
+		auth = authmw.New(authService, log)
+	}
+
 	srv := handler.New(es)
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
@@ -62,12 +70,13 @@ func NewGraphqlHandler(
 	srv.AddTransport(transport.MultipartForm{})
 	srv.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              websocketInit(auth, cfg.ServerGraphql.MaxSubscriptionsPerConnection),
 	})
 
-	// Main GraphQL endpoint (with auth middleware)
+	// Main GraphQL endpoint (with auth middleware; websocket connections authenticate on connection_init)
 	r.Group(func(protected chi.Router) {
-		if authService != nil {
-			protected.Use(authmw.New(authService, log).Auth)
+		if auth != nil {
+			protected.Use(websocketAuth(auth))
 		}
 		protected.Handle("/", srv)
 	})
@@ -0,0 +1,128 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	authmw "github.com/lechitz/aion-api/internal/auth/adapter/primary/http/middleware"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+)
+
+const (
+	// initPayloadAuthToken is the connection_init payload key accepted next to "Authorization".
+	initPayloadAuthToken = "authToken"
+
+	// bearerPrefix is stripped from the Authorization value of the connection_init payload.
+	bearerPrefix = "bearer "
+
+	// errSubscriptionLimitReached is returned when a websocket connection already runs its maximum number of subscriptions.
+	errSubscriptionLimitReached = "subscription limit reached: at most %d per connection"
+
+	// errRealtimeUnavailable is returned when a subscription is requested without a realtime service.
+	errRealtimeUnavailable = "realtime updates are not available"
+)
+
+type contextKey int
+
+const (
+	// upgradeTokenKey carries the token sent with the websocket upgrade request to the InitFunc.
+	upgradeTokenKey contextKey = iota
+
+	// subscriptionLimiterKey carries the per-connection subscriptionLimiter.
+	subscriptionLimiterKey
+)
+
+// websocketAuth lets websocket upgrades through without the HTTP auth middleware, since browsers
+// cannot attach an Authorization header to them, and remembers any token the upgrade did carry.
+// The connection is authenticated by websocketInit instead. Other requests go through auth.
+func websocketAuth(auth *authmw.AuthMiddleware) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		protected := auth.Auth(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isWebsocketUpgrade(r) {
+				protected.ServeHTTP(w, r)
+				return
+			}
+			ctx := r.Context()
+			if token, err := authmw.ExtractToken(r); err == nil {
+				ctx = context.WithValue(ctx, upgradeTokenKey, token)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// websocketInit authenticates a websocket connection from its connection_init payload, falling
+// back to the token of the upgrade request, with the same validation the HTTP auth middleware
+// applies. Connections already authenticated by a service token are accepted as they are.
+// Every connection gets a limiter allowing maxSubscriptions concurrent subscriptions.
+func websocketInit(auth *authmw.AuthMiddleware, maxSubscriptions int) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		ctx = context.WithValue(ctx, subscriptionLimiterKey, &subscriptionLimiter{limit: maxSubscriptions})
+
+		if auth == nil {
+			return ctx, nil, nil
+		}
+		if svc, ok := ctx.Value(ctxkeys.ServiceAccount).(bool); ok && svc {
+			return ctx, nil, nil
+		}
+
+		token := initPayloadToken(payload)
+		if token == "" {
+			token, _ = ctx.Value(upgradeTokenKey).(string)
+		}
+		ctx, err := auth.Authenticate(ctx, token)
+		return ctx, nil, err
+	}
+}
+
+func initPayloadToken(payload transport.InitPayload) string {
+	if value := payload.Authorization(); value != "" {
+		if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return value[len(bearerPrefix):]
+		}
+		return value
+	}
+	return payload.GetString(initPayloadAuthToken)
+}
+
+func isWebsocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// subscriptionLimiter counts the active subscriptions of one websocket connection.
+type subscriptionLimiter struct {
+	mu     sync.Mutex
+	active int
+	limit  int
+}
+
+// acquireSubscription takes one subscription slot of the connection in ctx and returns the function
+// that gives it back. Requests without a limiter, which never reach the websocket transport, are
+// not limited.
+func acquireSubscription(ctx context.Context) (func(), error) {
+	limiter, ok := ctx.Value(subscriptionLimiterKey).(*subscriptionLimiter)
+	if !ok {
+		return func() {}, nil
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	if limiter.active >= limiter.limit {
+		return nil, fmt.Errorf(errSubscriptionLimitReached, limiter.limit)
+	}
+	limiter.active++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			limiter.mu.Lock()
+			limiter.active--
+			limiter.mu.Unlock()
+		})
+	}, nil
+}
@@ -0,0 +1,120 @@
+//nolint:testpackage // exercises the unexported websocket init and subscription limiter.
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	authmw "github.com/lechitz/aion-api/internal/auth/adapter/primary/http/middleware"
+	authdomain "github.com/lechitz/aion-api/internal/auth/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/config"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
+	realtime "github.com/lechitz/aion-api/internal/realtime/core/usecase"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	"github.com/stretchr/testify/require"
+)
+
+type authSvcStub struct{}
+
+func (authSvcStub) Validate(_ context.Context, token string) (uint64, map[string]any, error) {
+	if token != "good-token" {
+		return 0, nil, errors.New("invalid token")
+	}
+	return 7, map[string]any{"roles": []string{"user"}}, nil
+}
+
+func (authSvcStub) Login(context.Context, string, string) (authdomain.AuthenticatedUser, string, string, error) {
+	return authdomain.AuthenticatedUser{}, "", "", nil
+}
+
+func (authSvcStub) Logout(context.Context, uint64) error { return nil }
+
+func (authSvcStub) RefreshTokenRenewal(context.Context, string) (string, string, error) {
+	return "", "", nil
+}
+
+const recordProjectionChangedSubscription = `subscription { recordProjectionChanged(filter: {actions: [CREATED]}) { recordId action } }`
+
+func TestWebsocketSubscriptionAuthenticatesFromInitPayload(t *testing.T) {
+	realtimeService := realtime.NewService(gqlLoggerStub{}, 4)
+	cfg := &config.Config{ServerGraphql: config.ServerGraphql{MaxSubscriptionsPerConnection: 1}}
+	h, err := NewGraphqlHandler(authSvcStub{}, categorySvcStub{}, tagSvcStub{}, recordSvcStub{}, chatSvcStub{}, userSvcStub{}, realtimeService, gqlLoggerStub{}, cfg)
+	require.NoError(t, err)
+	c := client.New(h)
+
+	rejected := c.WebsocketWithPayload(recordProjectionChangedSubscription, map[string]any{"authToken": "bad-token"})
+	defer func() { _ = rejected.Close() }()
+	require.Error(t, rejected.Next(&struct{}{}))
+
+	sub := c.WebsocketWithPayload(recordProjectionChangedSubscription, map[string]any{"Authorization": "Bearer good-token"})
+	defer func() { _ = sub.Close() }()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				realtimeService.Publish(context.Background(), realtimedomain.Event{Type: realtimedomain.EventTypeRecordProjectionChanged, UserID: 7, RecordID: 41, Action: realtimedomain.ActionDeleted})
+				realtimeService.Publish(context.Background(), realtimedomain.Event{Type: realtimedomain.EventTypeRecordProjectionChanged, UserID: 7, RecordID: 42, Action: realtimedomain.ActionCreated})
+			}
+		}
+	}()
+
+	var resp struct {
+		RecordProjectionChanged struct {
+			RecordID string
+			Action   string
+		}
+	}
+	require.NoError(t, sub.Next(&resp))
+	require.Equal(t, "42", resp.RecordProjectionChanged.RecordID)
+	require.Equal(t, "CREATED", resp.RecordProjectionChanged.Action)
+}
+
+func TestWebsocketInitAcceptsUpgradeTokenAndServiceAccounts(t *testing.T) {
+	init := websocketInit(authmw.New(authSvcStub{}, gqlLoggerStub{}), 2)
+
+	ctx := context.WithValue(t.Context(), upgradeTokenKey, "good-token")
+	ctx, _, err := init(ctx, transport.InitPayload{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), ctx.Value(ctxkeys.UserID))
+
+	ctx = context.WithValue(t.Context(), ctxkeys.ServiceAccount, true)
+	_, _, err = init(ctx, transport.InitPayload{})
+	require.NoError(t, err)
+
+	_, _, err = init(t.Context(), transport.InitPayload{})
+	require.Error(t, err)
+}
+
+func TestAcquireSubscriptionEnforcesConnectionLimit(t *testing.T) {
+	ctx, _, err := websocketInit(nil, 2)(t.Context(), transport.InitPayload{})
+	require.NoError(t, err)
+
+	first, err := acquireSubscription(ctx)
+	require.NoError(t, err)
+	_, err = acquireSubscription(ctx)
+	require.NoError(t, err)
+	_, err = acquireSubscription(ctx)
+	require.EqualError(t, err, "subscription limit reached: at most 2 per connection")
+
+	first()
+	first()
+	_, err = acquireSubscription(ctx)
+	require.NoError(t, err)
+	_, err = acquireSubscription(ctx)
+	require.Error(t, err, "expected a double release to free one slot only")
+
+	release, err := acquireSubscription(t.Context())
+	require.NoError(t, err, "expected requests outside a websocket connection to be unlimited")
+	release()
+}
@@ -72,12 +72,18 @@ func NewConnection(appCtx context.Context, cfg config.DBConfig, logger logger.Co
 	return db, nil
 }
 
+// ConnectionString renders the keyword/value DSN for cfg, shared by the pooled GORM connection
+// and by dedicated connections such as the outbox LISTEN session.
+func ConnectionString(cfg config.DBConfig) string {
+	return fmt.Sprintf(MsgFormatConString, cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.Name, cfg.SSLMode, cfg.TimeZone)
+}
+
 // tryConnectingWithRetries attempts to establish a database connection with retries.
 func tryConnectingWithRetries(cfg config.DBConfig, logger logger.ContextLogger) (*gorm.DB, error) {
 	var db *gorm.DB
 	var err error
 
-	conString := fmt.Sprintf(MsgFormatConString, cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.Name, cfg.SSLMode, cfg.TimeZone)
+	conString := ConnectionString(cfg)
 	logger.Infow(MsgDBConnection, commonkeys.DBHost, cfg.Host, commonkeys.DBPort, cfg.Port, commonkeys.DBName, cfg.Name)
 
 	for tryConnect := 1; tryConnect <= cfg.MaxRetries; tryConnect++ {
@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // AuthMiddleware is a middleware that authenticates a user.
@@ -52,7 +53,7 @@ func (a *AuthMiddleware) Auth(next http.Handler) http.Handler {
 		ctx, span := tr.Start(r.Context(), SpanAuthMiddleware)
 		defer span.End()
 
-		rawToken, err := extractToken(r)
+		rawToken, err := ExtractToken(r)
 		if err != nil || rawToken == "" {
 			span.SetStatus(codes.Error, SpanErrorMissingToken)
 			if err == nil {
@@ -63,35 +64,55 @@ func (a *AuthMiddleware) Auth(next http.Handler) http.Handler {
 			return
 		}
 
-		userID, claims, err := a.authService.Validate(ctx, rawToken)
+		ctx, err = a.Authenticate(ctx, rawToken)
 		if err != nil {
-			err = sharederrors.ErrUnauthorized(err.Error())
-			span.SetStatus(codes.Error, SpanErrorTokenInvalid)
-			span.SetAttributes(attribute.String(AttrAuthMiddlewareError, err.Error()))
-			a.logger.WarnwCtx(ctx, ErrorUnauthorizedAccessInvalidToken, commonkeys.Error, err.Error())
 			httpresponse.WriteAuthError(w, err, a.logger)
 			return
 		}
 
-		ctx = context.WithValue(ctx, ctxkeys.UserID, userID)
-		ctx = context.WithValue(ctx, ctxkeys.Token, rawToken)
-		if claims != nil {
-			ctx = context.WithValue(ctx, ctxkeys.Claims, claims)
-		}
-
-		span.SetStatus(codes.Ok, SpanStatusAuthenticated)
-		span.SetAttributes(
-			attribute.String(AttrAuthMiddlewareUserID, strconv.FormatUint(userID, 10)),
-			attribute.String(AttrAuthMiddlewareStatus, StatusAuthenticated),
-		)
-		a.logger.InfowCtx(ctx, MsgContextSet, commonkeys.UserID, strconv.FormatUint(userID, 10))
-
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
-// extractToken extracts the token from the request.
-func extractToken(r *http.Request) (string, error) {
+// Authenticate validates rawToken and returns ctx carrying the user ID, token and claims.
+// It is the check Auth runs for every request, exposed for transports that receive the token
+// outside HTTP headers, such as the GraphQL websocket connection_init payload.
+func (a *AuthMiddleware) Authenticate(ctx context.Context, rawToken string) (context.Context, error) {
+	span := trace.SpanFromContext(ctx)
+
+	if rawToken == "" {
+		span.SetStatus(codes.Error, SpanErrorMissingToken)
+		a.logger.WarnwCtx(ctx, ErrorUnauthorizedAccessMissingToken)
+		return ctx, sharederrors.ErrUnauthorized(ErrorUnauthorizedAccessMissingToken)
+	}
+
+	userID, claims, err := a.authService.Validate(ctx, rawToken)
+	if err != nil {
+		err = sharederrors.ErrUnauthorized(err.Error())
+		span.SetStatus(codes.Error, SpanErrorTokenInvalid)
+		span.SetAttributes(attribute.String(AttrAuthMiddlewareError, err.Error()))
+		a.logger.WarnwCtx(ctx, ErrorUnauthorizedAccessInvalidToken, commonkeys.Error, err.Error())
+		return ctx, err
+	}
+
+	ctx = context.WithValue(ctx, ctxkeys.UserID, userID)
+	ctx = context.WithValue(ctx, ctxkeys.Token, rawToken)
+	if claims != nil {
+		ctx = context.WithValue(ctx, ctxkeys.Claims, claims)
+	}
+
+	span.SetStatus(codes.Ok, SpanStatusAuthenticated)
+	span.SetAttributes(
+		attribute.String(AttrAuthMiddlewareUserID, strconv.FormatUint(userID, 10)),
+		attribute.String(AttrAuthMiddlewareStatus, StatusAuthenticated),
+	)
+	a.logger.InfowCtx(ctx, MsgContextSet, commonkeys.UserID, strconv.FormatUint(userID, 10))
+
+	return ctx, nil
+}
+
+// ExtractToken extracts the token from the Authorization bearer header or the auth cookie.
+func ExtractToken(r *http.Request) (string, error) {
 	// Authorization: Bearer <token>
 	if ah := r.Header.Get("Authorization"); ah != "" {
 		parts := strings.SplitN(ah, " ", 2)
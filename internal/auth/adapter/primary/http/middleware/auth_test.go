@@ -187,3 +187,32 @@ func TestAuthMiddleware_ValidCookieToken(t *testing.T) {
 		t.Fatalf("expected user id 7, got %d", gotUserID)
 	}
 }
+
+func TestAuthMiddleware_AuthenticateSetsContext(t *testing.T) {
+	m := middleware.New(&fakeAuthService{
+		validateFn: func(_ context.Context, token string) (uint64, map[string]any, error) {
+			if token != "ws-token" {
+				return 0, nil, sharederrors.ErrUnauthorized("invalid")
+			}
+			return 7, map[string]any{"roles": []string{"user"}}, nil
+		},
+	}, &fakeLogger{})
+
+	ctx, err := m.Authenticate(t.Context(), "ws-token")
+	if err != nil {
+		t.Fatalf("expected token to authenticate, got %v", err)
+	}
+	if got, _ := ctx.Value(ctxkeys.UserID).(uint64); got != 7 {
+		t.Fatalf("expected user 7 in context, got %v", ctx.Value(ctxkeys.UserID))
+	}
+	if got, _ := ctx.Value(ctxkeys.Token).(string); got != "ws-token" {
+		t.Fatalf("expected token in context, got %v", ctx.Value(ctxkeys.Token))
+	}
+
+	if _, err := m.Authenticate(t.Context(), ""); err == nil {
+		t.Fatal("expected error for empty token")
+	}
+	if _, err := m.Authenticate(t.Context(), "other"); err == nil {
+		t.Fatal("expected error for invalid token")
+	}
+}
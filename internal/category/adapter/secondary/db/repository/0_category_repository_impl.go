@@ -2,6 +2,7 @@
 package repository
 
 import (
+	"github.com/lechitz/aion-api/internal/category/core/ports/output"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
 )
@@ -20,3 +21,11 @@ func New(database db.DB, logger logger.ContextLogger) *CategoryRepository {
 		logger: logger,
 	}
 }
+
+// WithDB clones the repository with a transaction-bound database handle.
+func (c CategoryRepository) WithDB(database db.DB) output.CategoryRepository {
+	return CategoryRepository{
+		db:     database,
+		logger: c.logger,
+	}
+}
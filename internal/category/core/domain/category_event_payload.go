@@ -0,0 +1,13 @@
+package domain
+
+// CategoryEventPayloadV1 is the outbox payload of category.created, category.updated, and category.deleted at v1.
+// Its shape is pinned by the category.v1 JSON Schema in the event schema registry.
+type CategoryEventPayloadV1 struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Color        string `json:"color"`
+	Icon         string `json:"icon"`
+	UpdatedAtUTC string `json:"updated_at_utc"`
+	CategoryID   uint64 `json:"category_id"`
+	UserID       uint64 `json:"user_id"`
+}
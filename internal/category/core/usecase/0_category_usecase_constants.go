@@ -58,6 +58,8 @@ const (
 	EventInvalidateCache = "category.cache.invalidate"
 	// EventSaveToCache marks cache save operations.
 	EventSaveToCache = "category.cache.save"
+	// EventPublishRealtime marks announcing the committed change to the user's realtime streams.
+	EventPublishRealtime = "category.realtime.publish"
 	// EventSuccess marks a successful outcome.
 	EventSuccess = "category.success"
 )
@@ -193,3 +195,27 @@ var (
 	// ErrCategoryIconInvalid is a sentinel error when icon is invalid.
 	ErrCategoryIconInvalid = errors.New(CategoryIconInvalid)
 )
+
+// =============================================================================
+// OUTBOX EVENTS - Canonical category events
+// =============================================================================
+
+const (
+	// CategoryAggregateType identifies the category aggregate in canonical outbox events.
+	CategoryAggregateType = "category"
+	// CategoryEventVersionV1 identifies the first canonical category event contract version.
+	CategoryEventVersionV1 = "v1"
+	// CategoryEventTypeCreatedV1 is emitted after category creation succeeds.
+	CategoryEventTypeCreatedV1 = "category.created"
+	// CategoryEventTypeUpdatedV1 is emitted after a category update succeeds, including renames.
+	CategoryEventTypeUpdatedV1 = "category.updated"
+	// CategoryEventTypeDeletedV1 is emitted after category soft deletion succeeds.
+	CategoryEventTypeDeletedV1 = "category.deleted"
+)
+
+const (
+	// FailedToEnqueueCategoryEvent indicates the outbox rejected the event, which rolls the category write back.
+	FailedToEnqueueCategoryEvent = "failed to enqueue category outbox event"
+	// FailedToMarshalCategoryEventPayload indicates payload serialization failed before enqueue.
+	FailedToMarshalCategoryEventPayload = "failed to marshal category outbox payload"
+)
@@ -6,13 +6,19 @@ package usecase
 
 import (
 	"github.com/lechitz/aion-api/internal/category/core/ports/output"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	realtimeinput "github.com/lechitz/aion-api/internal/realtime/core/ports/input"
 )
 
 // Service provides operations for managing categories including creation, retrieval, updates, and soft deletion, using a repository and contextlogger.
 type Service struct {
 	CategoryRepository output.CategoryRepository
 	CategoryCache      output.CategoryCache
+	OutboxService      eventoutboxinput.Service
+	TransactionManager dbport.DB
+	Realtime           realtimeinput.Publisher
 	Logger             logger.ContextLogger
 }
 
@@ -24,3 +30,21 @@ func NewService(repository output.CategoryRepository, cache output.CategoryCache
 		Logger:             logger,
 	}
 }
+
+// WithOutbox attaches an optional outbox service without breaking existing constructor call sites.
+func (s *Service) WithOutbox(outboxService eventoutboxinput.Service) *Service {
+	s.OutboxService = outboxService
+	return s
+}
+
+// WithTransactionManager attaches an optional transaction manager without breaking constructor call sites.
+func (s *Service) WithTransactionManager(database dbport.DB) *Service {
+	s.TransactionManager = database
+	return s
+}
+
+// WithRealtime attaches an optional realtime publisher that announces committed category changes to the user's streams.
+func (s *Service) WithRealtime(publisher realtimeinput.Publisher) *Service {
+	s.Realtime = publisher
+	return s
+}
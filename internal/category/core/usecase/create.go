@@ -6,6 +6,9 @@ import (
 
 	"github.com/lechitz/aion-api/internal/category/core/domain"
 	"github.com/lechitz/aion-api/internal/category/core/ports/input"
+	"github.com/lechitz/aion-api/internal/category/core/ports/output"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -50,8 +53,17 @@ func (s *Service) Create(ctx context.Context, cmd input.CreateCategoryCommand) (
 		return domain.Category{}, ErrCategoryAlreadyExists
 	}
 
-	span.AddEvent(EventRepositoryCreate)
-	createdCategory, err := s.CategoryRepository.Create(ctx, newCategory)
+	var createdCategory domain.Category
+	err = s.runWithinCategoryOutboxTransaction(ctx, func(categoryRepo output.CategoryRepository, outboxService eventoutboxinput.Service) error {
+		span.AddEvent(EventRepositoryCreate)
+		var createErr error
+		createdCategory, createErr = categoryRepo.Create(ctx, newCategory)
+		if createErr != nil {
+			return createErr
+		}
+
+		return s.enqueueCategoryOutboxEventWithService(ctx, outboxService, CategoryEventTypeCreatedV1, createdCategory)
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, FailedToCreateCategory)
@@ -68,6 +80,8 @@ func (s *Service) Create(ctx context.Context, cmd input.CreateCategoryCommand) (
 		)
 	}
 
+	s.publishCategoryChanged(ctx, span, realtimedomain.ActionCreated, createdCategory)
+
 	span.AddEvent(EventSuccess)
 	span.SetStatus(codes.Ok, StatusCreated)
 	s.Logger.InfowCtx(ctx, fmt.Sprintf(SuccessfullyCreatedCategory, createdCategory.Name))
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lechitz/aion-api/internal/category/core/domain"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/outboxtx"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+)
+
+func (s *Service) enqueueCategoryOutboxEventWithService(ctx context.Context, outboxService eventoutboxinput.Service, eventType string, category domain.Category) error {
+	if outboxService == nil {
+		return nil
+	}
+
+	event, err := outboxtx.NewEvent(ctx, CategoryAggregateType, category.ID, eventType, CategoryEventVersionV1, domain.CategoryEventPayloadV1{
+		CategoryID:   category.ID,
+		UserID:       category.UserID,
+		Name:         category.Name,
+		Description:  category.Description,
+		Color:        category.Color,
+		Icon:         category.Icon,
+		UpdatedAtUTC: outboxtx.FormatTime(category.UpdatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", FailedToMarshalCategoryEventPayload, err)
+	}
+
+	if err := outboxService.Enqueue(ctx, event); err != nil {
+		return fmt.Errorf("%s: %w", FailedToEnqueueCategoryEvent, err)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/category/core/domain"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// publishCategoryChanged announces a committed category change to the user's realtime streams. A
+// deleted category only carries its id, so its name stays empty.
+func (s *Service) publishCategoryChanged(ctx context.Context, span trace.Span, action string, category domain.Category) {
+	if s.Realtime == nil {
+		return
+	}
+
+	changedAt := category.UpdatedAt.UTC()
+	if category.UpdatedAt.IsZero() {
+		changedAt = time.Now().UTC()
+	}
+
+	span.AddEvent(EventPublishRealtime)
+	s.Realtime.Publish(ctx, realtimedomain.NewCategoryChangedEvent(category.UserID, action, realtimedomain.CategoryChanged{
+		CategoryID:   category.ID,
+		Name:         category.Name,
+		ChangedAtUTC: changedAt,
+	}))
+}
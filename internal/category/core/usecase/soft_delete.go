@@ -5,6 +5,10 @@ import (
 	"errors"
 	"strconv"
 
+	"github.com/lechitz/aion-api/internal/category/core/domain"
+	"github.com/lechitz/aion-api/internal/category/core/ports/output"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -22,8 +26,14 @@ func (s *Service) SoftDelete(ctx context.Context, categoryID uint64, userID uint
 		attribute.String(commonkeys.CategoryID, strconv.FormatUint(categoryID, 10)),
 	)
 
-	span.AddEvent(EventRepositoryDelete)
-	if err := s.CategoryRepository.SoftDelete(ctx, categoryID, userID); err != nil {
+	if err := s.runWithinCategoryOutboxTransaction(ctx, func(categoryRepo output.CategoryRepository, outboxService eventoutboxinput.Service) error {
+		span.AddEvent(EventRepositoryDelete)
+		if deleteErr := categoryRepo.SoftDelete(ctx, categoryID, userID); deleteErr != nil {
+			return deleteErr
+		}
+
+		return s.enqueueCategoryOutboxEventWithService(ctx, outboxService, CategoryEventTypeDeletedV1, domain.Category{ID: categoryID, UserID: userID})
+	}); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, FailedToSoftDeleteCategory)
 		s.Logger.ErrorwCtx(ctx, FailedToSoftDeleteCategory, commonkeys.CategoryID, strconv.FormatUint(categoryID, 10), commonkeys.Error, err)
@@ -47,6 +57,8 @@ func (s *Service) SoftDelete(ctx context.Context, categoryID uint64, userID uint
 		)
 	}
 
+	s.publishCategoryChanged(ctx, span, realtimedomain.ActionDeleted, domain.Category{ID: categoryID, UserID: userID})
+
 	span.AddEvent(EventSuccess)
 	span.SetStatus(codes.Ok, StatusSoftDeleted)
 	s.Logger.InfowCtx(ctx, SuccessfullySoftDeletedCategory, commonkeys.CategoryID, strconv.FormatUint(categoryID, 10))
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/lechitz/aion-api/internal/category/core/usecase"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"go.uber.org/mock/gomock"
 
 	"github.com/lechitz/aion-api/tests/setup"
@@ -48,3 +49,36 @@ func TestSoftDeleteCategory_Success(t *testing.T) {
 	err := suite.CategoryService.SoftDelete(suite.Ctx, categoryID, userID)
 	assert.NoError(t, err)
 }
+
+func TestSoftDeleteCategory_PublishesCategoryDeleted(t *testing.T) {
+	suite := setup.CategoryServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.CategoryService.WithRealtime(publisher)
+
+	suite.CategoryRepository.EXPECT().SoftDelete(gomock.Any(), uint64(1), uint64(3)).Return(nil)
+	suite.CategoryCache.EXPECT().DeleteCategory(gomock.Any(), uint64(1), uint64(3)).Return(nil)
+	suite.CategoryCache.EXPECT().DeleteCategoryList(gomock.Any(), uint64(3)).Return(nil)
+
+	assert.NoError(t, suite.CategoryService.SoftDelete(suite.Ctx, 1, 3))
+
+	events := publisher.Events()
+	assert.Len(t, events, 1)
+	assert.Equal(t, realtimedomain.EventTypeCategoryChanged, events[0].Type)
+	assert.Equal(t, realtimedomain.ActionDeleted, events[0].Action)
+	assert.Equal(t, uint64(1), events[0].CategoryID)
+}
+
+func TestSoftDeleteCategory_FailedDeletePublishesNothing(t *testing.T) {
+	suite := setup.CategoryServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.CategoryService.WithRealtime(publisher)
+
+	suite.CategoryRepository.EXPECT().SoftDelete(gomock.Any(), uint64(1), uint64(3)).Return(errors.New("db down"))
+
+	assert.Error(t, suite.CategoryService.SoftDelete(suite.Ctx, 1, 3))
+	assert.Empty(t, publisher.Events())
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/category/core/ports/output"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/outboxtx"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+)
+
+func (s *Service) runWithinCategoryOutboxTransaction(ctx context.Context, fn func(categoryRepo output.CategoryRepository, outboxService eventoutboxinput.Service) error) error {
+	return outboxtx.Run(ctx, s.TransactionManager, s.CategoryRepository, s.OutboxService, fn)
+}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/lechitz/aion-api/internal/category/core/domain"
 	"github.com/lechitz/aion-api/internal/category/core/ports/input"
+	"github.com/lechitz/aion-api/internal/category/core/ports/output"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -44,8 +47,17 @@ func (s *Service) Update(ctx context.Context, cmd input.UpdateCategoryCommand) (
 
 	fieldsToUpdate := extractUpdateFields(cmd)
 
-	span.AddEvent(EventRepositoryUpdate)
-	updatedCategory, err := s.CategoryRepository.UpdateCategory(ctx, cmd.ID, cmd.UserID, fieldsToUpdate)
+	var updatedCategory domain.Category
+	err = s.runWithinCategoryOutboxTransaction(ctx, func(categoryRepo output.CategoryRepository, outboxService eventoutboxinput.Service) error {
+		span.AddEvent(EventRepositoryUpdate)
+		var updateErr error
+		updatedCategory, updateErr = categoryRepo.UpdateCategory(ctx, cmd.ID, cmd.UserID, fieldsToUpdate)
+		if updateErr != nil {
+			return updateErr
+		}
+
+		return s.enqueueCategoryOutboxEventWithService(ctx, outboxService, CategoryEventTypeUpdatedV1, updatedCategory)
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, FailedToUpdateCategory)
@@ -61,6 +73,8 @@ func (s *Service) Update(ctx context.Context, cmd input.UpdateCategoryCommand) (
 	span.AddEvent(EventInvalidateCache)
 	s.invalidateCategoryCaches(ctx, updatedCategory)
 
+	s.publishCategoryChanged(ctx, span, realtimedomain.ActionUpdated, updatedCategory)
+
 	span.AddEvent(EventSuccess)
 	span.SetStatus(codes.Ok, StatusUpdated)
 	s.Logger.InfowCtx(
@@ -1,13 +1,20 @@
 package usecase_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/lechitz/aion-api/internal/category/core/domain"
 	"github.com/lechitz/aion-api/internal/category/core/ports/input"
+	"github.com/lechitz/aion-api/internal/category/core/ports/output"
 	"github.com/lechitz/aion-api/internal/category/core/usecase"
+	eventoutboxdomain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"github.com/lechitz/aion-api/tests/mocks"
 	"github.com/lechitz/aion-api/tests/setup"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -167,3 +174,122 @@ func TestUpdateCategory_ErrorToValidateCategory_IconInvalid(t *testing.T) {
 	require.Equal(t, usecase.CategoryIconInvalid, err.Error())
 	require.Equal(t, domain.Category{}, updated)
 }
+
+type txAwareCategoryRepository struct {
+	output.CategoryRepository
+	txRepository output.CategoryRepository
+}
+
+func (r txAwareCategoryRepository) WithDB(_ dbport.DB) output.CategoryRepository {
+	return r.txRepository
+}
+
+type txAwareOutboxService struct {
+	eventoutboxinput.Service
+	txService eventoutboxinput.Service
+}
+
+func (s txAwareOutboxService) WithDB(_ dbport.DB) eventoutboxinput.Service {
+	return s.txService
+}
+
+func TestUpdateCategory_RenameEnqueuesEventInSameTransaction(t *testing.T) {
+	suite := setup.CategoryServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	database := mocks.NewMockDB(suite.Ctrl)
+	tx := mocks.NewMockDB(suite.Ctrl)
+	txRepository := mocks.NewMockCategoryRepository(suite.Ctrl)
+	txOutbox := mocks.NewMockOutboxService(suite.Ctrl)
+
+	suite.CategoryService.CategoryRepository = txAwareCategoryRepository{CategoryRepository: suite.CategoryRepository, txRepository: txRepository}
+	suite.CategoryService.
+		WithOutbox(txAwareOutboxService{Service: mocks.NewMockOutboxService(suite.Ctrl), txService: txOutbox}).
+		WithTransactionManager(database)
+
+	renamed := domain.Category{ID: 1, UserID: 3, Name: "Deep Work"}
+	cmd := makeUpdateCmdFromDomain(renamed)
+
+	suite.CategoryRepository.EXPECT().GetByName(gomock.Any(), renamed.Name, renamed.UserID).Return(domain.Category{}, nil)
+	database.EXPECT().WithContext(gomock.Any()).Return(database)
+	database.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(dbport.DB) error) error {
+		return fn(tx)
+	})
+	txRepository.EXPECT().
+		UpdateCategory(gomock.Any(), renamed.ID, renamed.UserID, map[string]interface{}{commonkeys.CategoryName: renamed.Name}).
+		Return(renamed, nil)
+	txOutbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event eventoutboxdomain.Event) error {
+		require.Equal(t, usecase.CategoryAggregateType, event.AggregateType)
+		require.Equal(t, usecase.CategoryEventTypeUpdatedV1, event.EventType)
+		require.Contains(t, string(event.PayloadJSON), `"name":"Deep Work"`)
+		return nil
+	})
+	suite.CategoryCache.EXPECT().DeleteCategory(gomock.Any(), renamed.ID, renamed.UserID).Return(nil)
+	suite.CategoryCache.EXPECT().DeleteCategoryByName(gomock.Any(), renamed.Name, renamed.UserID).Return(nil)
+	suite.CategoryCache.EXPECT().DeleteCategoryList(gomock.Any(), renamed.UserID).Return(nil)
+
+	updated, err := suite.CategoryService.Update(suite.Ctx, cmd)
+
+	require.NoError(t, err)
+	require.Equal(t, renamed, updated)
+}
+
+func TestUpdateCategory_FailedEnqueueRollsBackTheUpdate(t *testing.T) {
+	suite := setup.CategoryServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	database := mocks.NewMockDB(suite.Ctrl)
+	tx := mocks.NewMockDB(suite.Ctrl)
+	txRepository := mocks.NewMockCategoryRepository(suite.Ctrl)
+	txOutbox := mocks.NewMockOutboxService(suite.Ctrl)
+
+	suite.CategoryService.CategoryRepository = txAwareCategoryRepository{CategoryRepository: suite.CategoryRepository, txRepository: txRepository}
+	suite.CategoryService.
+		WithOutbox(txAwareOutboxService{Service: mocks.NewMockOutboxService(suite.Ctrl), txService: txOutbox}).
+		WithTransactionManager(database)
+
+	renamed := domain.Category{ID: 1, UserID: 3, Name: "Deep Work"}
+	enqueueErr := errors.New("payload rejected by schema")
+
+	suite.CategoryRepository.EXPECT().GetByName(gomock.Any(), renamed.Name, renamed.UserID).Return(domain.Category{}, nil)
+	database.EXPECT().WithContext(gomock.Any()).Return(database)
+	database.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(dbport.DB) error) error {
+		err := fn(tx)
+		require.ErrorIs(t, err, enqueueErr, "the callback error is what makes the transaction roll back")
+		return err
+	})
+	txRepository.EXPECT().UpdateCategory(gomock.Any(), renamed.ID, renamed.UserID, gomock.Any()).Return(renamed, nil)
+	txOutbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(enqueueErr)
+
+	_, err := suite.CategoryService.Update(suite.Ctx, makeUpdateCmdFromDomain(renamed))
+
+	require.ErrorIs(t, err, enqueueErr)
+}
+
+func TestUpdateCategory_PublishesCategoryChanged(t *testing.T) {
+	suite := setup.CategoryServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.CategoryService.WithRealtime(publisher)
+
+	category := domain.Category{ID: 1, UserID: 3, Name: "Work", Icon: "work/briefcase.svg"}
+	cmd := makeUpdateCmdFromDomain(category)
+
+	suite.CategoryRepository.EXPECT().GetByName(gomock.Any(), category.Name, category.UserID).Return(domain.Category{}, nil)
+	suite.CategoryRepository.EXPECT().UpdateCategory(gomock.Any(), category.ID, category.UserID, gomock.Any()).Return(category, nil)
+	suite.CategoryCache.EXPECT().DeleteCategory(gomock.Any(), category.ID, category.UserID).Return(nil)
+	suite.CategoryCache.EXPECT().DeleteCategoryByName(gomock.Any(), category.Name, category.UserID).Return(nil)
+	suite.CategoryCache.EXPECT().DeleteCategoryList(gomock.Any(), category.UserID).Return(nil)
+
+	_, err := suite.CategoryService.Update(suite.Ctx, cmd)
+	require.NoError(t, err)
+
+	events := publisher.Events()
+	require.Len(t, events, 1)
+	require.Equal(t, realtimedomain.EventTypeCategoryChanged, events[0].Type)
+	require.Equal(t, realtimedomain.ActionUpdated, events[0].Action)
+	require.Equal(t, category.ID, events[0].CategoryID)
+	require.Equal(t, category.UserID, events[0].UserID)
+	require.Equal(t, category.Name, events[0].Category.Name)
+}
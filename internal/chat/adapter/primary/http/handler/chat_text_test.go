@@ -223,6 +223,32 @@ func TestChatText_Errors(t *testing.T) {
 	})
 }
 
+func TestChatText_ForwardsQuickAddIdempotencyKey(t *testing.T) {
+	h := handler.New(mockChatService{
+		processFn: func(_ context.Context, _ uint64, _ string, requestContext map[string]interface{}) (*domain.ChatResult, error) {
+			uiAction, ok := requestContext["ui_action"].(map[string]interface{})
+			require.True(t, ok, "expected ui_action in the request context")
+			quickAdd, ok := uiAction["quick_add"].(map[string]interface{})
+			require.True(t, ok, "expected ui_action.quick_add in the request context")
+			require.Equal(t, "qa-1", quickAdd["idempotency_key"])
+			return &domain.ChatResult{Response: "ok"}, nil
+		},
+	}, &config.Config{}, mockLogger{})
+
+	req := httptest.NewRequestWithContext(
+		t.Context(),
+		http.MethodPost,
+		"/chat/text",
+		strings.NewReader(`{"message":"salvar","context":{"ui_action":{"type":"draft_accept","quick_add":{"entity":"record","operation":"create","idempotency_key":" qa-1 "}}}}`),
+	)
+	req = req.WithContext(context.WithValue(t.Context(), ctxkeys.UserID, uint64(9)))
+	rec := httptest.NewRecorder()
+
+	h.ChatText(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
 func TestChatText_LogsUIActionMetadataWithConsent(t *testing.T) {
 	logger := &capturingLogger{}
 	h := handler.New(mockChatService{
@@ -80,6 +80,27 @@ func TestSendMessage_Success(t *testing.T) {
 	require.Equal(t, 10, resp.TokensUsed)
 }
 
+func TestSendMessage_ForwardsRequestContext(t *testing.T) {
+	client := chathttp.New(mockChatHTTPClient{
+		doFn: func(req *stdhttp.Request) (*stdhttp.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"user_id":1,"message":"hello","context":{"ui_action":{"quick_add":{"idempotency_key":"qa-1"}}}}`, string(body))
+			return &stdhttp.Response{
+				StatusCode: stdhttp.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"response":"ok"}`)),
+			}, nil
+		},
+	}, "http://aion-chat:8000", mockChatHTTPLogger{})
+
+	req := newRequest()
+	req.Context = map[string]interface{}{
+		"ui_action": map[string]interface{}{"quick_add": map[string]interface{}{"idempotency_key": "qa-1"}},
+	}
+	_, err := client.SendMessage(t.Context(), req)
+	require.NoError(t, err)
+}
+
 func TestSendMessage_Errors(t *testing.T) {
 	t.Run("marshal error", func(t *testing.T) {
 		client := chathttp.New(mockChatHTTPClient{
@@ -44,6 +44,16 @@ const (
 	AttrFunctionCallsCount = "aion.chat.function_calls_count"
 )
 
+// -----------------------------------------------------------------------------
+// Span Events
+// Format: <domain>.<action>.<detail>
+// -----------------------------------------------------------------------------
+
+const (
+	// EventPublishRealtime marks announcing a stored chat answer to the user's realtime streams.
+	EventPublishRealtime = "chat.realtime.publish"
+)
+
 // -----------------------------------------------------------------------------
 // Status Descriptions
 // -----------------------------------------------------------------------------
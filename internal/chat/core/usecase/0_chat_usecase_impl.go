@@ -9,6 +9,7 @@ import (
 	"github.com/lechitz/aion-api/internal/chat/core/ports/input"
 	"github.com/lechitz/aion-api/internal/chat/core/ports/output"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	realtimeinput "github.com/lechitz/aion-api/internal/realtime/core/ports/input"
 )
 
 // ChatService provides operations for processing chat messages using the Aion-Chat AI service.
@@ -17,6 +18,7 @@ type ChatService struct {
 	chatHistoryRepo  output.ChatHistoryRepository
 	chatHistoryCache output.ChatHistoryCache // Redis cache for fast history access
 	auditService     auditinput.Service
+	realtime         realtimeinput.Publisher
 	logger           logger.ContextLogger
 }
 
@@ -27,7 +29,7 @@ func NewService(
 	historyCache output.ChatHistoryCache,
 	auditSvc auditinput.Service,
 	log logger.ContextLogger,
-) input.ChatService {
+) *ChatService {
 	return &ChatService{
 		aionChatClient:   client,
 		chatHistoryRepo:  historyRepo,
@@ -36,3 +38,11 @@ func NewService(
 		logger:           log,
 	}
 }
+
+// WithRealtime attaches an optional realtime publisher that announces stored chat answers to the user's streams.
+func (s *ChatService) WithRealtime(publisher realtimeinput.Publisher) *ChatService {
+	s.realtime = publisher
+	return s
+}
+
+var _ input.ChatService = (*ChatService)(nil)
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/chat/core/domain"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// publishChatResponseReady tells the user's realtime streams that the answer in saved is stored.
+// Only its ids and usage leave this context; the client reads the text from the chat history.
+func (s *ChatService) publishChatResponseReady(ctx context.Context, span trace.Span, saved domain.ChatHistory) {
+	if s.realtime == nil {
+		return
+	}
+
+	payload := realtimedomain.ChatResponseReady{
+		ChatID:         saved.ChatID,
+		TokensUsed:     saved.TokensUsed,
+		RespondedAtUTC: saved.CreatedAt.UTC(),
+	}
+	if saved.SessionID != nil {
+		payload.SessionID = saved.SessionID.String()
+	}
+
+	span.AddEvent(EventPublishRealtime)
+	s.realtime.Publish(ctx, realtimedomain.NewChatResponseReadyEvent(saved.UserID, payload))
+}
@@ -65,6 +65,8 @@ func (s *ChatService) SaveChatHistory(ctx context.Context, userID uint64, messag
 		// Don't return error - cache miss is acceptable
 	}
 
+	s.publishChatResponseReady(ctx, span, saved)
+
 	span.SetAttributes(
 		attribute.String("chat_id", strconv.FormatUint(saved.ChatID, 10)),
 	)
@@ -6,7 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/lechitz/aion-api/internal/chat/core/domain"
+	"github.com/lechitz/aion-api/internal/chat/core/usecase"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/tests/setup"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -188,3 +191,52 @@ func TestSaveChatHistory_WithLongMessage(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestSaveChatHistory_PublishesChatResponseReady(t *testing.T) {
+	suite := setup.ChatServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	service := usecase.NewService(suite.AionChatClient, suite.HistoryRepo, suite.HistoryCache, suite.AuditService, suite.Logger).
+		WithRealtime(publisher)
+
+	sessionID := uuid.New()
+	savedHistory := domain.ChatHistory{
+		ChatID:     789,
+		UserID:     456,
+		SessionID:  &sessionID,
+		Response:   "AI stands for Artificial Intelligence...",
+		TokensUsed: 100,
+		CreatedAt:  time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	suite.HistoryRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(savedHistory, nil)
+	suite.HistoryCache.EXPECT().Add(gomock.Any(), savedHistory.UserID, savedHistory).Return(nil)
+
+	require.NoError(t, service.SaveChatHistory(suite.Ctx, savedHistory.UserID, "What is AI?", savedHistory.Response, savedHistory.TokensUsed, nil))
+
+	events := publisher.Events()
+	require.Len(t, events, 1)
+	require.Equal(t, realtimedomain.EventTypeChatResponseReady, events[0].Type)
+	require.Equal(t, savedHistory.UserID, events[0].UserID)
+	require.Equal(t, realtimedomain.ChatResponseReady{
+		ChatID:         savedHistory.ChatID,
+		SessionID:      sessionID.String(),
+		TokensUsed:     savedHistory.TokensUsed,
+		RespondedAtUTC: savedHistory.CreatedAt,
+	}, *events[0].Chat)
+}
+
+func TestSaveChatHistory_FailedSavePublishesNothing(t *testing.T) {
+	suite := setup.ChatServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	service := usecase.NewService(suite.AionChatClient, suite.HistoryRepo, suite.HistoryCache, suite.AuditService, suite.Logger).
+		WithRealtime(publisher)
+
+	suite.HistoryRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(domain.ChatHistory{}, errors.New("database connection failed"))
+
+	require.Error(t, service.SaveChatHistory(suite.Ctx, 456, "Test", "Response", 10, nil))
+	require.Empty(t, publisher.Events())
+}
@@ -0,0 +1,40 @@
+// Package handler implements admin HTTP handlers for event outbox operations.
+package handler
+
+const (
+	// TracerOutboxHandler is the tracer name for event outbox HTTP handlers.
+	TracerOutboxHandler = "aion-api.eventoutbox.handler"
+)
+
+const (
+	// SpanListDeadLettersHandler is the span name for listing dead-lettered outbox events.
+	SpanListDeadLettersHandler = "eventoutbox.handler.list_dead_letters"
+	// SpanGetEventHandler is the span name for loading one outbox event.
+	SpanGetEventHandler = "eventoutbox.handler.get_event"
+	// SpanReplayDeadLettersHandler is the span name for replaying dead-lettered outbox events.
+	SpanReplayDeadLettersHandler = "eventoutbox.handler.replay_dead_letters"
+)
+
+const (
+	errListDeadLetters   = "failed to list dead-lettered outbox events"
+	errGetEvent          = "failed to load outbox event"
+	errReplayDeadLetters = "failed to replay dead-lettered outbox events"
+	errForbiddenOutbox   = "outbox administration requires the admin role"
+)
+
+const (
+	msgDeadLettersListed   = "Dead-lettered outbox events listed successfully"
+	msgEventLoaded         = "Outbox event loaded successfully"
+	msgDeadLettersReplayed = "Dead-lettered outbox events replayed successfully"
+)
+
+const (
+	paramEventID = "event_id"
+
+	queryEventID       = "event_id"
+	queryAggregateType = "aggregate_type"
+	queryAggregateID   = "aggregate_id"
+	queryFromUTC       = "from_utc"
+	queryToUTC         = "to_utc"
+	queryLimit         = "limit"
+)
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+)
+
+// Handler wires event outbox admin use cases to HTTP handlers.
+type Handler struct {
+	Service input.DeadLetterService
+	Logger  logger.ContextLogger
+}
+
+// New creates a new event outbox HTTP handler.
+func New(service input.DeadLetterService, log logger.ContextLogger) *Handler {
+	return &Handler{
+		Service: service,
+		Logger:  log,
+	}
+}
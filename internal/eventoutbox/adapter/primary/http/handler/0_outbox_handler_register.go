@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"net/http"
+
+	authMiddleware "github.com/lechitz/aion-api/internal/auth/adapter/primary/http/middleware"
+	authinput "github.com/lechitz/aion-api/internal/auth/core/ports/input"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/platform/server/http/ports"
+)
+
+// RegisterHTTP registers event outbox admin routes with auth protection.
+// Every route additionally requires the admin role.
+func RegisterHTTP(r ports.Router, h *Handler, authService authinput.AuthService, lg logger.ContextLogger) {
+	if authService == nil {
+		return
+	}
+
+	mw := authMiddleware.New(authService, lg)
+	r.GroupWith(mw.Auth, func(ar ports.Router) {
+		ar.GET("/admin/outbox/dead-letters", http.HandlerFunc(h.ListDeadLetters))
+		ar.POST("/admin/outbox/dead-letters/replay", http.HandlerFunc(h.ReplayDeadLetters))
+		ar.GET("/admin/outbox/events/{event_id}", http.HandlerFunc(h.GetEvent))
+	})
+}
@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/usecase"
+	httperrors "github.com/lechitz/aion-api/internal/platform/server/http/errors"
+	"github.com/lechitz/aion-api/internal/platform/server/http/utils/httpresponse"
+	"github.com/lechitz/aion-api/internal/platform/server/http/utils/sharederrors"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	"github.com/lechitz/aion-api/internal/shared/constants/roles"
+	"github.com/lechitz/aion-api/internal/shared/constants/tracingkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type listDeadLettersResponse struct {
+	Items []eventResponse `json:"items"`
+	Count int             `json:"count"`
+}
+
+type eventResponse struct {
+	EventID        string          `json:"event_id"`
+	AggregateType  string          `json:"aggregate_type"`
+	AggregateID    string          `json:"aggregate_id"`
+	EventType      string          `json:"event_type"`
+	EventVersion   string          `json:"event_version"`
+	Source         string          `json:"source"`
+	Status         string          `json:"status"`
+	TraceID        string          `json:"trace_id,omitempty"`
+	RequestID      string          `json:"request_id,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	LeaseOwner     string          `json:"lease_owner,omitempty"`
+	Payload        json.RawMessage `json:"payload,omitempty"`
+	AttemptCount   int             `json:"attempt_count"`
+	AggregateSeq   int64           `json:"aggregate_sequence"`
+	AvailableAtUTC time.Time       `json:"available_at_utc"`
+	CreatedAt      time.Time       `json:"created_at"`
+	PublishedAtUTC *time.Time      `json:"published_at_utc,omitempty"`
+	LeaseExpiresAt *time.Time      `json:"lease_expires_at_utc,omitempty"`
+}
+
+type replayDeadLettersRequest struct {
+	FromUTC       *time.Time `json:"from_utc,omitempty"`
+	ToUTC         *time.Time `json:"to_utc,omitempty"`
+	AggregateType string     `json:"aggregate_type,omitempty"`
+	AggregateID   string     `json:"aggregate_id,omitempty"`
+	EventIDs      []string   `json:"event_ids,omitempty"`
+}
+
+type replayDeadLettersResponse struct {
+	Replayed int64 `json:"replayed"`
+}
+
+// ListDeadLetters handles GET /admin/outbox/dead-letters.
+func (h *Handler) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer(TracerOutboxHandler).Start(r.Context(), SpanListDeadLettersHandler)
+	defer span.End()
+
+	if !hasAdminRole(extractRolesFromContext(ctx)) {
+		httpresponse.WriteDomainErrorSpan(ctx, w, span, sharederrors.ErrForbidden(errForbiddenOutbox), errForbiddenOutbox, h.Logger)
+		return
+	}
+
+	filter, err := buildDeadLetterFilter(r)
+	if err != nil {
+		httpresponse.WriteValidationErrorSpan(ctx, w, span, err, h.Logger)
+		return
+	}
+
+	events, err := h.Service.ListDeadLettered(ctx, filter)
+	if err != nil {
+		httpresponse.WriteDomainErrorSpan(ctx, w, span, mapServiceError(err), errListDeadLetters, h.Logger)
+		return
+	}
+
+	response := listDeadLettersResponse{Items: toResponseItems(events), Count: len(events)}
+	span.SetAttributes(
+		attribute.Int("outbox.dead_letter_count", len(events)),
+		attribute.Int(tracingkeys.HTTPStatusCodeKey, http.StatusOK),
+	)
+	span.SetStatus(codes.Ok, msgDeadLettersListed)
+
+	httpresponse.WriteSuccess(w, http.StatusOK, response, msgDeadLettersListed)
+}
+
+// GetEvent handles GET /admin/outbox/events/{event_id}, including attempts and last error.
+func (h *Handler) GetEvent(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer(TracerOutboxHandler).Start(r.Context(), SpanGetEventHandler)
+	defer span.End()
+
+	if !hasAdminRole(extractRolesFromContext(ctx)) {
+		httpresponse.WriteDomainErrorSpan(ctx, w, span, sharederrors.ErrForbidden(errForbiddenOutbox), errForbiddenOutbox, h.Logger)
+		return
+	}
+
+	eventID := strings.TrimSpace(chi.URLParam(r, paramEventID))
+	if eventID == "" {
+		httpresponse.WriteValidationErrorSpan(ctx, w, span, sharederrors.NewValidationError(paramEventID, "is required"), h.Logger)
+		return
+	}
+	span.SetAttributes(attribute.String(paramEventID, eventID))
+
+	event, err := h.Service.GetEvent(ctx, eventID)
+	if err != nil {
+		httpresponse.WriteDomainErrorSpan(ctx, w, span, mapServiceError(err), errGetEvent, h.Logger)
+		return
+	}
+
+	span.SetAttributes(attribute.Int(tracingkeys.HTTPStatusCodeKey, http.StatusOK))
+	span.SetStatus(codes.Ok, msgEventLoaded)
+
+	httpresponse.WriteSuccess(w, http.StatusOK, toResponseItem(event), msgEventLoaded)
+}
+
+// ReplayDeadLetters handles POST /admin/outbox/dead-letters/replay.
+// The request body must select events by id, aggregate, or time range.
+func (h *Handler) ReplayDeadLetters(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer(TracerOutboxHandler).Start(r.Context(), SpanReplayDeadLettersHandler)
+	defer span.End()
+
+	actorRoles := extractRolesFromContext(ctx)
+	if !hasAdminRole(actorRoles) {
+		httpresponse.WriteDomainErrorSpan(ctx, w, span, sharederrors.ErrForbidden(errForbiddenOutbox), errForbiddenOutbox, h.Logger)
+		return
+	}
+
+	var req replayDeadLettersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpresponse.WriteDecodeErrorSpan(ctx, w, span, err, h.Logger)
+		return
+	}
+
+	filter := domain.DeadLetterFilter{
+		CreatedFromUTC: req.FromUTC,
+		CreatedToUTC:   req.ToUTC,
+		AggregateType:  req.AggregateType,
+		AggregateID:    req.AggregateID,
+		EventIDs:       req.EventIDs,
+	}
+
+	replayed, err := h.Service.ReplayDeadLettered(ctx, filter)
+	if err != nil {
+		httpresponse.WriteDomainErrorSpan(ctx, w, span, mapServiceError(err), errReplayDeadLetters, h.Logger)
+		return
+	}
+
+	h.Logger.InfowCtx(ctx, msgDeadLettersReplayed,
+		commonkeys.UserID, ctx.Value(ctxkeys.UserID),
+		"replayed_count", replayed,
+	)
+	span.SetAttributes(
+		attribute.Int64("outbox.replayed_count", replayed),
+		attribute.Int(tracingkeys.HTTPStatusCodeKey, http.StatusOK),
+	)
+	span.SetStatus(codes.Ok, msgDeadLettersReplayed)
+
+	httpresponse.WriteSuccess(w, http.StatusOK, replayDeadLettersResponse{Replayed: replayed}, msgDeadLettersReplayed)
+}
+
+func buildDeadLetterFilter(r *http.Request) (domain.DeadLetterFilter, error) {
+	query := r.URL.Query()
+	filter := domain.DeadLetterFilter{
+		AggregateType: strings.TrimSpace(query.Get(queryAggregateType)),
+		AggregateID:   strings.TrimSpace(query.Get(queryAggregateID)),
+		EventIDs:      query[queryEventID],
+	}
+
+	if rawLimit := strings.TrimSpace(query.Get(queryLimit)); rawLimit != "" {
+		limit, err := strconv.Atoi(rawLimit)
+		if err != nil || limit <= 0 {
+			return domain.DeadLetterFilter{}, sharederrors.NewValidationError(queryLimit, "must be a positive integer")
+		}
+		filter.Limit = limit
+	}
+
+	from, err := parseOptionalTime(queryFromUTC, query.Get(queryFromUTC))
+	if err != nil {
+		return domain.DeadLetterFilter{}, err
+	}
+	to, err := parseOptionalTime(queryToUTC, query.Get(queryToUTC))
+	if err != nil {
+		return domain.DeadLetterFilter{}, err
+	}
+	filter.CreatedFromUTC = from
+	filter.CreatedToUTC = to
+
+	return filter, nil
+}
+
+func parseOptionalTime(field, raw string) (*time.Time, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return nil, nil //nolint:nilnil // absent bound is not an error.
+	}
+
+	parsed, err := time.Parse(time.RFC3339, trimmed)
+	if err != nil {
+		return nil, sharederrors.NewValidationError(field, "must be RFC3339")
+	}
+	return &parsed, nil
+}
+
+// mapServiceError translates outbox use-case sentinels into errors the shared HTTP mapper understands.
+func mapServiceError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrEventNotFound):
+		return fmt.Errorf("%w: %w", httperrors.ErrResourceNotFound, err)
+	case errors.Is(err, usecase.ErrEventIDRequired):
+		return sharederrors.NewValidationError(paramEventID, err.Error())
+	case errors.Is(err, usecase.ErrDeadLetterSelectorRequired),
+		errors.Is(err, usecase.ErrDeadLetterTimeRangeInvalid):
+		return sharederrors.NewValidationError("filter", err.Error())
+	default:
+		return err
+	}
+}
+
+func extractRolesFromContext(ctx context.Context) []string {
+	claims, ok := ctx.Value(ctxkeys.Claims).(map[string]any)
+	if !ok {
+		return []string{}
+	}
+
+	switch rolesVal := claims[commonkeys.Roles].(type) {
+	case []string:
+		return rolesVal
+	case []any:
+		typedRoles := make([]string, 0, len(rolesVal))
+		for _, value := range rolesVal {
+			if roleName, ok := value.(string); ok {
+				typedRoles = append(typedRoles, roleName)
+			}
+		}
+		return typedRoles
+	default:
+		return []string{}
+	}
+}
+
+func hasAdminRole(rolesList []string) bool {
+	for _, roleName := range rolesList {
+		if roleName == roles.Admin {
+			return true
+		}
+	}
+	return false
+}
+
+func toResponseItems(events []domain.Event) []eventResponse {
+	items := make([]eventResponse, 0, len(events))
+	for _, event := range events {
+		items = append(items, toResponseItem(event))
+	}
+	return items
+}
+
+func toResponseItem(event domain.Event) eventResponse {
+	response := eventResponse{
+		EventID:        event.EventID,
+		AggregateType:  event.AggregateType,
+		AggregateID:    event.AggregateID,
+		EventType:      event.EventType,
+		EventVersion:   event.EventVersion,
+		Source:         event.Source,
+		Status:         event.Status,
+		TraceID:        event.TraceID,
+		RequestID:      event.RequestID,
+		LastError:      event.LastError,
+		AttemptCount:   event.AttemptCount,
+		AggregateSeq:   event.AggregateSequence,
+		AvailableAtUTC: event.AvailableAtUTC,
+		CreatedAt:      event.CreatedAt,
+		PublishedAtUTC: event.PublishedAtUTC,
+		LeaseOwner:     event.LeaseOwner,
+		LeaseExpiresAt: event.LeaseExpiresAtUTC,
+	}
+	if json.Valid(event.PayloadJSON) {
+		response.Payload = json.RawMessage(event.PayloadJSON)
+	}
+	return response
+}
@@ -0,0 +1,193 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/primary/http/handler"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/usecase"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	"github.com/lechitz/aion-api/tests/mocks"
+	"github.com/lechitz/aion-api/tests/setup"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type envelope struct {
+	Result json.RawMessage `json:"result"`
+	Code   int             `json:"code"`
+}
+
+func newOutboxHandler(t *testing.T) (*handler.Handler, *mocks.MockDeadLetterService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	svc := mocks.NewMockDeadLetterService(ctrl)
+	lg := mocks.NewMockContextLogger(ctrl)
+	setup.ExpectLoggerDefaultBehavior(lg)
+	lg.EXPECT().Errorw(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	return handler.New(svc, lg), svc
+}
+
+func withRoles(ctx context.Context, roleNames ...any) context.Context {
+	ctx = context.WithValue(ctx, ctxkeys.UserID, uint64(1))
+	return context.WithValue(ctx, ctxkeys.Claims, map[string]any{commonkeys.Roles: roleNames})
+}
+
+func TestListDeadLetters_Success(t *testing.T) {
+	h, svc := newOutboxHandler(t)
+
+	svc.EXPECT().ListDeadLettered(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter domain.DeadLetterFilter) ([]domain.Event, error) {
+			require.Equal(t, "record", filter.AggregateType)
+			require.Equal(t, []string{"evt-1", "evt-2"}, filter.EventIDs)
+			require.Equal(t, 20, filter.Limit)
+			require.NotNil(t, filter.CreatedFromUTC)
+			return []domain.Event{{EventID: "evt-1", AttemptCount: 10, LastError: "broker down", PayloadJSON: []byte(`{"id":1}`)}}, nil
+		},
+	)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/admin/outbox/dead-letters?aggregate_type=record&event_id=evt-1&event_id=evt-2&limit=20&from_utc=2026-03-01T00:00:00Z", nil)
+	req = req.WithContext(withRoles(req.Context(), "admin"))
+	rec := httptest.NewRecorder()
+
+	h.ListDeadLetters(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body envelope
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	var result struct {
+		Items []struct {
+			EventID      string          `json:"event_id"`
+			LastError    string          `json:"last_error"`
+			Payload      json.RawMessage `json:"payload"`
+			AttemptCount int             `json:"attempt_count"`
+		} `json:"items"`
+		Count int `json:"count"`
+	}
+	require.NoError(t, json.Unmarshal(body.Result, &result))
+	require.Equal(t, 1, result.Count)
+	require.Equal(t, 10, result.Items[0].AttemptCount)
+	require.Equal(t, "broker down", result.Items[0].LastError)
+	require.JSONEq(t, `{"id":1}`, string(result.Items[0].Payload))
+}
+
+func TestListDeadLetters_ForbiddenWithoutAdminRole(t *testing.T) {
+	h, _ := newOutboxHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/outbox/dead-letters", nil)
+	req = req.WithContext(withRoles(req.Context(), "user"))
+	rec := httptest.NewRecorder()
+
+	h.ListDeadLetters(rec, req)
+
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestListDeadLetters_InvalidQuery(t *testing.T) {
+	h, _ := newOutboxHandler(t)
+
+	for _, rawQuery := range []string{"limit=0", "from_utc=yesterday"} {
+		req := httptest.NewRequest(http.MethodGet, "/admin/outbox/dead-letters?"+rawQuery, nil)
+		req = req.WithContext(withRoles(req.Context(), "admin"))
+		rec := httptest.NewRecorder()
+
+		h.ListDeadLetters(rec, req)
+
+		require.Equal(t, http.StatusBadRequest, rec.Code, rawQuery)
+	}
+}
+
+func TestGetEvent(t *testing.T) {
+	h, svc := newOutboxHandler(t)
+
+	newRequest := func(eventID string) *http.Request {
+		routeCtx := chi.NewRouteContext()
+		routeCtx.URLParams.Add("event_id", eventID)
+		req := httptest.NewRequest(http.MethodGet, "/admin/outbox/events/"+eventID, nil)
+		ctx := context.WithValue(withRoles(req.Context(), "admin"), chi.RouteCtxKey, routeCtx)
+		return req.WithContext(ctx)
+	}
+
+	t.Run("found", func(t *testing.T) {
+		svc.EXPECT().GetEvent(gomock.Any(), "evt-1").Return(domain.Event{EventID: "evt-1", Status: "failed"}, nil)
+		rec := httptest.NewRecorder()
+
+		h.GetEvent(rec, newRequest("evt-1"))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc.EXPECT().GetEvent(gomock.Any(), "evt-9").Return(domain.Event{}, usecase.ErrEventNotFound)
+		rec := httptest.NewRecorder()
+
+		h.GetEvent(rec, newRequest("evt-9"))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestReplayDeadLetters(t *testing.T) {
+	h, svc := newOutboxHandler(t)
+
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/admin/outbox/dead-letters/replay", strings.NewReader(body))
+		return req.WithContext(withRoles(req.Context(), "admin"))
+	}
+
+	t.Run("success", func(t *testing.T) {
+		svc.EXPECT().ReplayDeadLettered(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, filter domain.DeadLetterFilter) (int64, error) {
+				require.Equal(t, "record", filter.AggregateType)
+				require.Equal(t, "42", filter.AggregateID)
+				return 3, nil
+			},
+		)
+		rec := httptest.NewRecorder()
+
+		h.ReplayDeadLetters(rec, newRequest(`{"aggregate_type":"record","aggregate_id":"42"}`))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var body envelope
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.JSONEq(t, `{"replayed":3}`, string(body.Result))
+	})
+
+	t.Run("missing selector", func(t *testing.T) {
+		svc.EXPECT().ReplayDeadLettered(gomock.Any(), gomock.Any()).Return(int64(0), usecase.ErrDeadLetterSelectorRequired)
+		rec := httptest.NewRecorder()
+
+		h.ReplayDeadLetters(rec, newRequest(`{}`))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+
+		h.ReplayDeadLetters(rec, newRequest(`{`))
+
+		require.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("repository failure", func(t *testing.T) {
+		svc.EXPECT().ReplayDeadLettered(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("db down"))
+		rec := httptest.NewRecorder()
+
+		h.ReplayDeadLetters(rec, newRequest(`{"event_ids":["evt-1"]}`))
+
+		require.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,72 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	authdomain "github.com/lechitz/aion-api/internal/auth/core/domain"
+	handlerpkg "github.com/lechitz/aion-api/internal/eventoutbox/adapter/primary/http/handler"
+	"github.com/lechitz/aion-api/internal/platform/server/http/ports"
+	"github.com/stretchr/testify/require"
+)
+
+type mockOutboxRouter struct {
+	groupWithCall int
+	gets          []string
+	posts         []string
+}
+
+func (m *mockOutboxRouter) Use(...ports.Middleware)          {}
+func (m *mockOutboxRouter) Group(string, func(ports.Router)) {}
+func (m *mockOutboxRouter) GroupWith(_ ports.Middleware, fn func(ports.Router)) {
+	m.groupWithCall++
+	fn(m)
+}
+func (m *mockOutboxRouter) Mount(string, http.Handler)                               {}
+func (m *mockOutboxRouter) Handle(string, string, http.Handler)                      {}
+func (m *mockOutboxRouter) GET(path string, _ http.Handler)                          { m.gets = append(m.gets, path) }
+func (m *mockOutboxRouter) POST(path string, _ http.Handler)                         { m.posts = append(m.posts, path) }
+func (m *mockOutboxRouter) PUT(string, http.Handler)                                 {}
+func (m *mockOutboxRouter) DELETE(string, http.Handler)                              {}
+func (m *mockOutboxRouter) SetNotFound(http.Handler)                                 {}
+func (m *mockOutboxRouter) SetMethodNotAllowed(http.Handler)                         {}
+func (m *mockOutboxRouter) SetError(func(http.ResponseWriter, *http.Request, error)) {}
+func (m *mockOutboxRouter) ServeHTTP(http.ResponseWriter, *http.Request)             {}
+
+type authServiceStub struct{}
+
+func (authServiceStub) Login(context.Context, string, string) (authdomain.AuthenticatedUser, string, string, error) {
+	return authdomain.AuthenticatedUser{}, "", "", nil
+}
+
+func (authServiceStub) Validate(context.Context, string) (uint64, map[string]any, error) {
+	return 0, nil, nil
+}
+
+func (authServiceStub) Logout(context.Context, uint64) error { return nil }
+
+func (authServiceStub) RefreshTokenRenewal(context.Context, string) (string, string, error) {
+	return "", "", nil
+}
+
+func TestRegisterHTTP(t *testing.T) {
+	h, _ := newOutboxHandler(t)
+	router := &mockOutboxRouter{}
+
+	handlerpkg.RegisterHTTP(router, h, authServiceStub{}, nil)
+
+	require.Equal(t, 1, router.groupWithCall)
+	require.Equal(t, []string{"/admin/outbox/dead-letters", "/admin/outbox/events/{event_id}"}, router.gets)
+	require.Equal(t, []string{"/admin/outbox/dead-letters/replay"}, router.posts)
+}
+
+func TestRegisterHTTP_NoAuthService(t *testing.T) {
+	h, _ := newOutboxHandler(t)
+	router := &mockOutboxRouter{}
+
+	handlerpkg.RegisterHTTP(router, h, nil, nil)
+
+	require.Equal(t, 0, router.groupWithCall)
+	require.Empty(t, router.gets)
+}
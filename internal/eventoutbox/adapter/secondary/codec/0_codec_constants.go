@@ -0,0 +1,71 @@
+// Package codec encodes outbox events into the transport-neutral envelope, headers, and topic
+// routing shared by every outbox publisher adapter.
+package codec
+
+const (
+	// RecordAggregateType identifies record events emitted by the canonical API.
+	RecordAggregateType = "record"
+	// TagAggregateType identifies tag events emitted by the canonical API.
+	TagAggregateType = "tag"
+	// CategoryAggregateType identifies category events emitted by the canonical API.
+	CategoryAggregateType = "category"
+	// UserAggregateType identifies user events emitted by the canonical API.
+	UserAggregateType = "user"
+	// MetricDefinitionAggregateType identifies dashboard metric definition events emitted by the canonical API.
+	MetricDefinitionAggregateType = "metric_definition"
+)
+
+const (
+	// HeaderEventID carries the outbox event id.
+	HeaderEventID = "event_id"
+	// HeaderEventType carries the canonical event type, e.g. record.created.
+	HeaderEventType = "event_type"
+	// HeaderEventVersion carries the payload schema version.
+	HeaderEventVersion = "event_version"
+	// HeaderAggregateType carries the aggregate type used for routing.
+	HeaderAggregateType = "aggregate_type"
+	// HeaderAggregateID carries the aggregate id, also used as the message key.
+	HeaderAggregateID = "aggregate_id"
+	// HeaderAggregateSequence carries the per-aggregate outbox sequence number of one event.
+	HeaderAggregateSequence = "aggregate_sequence"
+	// HeaderSource carries the producing service name.
+	HeaderSource = "source"
+)
+
+const (
+	// CloudEventsSpecVersion is the CloudEvents specification version of every CloudEvents envelope.
+	CloudEventsSpecVersion = "1.0"
+	// HeaderCloudEventsPrefix prefixes CloudEvents attributes carried as binary-mode Kafka headers, e.g. ce_id.
+	HeaderCloudEventsPrefix = "ce_"
+	// HeaderContentType carries the media type of the message body in CloudEvents modes.
+	HeaderContentType = "content-type"
+	// ContentTypeJSON is the media type of a legacy envelope or a binary-mode payload.
+	ContentTypeJSON = "application/json"
+	// ContentTypeCloudEventsJSON is the media type of a structured-mode CloudEvents document.
+	ContentTypeCloudEventsJSON = "application/cloudevents+json"
+)
+
+// CloudEvents context attributes and the aion-api extension attributes mapped from outbox events.
+const (
+	CloudEventsAttrSpecVersion       = "specversion"
+	CloudEventsAttrID                = "id"
+	CloudEventsAttrSource            = "source"
+	CloudEventsAttrType              = "type"
+	CloudEventsAttrSubject           = "subject"
+	CloudEventsAttrTime              = "time"
+	CloudEventsAttrAggregateType     = "aggregatetype"
+	CloudEventsAttrAggregateSequence = "aggregatesequence"
+	CloudEventsAttrEventVersion      = "eventversion"
+	CloudEventsAttrTraceID           = "traceid"
+	CloudEventsAttrRequestID         = "requestid"
+)
+
+const (
+	// occurredAtLayout keeps microsecond precision to match the outbox timestamp columns.
+	occurredAtLayout = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+const (
+	// ErrUnsupportedAggregateType is returned when no topic is configured for the aggregate.
+	ErrUnsupportedAggregateType = "unsupported aggregate type for outbox publication"
+)
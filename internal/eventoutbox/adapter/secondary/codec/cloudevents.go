@@ -0,0 +1,68 @@
+package codec
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+)
+
+// CloudEvent is the CloudEvents 1.0 view of one outbox event. The event type becomes type, the
+// aggregate id becomes subject, and the remaining outbox metadata travels as extension attributes.
+type CloudEvent struct {
+	SpecVersion       string          `json:"specversion"`
+	ID                string          `json:"id"`
+	Source            string          `json:"source"`
+	Type              string          `json:"type"`
+	Subject           string          `json:"subject"`
+	Time              string          `json:"time"`
+	DataContentType   string          `json:"datacontenttype"`
+	AggregateType     string          `json:"aggregatetype"`
+	AggregateSequence int64           `json:"aggregatesequence"`
+	EventVersion      string          `json:"eventversion"`
+	TraceID           string          `json:"traceid,omitempty"`
+	RequestID         string          `json:"requestid,omitempty"`
+	Data              json.RawMessage `json:"data"`
+}
+
+// NewCloudEvent maps an outbox event to its CloudEvents attributes. The payload is passed through untouched.
+func NewCloudEvent(event domain.Event) CloudEvent {
+	return CloudEvent{
+		SpecVersion:       CloudEventsSpecVersion,
+		ID:                event.EventID,
+		Source:            event.Source,
+		Type:              event.EventType,
+		Subject:           event.AggregateID,
+		Time:              event.CreatedAt.UTC().Format(occurredAtLayout),
+		DataContentType:   ContentTypeJSON,
+		AggregateType:     event.AggregateType,
+		AggregateSequence: event.AggregateSequence,
+		EventVersion:      event.EventVersion,
+		TraceID:           event.TraceID,
+		RequestID:         event.RequestID,
+		Data:              json.RawMessage(event.PayloadJSON),
+	}
+}
+
+// binaryHeaders maps the attributes of a binary-mode CloudEvent to ce_* headers; datacontenttype
+// travels as the content-type header, as the Kafka protocol binding requires.
+func binaryHeaders(event CloudEvent) []Header {
+	headers := []Header{
+		{Key: HeaderCloudEventsPrefix + CloudEventsAttrSpecVersion, Value: event.SpecVersion},
+		{Key: HeaderCloudEventsPrefix + CloudEventsAttrID, Value: event.ID},
+		{Key: HeaderCloudEventsPrefix + CloudEventsAttrSource, Value: event.Source},
+		{Key: HeaderCloudEventsPrefix + CloudEventsAttrType, Value: event.Type},
+		{Key: HeaderCloudEventsPrefix + CloudEventsAttrSubject, Value: event.Subject},
+		{Key: HeaderCloudEventsPrefix + CloudEventsAttrTime, Value: event.Time},
+		{Key: HeaderCloudEventsPrefix + CloudEventsAttrAggregateType, Value: event.AggregateType},
+		{Key: HeaderCloudEventsPrefix + CloudEventsAttrAggregateSequence, Value: strconv.FormatInt(event.AggregateSequence, 10)},
+		{Key: HeaderCloudEventsPrefix + CloudEventsAttrEventVersion, Value: event.EventVersion},
+	}
+	if event.TraceID != "" {
+		headers = append(headers, Header{Key: HeaderCloudEventsPrefix + CloudEventsAttrTraceID, Value: event.TraceID})
+	}
+	if event.RequestID != "" {
+		headers = append(headers, Header{Key: HeaderCloudEventsPrefix + CloudEventsAttrRequestID, Value: event.RequestID})
+	}
+	return append(headers, Header{Key: HeaderContentType, Value: event.DataContentType})
+}
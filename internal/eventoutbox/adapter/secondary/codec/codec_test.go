@@ -0,0 +1,272 @@
+package codec_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRouterTopicForRecordEvent(t *testing.T) {
+	t.Parallel()
+
+	router := codec.Router{RecordEventsTopic: "aion.record.events.v1"}
+	topic, err := router.Topic(domain.Event{AggregateType: codec.RecordAggregateType})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if topic != "aion.record.events.v1" {
+		t.Fatalf("expected record topic, got %q", topic)
+	}
+
+	if _, err := router.Topic(domain.Event{AggregateType: "unknown"}); err == nil {
+		t.Fatal("expected error for unsupported aggregate type")
+	}
+}
+
+func TestRouterTopicForDomainEvents(t *testing.T) {
+	t.Parallel()
+
+	router := codec.NewRouter(config.KafkaConfig{
+		RecordEventsTopic:           "aion.record.events.v1",
+		TagEventsTopic:              "aion.tag.events.v1",
+		CategoryEventsTopic:         "aion.category.events.v1",
+		UserEventsTopic:             "aion.user.events.v1",
+		MetricDefinitionEventsTopic: "aion.metric_definition.events.v1",
+	})
+
+	cases := map[string]string{
+		codec.RecordAggregateType:           "aion.record.events.v1",
+		codec.TagAggregateType:              "aion.tag.events.v1",
+		codec.CategoryAggregateType:         "aion.category.events.v1",
+		codec.UserAggregateType:             "aion.user.events.v1",
+		codec.MetricDefinitionAggregateType: "aion.metric_definition.events.v1",
+	}
+	for aggregateType, want := range cases {
+		topic, err := router.Topic(domain.Event{AggregateType: aggregateType})
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", aggregateType, err)
+		}
+		if topic != want {
+			t.Fatalf("%s: expected topic %q, got %q", aggregateType, want, topic)
+		}
+	}
+
+	unconfigured := codec.Router{RecordEventsTopic: "aion.record.events.v1"}
+	if _, err := unconfigured.Topic(domain.Event{AggregateType: codec.TagAggregateType}); err == nil {
+		t.Fatal("expected error when the aggregate topic is not configured")
+	}
+}
+
+func TestNewEnvelope(t *testing.T) {
+	t.Parallel()
+
+	event := domain.Event{
+		EventID:       "evt-1",
+		AggregateType: "record",
+		AggregateID:   "123",
+		EventType:     "record.created",
+		EventVersion:  "v1",
+		Source:        "aion-api",
+		TraceID:       "trace-1",
+		RequestID:     "req-1",
+		CreatedAt:     time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC),
+		PayloadJSON:   []byte(`{"record_id":123}`),
+	}
+
+	envelope := codec.NewEnvelope(event)
+	if envelope.EventID != event.EventID {
+		t.Fatalf("expected event id %q, got %q", event.EventID, envelope.EventID)
+	}
+	if string(envelope.Payload) != `{"record_id":123}` {
+		t.Fatalf("expected payload passthrough, got %s", string(envelope.Payload))
+	}
+	if envelope.OccurredAtUTC != "2026-03-13T13:00:00.000000Z" {
+		t.Fatalf("unexpected occurred_at_utc: %q", envelope.OccurredAtUTC)
+	}
+}
+
+func TestHeadersCarryAggregateSequence(t *testing.T) {
+	t.Parallel()
+
+	headers := codec.Headers(context.Background(), domain.Event{EventID: "evt-1", AggregateID: "42", AggregateSequence: 17})
+
+	values := make(map[string]string, len(headers))
+	for _, header := range headers {
+		values[header.Key] = header.Value
+	}
+	if values[codec.HeaderAggregateSequence] != "17" {
+		t.Fatalf("expected aggregate sequence header 17, got %q", values[codec.HeaderAggregateSequence])
+	}
+	if values[codec.HeaderAggregateID] != "42" {
+		t.Fatalf("expected aggregate id header 42, got %q", values[codec.HeaderAggregateID])
+	}
+}
+
+func cloudEventsTestEvent() domain.Event {
+	return domain.Event{
+		EventID:           "evt-1",
+		AggregateType:     "record",
+		AggregateID:       "123",
+		AggregateSequence: 4,
+		EventType:         "record.created",
+		EventVersion:      "v1",
+		Source:            "aion-api",
+		TraceID:           "trace-1",
+		CreatedAt:         time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC),
+		PayloadJSON:       []byte(`{"record_id":123}`),
+	}
+}
+
+func TestEncoderCloudEventsBinary(t *testing.T) {
+	t.Parallel()
+
+	encoder := codec.NewEncoder(codec.FormatCloudEventsBinary)
+	message, err := encoder.Encode(context.Background(), cloudEventsTestEvent())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if string(message.Value) != `{"record_id":123}` {
+		t.Fatalf("expected the bare payload as body, got %s", string(message.Value))
+	}
+	headers := message.HeaderMap()
+	expected := map[string]string{
+		"ce_specversion":       "1.0",
+		"ce_id":                "evt-1",
+		"ce_source":            "aion-api",
+		"ce_type":              "record.created",
+		"ce_subject":           "123",
+		"ce_time":              "2026-03-13T13:00:00.000000Z",
+		"ce_aggregatetype":     "record",
+		"ce_aggregatesequence": "4",
+		"ce_eventversion":      "v1",
+		"ce_traceid":           "trace-1",
+		"content-type":         "application/json",
+	}
+	for key, value := range expected {
+		if headers[key] != value {
+			t.Fatalf("expected header %s=%q, got %q", key, value, headers[key])
+		}
+	}
+	if _, ok := headers["ce_requestid"]; ok {
+		t.Fatal("expected empty request id to be omitted")
+	}
+	if _, ok := headers[codec.HeaderEventID]; ok {
+		t.Fatal("expected no legacy headers in binary mode")
+	}
+}
+
+func TestEncoderCloudEventsStructured(t *testing.T) {
+	t.Parallel()
+
+	encoder := codec.NewEncoder(codec.FormatCloudEventsStructured)
+	message, err := encoder.Encode(context.Background(), cloudEventsTestEvent())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if message.ContentType() != codec.ContentTypeCloudEventsJSON {
+		t.Fatalf("expected CloudEvents content type, got %q", message.ContentType())
+	}
+	var event codec.CloudEvent
+	if err := json.Unmarshal(message.Value, &event); err != nil {
+		t.Fatalf("decode structured event: %v", err)
+	}
+	if event.SpecVersion != "1.0" || event.ID != "evt-1" || event.Type != "record.created" || event.Subject != "123" {
+		t.Fatalf("unexpected CloudEvents attributes: %#v", event)
+	}
+	if event.AggregateSequence != 4 || event.EventVersion != "v1" {
+		t.Fatalf("unexpected extension attributes: %#v", event)
+	}
+	if string(event.Data) != `{"record_id":123}` {
+		t.Fatalf("expected payload under data, got %s", string(event.Data))
+	}
+}
+
+func TestEncoderFallsBackToLegacy(t *testing.T) {
+	t.Parallel()
+
+	encoder := codec.NewEncoder("bogus")
+	if encoder.Format() != codec.FormatLegacy {
+		t.Fatalf("expected legacy fallback, got %q", encoder.Format())
+	}
+
+	message, err := encoder.Encode(context.Background(), cloudEventsTestEvent())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	envelope, err := codec.EncodeEnvelope(cloudEventsTestEvent())
+	if err != nil {
+		t.Fatalf("encode envelope: %v", err)
+	}
+	if string(message.Value) != string(envelope) {
+		t.Fatalf("expected legacy envelope body, got %s", string(message.Value))
+	}
+	if message.HeaderMap()[codec.HeaderEventID] != "evt-1" {
+		t.Fatalf("expected legacy event_id header, got %#v", message.HeaderMap())
+	}
+	if message.ContentType() != codec.ContentTypeJSON {
+		t.Fatalf("expected JSON content type, got %q", message.ContentType())
+	}
+}
+
+func TestStartSendSpanContinuesEnqueueTraceAndLinksBatch(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	batchCtx, batchSpan := tracer.Start(context.Background(), "batch")
+	defer batchSpan.End()
+
+	origin := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	sendCtx, sendSpan := codec.StartSendSpan(batchCtx, tracer, "send", domain.Event{EventID: "evt-1", TraceParent: origin})
+
+	headers := codec.HeaderMap(sendCtx, domain.Event{EventID: "evt-1"})
+	sendSpan.End()
+
+	sent := recorder.Ended()[0]
+	if sent.SpanKind() != trace.SpanKindProducer {
+		t.Fatalf("expected producer span, got %s", sent.SpanKind())
+	}
+	if sent.Parent().TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" || sent.Parent().SpanID().String() != "00f067aa0ba902b7" {
+		t.Fatalf("expected send span to continue the enqueue trace, got parent %v", sent.Parent())
+	}
+	if len(sent.Links()) != 1 || sent.Links()[0].SpanContext.SpanID() != batchSpan.SpanContext().SpanID() {
+		t.Fatalf("expected one link to the batch span, got %v", sent.Links())
+	}
+
+	propagated := tracecontext.SpanContext(headers[tracecontext.HeaderTraceParent], "")
+	if propagated.TraceID() != sent.SpanContext().TraceID() || propagated.SpanID() != sent.SpanContext().SpanID() {
+		t.Fatalf("expected traceparent header of the send span, got %q", headers[tracecontext.HeaderTraceParent])
+	}
+}
+
+func TestStartSendSpanWithoutOriginIsChildOfBatch(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	batchCtx, batchSpan := tracer.Start(context.Background(), "batch")
+	_, sendSpan := codec.StartSendSpan(batchCtx, tracer, "send", domain.Event{EventID: "evt-1"})
+	sendSpan.End()
+	batchSpan.End()
+
+	sent := recorder.Ended()[0]
+	if sent.Parent().SpanID() != batchSpan.SpanContext().SpanID() {
+		t.Fatalf("expected batch span as parent, got %v", sent.Parent())
+	}
+	if len(sent.Links()) != 0 {
+		t.Fatalf("expected no links, got %v", sent.Links())
+	}
+}
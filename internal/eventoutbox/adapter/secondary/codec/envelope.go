@@ -0,0 +1,42 @@
+package codec
+
+import (
+	"encoding/json"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+)
+
+// Envelope is the JSON body every transport delivers for one outbox event.
+type Envelope struct {
+	EventID       string          `json:"event_id"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	EventType     string          `json:"event_type"`
+	EventVersion  string          `json:"event_version"`
+	Source        string          `json:"source"`
+	TraceID       string          `json:"trace_id,omitempty"`
+	RequestID     string          `json:"request_id,omitempty"`
+	OccurredAtUTC string          `json:"occurred_at_utc"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// NewEnvelope maps an outbox event to its wire envelope. The payload is passed through untouched.
+func NewEnvelope(event domain.Event) Envelope {
+	return Envelope{
+		EventID:       event.EventID,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		EventType:     event.EventType,
+		EventVersion:  event.EventVersion,
+		Source:        event.Source,
+		TraceID:       event.TraceID,
+		RequestID:     event.RequestID,
+		OccurredAtUTC: event.CreatedAt.UTC().Format(occurredAtLayout),
+		Payload:       json.RawMessage(event.PayloadJSON),
+	}
+}
+
+// EncodeEnvelope returns the JSON envelope of one outbox event.
+func EncodeEnvelope(event domain.Event) ([]byte, error) {
+	return json.Marshal(NewEnvelope(event))
+}
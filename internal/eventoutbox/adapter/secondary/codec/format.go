@@ -0,0 +1,108 @@
+package codec
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+)
+
+// Format selects how an outbox event is laid out on the wire.
+type Format string
+
+const (
+	// FormatLegacy is the aion-api Envelope body with event_id, event_type, ... routing headers.
+	FormatLegacy Format = config.OutboxEnvelopeLegacy
+	// FormatCloudEventsBinary carries the payload as the body and CloudEvents attributes as ce_* headers.
+	FormatCloudEventsBinary Format = config.OutboxEnvelopeCloudEventsBinary
+	// FormatCloudEventsStructured carries one CloudEvents JSON document with the payload under data.
+	FormatCloudEventsStructured Format = config.OutboxEnvelopeCloudEventsStructured
+)
+
+// Message is one outbox event encoded for a transport: the body and the metadata carried next to it.
+type Message struct {
+	Value   []byte
+	Headers []Header
+}
+
+// HeaderMap returns the message headers keyed by name for transports that carry metadata as a map.
+func (m Message) HeaderMap() map[string]string {
+	out := make(map[string]string, len(m.Headers))
+	for _, header := range m.Headers {
+		out[header.Key] = header.Value
+	}
+	return out
+}
+
+// ContentType returns the media type of the message body.
+func (m Message) ContentType() string {
+	for _, header := range m.Headers {
+		if header.Key == HeaderContentType {
+			return header.Value
+		}
+	}
+	return ContentTypeJSON
+}
+
+// Encoder lays outbox events out in one envelope format. Every format keeps the aggregate id as
+// the message key and the W3C traceparent and tracestate headers, so partitioning and trace
+// propagation do not depend on the format.
+type Encoder struct {
+	format Format
+}
+
+// NewEncoder returns an encoder for one envelope format, usually Format(OUTBOX_ENVELOPE_FORMAT).
+// An empty or unknown format encodes as FormatLegacy.
+func NewEncoder(format Format) Encoder {
+	switch format {
+	case FormatCloudEventsBinary, FormatCloudEventsStructured:
+		return Encoder{format: format}
+	default:
+		return Encoder{format: FormatLegacy}
+	}
+}
+
+// Format reports the envelope format of the encoder.
+func (e Encoder) Format() Format {
+	return e.format
+}
+
+// Encode returns the body and headers of one outbox event. The trace context of the span in ctx,
+// usually the one from StartSendSpan, is appended to the headers when present.
+func (e Encoder) Encode(ctx context.Context, event domain.Event) (Message, error) {
+	switch e.format {
+	case FormatCloudEventsBinary:
+		return Message{
+			Value:   event.PayloadJSON,
+			Headers: appendTraceHeaders(ctx, binaryHeaders(NewCloudEvent(event))),
+		}, nil
+	case FormatCloudEventsStructured:
+		value, err := json.Marshal(NewCloudEvent(event))
+		if err != nil {
+			return Message{}, err
+		}
+		return Message{
+			Value:   value,
+			Headers: appendTraceHeaders(ctx, []Header{{Key: HeaderContentType, Value: ContentTypeCloudEventsJSON}}),
+		}, nil
+	default:
+		value, err := EncodeEnvelope(event)
+		if err != nil {
+			return Message{}, err
+		}
+		return Message{Value: value, Headers: Headers(ctx, event)}, nil
+	}
+}
+
+func appendTraceHeaders(ctx context.Context, headers []Header) []Header {
+	traceParent, traceState := tracecontext.Inject(ctx)
+	if traceParent != "" {
+		headers = append(headers, Header{Key: tracecontext.HeaderTraceParent, Value: traceParent})
+	}
+	if traceState != "" {
+		headers = append(headers, Header{Key: tracecontext.HeaderTraceState, Value: traceState})
+	}
+	return headers
+}
@@ -0,0 +1,40 @@
+package codec
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+)
+
+// Header is one routing metadata entry carried next to the envelope.
+type Header struct {
+	Key   string
+	Value string
+}
+
+// Headers exposes routing metadata without decoding the envelope, in a stable order.
+// HeaderAggregateSequence lets consumers detect gaps or reordering per aggregate. The W3C
+// traceparent and tracestate of the span in ctx, usually the one from StartSendSpan, are appended when present.
+func Headers(ctx context.Context, event domain.Event) []Header {
+	headers := []Header{
+		{Key: HeaderEventID, Value: event.EventID},
+		{Key: HeaderEventType, Value: event.EventType},
+		{Key: HeaderEventVersion, Value: event.EventVersion},
+		{Key: HeaderAggregateType, Value: event.AggregateType},
+		{Key: HeaderAggregateID, Value: event.AggregateID},
+		{Key: HeaderAggregateSequence, Value: strconv.FormatInt(event.AggregateSequence, 10)},
+		{Key: HeaderSource, Value: event.Source},
+	}
+	return appendTraceHeaders(ctx, headers)
+}
+
+// HeaderMap returns Headers keyed by name for transports that carry metadata as a map.
+func HeaderMap(ctx context.Context, event domain.Event) map[string]string {
+	headers := Headers(ctx, event)
+	out := make(map[string]string, len(headers))
+	for _, header := range headers {
+		out[header.Key] = header.Value
+	}
+	return out
+}
@@ -0,0 +1,49 @@
+package codec
+
+import (
+	"fmt"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/config"
+)
+
+// Router maps aggregate types to the topic names configured for them.
+type Router struct {
+	RecordEventsTopic           string
+	TagEventsTopic              string
+	CategoryEventsTopic         string
+	UserEventsTopic             string
+	MetricDefinitionEventsTopic string
+}
+
+// NewRouter builds a Router from the configured canonical event topics.
+func NewRouter(cfg config.KafkaConfig) Router {
+	return Router{
+		RecordEventsTopic:           cfg.RecordEventsTopic,
+		TagEventsTopic:              cfg.TagEventsTopic,
+		CategoryEventsTopic:         cfg.CategoryEventsTopic,
+		UserEventsTopic:             cfg.UserEventsTopic,
+		MetricDefinitionEventsTopic: cfg.MetricDefinitionEventsTopic,
+	}
+}
+
+// Topic returns the destination topic of one event.
+func (r Router) Topic(event domain.Event) (string, error) {
+	var topic string
+	switch event.AggregateType {
+	case RecordAggregateType:
+		topic = r.RecordEventsTopic
+	case TagAggregateType:
+		topic = r.TagEventsTopic
+	case CategoryAggregateType:
+		topic = r.CategoryEventsTopic
+	case UserAggregateType:
+		topic = r.UserEventsTopic
+	case MetricDefinitionAggregateType:
+		topic = r.MetricDefinitionEventsTopic
+	}
+	if topic == "" {
+		return "", fmt.Errorf("%s: %s", ErrUnsupportedAggregateType, event.AggregateType)
+	}
+	return topic, nil
+}
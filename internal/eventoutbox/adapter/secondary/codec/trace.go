@@ -0,0 +1,32 @@
+package codec
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// StartSendSpan starts the producer span of one event inside a batch. When the event carries the
+// trace context of its enqueueing span, the send span continues that trace and links back to the
+// batch span in ctx; otherwise it is a plain child of the batch span. Inject the returned context
+// into the message headers so consumers continue the same trace.
+func StartSendSpan(ctx context.Context, tracer trace.Tracer, name string, event domain.Event) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String(HeaderEventID, event.EventID),
+			attribute.String(HeaderEventType, event.EventType),
+			attribute.String(HeaderAggregateID, event.AggregateID),
+		),
+	}
+
+	parent := ctx
+	if origin := tracecontext.SpanContext(event.TraceParent, event.TraceState); origin.IsValid() {
+		parent = trace.ContextWithRemoteSpanContext(ctx, origin)
+		opts = append(opts, trace.WithLinks(trace.LinkFromContext(ctx)))
+	}
+	return tracer.Start(parent, name, opts...)
+}
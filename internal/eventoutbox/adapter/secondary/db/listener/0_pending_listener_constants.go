@@ -0,0 +1,23 @@
+// Package listener wakes the outbox publisher through Postgres LISTEN/NOTIFY.
+package listener
+
+import "time"
+
+const (
+	// DefaultRetryInterval is the delay before a dropped LISTEN connection is reopened.
+	DefaultRetryInterval = 5 * time.Second
+)
+
+const (
+	// closeTimeout bounds the graceful close of one LISTEN connection.
+	closeTimeout = 2 * time.Second
+)
+
+const (
+	// LogListening indicates the LISTEN connection is open and waiting for notifications.
+	LogListening = "outbox listener waiting for notifications"
+	// LogListenConnectionLost indicates the LISTEN connection failed; the publisher falls back to polling.
+	LogListenConnectionLost = "outbox listener connection lost, falling back to polling"
+	// LogListenerStopped indicates the listener exited because its context ended.
+	LogListenerStopped = "outbox listener stopped"
+)
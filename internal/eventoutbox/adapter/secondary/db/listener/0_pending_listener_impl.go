@@ -0,0 +1,42 @@
+package listener
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+)
+
+// notificationConn is the subset of *pgx.Conn used by the listener.
+type notificationConn interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	WaitForNotification(ctx context.Context) (*pgconn.Notification, error)
+	Close(ctx context.Context) error
+}
+
+// PendingListener holds one dedicated Postgres connection in LISTEN mode.
+// It is kept outside the GORM pool because a listening session must stay pinned to one connection.
+type PendingListener struct {
+	connect       func(ctx context.Context) (notificationConn, error)
+	logger        logger.ContextLogger
+	channel       string
+	retryInterval time.Duration
+}
+
+// NewPendingListener creates a listener for channel on the database at connString.
+// A non-positive retryInterval falls back to DefaultRetryInterval.
+func NewPendingListener(connString, channel string, retryInterval time.Duration, log logger.ContextLogger) *PendingListener {
+	if retryInterval <= 0 {
+		retryInterval = DefaultRetryInterval
+	}
+	return &PendingListener{
+		connect: func(ctx context.Context) (notificationConn, error) {
+			return pgx.Connect(ctx, connString)
+		},
+		logger:        log,
+		channel:       channel,
+		retryInterval: retryInterval,
+	}
+}
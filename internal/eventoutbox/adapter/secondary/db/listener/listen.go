@@ -0,0 +1,70 @@
+package listener
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+)
+
+// Listen blocks until ctx is done, signalling wake after every notification on the channel.
+// A lost connection is reopened after the retry interval; the publisher keeps polling meanwhile.
+func (l *PendingListener) Listen(ctx context.Context, wake chan<- struct{}) {
+	for {
+		err := l.listenOnce(ctx, wake)
+		if ctx.Err() != nil {
+			l.logger.InfowCtx(ctx, LogListenerStopped, "channel", l.channel)
+			return
+		}
+
+		l.logger.WarnwCtx(ctx, LogListenConnectionLost,
+			commonkeys.Error, err.Error(),
+			"channel", l.channel,
+			"retry_in", l.retryInterval.String(),
+		)
+
+		select {
+		case <-ctx.Done():
+			l.logger.InfowCtx(ctx, LogListenerStopped, "channel", l.channel)
+			return
+		case <-time.After(l.retryInterval):
+		}
+	}
+}
+
+func (l *PendingListener) listenOnce(ctx context.Context, wake chan<- struct{}) error {
+	conn, err := l.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), closeTimeout)
+		defer cancel()
+		_ = conn.Close(closeCtx)
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return err
+	}
+
+	l.logger.InfowCtx(ctx, LogListening, "channel", l.channel)
+
+	// Rows committed while the connection was down produced no notification we could receive.
+	signal(wake)
+
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return err
+		}
+		signal(wake)
+	}
+}
+
+// signal wakes the publisher without blocking; a pending wake already covers every row committed so far.
+func signal(wake chan<- struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,156 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type fakeConn struct {
+	notifications chan *pgconn.Notification
+	listened      []string
+	mu            sync.Mutex
+	waitErr       error
+}
+
+func (c *fakeConn) Exec(_ context.Context, sql string, _ ...any) (pgconn.CommandTag, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listened = append(c.listened, sql)
+	return pgconn.CommandTag{}, nil
+}
+
+func (c *fakeConn) WaitForNotification(ctx context.Context) (*pgconn.Notification, error) {
+	if c.waitErr != nil {
+		return nil, c.waitErr
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case n := <-c.notifications:
+		return n, nil
+	}
+}
+
+func (c *fakeConn) Close(context.Context) error {
+	return nil
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Infof(string, ...any)                      {}
+func (noopLogger) Errorf(string, ...any)                     {}
+func (noopLogger) Debugf(string, ...any)                     {}
+func (noopLogger) Warnf(string, ...any)                      {}
+func (noopLogger) Infow(string, ...any)                      {}
+func (noopLogger) Errorw(string, ...any)                     {}
+func (noopLogger) Debugw(string, ...any)                     {}
+func (noopLogger) Warnw(string, ...any)                      {}
+func (noopLogger) InfowCtx(context.Context, string, ...any)  {}
+func (noopLogger) ErrorwCtx(context.Context, string, ...any) {}
+func (noopLogger) WarnwCtx(context.Context, string, ...any)  {}
+func (noopLogger) DebugwCtx(context.Context, string, ...any) {}
+
+func waitForWake(t *testing.T, wake <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-wake:
+	case <-time.After(time.Second):
+		t.Fatal("expected a wake signal")
+	}
+}
+
+func TestListenWakesOnNotification(t *testing.T) {
+	t.Parallel()
+
+	conn := &fakeConn{notifications: make(chan *pgconn.Notification)}
+	l := &PendingListener{
+		connect:       func(context.Context) (notificationConn, error) { return conn, nil },
+		logger:        noopLogger{},
+		channel:       "aion_outbox_pending",
+		retryInterval: time.Millisecond,
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	wake := make(chan struct{}, 1)
+	done := make(chan struct{})
+	go func() {
+		l.Listen(ctx, wake)
+		close(done)
+	}()
+
+	// The initial wake drains rows committed before the connection was opened.
+	waitForWake(t, wake)
+
+	conn.notifications <- &pgconn.Notification{Channel: "aion_outbox_pending", Payload: "record"}
+	waitForWake(t, wake)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("listener did not stop after cancellation")
+	}
+
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	if len(conn.listened) != 1 || conn.listened[0] != `LISTEN "aion_outbox_pending"` {
+		t.Fatalf("unexpected LISTEN statements: %v", conn.listened)
+	}
+}
+
+func TestListenReconnectsAfterConnectionLoss(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		attempts int
+	)
+	healthy := &fakeConn{notifications: make(chan *pgconn.Notification)}
+	l := &PendingListener{
+		connect: func(context.Context) (notificationConn, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			attempts++
+			switch attempts {
+			case 1:
+				return nil, errors.New("connection refused")
+			case 2:
+				return &fakeConn{waitErr: errors.New("unexpected EOF")}, nil
+			default:
+				return healthy, nil
+			}
+		},
+		logger:        noopLogger{},
+		channel:       "aion_outbox_pending",
+		retryInterval: time.Millisecond,
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	wake := make(chan struct{}, 1)
+	go l.Listen(ctx, wake)
+
+	deadline := time.After(time.Second)
+	for {
+		mu.Lock()
+		reached := attempts >= 3
+		mu.Unlock()
+		if reached {
+			break
+		}
+		select {
+		case <-wake:
+		case <-deadline:
+			t.Fatal("listener did not reconnect")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+
+	healthy.notifications <- &pgconn.Notification{Payload: "record"}
+	waitForWake(t, wake)
+}
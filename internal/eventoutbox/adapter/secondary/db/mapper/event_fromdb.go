@@ -8,23 +8,31 @@ import (
 
 // EventFromDB converts one DB row into a domain outbox event.
 func EventFromDB(row model.EventDB) domain.Event {
-	return domain.Event{
-		EventID:        row.EventID,
-		AggregateType:  row.AggregateType,
-		AggregateID:    row.AggregateID,
-		EventType:      row.EventType,
-		EventVersion:   row.EventVersion,
-		Source:         row.Source,
-		TraceID:        row.TraceID,
-		RequestID:      row.RequestID,
-		Status:         row.Status,
-		AttemptCount:   row.AttemptCount,
-		AvailableAtUTC: row.AvailableAtUTC,
-		PublishedAtUTC: row.PublishedAtUTC,
-		LastError:      row.LastError,
-		PayloadJSON:    row.PayloadJSON,
-		CreatedAt:      row.CreatedAt,
+	event := domain.Event{
+		EventID:           row.EventID,
+		AggregateType:     row.AggregateType,
+		AggregateID:       row.AggregateID,
+		EventType:         row.EventType,
+		EventVersion:      row.EventVersion,
+		Source:            row.Source,
+		TraceID:           row.TraceID,
+		TraceParent:       row.TraceParent,
+		TraceState:        row.TraceState,
+		RequestID:         row.RequestID,
+		Status:            row.Status,
+		AttemptCount:      row.AttemptCount,
+		AggregateSequence: row.AggregateSeq,
+		AvailableAtUTC:    row.AvailableAtUTC,
+		PublishedAtUTC:    row.PublishedAtUTC,
+		LastError:         row.LastError,
+		PayloadJSON:       row.PayloadJSON,
+		CreatedAt:         row.CreatedAt,
 	}
+	if row.LeaseOwner != nil {
+		event.LeaseOwner = *row.LeaseOwner
+		event.LeaseExpiresAtUTC = row.LeaseExpiresAt
+	}
+	return event
 }
 
 // EventsFromDB converts DB rows into domain outbox events.
@@ -16,9 +16,12 @@ func EventToDB(event domain.Event) model.EventDB {
 		EventVersion:   event.EventVersion,
 		Source:         event.Source,
 		TraceID:        event.TraceID,
+		TraceParent:    event.TraceParent,
+		TraceState:     event.TraceState,
 		RequestID:      event.RequestID,
 		Status:         event.Status,
 		AttemptCount:   event.AttemptCount,
+		AggregateSeq:   event.AggregateSequence,
 		AvailableAtUTC: event.AvailableAtUTC,
 		PublishedAtUTC: event.PublishedAtUTC,
 		LastError:      event.LastError,
@@ -18,15 +18,20 @@ type EventDB struct {
 	EventVersion   string     `gorm:"column:event_version;size:16;not null"`
 	Source         string     `gorm:"column:source;size:32;not null"`
 	TraceID        string     `gorm:"column:trace_id;size:64;index"`
+	TraceParent    string     `gorm:"column:trace_parent;size:64"`
+	TraceState     string     `gorm:"column:trace_state;size:512"`
 	RequestID      string     `gorm:"column:request_id;size:64"`
 	Status         string     `gorm:"column:status;size:24;not null;index"`
 	AttemptCount   int        `gorm:"column:attempt_count;not null;default:0"`
+	AggregateSeq   int64      `gorm:"column:aggregate_sequence;not null;default:0"`
 	AvailableAtUTC time.Time  `gorm:"column:available_at_utc;not null;index"`
 	PublishedAtUTC *time.Time `gorm:"column:published_at_utc"`
 	LastError      string     `gorm:"column:last_error"`
 	PayloadJSON    []byte     `gorm:"column:payload_json;type:jsonb;not null"`
 	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	LeaseOwner     *string    `gorm:"column:lease_owner;size:128"`
+	LeaseExpiresAt *time.Time `gorm:"column:lease_expires_at_utc"`
 }
 
 // TableName returns the fully qualified database table name for EventDB.
@@ -6,15 +6,32 @@ const (
 	OutboxTracerName = "aion-api.eventoutbox.repository"
 )
 
+const (
+	// PendingNotifyChannel is the Postgres NOTIFY channel signalled whenever outbox rows become publishable.
+	PendingNotifyChannel = "aion_outbox_pending"
+)
+
 const (
 	// SpanOutboxSaveRepo is the span name for persisting one outbox event.
 	SpanOutboxSaveRepo = "eventoutbox.repository.save"
 	// SpanOutboxListPendingRepo is the span name for listing pending outbox events.
 	SpanOutboxListPendingRepo = "eventoutbox.repository.list_pending"
-	// SpanOutboxMarkPublishedRepo is the span name for marking one outbox event as published.
-	SpanOutboxMarkPublishedRepo = "eventoutbox.repository.mark_published"
+	// SpanOutboxMarkPublishedRepo is the span name for marking a batch of outbox events as published.
+	SpanOutboxMarkPublishedRepo = "eventoutbox.repository.mark_published_batch"
 	// SpanOutboxRescheduleRepo is the span name for rescheduling one outbox event.
 	SpanOutboxRescheduleRepo = "eventoutbox.repository.reschedule"
+	// SpanOutboxMarkDeadLetteredRepo is the span name for moving one outbox event to the dead-letter state.
+	SpanOutboxMarkDeadLetteredRepo = "eventoutbox.repository.mark_dead_lettered"
+	// SpanOutboxGetByEventIDRepo is the span name for loading one outbox event by id.
+	SpanOutboxGetByEventIDRepo = "eventoutbox.repository.get_by_event_id"
+	// SpanOutboxListDeadLetteredRepo is the span name for listing dead-lettered outbox events.
+	SpanOutboxListDeadLetteredRepo = "eventoutbox.repository.list_dead_lettered"
+	// SpanOutboxReplayDeadLetteredRepo is the span name for returning dead-lettered events to the pending state.
+	SpanOutboxReplayDeadLetteredRepo = "eventoutbox.repository.replay_dead_lettered"
+	// SpanOutboxPurgePublishedRepo is the span name for deleting one batch of expired published rows.
+	SpanOutboxPurgePublishedRepo = "eventoutbox.repository.purge_published"
+	// SpanOutboxNotifyPendingRepo is the span name for signalling listeners that new rows are pending.
+	SpanOutboxNotifyPendingRepo = "eventoutbox.repository.notify_pending"
 )
 
 const (
@@ -26,6 +43,18 @@ const (
 	OpOutboxMarkPublished = "event_outbox_mark_published"
 	// OpOutboxReschedule is the operation value for deferring one event.
 	OpOutboxReschedule = "event_outbox_reschedule"
+	// OpOutboxMarkDeadLettered is the operation value for dead-lettering one event.
+	OpOutboxMarkDeadLettered = "event_outbox_mark_dead_lettered"
+	// OpOutboxGetByEventID is the operation value for single event lookup.
+	OpOutboxGetByEventID = "event_outbox_get_by_event_id"
+	// OpOutboxListDeadLettered is the operation value for dead-letter lookup.
+	OpOutboxListDeadLettered = "event_outbox_list_dead_lettered"
+	// OpOutboxReplayDeadLettered is the operation value for dead-letter replay.
+	OpOutboxReplayDeadLettered = "event_outbox_replay_dead_lettered"
+	// OpOutboxPurgePublished is the operation value for the retention purge.
+	OpOutboxPurgePublished = "event_outbox_purge_published"
+	// OpOutboxNotifyPending is the operation value for the pending-row notification.
+	OpOutboxNotifyPending = "event_outbox_notify_pending"
 )
 
 const (
@@ -33,10 +62,20 @@ const (
 	StatusOutboxSaved = "event outbox row saved successfully"
 	// StatusOutboxListed indicates successful listing of outbox rows.
 	StatusOutboxListed = "event outbox rows listed successfully"
-	// StatusOutboxPublished indicates one outbox row was marked as published.
-	StatusOutboxPublished = "event outbox row marked as published"
+	// StatusOutboxPublished indicates a batch of outbox rows was marked as published.
+	StatusOutboxPublished = "event outbox rows marked as published"
 	// StatusOutboxRescheduled indicates one outbox row was deferred for retry.
 	StatusOutboxRescheduled = "event outbox row rescheduled"
+	// StatusOutboxLeaseLost indicates the rows to settle were reclaimed by another publisher.
+	StatusOutboxLeaseLost = "event outbox lease lost"
+	// StatusOutboxDeadLettered indicates one outbox row exhausted its retries.
+	StatusOutboxDeadLettered = "event outbox row dead-lettered"
+	// StatusOutboxLoaded indicates one outbox row was loaded by id.
+	StatusOutboxLoaded = "event outbox row loaded"
+	// StatusOutboxPurged indicates one batch of expired published rows was deleted.
+	StatusOutboxPurged = "expired published outbox rows purged"
+	// StatusOutboxReplayed indicates dead-lettered outbox rows were returned to pending.
+	StatusOutboxReplayed = "dead-lettered event outbox rows replayed"
 )
 
 const (
@@ -44,8 +83,29 @@ const (
 	ErrSaveOutboxEventMsg = "error saving outbox event"
 	// ErrListOutboxEventsMsg is used when listing outbox events fails.
 	ErrListOutboxEventsMsg = "error listing outbox events"
-	// ErrMarkOutboxPublishedMsg is used when marking an event as published fails.
-	ErrMarkOutboxPublishedMsg = "error marking outbox event as published"
+	// ErrMarkOutboxPublishedMsg is used when marking a batch of events as published fails.
+	ErrMarkOutboxPublishedMsg = "error marking outbox events as published"
 	// ErrRescheduleOutboxEventMsg is used when rescheduling an event fails.
 	ErrRescheduleOutboxEventMsg = "error rescheduling outbox event"
+	// ErrMarkOutboxDeadLetteredMsg is used when dead-lettering an event fails.
+	ErrMarkOutboxDeadLetteredMsg = "error dead-lettering outbox event"
+	// ErrGetOutboxEventMsg is used when loading one outbox event fails.
+	ErrGetOutboxEventMsg = "error loading outbox event"
+	// ErrReplayOutboxEventsMsg is used when replaying dead-lettered events fails.
+	ErrReplayOutboxEventsMsg = "error replaying dead-lettered outbox events"
+	// ErrPurgeOutboxEventsMsg is used when purging expired published rows fails.
+	ErrPurgeOutboxEventsMsg = "error purging published outbox events"
+	// ErrNotifyOutboxPendingMsg is used when the pending-row notification cannot be queued.
+	ErrNotifyOutboxPendingMsg = "error notifying outbox listeners"
+)
+
+const (
+	// statusPending is the persisted status for rows waiting for publication.
+	statusPending = "pending"
+	// statusPublishing is the persisted status for rows claimed under a publisher lease.
+	statusPublishing = "publishing"
+	// statusPublished is the persisted status for rows delivered to the event backbone.
+	statusPublished = "published"
+	// statusDeadLettered is the persisted status for rows that exhausted their publish attempts.
+	statusDeadLettered = "failed"
 )
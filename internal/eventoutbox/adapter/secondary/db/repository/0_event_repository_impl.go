@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
 )
@@ -20,7 +21,7 @@ func NewEventRepository(database db.DB, log logger.ContextLogger) *EventReposito
 }
 
 // WithDB clones the repository with a transaction-bound database handle.
-func (r *EventRepository) WithDB(database db.DB) *EventRepository {
+func (r *EventRepository) WithDB(database db.DB) output.EventRepository {
 	if r == nil {
 		return nil
 	}
@@ -0,0 +1,146 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestEventRepository_MarkDeadLettered(t *testing.T) {
+	repo, dbMock := newOutboxRepo(t)
+
+	t.Run("success", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().
+			Exec(gomock.Any(), "failed", "broker down", gomock.Any(), "evt-1", "publisher-a", "publishing").
+			Return(dbMock)
+		dbMock.EXPECT().Error().Return(nil)
+		dbMock.EXPECT().RowsAffected().Return(int64(1))
+
+		require.NoError(t, repo.MarkDeadLettered(t.Context(), "publisher-a", "evt-1", "broker down"))
+	})
+
+	t.Run("lost lease", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec(gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(nil)
+		dbMock.EXPECT().RowsAffected().Return(int64(0))
+
+		err := repo.MarkDeadLettered(t.Context(), "publisher-a", "evt-1", "broker down")
+		require.ErrorIs(t, err, domain.ErrLeaseLost)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec(gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("update fail"))
+
+		require.Error(t, repo.MarkDeadLettered(t.Context(), "publisher-a", "evt-1", "broker down"))
+	})
+}
+
+func TestEventRepository_GetByEventID(t *testing.T) {
+	repo, dbMock := newOutboxRepo(t)
+
+	t.Run("found", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where("event_id = ?", "evt-1").Return(dbMock)
+		dbMock.EXPECT().First(gomock.Any()).DoAndReturn(func(dest any, _ ...any) db.DB {
+			row, ok := dest.(*model.EventDB)
+			require.True(t, ok)
+			row.EventID = "evt-1"
+			row.AttemptCount = 4
+			row.LastError = "broker down"
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		event, err := repo.GetByEventID(t.Context(), "evt-1")
+		require.NoError(t, err)
+		require.Equal(t, "evt-1", event.EventID)
+		require.Equal(t, 4, event.AttemptCount)
+		require.Equal(t, "broker down", event.LastError)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where("event_id = ?", "evt-2").Return(dbMock)
+		dbMock.EXPECT().First(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(gorm.ErrRecordNotFound)
+
+		event, err := repo.GetByEventID(t.Context(), "evt-2")
+		require.NoError(t, err)
+		require.Empty(t, event.EventID)
+	})
+}
+
+func TestEventRepository_ListDeadLettered(t *testing.T) {
+	repo, dbMock := newOutboxRepo(t)
+
+	dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+	dbMock.EXPECT().Where("status = ?", "failed").Return(dbMock)
+	dbMock.EXPECT().Where("aggregate_type = ?", "record").Return(dbMock)
+	dbMock.EXPECT().Order("created_at ASC, id ASC").Return(dbMock)
+	dbMock.EXPECT().Limit(10).Return(dbMock)
+	dbMock.EXPECT().Find(gomock.Any()).DoAndReturn(func(dest any, _ ...any) db.DB {
+		rows, ok := dest.(*[]model.EventDB)
+		require.True(t, ok)
+		*rows = []model.EventDB{{EventID: "evt-1", Status: "failed"}}
+		return dbMock
+	})
+	dbMock.EXPECT().Error().Return(nil)
+
+	events, err := repo.ListDeadLettered(t.Context(), domain.DeadLetterFilter{AggregateType: "record", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "evt-1", events[0].EventID)
+}
+
+func TestEventRepository_ReplayDeadLettered(t *testing.T) {
+	repo, dbMock := newOutboxRepo(t)
+	from := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	availableAt := time.Date(2026, time.March, 14, 9, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Model(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where("status = ?", "failed").Return(dbMock)
+		dbMock.EXPECT().Where("event_id IN ?", []string{"evt-1", "evt-2"}).Return(dbMock)
+		dbMock.EXPECT().Where("created_at >= ?", from).Return(dbMock)
+		dbMock.EXPECT().Updates(gomock.Any()).DoAndReturn(func(values any) db.DB {
+			updates, ok := values.(map[string]any)
+			require.True(t, ok)
+			require.Equal(t, "pending", updates["status"])
+			require.Equal(t, 0, updates["attempt_count"])
+			require.Equal(t, availableAt, updates["available_at_utc"])
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+		dbMock.EXPECT().RowsAffected().Return(int64(2))
+
+		replayed, err := repo.ReplayDeadLettered(t.Context(), domain.DeadLetterFilter{
+			EventIDs:       []string{"evt-1", "evt-2"},
+			CreatedFromUTC: &from,
+		}, availableAt)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), replayed)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Model(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where(gomock.Any(), gomock.Any()).Return(dbMock).AnyTimes()
+		dbMock.EXPECT().Updates(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("update fail"))
+
+		_, err := repo.ReplayDeadLettered(t.Context(), domain.DeadLetterFilter{AggregateID: "42"}, availableAt)
+		require.Error(t, err)
+	})
+}
@@ -44,13 +44,27 @@ func TestEventRepository_Save(t *testing.T) {
 		CreatedAt:      time.Now().UTC(),
 	}
 
+	expectSequence := func(sequence int64) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Raw(gomock.Any(), "record", "1", gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest any) db.DB {
+			value, ok := dest.(*int64)
+			require.True(t, ok)
+			*value = sequence
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+	}
+
 	t.Run("success", func(t *testing.T) {
+		expectSequence(7)
 		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
 		dbMock.EXPECT().Create(gomock.Any()).DoAndReturn(func(v any) db.DB {
 			row, ok := v.(*model.EventDB)
 			require.True(t, ok)
 			require.Equal(t, "evt-1", row.EventID)
 			require.Equal(t, "record", row.AggregateType)
+			require.Equal(t, int64(7), row.AggregateSeq)
 			return dbMock
 		})
 		dbMock.EXPECT().Error().Return(nil)
@@ -59,7 +73,18 @@ func TestEventRepository_Save(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("sequence error", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Raw(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Scan(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("counter locked"))
+
+		err := repo.Save(t.Context(), event)
+		require.Error(t, err)
+	})
+
 	t.Run("error", func(t *testing.T) {
+		expectSequence(8)
 		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
 		dbMock.EXPECT().Create(gomock.Any()).Return(dbMock)
 		dbMock.EXPECT().Error().Return(errors.New("insert fail"))
@@ -85,3 +110,167 @@ func (noopLogger) WarnwCtx(context.Context, string, ...any)  {}
 func (noopLogger) DebugwCtx(context.Context, string, ...any) {}
 
 var _ logger.ContextLogger = noopLogger{}
+
+func TestEventRepository_ListPendingClaimsUnderLease(t *testing.T) {
+	repo, dbMock := newOutboxRepo(t)
+	lease := domain.Lease{Owner: "publisher-a", Duration: 30 * time.Second}
+	earlier := time.Date(2026, time.March, 14, 9, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().
+			Raw(gomock.Any(),
+				"publishing", "publisher-a", gomock.Any(), gomock.Any(),
+				"publishing",
+				"pending", gomock.Any(),
+				"publishing", gomock.Any(),
+				true, "pending", "publishing",
+				25,
+			).
+			DoAndReturn(func(sql string, _ ...any) db.DB {
+				require.Contains(t, sql, "FOR UPDATE SKIP LOCKED")
+				require.Contains(t, sql, "RETURNING")
+				require.Contains(t, sql, "prior.aggregate_sequence < candidate.aggregate_sequence")
+				return dbMock
+			})
+		dbMock.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest any) db.DB {
+			rows, ok := dest.(*[]model.EventDB)
+			require.True(t, ok)
+			owner := "publisher-a"
+			*rows = []model.EventDB{
+				{ID: 2, EventID: "evt-2", AvailableAtUTC: earlier.Add(time.Minute), LeaseOwner: &owner},
+				{ID: 1, EventID: "evt-1", AvailableAtUTC: earlier, LeaseOwner: &owner},
+			}
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		events, err := repo.ListPending(t.Context(), lease, domain.OrderingAggregate, 25)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, "evt-1", events[0].EventID)
+		require.Equal(t, "publisher-a", events[0].LeaseOwner)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().
+			Raw(gomock.Any(), "publishing", "publisher-a", gomock.Any(), gomock.Any(), "publishing", "pending", gomock.Any(),
+				"publishing", gomock.Any(), false, "pending", "publishing", 25).
+			Return(dbMock)
+		dbMock.EXPECT().Scan(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("lock timeout"))
+
+		_, err := repo.ListPending(t.Context(), lease, domain.OrderingNone, 25)
+		require.Error(t, err)
+	})
+}
+
+func TestEventRepository_RescheduleReleasesLease(t *testing.T) {
+	repo, dbMock := newOutboxRepo(t)
+	next := time.Date(2026, time.March, 14, 9, 5, 0, 0, time.UTC)
+
+	dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+	dbMock.EXPECT().
+		Exec(gomock.Any(), "pending", next, "broker down", gomock.Any(), "evt-1", "publisher-a", "publishing").
+		DoAndReturn(func(sql string, _ ...any) db.DB {
+			require.Contains(t, sql, "lease_owner = NULL")
+			return dbMock
+		})
+	dbMock.EXPECT().Error().Return(nil)
+	dbMock.EXPECT().RowsAffected().Return(int64(1))
+
+	require.NoError(t, repo.Reschedule(t.Context(), "publisher-a", "evt-1", next, "broker down"))
+}
+
+func TestEventRepository_RescheduleReportsLostLease(t *testing.T) {
+	repo, dbMock := newOutboxRepo(t)
+
+	dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+	dbMock.EXPECT().Exec(gomock.Any(), gomock.Any()).Return(dbMock)
+	dbMock.EXPECT().Error().Return(nil)
+	dbMock.EXPECT().RowsAffected().Return(int64(0))
+
+	err := repo.Reschedule(t.Context(), "publisher-a", "evt-1", time.Now().UTC(), "broker down")
+	require.ErrorIs(t, err, domain.ErrLeaseLost)
+}
+
+func TestEventRepository_MarkPublishedBatch(t *testing.T) {
+	publishedAt := time.Date(2026, time.March, 14, 9, 10, 0, 0, time.UTC)
+
+	t.Run("updates every event in one statement", func(t *testing.T) {
+		repo, dbMock := newOutboxRepo(t)
+		ids := []string{"evt-1", "evt-2", "evt-3"}
+
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Model(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().
+			Where("event_id IN ? AND lease_owner = ? AND status = ?", ids, "publisher-a", "publishing").
+			Return(dbMock)
+		dbMock.EXPECT().Updates(gomock.Any()).DoAndReturn(func(values any) db.DB {
+			updates, ok := values.(map[string]any)
+			require.True(t, ok)
+			require.Equal(t, "published", updates["status"])
+			require.Equal(t, publishedAt, updates["published_at_utc"])
+			require.Nil(t, updates["lease_owner"])
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+		dbMock.EXPECT().RowsAffected().Return(int64(3))
+
+		require.NoError(t, repo.MarkPublishedBatch(t.Context(), "publisher-a", ids, publishedAt))
+	})
+
+	t.Run("rows reclaimed by another publisher report a lost lease", func(t *testing.T) {
+		repo, dbMock := newOutboxRepo(t)
+
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Model(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where(gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Updates(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(nil)
+		dbMock.EXPECT().RowsAffected().Return(int64(1))
+
+		err := repo.MarkPublishedBatch(t.Context(), "publisher-a", []string{"evt-1", "evt-2"}, publishedAt)
+		require.ErrorIs(t, err, domain.ErrLeaseLost)
+	})
+
+	t.Run("empty batch is a no-op", func(t *testing.T) {
+		repo, _ := newOutboxRepo(t)
+		require.NoError(t, repo.MarkPublishedBatch(t.Context(), "publisher-a", nil, publishedAt))
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		repo, dbMock := newOutboxRepo(t)
+
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Model(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where(gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Updates(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("conn reset"))
+
+		require.Error(t, repo.MarkPublishedBatch(t.Context(), "publisher-a", []string{"evt-1"}, publishedAt))
+	})
+}
+
+func TestEventRepository_NotifyPending(t *testing.T) {
+	t.Run("queues notification on the pending channel", func(t *testing.T) {
+		repo, dbMock := newOutboxRepo(t)
+
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec("SELECT pg_notify(?, ?)", repository.PendingNotifyChannel, "record").Return(dbMock)
+		dbMock.EXPECT().Error().Return(nil)
+
+		require.NoError(t, repo.NotifyPending(t.Context(), "record"))
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		repo, dbMock := newOutboxRepo(t)
+
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("tx aborted"))
+
+		require.Error(t, repo.NotifyPending(t.Context(), "record"))
+	})
+}
@@ -0,0 +1,88 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEventRepository_PurgePublished(t *testing.T) {
+	cutoff := time.Date(2026, time.March, 7, 0, 0, 0, 0, time.UTC)
+
+	t.Run("archives deleted rows before commit", func(t *testing.T) {
+		repo, dbMock := newOutboxRepo(t)
+
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(db.DB) error) error {
+			return fn(dbMock)
+		})
+		dbMock.EXPECT().Raw(gomock.Any(), "published", cutoff, 100).DoAndReturn(func(sql string, _ ...any) db.DB {
+			require.Contains(t, sql, "FOR UPDATE SKIP LOCKED")
+			require.Contains(t, sql, "RETURNING o.*")
+			return dbMock
+		})
+		dbMock.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest any) db.DB {
+			rows, ok := dest.(*[]model.EventDB)
+			require.True(t, ok)
+			*rows = []model.EventDB{{EventID: "evt-1"}, {EventID: "evt-2"}}
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		var archived []string
+		removed, err := repo.PurgePublished(t.Context(), cutoff, 100, func(_ context.Context, events []domain.Event) error {
+			for _, event := range events {
+				archived = append(archived, event.EventID)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), removed)
+		require.Equal(t, []string{"evt-1", "evt-2"}, archived)
+	})
+
+	t.Run("archive failure rolls the deletion back", func(t *testing.T) {
+		repo, dbMock := newOutboxRepo(t)
+
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(db.DB) error) error {
+			return fn(dbMock)
+		})
+		dbMock.EXPECT().Raw(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest any) db.DB {
+			rows, _ := dest.(*[]model.EventDB)
+			*rows = []model.EventDB{{EventID: "evt-1"}}
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		removed, err := repo.PurgePublished(t.Context(), cutoff, 100, func(context.Context, []domain.Event) error {
+			return errors.New("disk full")
+		})
+		require.Error(t, err)
+		require.Zero(t, removed)
+	})
+
+	t.Run("nothing expired", func(t *testing.T) {
+		repo, dbMock := newOutboxRepo(t)
+
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(db.DB) error) error {
+			return fn(dbMock)
+		})
+		dbMock.EXPECT().Raw(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Scan(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(nil)
+
+		removed, err := repo.PurgePublished(t.Context(), cutoff, 100, nil)
+		require.NoError(t, err)
+		require.Zero(t, removed)
+	})
+}
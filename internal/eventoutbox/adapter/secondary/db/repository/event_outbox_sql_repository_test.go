@@ -0,0 +1,156 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/adapter/secondary/db/postgres"
+	repository "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/repository"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/stretchr/testify/require"
+	gormpostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// capturedStatement is one statement GORM rendered for the Postgres dialect.
+type capturedStatement struct {
+	sql  string
+	vars []any
+}
+
+// newDryRunOutboxRepo builds the repository on a GORM session that renders SQL without a server.
+// Every statement is recorded, and each one reports rowsAffected so the lease checks can be driven.
+func newDryRunOutboxRepo(t *testing.T, rowsAffected int64) (*repository.EventRepository, *[]capturedStatement) {
+	t.Helper()
+
+	gormDB, err := gorm.Open(gormpostgres.New(gormpostgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		SkipDefaultTransaction: true,
+		DisableAutomaticPing:   true,
+	})
+	require.NoError(t, err)
+
+	statements := &[]capturedStatement{}
+	capture := func(tx *gorm.DB) {
+		*statements = append(*statements, capturedStatement{sql: tx.Statement.SQL.String(), vars: tx.Statement.Vars})
+		tx.RowsAffected = rowsAffected
+	}
+	require.NoError(t, gormDB.Callback().Update().After("gorm:update").Register("test:capture_update", capture))
+	require.NoError(t, gormDB.Callback().Raw().After("gorm:raw").Register("test:capture_raw", capture))
+	require.NoError(t, gormDB.Callback().Row().After("gorm:row").Register("test:capture_row", capture))
+
+	return repository.NewEventRepository(postgres.NewDBAdapter(gormDB), noopLogger{}), statements
+}
+
+// claimSQL is the claim exactly as Postgres receives it. Pinning the whole statement keeps the lock
+// clause, the expired-lease predicate and the attempt counted on reclaim from drifting unnoticed.
+const claimSQL = `
+UPDATE aion_api.event_outbox AS o
+SET status = $1,
+    lease_owner = $2,
+    lease_expires_at_utc = $3,
+    updated_at = $4,
+    attempt_count = o.attempt_count + CASE WHEN o.status = $5 THEN 1 ELSE 0 END
+WHERE o.id IN (
+	SELECT candidate.id
+	FROM aion_api.event_outbox AS candidate
+	WHERE ((candidate.status = $6 AND candidate.available_at_utc <= $7)
+	    OR (candidate.status = $8 AND candidate.lease_expires_at_utc <= $9))
+	  AND (NOT $10 OR NOT EXISTS (
+		SELECT 1
+		FROM aion_api.event_outbox AS prior
+		WHERE prior.aggregate_type = candidate.aggregate_type
+		  AND prior.aggregate_id = candidate.aggregate_id
+		  AND prior.aggregate_sequence < candidate.aggregate_sequence
+		  AND prior.status IN ($11, $12)
+	  ))
+	ORDER BY candidate.available_at_utc ASC, candidate.id ASC
+	LIMIT $13
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING o.*;
+`
+
+func TestEventRepositorySQL_ClaimPendingLeasesRowsToOwner(t *testing.T) {
+	repo, statements := newDryRunOutboxRepo(t, 0)
+	lease := domain.Lease{Owner: "publisher-a", Duration: 30 * time.Second}
+
+	// A dry run renders the claim but has no rows to scan back.
+	before := time.Now().UTC()
+	_, err := repo.ListPending(t.Context(), lease, domain.OrderingAggregate, 25)
+	require.ErrorIs(t, err, gorm.ErrDryRunModeUnsupported)
+
+	require.Len(t, *statements, 1)
+	claim := (*statements)[0]
+	require.Equal(t, claimSQL, claim.sql)
+	require.Len(t, claim.vars, 13)
+
+	now, ok := claim.vars[3].(time.Time)
+	require.True(t, ok)
+	require.False(t, now.Before(before))
+	require.Equal(t, []any{
+		"publishing",            // status
+		"publisher-a",           // lease_owner
+		now.Add(lease.Duration), // lease_expires_at_utc
+		now,                     // updated_at
+		"publishing",            // a reclaimed row counts an attempt
+		"pending", now,          // pending and available
+		"publishing", now, // or publishing with an expired lease
+		true,                    // ordering by aggregate
+		"pending", "publishing", // earlier rows that hold a candidate back
+		25, // limit
+	}, claim.vars)
+}
+
+func TestEventRepositorySQL_SettlingUpdatesAreFencedByLeaseOwner(t *testing.T) {
+	publishedAt := time.Date(2026, time.March, 14, 9, 10, 0, 0, time.UTC)
+
+	cases := []struct {
+		name   string
+		settle func(repo *repository.EventRepository) error
+		clause string
+	}{
+		{
+			name: "mark published",
+			settle: func(repo *repository.EventRepository) error {
+				return repo.MarkPublishedBatch(t.Context(), "publisher-a", []string{"evt-1"}, publishedAt)
+			},
+			clause: "WHERE event_id IN ($",
+		},
+		{
+			name: "reschedule",
+			settle: func(repo *repository.EventRepository) error {
+				return repo.Reschedule(t.Context(), "publisher-a", "evt-1", publishedAt, "broker down")
+			},
+			clause: "WHERE event_id = $",
+		},
+		{
+			name: "dead letter",
+			settle: func(repo *repository.EventRepository) error {
+				return repo.MarkDeadLettered(t.Context(), "publisher-a", "evt-1", "broker down")
+			},
+			clause: "WHERE event_id = $",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo, statements := newDryRunOutboxRepo(t, 1)
+			require.NoError(t, tc.settle(repo))
+
+			require.Len(t, *statements, 1)
+			update := (*statements)[0]
+			require.Contains(t, update.sql, "UPDATE")
+			require.Contains(t, update.sql, tc.clause)
+			require.Regexp(t, `AND lease_owner = \$\d+`, update.sql)
+			require.Regexp(t, `AND status = \$\d+`, update.sql)
+			require.Equal(t, "publisher-a", update.vars[len(update.vars)-2])
+			require.Equal(t, "publishing", update.vars[len(update.vars)-1])
+		})
+
+		t.Run(tc.name+" reports a lost lease when no row matches", func(t *testing.T) {
+			repo, _ := newDryRunOutboxRepo(t, 0)
+			require.ErrorIs(t, tc.settle(repo), domain.ErrLeaseLost)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/mapper"
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListDeadLettered returns dead-lettered outbox events matching the filter, oldest first.
+func (r *EventRepository) ListDeadLettered(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.Event, error) {
+	tr := otel.Tracer(OutboxTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxListDeadLetteredRepo, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxListDeadLettered),
+		attribute.Int("limit", filter.Limit),
+	))
+	defer span.End()
+
+	var rows []model.EventDB
+	query := applyDeadLetterFilter(r.db.WithContext(ctx), filter).
+		Order("created_at ASC, id ASC").
+		Limit(filter.Limit).
+		Find(&rows)
+
+	if err := query.Error(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, OpOutboxListDeadLettered)
+		r.logger.ErrorwCtx(ctx, ErrListOutboxEventsMsg,
+			commonkeys.Error, err.Error(),
+			"limit", filter.Limit,
+		)
+		return nil, fmt.Errorf("list dead-lettered outbox events: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int("rows", len(rows)))
+	span.SetStatus(codes.Ok, StatusOutboxListed)
+	return mapper.EventsFromDB(rows), nil
+}
+
+// ReplayDeadLettered moves matching dead-lettered events back to pending with a fresh attempt budget.
+func (r *EventRepository) ReplayDeadLettered(ctx context.Context, filter domain.DeadLetterFilter, availableAt time.Time) (int64, error) {
+	tr := otel.Tracer(OutboxTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxReplayDeadLetteredRepo, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxReplayDeadLettered),
+	))
+	defer span.End()
+
+	res := applyDeadLetterFilter(r.db.WithContext(ctx).Model(&model.EventDB{}), filter).
+		Updates(map[string]any{
+			"status":           statusPending,
+			"attempt_count":    0,
+			"available_at_utc": availableAt,
+			"last_error":       "",
+			"updated_at":       time.Now().UTC(),
+		})
+
+	if err := res.Error(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, OpOutboxReplayDeadLettered)
+		r.logger.ErrorwCtx(ctx, ErrReplayOutboxEventsMsg, commonkeys.Error, err.Error())
+		return 0, fmt.Errorf("replay dead-lettered outbox events: %w", err)
+	}
+
+	replayed := res.RowsAffected()
+	span.SetAttributes(attribute.Int64("rows", replayed))
+	span.SetStatus(codes.Ok, StatusOutboxReplayed)
+	r.logger.InfowCtx(ctx, StatusOutboxReplayed, "rows", replayed)
+	return replayed, nil
+}
+
+func applyDeadLetterFilter(query db.DB, filter domain.DeadLetterFilter) db.DB {
+	query = query.Where("status = ?", statusDeadLettered)
+	if len(filter.EventIDs) > 0 {
+		query = query.Where("event_id IN ?", filter.EventIDs)
+	}
+	if filter.AggregateType != "" {
+		query = query.Where("aggregate_type = ?", filter.AggregateType)
+	}
+	if filter.AggregateID != "" {
+		query = query.Where("aggregate_id = ?", filter.AggregateID)
+	}
+	if filter.CreatedFromUTC != nil {
+		query = query.Where("created_at >= ?", filter.CreatedFromUTC.UTC())
+	}
+	if filter.CreatedToUTC != nil {
+		query = query.Where("created_at < ?", filter.CreatedToUTC.UTC())
+	}
+	return query
+}
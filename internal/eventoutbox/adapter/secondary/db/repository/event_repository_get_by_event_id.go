@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/mapper"
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// GetByEventID loads one outbox event regardless of its publication status.
+// A missing row yields an empty event and no error so the use case can decide how to report it.
+func (r *EventRepository) GetByEventID(ctx context.Context, eventID string) (domain.Event, error) {
+	tr := otel.Tracer(OutboxTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxGetByEventIDRepo, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxGetByEventID),
+		attribute.String("event_id", eventID),
+	))
+	defer span.End()
+
+	var row model.EventDB
+	query := r.db.WithContext(ctx).
+		Where("event_id = ?", eventID).
+		First(&row)
+
+	if err := query.Error(); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			span.SetStatus(codes.Ok, StatusOutboxLoaded)
+			return domain.Event{}, nil
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, OpOutboxGetByEventID)
+		r.logger.ErrorwCtx(ctx, ErrGetOutboxEventMsg,
+			commonkeys.Error, err.Error(),
+			"event_id", eventID,
+		)
+		return domain.Event{}, fmt.Errorf("get outbox event: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, StatusOutboxLoaded)
+	return mapper.EventFromDB(row), nil
+}
@@ -17,15 +17,19 @@ type outboxStatsRow struct {
 	PendingCount       int64      `gorm:"column:pending_count"`
 	PublishedCount     int64      `gorm:"column:published_count"`
 	FailedCount        int64      `gorm:"column:failed_count"`
+	RetryingCount      int64      `gorm:"column:retrying_count"`
+	LeasedCount        int64      `gorm:"column:leased_count"`
 	OldestPendingAtUTC *time.Time `gorm:"column:oldest_pending_at_utc"`
 }
 
 const getOutboxStatsSQL = `
 SELECT
-	COUNT(*) FILTER (WHERE status = 'pending') AS pending_count,
+	COUNT(*) FILTER (WHERE status IN ('pending', 'publishing')) AS pending_count,
+	COUNT(*) FILTER (WHERE status = 'publishing') AS leased_count,
 	COUNT(*) FILTER (WHERE status = 'published') AS published_count,
-	COUNT(*) FILTER (WHERE status = 'pending' AND last_error IS NOT NULL AND last_error <> '') AS failed_count,
-	MIN(available_at_utc) FILTER (WHERE status = 'pending') AS oldest_pending_at_utc
+	COUNT(*) FILTER (WHERE status = 'failed') AS failed_count,
+	COUNT(*) FILTER (WHERE status = 'pending' AND last_error IS NOT NULL AND last_error <> '') AS retrying_count,
+	MIN(available_at_utc) FILTER (WHERE status IN ('pending', 'publishing')) AS oldest_pending_at_utc
 FROM aion_api.event_outbox;
 `
 
@@ -50,6 +54,8 @@ func (r *EventRepository) GetStats(ctx context.Context) (domain.Stats, error) {
 		PendingCount:       row.PendingCount,
 		PublishedCount:     row.PublishedCount,
 		FailedCount:        row.FailedCount,
+		RetryingCount:      row.RetryingCount,
+		LeasedCount:        row.LeasedCount,
 		OldestPendingAtUTC: row.OldestPendingAtUTC,
 	}
 
@@ -57,6 +63,8 @@ func (r *EventRepository) GetStats(ctx context.Context) (domain.Stats, error) {
 		attribute.Int64("pending_count", stats.PendingCount),
 		attribute.Int64("published_count", stats.PublishedCount),
 		attribute.Int64("failed_count", stats.FailedCount),
+		attribute.Int64("retrying_count", stats.RetryingCount),
+		attribute.Int64("leased_count", stats.LeasedCount),
 	)
 	span.SetStatus(codes.Ok, "event outbox stats loaded")
 	return stats, nil
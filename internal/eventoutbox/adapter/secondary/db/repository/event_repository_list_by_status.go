@@ -36,7 +36,7 @@ func (r *EventRepository) ListFailed(ctx context.Context, limit int) ([]domain.E
 	var rows []model.EventDB
 
 	query := r.db.WithContext(ctx).
-		Where("status = ? AND last_error IS NOT NULL AND last_error <> ''", "pending").
+		Where("status = ? AND last_error IS NOT NULL AND last_error <> ''", statusPending).
 		Order("available_at_utc ASC, id ASC").
 		Limit(limit).
 		Find(&rows)
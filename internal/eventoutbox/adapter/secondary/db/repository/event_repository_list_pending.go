@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/mapper"
@@ -15,32 +16,88 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-// ListPending returns pending outbox rows available for publication.
-func (r *EventRepository) ListPending(ctx context.Context, limit int) ([]domain.Event, error) {
+// claimPendingSQL moves a batch of publishable rows to publishing under one lease.
+// FOR UPDATE SKIP LOCKED lets concurrent publishers claim disjoint batches without blocking each other.
+// When ordering is requested, a row is claimable only if no earlier row of its aggregate is still
+// pending or publishing, so a retrying event holds back everything enqueued after it.
+// Reclaiming a row whose lease expired counts as a failed attempt (SET reads the row before the
+// update), so an event that keeps taking its publisher down still reaches the dead-letter state.
+const claimPendingSQL = `
+UPDATE aion_api.event_outbox AS o
+SET status = ?,
+    lease_owner = ?,
+    lease_expires_at_utc = ?,
+    updated_at = ?,
+    attempt_count = o.attempt_count + CASE WHEN o.status = ? THEN 1 ELSE 0 END
+WHERE o.id IN (
+	SELECT candidate.id
+	FROM aion_api.event_outbox AS candidate
+	WHERE ((candidate.status = ? AND candidate.available_at_utc <= ?)
+	    OR (candidate.status = ? AND candidate.lease_expires_at_utc <= ?))
+	  AND (NOT ? OR NOT EXISTS (
+		SELECT 1
+		FROM aion_api.event_outbox AS prior
+		WHERE prior.aggregate_type = candidate.aggregate_type
+		  AND prior.aggregate_id = candidate.aggregate_id
+		  AND prior.aggregate_sequence < candidate.aggregate_sequence
+		  AND prior.status IN (?, ?)
+	  ))
+	ORDER BY candidate.available_at_utc ASC, candidate.id ASC
+	LIMIT ?
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING o.*;
+`
+
+// ListPending claims pending outbox rows available for publication, plus rows whose lease expired,
+// counting an attempt for each of the latter.
+func (r *EventRepository) ListPending(ctx context.Context, lease domain.Lease, ordering domain.Ordering, limit int) ([]domain.Event, error) {
 	tr := otel.Tracer(OutboxTracerName)
 	ctx, span := tr.Start(ctx, SpanOutboxListPendingRepo, trace.WithAttributes(
 		attribute.String(commonkeys.Operation, OpOutboxListPending),
+		attribute.String("lease_owner", lease.Owner),
+		attribute.String("ordering", string(ordering)),
 		attribute.Int("limit", limit),
 	))
 	defer span.End()
 
+	now := time.Now().UTC()
 	var rows []model.EventDB
-	query := r.db.WithContext(ctx).
-		Where("status = ? AND available_at_utc <= ?", "pending", time.Now().UTC()).
-		Order("available_at_utc ASC, id ASC").
-		Limit(limit).
-		Find(&rows)
+	query := r.db.WithContext(ctx).Raw(claimPendingSQL,
+		statusPublishing,
+		lease.Owner,
+		now.Add(lease.Duration),
+		now,
+		statusPublishing,
+		statusPending,
+		now,
+		statusPublishing,
+		now,
+		ordering == domain.OrderingAggregate,
+		statusPending,
+		statusPublishing,
+		limit,
+	).Scan(&rows)
 
 	if err := query.Error(); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, OpOutboxListPending)
 		r.logger.ErrorwCtx(ctx, ErrListOutboxEventsMsg,
 			commonkeys.Error, err.Error(),
+			"lease_owner", lease.Owner,
 			"limit", limit,
 		)
-		return nil, fmt.Errorf("list pending outbox events: %w", err)
+		return nil, fmt.Errorf("claim pending outbox events: %w", err)
 	}
 
+	// RETURNING does not preserve the subquery order.
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].AvailableAtUTC.Equal(rows[j].AvailableAtUTC) {
+			return rows[i].ID < rows[j].ID
+		}
+		return rows[i].AvailableAtUTC.Before(rows[j].AvailableAtUTC)
+	})
+
 	span.SetAttributes(attribute.Int("rows", len(rows)))
 	span.SetStatus(codes.Ok, StatusOutboxListed)
 	return mapper.EventsFromDB(rows), nil
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MarkDeadLettered records the final failed attempt and parks the event in the dead-letter state.
+// The row must still be publishing under leaseOwner, otherwise domain.ErrLeaseLost is returned.
+func (r *EventRepository) MarkDeadLettered(ctx context.Context, leaseOwner string, eventID string, lastError string) error {
+	tr := otel.Tracer(OutboxTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxMarkDeadLetteredRepo, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxMarkDeadLettered),
+		attribute.String("event_id", eventID),
+		attribute.String("lease_owner", leaseOwner),
+	))
+	defer span.End()
+
+	res := r.db.WithContext(ctx).Exec(
+		`UPDATE aion_api.event_outbox
+		 SET status = ?,
+		     attempt_count = attempt_count + 1,
+		     last_error = ?,
+		     lease_owner = NULL,
+		     lease_expires_at_utc = NULL,
+		     updated_at = ?
+		 WHERE event_id = ?
+		   AND lease_owner = ?
+		   AND status = ?`,
+		statusDeadLettered,
+		lastError,
+		time.Now().UTC(),
+		eventID,
+		leaseOwner,
+		statusPublishing,
+	)
+
+	if err := res.Error(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, OpOutboxMarkDeadLettered)
+		r.logger.ErrorwCtx(ctx, ErrMarkOutboxDeadLetteredMsg,
+			commonkeys.Error, err.Error(),
+			"event_id", eventID,
+		)
+		return fmt.Errorf("mark outbox dead-lettered: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		span.SetStatus(codes.Error, StatusOutboxLeaseLost)
+		return fmt.Errorf("mark outbox dead-lettered %s: %w", eventID, domain.ErrLeaseLost)
+	}
+
+	span.SetStatus(codes.Ok, StatusOutboxDeadLettered)
+	return nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MarkPublishedBatch marks the given outbox events as published with one UPDATE and releases their leases.
+// Only rows still publishing under leaseOwner are updated; any other row was reclaimed after the lease
+// expired and is reported with domain.ErrLeaseLost.
+func (r *EventRepository) MarkPublishedBatch(ctx context.Context, leaseOwner string, eventIDs []string, publishedAt time.Time) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	tr := otel.Tracer(OutboxTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxMarkPublishedRepo, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxMarkPublished),
+		attribute.Int("batch_size", len(eventIDs)),
+		attribute.String("lease_owner", leaseOwner),
+	))
+	defer span.End()
+
+	res := r.db.WithContext(ctx).
+		Model(&model.EventDB{}).
+		Where("event_id IN ? AND lease_owner = ? AND status = ?", eventIDs, leaseOwner, statusPublishing).
+		Updates(map[string]any{
+			"status":               statusPublished,
+			"published_at_utc":     publishedAt,
+			"last_error":           "",
+			"lease_owner":          nil,
+			"lease_expires_at_utc": nil,
+			"updated_at":           publishedAt,
+		})
+
+	if err := res.Error(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, OpOutboxMarkPublished)
+		r.logger.ErrorwCtx(ctx, ErrMarkOutboxPublishedMsg,
+			commonkeys.Error, err.Error(),
+			"batch_size", len(eventIDs),
+		)
+		return fmt.Errorf("mark outbox published: %w", err)
+	}
+
+	rowsAffected := res.RowsAffected()
+	span.SetAttributes(attribute.Int64("rows_affected", rowsAffected))
+	if rowsAffected < int64(len(eventIDs)) {
+		span.SetStatus(codes.Error, StatusOutboxLeaseLost)
+		return fmt.Errorf("mark outbox published: %w: %d of %d rows", domain.ErrLeaseLost, int64(len(eventIDs))-rowsAffected, len(eventIDs))
+	}
+
+	span.SetStatus(codes.Ok, StatusOutboxPublished)
+	return nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NotifyPending queues a NOTIFY on PendingNotifyChannel with the aggregate type as payload.
+// Postgres delivers it only when the surrounding transaction commits, so listeners never wake for rolled-back rows.
+func (r *EventRepository) NotifyPending(ctx context.Context, aggregateType string) error {
+	tr := otel.Tracer(OutboxTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxNotifyPendingRepo, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxNotifyPending),
+		attribute.String(commonkeys.Entity, aggregateType),
+	))
+	defer span.End()
+
+	if err := r.db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", PendingNotifyChannel, aggregateType).Error(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, OpOutboxNotifyPending)
+		r.logger.ErrorwCtx(ctx, ErrNotifyOutboxPendingMsg,
+			commonkeys.Error, err.Error(),
+			commonkeys.Entity, aggregateType,
+		)
+		return fmt.Errorf("notify outbox listeners: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, OpOutboxNotifyPending)
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/mapper"
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// purgePublishedSQL deletes the oldest batch of expired published rows and returns them for archival.
+// SKIP LOCKED lets retention jobs on several publisher replicas purge disjoint batches.
+const purgePublishedSQL = `
+DELETE FROM aion_api.event_outbox o
+WHERE o.id IN (
+	SELECT candidate.id
+	FROM aion_api.event_outbox candidate
+	WHERE candidate.status = ?
+	  AND candidate.published_at_utc < ?
+	ORDER BY candidate.published_at_utc ASC, candidate.id ASC
+	LIMIT ?
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING o.*;
+`
+
+// PurgePublished deletes one batch of rows published before cutoff inside a transaction.
+// When archive is set it sees the deleted rows before commit; an archive error rolls the deletion back.
+func (r *EventRepository) PurgePublished(
+	ctx context.Context,
+	cutoff time.Time,
+	limit int,
+	archive output.ArchiveFunc,
+) (int64, error) {
+	tr := otel.Tracer(OutboxTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxPurgePublishedRepo, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxPurgePublished),
+		attribute.String("cutoff", cutoff.UTC().Format(time.RFC3339)),
+		attribute.Int("batch_limit", limit),
+		attribute.Bool("archive", archive != nil),
+	))
+	defer span.End()
+
+	var removed int64
+	err := r.db.WithContext(ctx).Transaction(func(tx db.DB) error {
+		var rows []model.EventDB
+		if err := tx.Raw(purgePublishedSQL, statusPublished, cutoff, limit).Scan(&rows).Error(); err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		if archive != nil {
+			if err := archive(ctx, mapper.EventsFromDB(rows)); err != nil {
+				return fmt.Errorf("archive purged outbox rows: %w", err)
+			}
+		}
+
+		removed = int64(len(rows))
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, OpOutboxPurgePublished)
+		r.logger.ErrorwCtx(ctx, ErrPurgeOutboxEventsMsg,
+			commonkeys.Error, err.Error(),
+			"cutoff", cutoff,
+		)
+		return 0, fmt.Errorf("purge published outbox events: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int64("rows_removed", removed))
+	span.SetStatus(codes.Ok, StatusOutboxPurged)
+	return removed, nil
+}
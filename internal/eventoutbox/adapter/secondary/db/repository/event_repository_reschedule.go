@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -12,26 +13,36 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-// Reschedule increments attempts and postpones the next publish attempt.
-func (r *EventRepository) Reschedule(ctx context.Context, eventID string, nextAvailableAt time.Time, lastError string) error {
+// Reschedule increments attempts, releases the lease, and postpones the next publish attempt.
+// The row must still be publishing under leaseOwner, otherwise domain.ErrLeaseLost is returned.
+func (r *EventRepository) Reschedule(ctx context.Context, leaseOwner string, eventID string, nextAvailableAt time.Time, lastError string) error {
 	tr := otel.Tracer(OutboxTracerName)
 	ctx, span := tr.Start(ctx, SpanOutboxRescheduleRepo, trace.WithAttributes(
 		attribute.String(commonkeys.Operation, OpOutboxReschedule),
 		attribute.String("event_id", eventID),
+		attribute.String("lease_owner", leaseOwner),
 	))
 	defer span.End()
 
 	res := r.db.WithContext(ctx).Exec(
 		`UPDATE aion_api.event_outbox
-		 SET attempt_count = attempt_count + 1,
+		 SET status = ?,
+		     attempt_count = attempt_count + 1,
 		     available_at_utc = ?,
 		     last_error = ?,
+		     lease_owner = NULL,
+		     lease_expires_at_utc = NULL,
 		     updated_at = ?
-		 WHERE event_id = ?`,
+		 WHERE event_id = ?
+		   AND lease_owner = ?
+		   AND status = ?`,
+		statusPending,
 		nextAvailableAt,
 		lastError,
 		time.Now().UTC(),
 		eventID,
+		leaseOwner,
+		statusPublishing,
 	)
 
 	if err := res.Error(); err != nil {
@@ -43,6 +54,10 @@ func (r *EventRepository) Reschedule(ctx context.Context, eventID string, nextAv
 		)
 		return fmt.Errorf("reschedule outbox event: %w", err)
 	}
+	if res.RowsAffected() == 0 {
+		span.SetStatus(codes.Error, StatusOutboxLeaseLost)
+		return fmt.Errorf("reschedule outbox event %s: %w", eventID, domain.ErrLeaseLost)
+	}
 
 	span.SetStatus(codes.Ok, StatusOutboxRescheduled)
 	return nil
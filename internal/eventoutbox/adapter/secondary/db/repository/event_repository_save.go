@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/mapper"
 	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
@@ -13,7 +14,18 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-// Save inserts one durable outbox event row.
+// nextAggregateSequenceSQL bumps the per-aggregate counter and returns the new value.
+// The upsert row lock serializes concurrent enqueues of one aggregate until the surrounding transaction commits.
+const nextAggregateSequenceSQL = `
+INSERT INTO aion_api.event_outbox_aggregate_sequences (aggregate_type, aggregate_id, last_sequence, updated_at)
+VALUES (?, ?, 1, ?)
+ON CONFLICT (aggregate_type, aggregate_id)
+DO UPDATE SET last_sequence = aion_api.event_outbox_aggregate_sequences.last_sequence + 1,
+              updated_at = EXCLUDED.updated_at
+RETURNING last_sequence;
+`
+
+// Save assigns the next aggregate sequence number and inserts one durable outbox event row.
 func (r *EventRepository) Save(ctx context.Context, event domain.Event) error {
 	tr := otel.Tracer(OutboxTracerName)
 	ctx, span := tr.Start(ctx, SpanOutboxSaveRepo, trace.WithAttributes(
@@ -25,7 +37,25 @@ func (r *EventRepository) Save(ctx context.Context, event domain.Event) error {
 	))
 	defer span.End()
 
+	var sequence int64
+	if err := r.db.WithContext(ctx).
+		Raw(nextAggregateSequenceSQL, event.AggregateType, event.AggregateID, time.Now().UTC()).
+		Scan(&sequence).
+		Error(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, OpOutboxSave)
+		r.logger.ErrorwCtx(ctx, ErrSaveOutboxEventMsg,
+			commonkeys.Error, err.Error(),
+			commonkeys.Entity, event.AggregateType,
+			"aggregate_id", event.AggregateID,
+			"event_id", event.EventID,
+		)
+		return fmt.Errorf("assign outbox aggregate sequence: %w", err)
+	}
+
 	row := mapper.EventToDB(event)
+	row.AggregateSeq = sequence
+	span.SetAttributes(attribute.Int64("aggregate_sequence", sequence))
 
 	if err := r.db.WithContext(ctx).Create(&row).Error(); err != nil {
 		span.RecordError(err)
@@ -46,6 +76,7 @@ func (r *EventRepository) Save(ctx context.Context, event domain.Event) error {
 		"aggregate_id", event.AggregateID,
 		"event_type", event.EventType,
 		"event_id", event.EventID,
+		"aggregate_sequence", sequence,
 	)
 
 	return nil
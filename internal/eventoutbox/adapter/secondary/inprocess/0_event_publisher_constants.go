@@ -0,0 +1,24 @@
+// Package inprocess publishes canonical outbox events to the in-process event bus.
+package inprocess
+
+const (
+	// PublisherTracerName is the tracer name used by the in-process outbox publisher adapter.
+	PublisherTracerName = "aion-api.eventoutbox.inprocess.publisher"
+)
+
+const (
+	// SpanOutboxInProcessPublishBatch is the span name for one batch handed to the bus.
+	SpanOutboxInProcessPublishBatch = "eventoutbox.inprocess.publish_batch"
+	// SpanOutboxInProcessSend is the producer span of one event handed to the bus.
+	SpanOutboxInProcessSend = "eventoutbox.inprocess.send"
+)
+
+const (
+	// OpOutboxInProcessPublishBatch is the operation name for batched in-process publication.
+	OpOutboxInProcessPublishBatch = "event_outbox_inprocess_publish_batch"
+)
+
+const (
+	// LogPublishedBatch indicates a batch was handed to the in-process bus, possibly with per-event failures.
+	LogPublishedBatch = "outbox batch published to in-process bus"
+)
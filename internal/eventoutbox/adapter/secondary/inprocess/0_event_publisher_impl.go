@@ -0,0 +1,33 @@
+package inprocess
+
+import (
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+)
+
+// EventPublisher publishes durable outbox events to subscribers in the same process.
+type EventPublisher struct {
+	bus     *eventbus.Bus
+	logger  logger.ContextLogger
+	router  codec.Router
+	encoder codec.Encoder
+}
+
+// NewEventPublisher creates an outbox publisher on top of the shared in-process bus.
+// Topic names follow the Kafka configuration so readers subscribe to the same names in both modes.
+func NewEventPublisher(bus *eventbus.Bus, cfg config.KafkaConfig, log logger.ContextLogger) *EventPublisher {
+	return &EventPublisher{
+		bus:     bus,
+		logger:  log,
+		router:  codec.NewRouter(cfg),
+		encoder: codec.NewEncoder(codec.FormatLegacy),
+	}
+}
+
+// WithEncoder sets the envelope format of published events; the default is codec.FormatLegacy.
+func (p *EventPublisher) WithEncoder(encoder codec.Encoder) *EventPublisher {
+	p.encoder = encoder
+	return p
+}
@@ -0,0 +1,12 @@
+package inprocess
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+)
+
+// Publish hands one outbox event to the in-process bus.
+func (p *EventPublisher) Publish(ctx context.Context, event domain.Event) error {
+	return p.PublishBatch(ctx, []domain.Event{event})[0].Err
+}
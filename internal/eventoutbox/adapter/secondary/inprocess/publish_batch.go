@@ -0,0 +1,85 @@
+package inprocess
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PublishBatch delivers the events to the bus in input order, one message per event.
+// An event that cannot be routed or encoded fails on its own; once ctx ends the remaining events fail with it.
+func (p *EventPublisher) PublishBatch(ctx context.Context, events []domain.Event) []domain.PublishResult {
+	results := make([]domain.PublishResult, len(events))
+	if len(events) == 0 {
+		return results
+	}
+
+	tr := otel.Tracer(PublisherTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxInProcessPublishBatch, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxInProcessPublishBatch),
+		attribute.Int("batch_size", len(events)),
+	))
+	defer span.End()
+
+	failed := 0
+	for i, event := range events {
+		results[i].EventID = event.EventID
+		results[i].Err = p.publishOne(ctx, tr, event)
+		if results[i].Err != nil {
+			failed++
+			span.RecordError(results[i].Err, trace.WithAttributes(attribute.String("event_id", event.EventID)))
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("published_count", len(events)-failed),
+		attribute.Int("failed_count", failed),
+	)
+	if failed > 0 {
+		span.SetStatus(codes.Error, OpOutboxInProcessPublishBatch)
+	} else {
+		span.SetStatus(codes.Ok, LogPublishedBatch)
+	}
+	p.logger.InfowCtx(ctx, LogPublishedBatch,
+		"batch_size", len(events),
+		"published_count", len(events)-failed,
+		"failed_count", failed,
+	)
+
+	return results
+}
+
+func (p *EventPublisher) publishOne(ctx context.Context, tr trace.Tracer, event domain.Event) (err error) {
+	ctx, span := codec.StartSendSpan(ctx, tr, SpanOutboxInProcessSend, event)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, OpOutboxInProcessPublishBatch)
+		}
+		span.End()
+	}()
+
+	topic, err := p.router.Topic(event)
+	if err != nil {
+		return err
+	}
+
+	message, err := p.encoder.Encode(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	return p.bus.Publish(ctx, eventbus.Message{
+		Topic:   topic,
+		Key:     event.AggregateID,
+		Value:   message.Value,
+		Headers: message.HeaderMap(),
+	})
+}
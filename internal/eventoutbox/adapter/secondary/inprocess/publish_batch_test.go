@@ -0,0 +1,80 @@
+package inprocess_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/inprocess"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+)
+
+type noopLogger struct{}
+
+func (noopLogger) Infof(string, ...any)                      {}
+func (noopLogger) Errorf(string, ...any)                     {}
+func (noopLogger) Debugf(string, ...any)                     {}
+func (noopLogger) Warnf(string, ...any)                      {}
+func (noopLogger) Infow(string, ...any)                      {}
+func (noopLogger) Errorw(string, ...any)                     {}
+func (noopLogger) Debugw(string, ...any)                     {}
+func (noopLogger) Warnw(string, ...any)                      {}
+func (noopLogger) InfowCtx(context.Context, string, ...any)  {}
+func (noopLogger) ErrorwCtx(context.Context, string, ...any) {}
+func (noopLogger) WarnwCtx(context.Context, string, ...any)  {}
+func (noopLogger) DebugwCtx(context.Context, string, ...any) {}
+
+func TestPublishBatchDeliversEnvelopesToTopicSubscribers(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	messages, cancel := bus.Subscribe("aion.record.events.v1", 4)
+	defer cancel()
+
+	publisher := inprocess.NewEventPublisher(bus, config.KafkaConfig{RecordEventsTopic: "aion.record.events.v1"}, noopLogger{})
+	results := publisher.PublishBatch(context.Background(), []domain.Event{
+		{EventID: "evt-1", AggregateType: codec.RecordAggregateType, AggregateID: "42", EventType: "record.created", AggregateSequence: 1, PayloadJSON: []byte(`{"record_id":42}`)},
+		{EventID: "evt-2", AggregateType: "unknown", AggregateID: "7"},
+	})
+
+	if results[0].Err != nil {
+		t.Fatalf("expected evt-1 to be published, got %v", results[0].Err)
+	}
+	if results[1].Err == nil {
+		t.Fatal("expected evt-2 to fail routing")
+	}
+
+	msg := <-messages
+	if msg.Key != "42" || msg.Headers[codec.HeaderAggregateSequence] != "1" {
+		t.Fatalf("unexpected message metadata: %#v", msg)
+	}
+	var envelope codec.Envelope
+	if err := json.Unmarshal(msg.Value, &envelope); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if envelope.EventID != "evt-1" || string(envelope.Payload) != `{"record_id":42}` {
+		t.Fatalf("unexpected envelope: %#v", envelope)
+	}
+}
+
+func TestPublishBatchFailsRemainingEventsOnceContextEnds(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	_, cancel := bus.Subscribe("aion.record.events.v1", 1)
+	defer cancel()
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	publisher := inprocess.NewEventPublisher(bus, config.KafkaConfig{RecordEventsTopic: "aion.record.events.v1"}, noopLogger{})
+	if err := publisher.Publish(ctx, domain.Event{EventID: "evt-1", AggregateType: codec.RecordAggregateType}); err != nil {
+		t.Fatalf("expected first publish to fill the buffer, got %v", err)
+	}
+
+	cancelCtx()
+	if err := publisher.Publish(ctx, domain.Event{EventID: "evt-2", AggregateType: codec.RecordAggregateType}); err == nil {
+		t.Fatal("expected publish on a full subscriber to fail after cancellation")
+	}
+}
@@ -1,34 +1,34 @@
 // Package kafka publishes canonical outbox events to Kafka topics.
 package kafka
 
+import "time"
+
 const (
 	// PublisherTracerName is the tracer name used by the Kafka outbox publisher adapter.
 	PublisherTracerName = "aion-api.eventoutbox.kafka.publisher"
 )
 
 const (
-	// SpanOutboxKafkaPublish is the span name for Kafka publication.
-	SpanOutboxKafkaPublish = "eventoutbox.kafka.publish"
-)
-
-const (
-	// OpOutboxKafkaPublish is the operation name for Kafka publication.
-	OpOutboxKafkaPublish = "event_outbox_kafka_publish"
+	// SpanOutboxKafkaPublishBatch is the span name for one batched Kafka write.
+	SpanOutboxKafkaPublishBatch = "eventoutbox.kafka.publish_batch"
+	// SpanOutboxKafkaSend is the producer span of one event inside a batched write.
+	SpanOutboxKafkaSend = "eventoutbox.kafka.send"
 )
 
 const (
-	// RecordAggregateType identifies record events emitted by the canonical API.
-	RecordAggregateType = "record"
+	// OpOutboxKafkaPublishBatch is the operation name for batched Kafka publication.
+	OpOutboxKafkaPublishBatch = "event_outbox_kafka_publish_batch"
 )
 
 const (
-	// LogPublishingEvent indicates one outbox event is being sent to Kafka.
-	LogPublishingEvent = "publishing outbox event to kafka"
-	// LogPublishedEvent indicates one outbox event was sent to Kafka.
-	LogPublishedEvent = "outbox event published to kafka"
+	// writerBatchTimeout bounds how long the writer waits to fill a partition batch.
+	// The outbox already hands over whole batches, so the kafka-go default of one second only adds latency.
+	writerBatchTimeout = 10 * time.Millisecond
 )
 
 const (
-	// ErrUnsupportedAggregateType is returned when no topic is configured for the aggregate.
-	ErrUnsupportedAggregateType = "unsupported aggregate type for kafka publication"
+	// LogPublishingBatch indicates a batch of outbox events is being sent to Kafka.
+	LogPublishingBatch = "publishing outbox batch to kafka"
+	// LogPublishedBatch indicates a batch write to Kafka completed, possibly with per-event failures.
+	LogPublishedBatch = "outbox batch published to kafka"
 )
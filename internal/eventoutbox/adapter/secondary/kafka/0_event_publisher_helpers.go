@@ -1,32 +1,60 @@
 package kafka
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
+	"errors"
 
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
 	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	kafkago "github.com/segmentio/kafka-go"
 )
 
-func (p *EventPublisher) topicFor(event domain.Event) (string, error) {
-	switch event.AggregateType {
-	case RecordAggregateType:
-		return p.recordEventsTopic, nil
-	default:
-		return "", fmt.Errorf("%s: %s", ErrUnsupportedAggregateType, event.AggregateType)
+// buildMessage encodes one outbox event as a Kafka message keyed by aggregate id,
+// so every event of one aggregate lands on the same partition. The trace context in ctx travels as headers.
+func (p *EventPublisher) buildMessage(ctx context.Context, event domain.Event) (kafkago.Message, error) {
+	topic, err := p.router.Topic(event)
+	if err != nil {
+		return kafkago.Message{}, err
+	}
+
+	message, err := p.encoder.Encode(ctx, event)
+	if err != nil {
+		return kafkago.Message{}, err
+	}
+
+	return kafkago.Message{
+		Topic:   topic,
+		Key:     []byte(event.AggregateID),
+		Value:   message.Value,
+		Headers: buildHeaders(message.Headers),
+	}, nil
+}
+
+// applyWriteError spreads the outcome of one WriteMessages call over the batch results.
+// positions maps each written message back to its index in results. kafka-go reports
+// partial failures as WriteErrors aligned with the written messages; any other error fails the whole write.
+func applyWriteError(results []domain.PublishResult, positions []int, err error) {
+	if err == nil {
+		return
+	}
+
+	var writeErrs kafkago.WriteErrors
+	if errors.As(err, &writeErrs) && len(writeErrs) == len(positions) {
+		for i, pos := range positions {
+			results[pos].Err = writeErrs[i]
+		}
+		return
+	}
+
+	for _, pos := range positions {
+		results[pos].Err = err
 	}
 }
 
-func buildEnvelope(event domain.Event) envelope {
-	return envelope{
-		EventID:       event.EventID,
-		AggregateType: event.AggregateType,
-		AggregateID:   event.AggregateID,
-		EventType:     event.EventType,
-		EventVersion:  event.EventVersion,
-		Source:        event.Source,
-		TraceID:       event.TraceID,
-		RequestID:     event.RequestID,
-		OccurredAtUTC: event.CreatedAt.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
-		Payload:       json.RawMessage(event.PayloadJSON),
+func buildHeaders(headers []codec.Header) []kafkago.Header {
+	out := make([]kafkago.Header, 0, len(headers))
+	for _, header := range headers {
+		out = append(out, kafkago.Header{Key: header.Key, Value: []byte(header.Value)})
 	}
+	return out
 }
@@ -1,49 +1,117 @@
 package kafka
 
 import (
+	"context"
+	"errors"
 	"testing"
-	"time"
 
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
 	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	kafkago "github.com/segmentio/kafka-go"
 )
 
-func TestTopicForRecordEvent(t *testing.T) {
+func headerValues(headers []kafkago.Header) map[string]string {
+	values := make(map[string]string, len(headers))
+	for _, header := range headers {
+		values[header.Key] = string(header.Value)
+	}
+	return values
+}
+
+func TestBuildHeadersCarriesAggregateSequence(t *testing.T) {
 	t.Parallel()
 
-	publisher := &EventPublisher{recordEventsTopic: "aion.record.events.v1"}
-	topic, err := publisher.topicFor(domain.Event{AggregateType: RecordAggregateType})
+	publisher := &EventPublisher{router: codec.Router{RecordEventsTopic: "aion.record.events.v1"}}
+	msg, err := publisher.buildMessage(context.Background(), domain.Event{
+		EventID: "evt-1", AggregateType: codec.RecordAggregateType, AggregateID: "42", AggregateSequence: 17,
+	})
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
-	if topic != "aion.record.events.v1" {
-		t.Fatalf("expected record topic, got %q", topic)
+
+	values := headerValues(msg.Headers)
+	if values[codec.HeaderAggregateSequence] != "17" {
+		t.Fatalf("expected aggregate sequence header 17, got %q", values[codec.HeaderAggregateSequence])
+	}
+	if values[codec.HeaderAggregateID] != "42" {
+		t.Fatalf("expected aggregate id header 42, got %q", values[codec.HeaderAggregateID])
 	}
 }
 
-func TestBuildEnvelope(t *testing.T) {
+func TestBuildMessageInCloudEventsBinaryMode(t *testing.T) {
 	t.Parallel()
 
-	event := domain.Event{
-		EventID:       "evt-1",
-		AggregateType: "record",
-		AggregateID:   "123",
-		EventType:     "record.created",
-		EventVersion:  "v1",
-		Source:        "aion-api",
-		TraceID:       "trace-1",
-		RequestID:     "req-1",
-		CreatedAt:     time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC),
-		PayloadJSON:   []byte(`{"record_id":123}`),
+	publisher := (&EventPublisher{router: codec.Router{RecordEventsTopic: "aion.record.events.v1"}}).
+		WithEncoder(codec.NewEncoder(codec.FormatCloudEventsBinary))
+	msg, err := publisher.buildMessage(context.Background(), domain.Event{
+		EventID: "evt-1", AggregateType: codec.RecordAggregateType, AggregateID: "42", EventType: "record.created",
+		PayloadJSON: []byte(`{"record_id":42}`),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
 	}
 
-	envelope := buildEnvelope(event)
-	if envelope.EventID != event.EventID {
-		t.Fatalf("expected event id %q, got %q", event.EventID, envelope.EventID)
+	values := headerValues(msg.Headers)
+	if values["ce_id"] != "evt-1" || values["ce_type"] != "record.created" || values["ce_subject"] != "42" {
+		t.Fatalf("unexpected CloudEvents headers: %v", values)
+	}
+	if string(msg.Value) != `{"record_id":42}` || string(msg.Key) != "42" {
+		t.Fatalf("expected the bare payload keyed by aggregate id, got key=%q value=%s", msg.Key, msg.Value)
+	}
+}
+
+func TestBuildMessageRejectsUnroutableEvent(t *testing.T) {
+	t.Parallel()
+
+	publisher := &EventPublisher{router: codec.Router{RecordEventsTopic: "aion.record.events.v1"}}
+	if _, err := publisher.buildMessage(context.Background(), domain.Event{AggregateType: "unknown"}); err == nil {
+		t.Fatal("expected error for unsupported aggregate type")
+	}
+
+	msg, err := publisher.buildMessage(context.Background(), domain.Event{EventID: "evt-1", AggregateType: codec.RecordAggregateType, AggregateID: "42"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
 	}
-	if string(envelope.Payload) != `{"record_id":123}` {
-		t.Fatalf("expected payload passthrough, got %s", string(envelope.Payload))
+	if msg.Topic != "aion.record.events.v1" || string(msg.Key) != "42" {
+		t.Fatalf("unexpected message routing: topic=%q key=%q", msg.Topic, string(msg.Key))
 	}
-	if envelope.OccurredAtUTC != "2026-03-13T13:00:00.000000Z" {
-		t.Fatalf("unexpected occurred_at_utc: %q", envelope.OccurredAtUTC)
+}
+
+func TestApplyWriteErrorMapsPartialFailures(t *testing.T) {
+	t.Parallel()
+
+	brokerErr := errors.New("leader not available")
+	routingErr := errors.New("unsupported aggregate type")
+	results := []domain.PublishResult{
+		{EventID: "evt-1"},
+		{EventID: "evt-2", Err: routingErr},
+		{EventID: "evt-3"},
+	}
+
+	applyWriteError(results, []int{0, 2}, kafkago.WriteErrors{nil, brokerErr})
+
+	if results[0].Err != nil {
+		t.Fatalf("expected evt-1 to succeed, got %v", results[0].Err)
+	}
+	if !errors.Is(results[1].Err, routingErr) {
+		t.Fatalf("expected evt-2 to keep its routing error, got %v", results[1].Err)
+	}
+	if !errors.Is(results[2].Err, brokerErr) {
+		t.Fatalf("expected evt-3 to carry the broker error, got %v", results[2].Err)
+	}
+}
+
+func TestApplyWriteErrorFailsWholeWrite(t *testing.T) {
+	t.Parallel()
+
+	writeErr := errors.New("dial tcp: connection refused")
+	results := []domain.PublishResult{{EventID: "evt-1"}, {EventID: "evt-2"}}
+
+	applyWriteError(results, []int{0, 1}, writeErr)
+
+	for _, result := range results {
+		if !errors.Is(result.Err, writeErr) {
+			t.Fatalf("expected %s to carry the write error, got %v", result.EventID, result.Err)
+		}
 	}
 }
@@ -3,6 +3,7 @@ package kafka
 import (
 	"strings"
 
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
 	"github.com/lechitz/aion-api/internal/platform/config"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
 	kafkago "github.com/segmentio/kafka-go"
@@ -10,9 +11,10 @@ import (
 
 // EventPublisher publishes durable outbox events to Kafka.
 type EventPublisher struct {
-	writer            *kafkago.Writer
-	logger            logger.ContextLogger
-	recordEventsTopic string
+	writer  *kafkago.Writer
+	logger  logger.ContextLogger
+	router  codec.Router
+	encoder codec.Encoder
 }
 
 // NewEventPublisher creates a Kafka-backed outbox publisher.
@@ -22,13 +24,21 @@ func NewEventPublisher(cfg config.KafkaConfig, log logger.ContextLogger) *EventP
 			Addr:         kafkago.TCP(strings.Split(cfg.Brokers, ",")...),
 			Balancer:     &kafkago.LeastBytes{},
 			RequiredAcks: kafkago.RequireAll,
+			BatchTimeout: writerBatchTimeout,
 			Async:        false,
 		},
-		logger:            log,
-		recordEventsTopic: cfg.RecordEventsTopic,
+		logger:  log,
+		router:  codec.NewRouter(cfg),
+		encoder: codec.NewEncoder(codec.FormatLegacy),
 	}
 }
 
+// WithEncoder sets the envelope format of published events; the default is codec.FormatLegacy.
+func (p *EventPublisher) WithEncoder(encoder codec.Encoder) *EventPublisher {
+	p.encoder = encoder
+	return p
+}
+
 // Close releases the underlying Kafka writer.
 func (p *EventPublisher) Close() error {
 	return p.writer.Close()
@@ -0,0 +1,81 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	kafkago "github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PublishBatch sends every routable event in one WriteMessages call.
+// Events that cannot be encoded or routed fail on their own without blocking the rest of the batch.
+func (p *EventPublisher) PublishBatch(ctx context.Context, events []domain.Event) []domain.PublishResult {
+	results := make([]domain.PublishResult, len(events))
+	if len(events) == 0 {
+		return results
+	}
+
+	tr := otel.Tracer(PublisherTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxKafkaPublishBatch, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxKafkaPublishBatch),
+		attribute.Int("batch_size", len(events)),
+	))
+	defer span.End()
+
+	messages := make([]kafkago.Message, 0, len(events))
+	positions := make([]int, 0, len(events))
+	sendSpans := make([]trace.Span, len(events))
+	for i, event := range events {
+		results[i].EventID = event.EventID
+
+		var sendCtx context.Context
+		sendCtx, sendSpans[i] = codec.StartSendSpan(ctx, tr, SpanOutboxKafkaSend, event)
+		msg, err := p.buildMessage(sendCtx, event)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		messages = append(messages, msg)
+		positions = append(positions, i)
+	}
+
+	p.logger.InfowCtx(ctx, LogPublishingBatch, "batch_size", len(events), "message_count", len(messages))
+
+	if len(messages) > 0 {
+		applyWriteError(results, positions, p.writer.WriteMessages(ctx, messages...))
+	}
+
+	failed := 0
+	for i, result := range results {
+		if result.Err != nil {
+			failed++
+			span.RecordError(result.Err, trace.WithAttributes(attribute.String("event_id", result.EventID)))
+			sendSpans[i].RecordError(result.Err)
+			sendSpans[i].SetStatus(codes.Error, OpOutboxKafkaPublishBatch)
+		}
+		sendSpans[i].End()
+	}
+	span.SetAttributes(
+		attribute.Int("published_count", len(events)-failed),
+		attribute.Int("failed_count", failed),
+	)
+
+	if failed > 0 {
+		span.SetStatus(codes.Error, OpOutboxKafkaPublishBatch)
+	} else {
+		span.SetStatus(codes.Ok, LogPublishedBatch)
+	}
+	p.logger.InfowCtx(ctx, LogPublishedBatch,
+		"batch_size", len(events),
+		"published_count", len(events)-failed,
+		"failed_count", failed,
+	)
+
+	return results
+}
@@ -0,0 +1,31 @@
+// Package ndjson publishes canonical outbox events by appending them to a newline-delimited JSON file.
+package ndjson
+
+import "os"
+
+const (
+	// PublisherTracerName is the tracer name used by the NDJSON file outbox publisher adapter.
+	PublisherTracerName = "aion-api.eventoutbox.ndjson.publisher"
+)
+
+const (
+	// SpanOutboxFilePublishBatch is the span name for one batch appended to the file.
+	SpanOutboxFilePublishBatch = "eventoutbox.ndjson.publish_batch"
+	// SpanOutboxFileSend is the producer span of one event appended to the file.
+	SpanOutboxFileSend = "eventoutbox.ndjson.send"
+)
+
+const (
+	// OpOutboxFilePublishBatch is the operation name for batched file publication.
+	OpOutboxFilePublishBatch = "event_outbox_ndjson_publish_batch"
+)
+
+const (
+	fileMode os.FileMode = 0o600
+	dirMode  os.FileMode = 0o750
+)
+
+const (
+	// LogPublishedBatch indicates a batch was appended to the file, possibly with per-event failures.
+	LogPublishedBatch = "outbox batch published to ndjson file"
+)
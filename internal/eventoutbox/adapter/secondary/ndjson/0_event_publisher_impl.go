@@ -0,0 +1,61 @@
+package ndjson
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+)
+
+// EventPublisher appends durable outbox events to one NDJSON file, one line per event.
+type EventPublisher struct {
+	mu      sync.Mutex
+	file    *os.File
+	logger  logger.ContextLogger
+	router  codec.Router
+	encoder codec.Encoder
+	path    string
+}
+
+// line is one NDJSON record. It keeps the topic, key, and headers next to the message body
+// so the file can be replayed into a broker later without re-deriving routing. Every envelope
+// format produces a JSON body, so value stays an embedded JSON document.
+type line struct {
+	Topic   string            `json:"topic"`
+	Key     string            `json:"key"`
+	Headers map[string]string `json:"headers"`
+	Value   json.RawMessage   `json:"value"`
+}
+
+// NewEventPublisher creates a file-backed outbox publisher. The file and its directory are
+// created on the first publish.
+func NewEventPublisher(path string, cfg config.KafkaConfig, log logger.ContextLogger) *EventPublisher {
+	return &EventPublisher{
+		logger:  log,
+		router:  codec.NewRouter(cfg),
+		encoder: codec.NewEncoder(codec.FormatLegacy),
+		path:    path,
+	}
+}
+
+// WithEncoder sets the envelope format of published events; the default is codec.FormatLegacy.
+func (p *EventPublisher) WithEncoder(encoder codec.Encoder) *EventPublisher {
+	p.encoder = encoder
+	return p
+}
+
+// Close releases the file handle, if one was opened.
+func (p *EventPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.file == nil {
+		return nil
+	}
+	err := p.file.Close()
+	p.file = nil
+	return err
+}
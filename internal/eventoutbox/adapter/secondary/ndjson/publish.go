@@ -0,0 +1,12 @@
+package ndjson
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+)
+
+// Publish appends one outbox event to the file.
+func (p *EventPublisher) Publish(ctx context.Context, event domain.Event) error {
+	return p.PublishBatch(ctx, []domain.Event{event})[0].Err
+}
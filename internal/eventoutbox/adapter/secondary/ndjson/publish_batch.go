@@ -0,0 +1,123 @@
+package ndjson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PublishBatch appends every routable event in one write followed by an fsync, so an event is
+// only reported as published once it is durable on disk. Events that cannot be routed or
+// encoded fail on their own; a write failure fails every line of the batch.
+func (p *EventPublisher) PublishBatch(ctx context.Context, events []domain.Event) []domain.PublishResult {
+	results := make([]domain.PublishResult, len(events))
+	if len(events) == 0 {
+		return results
+	}
+
+	tr := otel.Tracer(PublisherTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxFilePublishBatch, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxFilePublishBatch),
+		attribute.Int("batch_size", len(events)),
+	))
+	defer span.End()
+
+	var buf bytes.Buffer
+	positions := make([]int, 0, len(events))
+	sendSpans := make([]trace.Span, len(events))
+	encoder := json.NewEncoder(&buf)
+	for i, event := range events {
+		results[i].EventID = event.EventID
+
+		var sendCtx context.Context
+		sendCtx, sendSpans[i] = codec.StartSendSpan(ctx, tr, SpanOutboxFileSend, event)
+
+		topic, err := p.router.Topic(event)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		message, err := p.encoder.Encode(sendCtx, event)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		if err := encoder.Encode(line{
+			Topic:   topic,
+			Key:     event.AggregateID,
+			Headers: message.HeaderMap(),
+			Value:   message.Value,
+		}); err != nil {
+			results[i].Err = err
+			continue
+		}
+		positions = append(positions, i)
+	}
+
+	if len(positions) > 0 {
+		if err := p.append(buf.Bytes()); err != nil {
+			for _, pos := range positions {
+				results[pos].Err = err
+			}
+		}
+	}
+
+	failed := 0
+	for i, result := range results {
+		if result.Err != nil {
+			failed++
+			span.RecordError(result.Err, trace.WithAttributes(attribute.String("event_id", result.EventID)))
+			sendSpans[i].RecordError(result.Err)
+			sendSpans[i].SetStatus(codes.Error, OpOutboxFilePublishBatch)
+		}
+		sendSpans[i].End()
+	}
+	span.SetAttributes(
+		attribute.Int("published_count", len(events)-failed),
+		attribute.Int("failed_count", failed),
+	)
+	if failed > 0 {
+		span.SetStatus(codes.Error, OpOutboxFilePublishBatch)
+	} else {
+		span.SetStatus(codes.Ok, LogPublishedBatch)
+	}
+	p.logger.InfowCtx(ctx, LogPublishedBatch,
+		"batch_size", len(events),
+		"published_count", len(events)-failed,
+		"failed_count", failed,
+		"path", p.path,
+	)
+
+	return results
+}
+
+func (p *EventPublisher) append(data []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.file == nil {
+		if err := os.MkdirAll(filepath.Dir(p.path), dirMode); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(p.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, fileMode)
+		if err != nil {
+			return err
+		}
+		p.file = file
+	}
+
+	if _, err := p.file.Write(data); err != nil {
+		return err
+	}
+	return p.file.Sync()
+}
@@ -0,0 +1,115 @@
+package ndjson_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/ndjson"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/config"
+)
+
+type noopLogger struct{}
+
+func (noopLogger) Infof(string, ...any)                      {}
+func (noopLogger) Errorf(string, ...any)                     {}
+func (noopLogger) Debugf(string, ...any)                     {}
+func (noopLogger) Warnf(string, ...any)                      {}
+func (noopLogger) Infow(string, ...any)                      {}
+func (noopLogger) Errorw(string, ...any)                     {}
+func (noopLogger) Debugw(string, ...any)                     {}
+func (noopLogger) Warnw(string, ...any)                      {}
+func (noopLogger) InfowCtx(context.Context, string, ...any)  {}
+func (noopLogger) ErrorwCtx(context.Context, string, ...any) {}
+func (noopLogger) WarnwCtx(context.Context, string, ...any)  {}
+func (noopLogger) DebugwCtx(context.Context, string, ...any) {}
+
+type fileLine struct {
+	Topic   string            `json:"topic"`
+	Key     string            `json:"key"`
+	Headers map[string]string `json:"headers"`
+	Value   codec.Envelope    `json:"value"`
+}
+
+func readLines(t *testing.T, path string) []fileLine {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open output: %v", err)
+	}
+	defer file.Close()
+
+	var lines []fileLine
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var l fileLine
+		if err := json.Unmarshal(scanner.Bytes(), &l); err != nil {
+			t.Fatalf("decode line %q: %v", scanner.Text(), err)
+		}
+		lines = append(lines, l)
+	}
+	return lines
+}
+
+func TestPublishBatchAppendsOneLinePerRoutableEvent(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "outbox", "events.ndjson")
+	publisher := ndjson.NewEventPublisher(path, config.KafkaConfig{RecordEventsTopic: "aion.record.events.v1"}, noopLogger{})
+	defer publisher.Close()
+
+	results := publisher.PublishBatch(context.Background(), []domain.Event{
+		{EventID: "evt-1", AggregateType: codec.RecordAggregateType, AggregateID: "42", AggregateSequence: 1, PayloadJSON: []byte(`{"record_id":42}`)},
+		{EventID: "evt-2", AggregateType: "unknown"},
+	})
+	if results[0].Err != nil {
+		t.Fatalf("expected evt-1 to be written, got %v", results[0].Err)
+	}
+	if results[1].Err == nil {
+		t.Fatal("expected evt-2 to fail routing")
+	}
+
+	if err := publisher.Publish(context.Background(), domain.Event{EventID: "evt-3", AggregateType: codec.RecordAggregateType, AggregateID: "42", AggregateSequence: 2}); err != nil {
+		t.Fatalf("expected evt-3 to be appended, got %v", err)
+	}
+
+	lines := readLines(t, path)
+	if len(lines) != 2 {
+		t.Fatalf("expected two lines, got %d", len(lines))
+	}
+	if lines[0].Topic != "aion.record.events.v1" || lines[0].Key != "42" || lines[0].Value.EventID != "evt-1" {
+		t.Fatalf("unexpected first line: %#v", lines[0])
+	}
+	if string(lines[0].Value.Payload) != `{"record_id":42}` {
+		t.Fatalf("expected payload passthrough, got %s", string(lines[0].Value.Payload))
+	}
+	if lines[1].Headers[codec.HeaderAggregateSequence] != "2" {
+		t.Fatalf("expected appended line with sequence 2, got %#v", lines[1].Headers)
+	}
+}
+
+func TestPublishBatchFailsEveryLineWhenFileCannotBeOpened(t *testing.T) {
+	t.Parallel()
+
+	blocker := filepath.Join(t.TempDir(), "not-a-dir")
+	if err := os.WriteFile(blocker, nil, 0o600); err != nil {
+		t.Fatalf("write blocker: %v", err)
+	}
+
+	publisher := ndjson.NewEventPublisher(filepath.Join(blocker, "events.ndjson"), config.KafkaConfig{RecordEventsTopic: "aion.record.events.v1"}, noopLogger{})
+	results := publisher.PublishBatch(context.Background(), []domain.Event{
+		{EventID: "evt-1", AggregateType: codec.RecordAggregateType},
+		{EventID: "evt-2", AggregateType: codec.RecordAggregateType},
+	})
+	for _, result := range results {
+		if result.Err == nil {
+			t.Fatalf("expected %s to fail", result.EventID)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Package schemaregistry validates outbox payloads against the JSON Schemas kept in this package
+// and upcasts payloads stored at an older event version to the current one.
+package schemaregistry
+
+import "errors"
+
+const (
+	// schemaDir is the embedded directory holding one JSON Schema file per aggregate and version.
+	schemaDir = "schemas"
+	// schemaURLPrefix namespaces the embedded schemas inside the compiler, which requires absolute URLs.
+	schemaURLPrefix = "mem://aion-api/events/"
+	// versionPrefix precedes the numeric part of every event version, e.g. v1.
+	versionPrefix = "v"
+)
+
+const (
+	// SchemaNotFound is returned when no schema is registered for an event type and version.
+	SchemaNotFound = "no schema registered for event type and version"
+	// PayloadInvalid is returned when a payload does not match its registered schema.
+	PayloadInvalid = "payload does not match schema"
+	// UpcasterMissing is returned when no upcaster leads from a stored version towards the current one.
+	UpcasterMissing = "no upcaster registered for event version"
+	// UpcasterInvalid is returned when an upcaster does not move between two registered versions.
+	UpcasterInvalid = "upcaster must move one registered event version to a later one"
+	// VersionInvalid is returned when an event version does not have the v<N> form.
+	VersionInvalid = "event version must have the form v<N>"
+)
+
+var (
+	// ErrSchemaNotFound indicates that no schema is registered for an event type and version.
+	ErrSchemaNotFound = errors.New(SchemaNotFound)
+	// ErrPayloadInvalid indicates that a payload does not match its registered schema.
+	ErrPayloadInvalid = errors.New(PayloadInvalid)
+	// ErrUpcasterMissing indicates that a stored version cannot be brought up to the current version.
+	ErrUpcasterMissing = errors.New(UpcasterMissing)
+	// ErrUpcasterInvalid indicates that an upcaster was registered between unknown or unordered versions.
+	ErrUpcasterInvalid = errors.New(UpcasterInvalid)
+	// ErrVersionInvalid indicates that an event version does not have the v<N> form.
+	ErrVersionInvalid = errors.New(VersionInvalid)
+)
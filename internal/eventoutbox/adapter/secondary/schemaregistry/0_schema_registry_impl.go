@@ -0,0 +1,138 @@
+package schemaregistry
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// Upcaster rewrites a payload of EventType from FromVersion into ToVersion.
+// Upcasters chain, so a v1 payload reaches v3 through v1→v2 and v2→v3.
+type Upcaster struct {
+	Apply       func(payload []byte) ([]byte, error)
+	EventType   string
+	FromVersion string
+	ToVersion   string
+}
+
+type schemaKey struct {
+	eventType    string
+	eventVersion string
+}
+
+type registeredSchema struct {
+	compiled *jsonschema.Schema
+	file     string
+	raw      []byte
+}
+
+// Registry holds the compiled schemas of the catalog and the upcasters registered on top of them.
+type Registry struct {
+	schemas   map[schemaKey]registeredSchema
+	current   map[string]string
+	upcasters map[schemaKey]Upcaster
+}
+
+var _ output.SchemaRegistry = (*Registry)(nil)
+
+// New compiles every schema of the catalog. It fails when a catalog row names a missing or invalid schema file.
+func New(entries []Entry) (*Registry, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft2020
+	compiler.AssertFormat = true
+
+	registry := &Registry{
+		schemas:   make(map[schemaKey]registeredSchema, len(entries)),
+		current:   make(map[string]string),
+		upcasters: make(map[schemaKey]Upcaster),
+	}
+	compiled := make(map[string]registeredSchema)
+
+	for _, entry := range entries {
+		if _, err := versionNumber(entry.EventVersion); err != nil {
+			return nil, fmt.Errorf("%s %s: %w", entry.EventType, entry.EventVersion, err)
+		}
+
+		schema, ok := compiled[entry.SchemaFile]
+		if !ok {
+			var err error
+			if schema, err = compileFile(compiler, entry.SchemaFile); err != nil {
+				return nil, err
+			}
+			compiled[entry.SchemaFile] = schema
+		}
+
+		registry.schemas[schemaKey{entry.EventType, entry.EventVersion}] = schema
+		if current, ok := registry.current[entry.EventType]; !ok || laterVersion(entry.EventVersion, current) {
+			registry.current[entry.EventType] = entry.EventVersion
+		}
+	}
+	return registry, nil
+}
+
+// NewDefault builds a registry over the catalog and upcasters shipped with the API.
+func NewDefault() (*Registry, error) {
+	registry, err := New(Catalog())
+	if err != nil {
+		return nil, err
+	}
+	for _, upcaster := range Upcasters() {
+		if err := registry.RegisterUpcaster(upcaster); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+func compileFile(compiler *jsonschema.Compiler, file string) (registeredSchema, error) {
+	raw, err := schemaFS.ReadFile(path.Join(schemaDir, file))
+	if err != nil {
+		return registeredSchema{}, fmt.Errorf("read schema %s: %w", file, err)
+	}
+
+	url := schemaURLPrefix + file
+	if err := compiler.AddResource(url, bytes.NewReader(raw)); err != nil {
+		return registeredSchema{}, fmt.Errorf("load schema %s: %w", file, err)
+	}
+	compiled, err := compiler.Compile(url)
+	if err != nil {
+		return registeredSchema{}, fmt.Errorf("compile schema %s: %w", file, err)
+	}
+	return registeredSchema{compiled: compiled, file: file, raw: raw}, nil
+}
+
+// CurrentVersion returns the latest registered version of an event type.
+func (r *Registry) CurrentVersion(eventType string) (string, bool) {
+	version, ok := r.current[eventType]
+	return version, ok
+}
+
+// SchemaFile returns the schema file registered for an event type and version.
+func (r *Registry) SchemaFile(eventType, eventVersion string) (string, bool) {
+	schema, ok := r.schemas[schemaKey{eventType, eventVersion}]
+	return schema.file, ok
+}
+
+func versionNumber(version string) (int, error) {
+	digits, ok := strings.CutPrefix(version, versionPrefix)
+	if !ok {
+		return 0, ErrVersionInvalid
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil || n < 1 {
+		return 0, ErrVersionInvalid
+	}
+	return n, nil
+}
+
+// laterVersion reports whether a is later than b. Both versions were validated on registration.
+func laterVersion(a, b string) bool {
+	na, _ := versionNumber(a)
+	nb, _ := versionNumber(b)
+	return na > nb
+}
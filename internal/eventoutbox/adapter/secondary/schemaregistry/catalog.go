@@ -0,0 +1,42 @@
+package schemaregistry
+
+import "embed"
+
+//go:embed schemas/*.json
+var schemaFS embed.FS
+
+// Entry binds one event type and version to the schema file that describes its payload.
+// Several event types of one aggregate usually share a file, since they carry the same payload.
+type Entry struct {
+	EventType    string
+	EventVersion string
+	SchemaFile   string
+}
+
+// Catalog lists every event type and version the API emits with the schema its payload must match.
+// Adding an event type or version means adding its schema file and a row here.
+func Catalog() []Entry {
+	return []Entry{
+		{EventType: "record.created", EventVersion: "v1", SchemaFile: "record.v1.json"},
+		{EventType: "record.updated", EventVersion: "v1", SchemaFile: "record.v1.json"},
+		{EventType: "record.deleted", EventVersion: "v1", SchemaFile: "record.v1.json"},
+		{EventType: "record.restored", EventVersion: "v1", SchemaFile: "record.v1.json"},
+		{EventType: "tag.created", EventVersion: "v1", SchemaFile: "tag.v1.json"},
+		{EventType: "tag.updated", EventVersion: "v1", SchemaFile: "tag.v1.json"},
+		{EventType: "tag.deleted", EventVersion: "v1", SchemaFile: "tag.v1.json"},
+		{EventType: "category.created", EventVersion: "v1", SchemaFile: "category.v1.json"},
+		{EventType: "category.updated", EventVersion: "v1", SchemaFile: "category.v1.json"},
+		{EventType: "category.deleted", EventVersion: "v1", SchemaFile: "category.v1.json"},
+		{EventType: "user.created", EventVersion: "v1", SchemaFile: "user.v1.json"},
+		{EventType: "user.updated", EventVersion: "v1", SchemaFile: "user.v1.json"},
+		{EventType: "user.deleted", EventVersion: "v1", SchemaFile: "user.v1.json"},
+		{EventType: "metric_definition.created", EventVersion: "v1", SchemaFile: "metric_definition.v1.json"},
+		{EventType: "metric_definition.updated", EventVersion: "v1", SchemaFile: "metric_definition.v1.json"},
+	}
+}
+
+// Upcasters lists the steps that bring stored payloads of an older version up to the next one.
+// Every event version except the first needs a step from its predecessor; all event types are still at v1.
+func Upcasters() []Upcaster {
+	return nil
+}
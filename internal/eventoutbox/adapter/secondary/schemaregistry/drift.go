@@ -0,0 +1,154 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// schemaShape is the part of a payload schema that a Go payload struct can drift from.
+type schemaShape struct {
+	Properties map[string]struct {
+		Type json.RawMessage `json:"type"`
+	} `json:"properties"`
+	Required []string `json:"required"`
+}
+
+type structField struct {
+	types    []string
+	required bool
+}
+
+// Drift compares the JSON shape of a payload struct with the schema registered for an event type
+// and version. It reports one line per difference: a property present on one side only, a JSON type
+// mismatch, or a property whose omitempty tag disagrees with the schema's required list.
+func (r *Registry) Drift(eventType, eventVersion string, payloadType reflect.Type) ([]string, error) {
+	schema, ok := r.schemas[schemaKey{eventType, eventVersion}]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %s", ErrSchemaNotFound, eventType, eventVersion)
+	}
+
+	var shape schemaShape
+	if err := json.Unmarshal(schema.raw, &shape); err != nil {
+		return nil, fmt.Errorf("parse schema %s: %w", schema.file, err)
+	}
+
+	for payloadType.Kind() == reflect.Pointer {
+		payloadType = payloadType.Elem()
+	}
+	if payloadType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("payload type %s is not a struct", payloadType)
+	}
+
+	fields := structFields(payloadType)
+	var drift []string
+	for _, name := range sortedKeys(fields) {
+		field := fields[name]
+		property, ok := shape.Properties[name]
+		if !ok {
+			drift = append(drift, fmt.Sprintf("%s: field %q is missing from %s", payloadType.Name(), name, schema.file))
+			continue
+		}
+
+		if types := schemaTypes(property.Type); !slices.Equal(types, field.types) {
+			drift = append(drift, fmt.Sprintf("%s: field %q has JSON type %v, %s declares %v",
+				payloadType.Name(), name, field.types, schema.file, types))
+		}
+		if required := slices.Contains(shape.Required, name); required != field.required {
+			drift = append(drift, fmt.Sprintf("%s: field %q required=%t, %s declares required=%t",
+				payloadType.Name(), name, field.required, schema.file, required))
+		}
+	}
+	for _, name := range sortedKeys(shape.Properties) {
+		if _, ok := fields[name]; !ok {
+			drift = append(drift, fmt.Sprintf("%s: %s property %q has no struct field", payloadType.Name(), schema.file, name))
+		}
+	}
+	return drift, nil
+}
+
+func structFields(t reflect.Type) map[string]structField {
+	fields := make(map[string]structField, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = structField{
+			types:    jsonTypes(field.Type),
+			required: !slices.Contains(strings.Split(options, ","), "omitempty"),
+		}
+	}
+	return fields
+}
+
+// jsonTypes returns the sorted JSON Schema types encoding/json can produce for a Go type.
+func jsonTypes(t reflect.Type) []string {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		nullable = true
+		t = t.Elem()
+	}
+
+	var base string
+	switch {
+	case t == reflect.TypeFor[time.Time]():
+		base = "string"
+	case t.Kind() == reflect.String:
+		base = "string"
+	case t.Kind() == reflect.Bool:
+		base = "boolean"
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint64:
+		base = "integer"
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		base = "number"
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		base, nullable = "string", true
+	case t.Kind() == reflect.Slice:
+		base, nullable = "array", true
+	case t.Kind() == reflect.Array:
+		base = "array"
+	case t.Kind() == reflect.Map:
+		base, nullable = "object", true
+	default:
+		base = "object"
+	}
+
+	if nullable {
+		return sortedTypes(base, "null")
+	}
+	return []string{base}
+}
+
+func schemaTypes(raw json.RawMessage) []string {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}
+	}
+	var many []string
+	_ = json.Unmarshal(raw, &many)
+	return sortedTypes(many...)
+}
+
+func sortedTypes(types ...string) []string {
+	sorted := slices.Clone(types)
+	slices.Sort(sorted)
+	return sorted
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,219 @@
+package schemaregistry_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/schemaregistry"
+)
+
+func newDefaultRegistry(t *testing.T) *schemaregistry.Registry {
+	t.Helper()
+
+	registry, err := schemaregistry.NewDefault()
+	if err != nil {
+		t.Fatalf("build registry: %v", err)
+	}
+	return registry
+}
+
+func TestValidateAcceptsCatalogPayload(t *testing.T) {
+	t.Parallel()
+
+	registry := newDefaultRegistry(t)
+	payload := `{"tag_id":7,"user_id":3,"category_id":2,"name":"Run","description":"","icon":"","updated_at_utc":"2026-03-14T10:00:00.000000Z"}`
+
+	if err := registry.Validate("tag.created", "v1", []byte(payload)); err != nil {
+		t.Fatalf("expected payload to validate, got %v", err)
+	}
+}
+
+func TestValidateRejectsBadPayloads(t *testing.T) {
+	t.Parallel()
+
+	registry := newDefaultRegistry(t)
+	cases := map[string]string{
+		"missing field":  `{"tag_id":7,"user_id":3,"category_id":2,"name":"Run","description":"","icon":""}`,
+		"wrong type":     `{"tag_id":"7","user_id":3,"category_id":2,"name":"Run","description":"","icon":"","updated_at_utc":""}`,
+		"unknown field":  `{"tag_id":7,"user_id":3,"category_id":2,"name":"Run","description":"","icon":"","updated_at_utc":"","email":"x@y.z"}`,
+		"zero aggregate": `{"tag_id":0,"user_id":3,"category_id":2,"name":"Run","description":"","icon":"","updated_at_utc":""}`,
+		"not json":       `{"tag_id":`,
+	}
+
+	for name, payload := range cases {
+		if err := registry.Validate("tag.updated", "v1", []byte(payload)); !errors.Is(err, schemaregistry.ErrPayloadInvalid) {
+			t.Errorf("%s: expected ErrPayloadInvalid, got %v", name, err)
+		}
+	}
+}
+
+func TestValidateRejectsUnregisteredEventType(t *testing.T) {
+	t.Parallel()
+
+	err := newDefaultRegistry(t).Validate("tag.archived", "v1", []byte(`{}`))
+	if !errors.Is(err, schemaregistry.ErrSchemaNotFound) {
+		t.Fatalf("expected ErrSchemaNotFound, got %v", err)
+	}
+}
+
+func TestValidateAssertsDateTimeFormat(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"record_id":1,"user_id":2,"tag_id":3,"event_time_utc":"yesterday","recorded_at_utc":null,"status":null,` +
+		`"timezone":null,"duration_seconds":null,"value":null,"source":null,"description":null}`
+
+	err := newDefaultRegistry(t).Validate("record.created", "v1", []byte(payload))
+	if !errors.Is(err, schemaregistry.ErrPayloadInvalid) {
+		t.Fatalf("expected ErrPayloadInvalid, got %v", err)
+	}
+}
+
+// newVersionedRegistry registers tag.updated at v1 and v2 over the same schema, so upcasting can
+// be exercised before any event type has a second version.
+func newVersionedRegistry(t *testing.T) *schemaregistry.Registry {
+	t.Helper()
+
+	registry, err := schemaregistry.New([]schemaregistry.Entry{
+		{EventType: "tag.updated", EventVersion: "v1", SchemaFile: "tag.v1.json"},
+		{EventType: "tag.updated", EventVersion: "v2", SchemaFile: "tag.v1.json"},
+	})
+	if err != nil {
+		t.Fatalf("build registry: %v", err)
+	}
+	return registry
+}
+
+func defaultIcon(payload []byte) ([]byte, error) {
+	var fields map[string]any
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, err
+	}
+	if fields["icon"] == "" {
+		fields["icon"] = "tag"
+	}
+	return json.Marshal(fields)
+}
+
+func TestUpcastRewritesOlderVersion(t *testing.T) {
+	t.Parallel()
+
+	registry := newVersionedRegistry(t)
+	if err := registry.RegisterUpcaster(schemaregistry.Upcaster{
+		EventType: "tag.updated", FromVersion: "v1", ToVersion: "v2", Apply: defaultIcon,
+	}); err != nil {
+		t.Fatalf("register upcaster: %v", err)
+	}
+
+	payload := []byte(`{"tag_id":7,"user_id":3,"category_id":2,"name":"Run","description":"","icon":"","updated_at_utc":""}`)
+	version, upcast, err := registry.Upcast("tag.updated", "v1", payload)
+	if err != nil {
+		t.Fatalf("expected upcast to succeed, got %v", err)
+	}
+	if version != "v2" || !strings.Contains(string(upcast), `"icon":"tag"`) {
+		t.Fatalf("unexpected upcast result %s %s", version, upcast)
+	}
+}
+
+func TestUpcastLeavesCurrentAndUnknownEventsUnchanged(t *testing.T) {
+	t.Parallel()
+
+	registry := newVersionedRegistry(t)
+	payload := []byte(`{"anything":true}`)
+
+	for _, tc := range []struct{ eventType, version string }{{"tag.updated", "v2"}, {"record.created", "v1"}} {
+		version, upcast, err := registry.Upcast(tc.eventType, tc.version, payload)
+		if err != nil || version != tc.version || string(upcast) != string(payload) {
+			t.Fatalf("%s %s: expected unchanged payload, got %s %s %v", tc.eventType, tc.version, version, upcast, err)
+		}
+	}
+}
+
+func TestUpcastFailsWithoutUpcaster(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := newVersionedRegistry(t).Upcast("tag.updated", "v1", []byte(`{}`))
+	if !errors.Is(err, schemaregistry.ErrUpcasterMissing) {
+		t.Fatalf("expected ErrUpcasterMissing, got %v", err)
+	}
+}
+
+func TestUpcastValidatesResult(t *testing.T) {
+	t.Parallel()
+
+	registry := newVersionedRegistry(t)
+	if err := registry.RegisterUpcaster(schemaregistry.Upcaster{
+		EventType: "tag.updated", FromVersion: "v1", ToVersion: "v2",
+		Apply: func([]byte) ([]byte, error) { return []byte(`{"tag_id":7}`), nil },
+	}); err != nil {
+		t.Fatalf("register upcaster: %v", err)
+	}
+
+	payload := []byte(`{"tag_id":7,"user_id":3,"category_id":2,"name":"Run","description":"","icon":"","updated_at_utc":""}`)
+	version, upcast, err := registry.Upcast("tag.updated", "v1", payload)
+	if !errors.Is(err, schemaregistry.ErrPayloadInvalid) {
+		t.Fatalf("expected ErrPayloadInvalid, got %v", err)
+	}
+	if version != "v1" || string(upcast) != string(payload) {
+		t.Fatalf("expected the stored payload back on failure, got %s %s", version, upcast)
+	}
+}
+
+func TestRegisterUpcasterRejectsInvalidSteps(t *testing.T) {
+	t.Parallel()
+
+	registry := newVersionedRegistry(t)
+	steps := []schemaregistry.Upcaster{
+		{EventType: "tag.updated", FromVersion: "v2", ToVersion: "v1", Apply: defaultIcon},
+		{EventType: "tag.updated", FromVersion: "v1", ToVersion: "v3", Apply: defaultIcon},
+		{EventType: "tag.updated", FromVersion: "v1", ToVersion: "v2"},
+	}
+
+	for _, step := range steps {
+		if err := registry.RegisterUpcaster(step); !errors.Is(err, schemaregistry.ErrUpcasterInvalid) {
+			t.Errorf("%s -> %s: expected ErrUpcasterInvalid, got %v", step.FromVersion, step.ToVersion, err)
+		}
+	}
+}
+
+func TestNewRejectsMalformedCatalog(t *testing.T) {
+	t.Parallel()
+
+	if _, err := schemaregistry.New([]schemaregistry.Entry{{EventType: "tag.updated", EventVersion: "1", SchemaFile: "tag.v1.json"}}); !errors.Is(err, schemaregistry.ErrVersionInvalid) {
+		t.Fatalf("expected ErrVersionInvalid, got %v", err)
+	}
+	if _, err := schemaregistry.New([]schemaregistry.Entry{{EventType: "tag.updated", EventVersion: "v1", SchemaFile: "missing.json"}}); err == nil {
+		t.Fatal("expected an error for a missing schema file")
+	}
+}
+
+type tagPayloadDrifted struct {
+	Description  *string `json:"description"`
+	Name         string  `json:"name"`
+	Icon         string  `json:"icon,omitempty"`
+	UpdatedAtUTC string  `json:"updated_at_utc"`
+	Color        string  `json:"color"`
+	TagID        uint64  `json:"tag_id"`
+	UserID       uint64  `json:"user_id"`
+}
+
+func TestDriftReportsEveryDifference(t *testing.T) {
+	t.Parallel()
+
+	drift, err := newDefaultRegistry(t).Drift("tag.created", "v1", reflect.TypeFor[tagPayloadDrifted]())
+	if err != nil {
+		t.Fatalf("drift check: %v", err)
+	}
+
+	report := strings.Join(drift, "\n")
+	for _, want := range []string{`"color" is missing`, `"description" has JSON type [null string]`, `"icon" required=false`, `"category_id" has no struct field`} {
+		if !strings.Contains(report, want) {
+			t.Errorf("expected drift %q in:\n%s", want, report)
+		}
+	}
+	if len(drift) != 4 {
+		t.Errorf("expected 4 differences, got %d:\n%s", len(drift), report)
+	}
+}
@@ -0,0 +1,46 @@
+package schemaregistry
+
+import "fmt"
+
+// RegisterUpcaster adds one step of the upcast chain of an event type. Both versions must have a
+// registered schema and the step must move forward; a later registration for the same source
+// version replaces the earlier one.
+func (r *Registry) RegisterUpcaster(upcaster Upcaster) error {
+	_, hasFrom := r.schemas[schemaKey{upcaster.EventType, upcaster.FromVersion}]
+	_, hasTo := r.schemas[schemaKey{upcaster.EventType, upcaster.ToVersion}]
+	if !hasFrom || !hasTo || upcaster.Apply == nil || !laterVersion(upcaster.ToVersion, upcaster.FromVersion) {
+		return fmt.Errorf("%w: %s %s -> %s", ErrUpcasterInvalid, upcaster.EventType, upcaster.FromVersion, upcaster.ToVersion)
+	}
+
+	r.upcasters[schemaKey{upcaster.EventType, upcaster.FromVersion}] = upcaster
+	return nil
+}
+
+// Upcast brings a stored payload up to the current version of its event type, validating the result
+// against the current schema. Payloads already at the current version, and event types the registry
+// does not know, are returned unchanged.
+func (r *Registry) Upcast(eventType, eventVersion string, payload []byte) (string, []byte, error) {
+	current, ok := r.current[eventType]
+	if !ok || eventVersion == current {
+		return eventVersion, payload, nil
+	}
+
+	version, upcast := eventVersion, payload
+	for version != current {
+		upcaster, ok := r.upcasters[schemaKey{eventType, version}]
+		if !ok {
+			return eventVersion, payload, fmt.Errorf("%w: %s %s", ErrUpcasterMissing, eventType, version)
+		}
+
+		next, err := upcaster.Apply(upcast)
+		if err != nil {
+			return eventVersion, payload, fmt.Errorf("upcast %s %s -> %s: %w", eventType, version, upcaster.ToVersion, err)
+		}
+		upcast, version = next, upcaster.ToVersion
+	}
+
+	if err := validatePayload(r.schemas[schemaKey{eventType, current}], upcast); err != nil {
+		return eventVersion, payload, err
+	}
+	return current, upcast, nil
+}
@@ -0,0 +1,31 @@
+package schemaregistry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Validate checks a payload against the schema registered for its event type and version.
+func (r *Registry) Validate(eventType, eventVersion string, payload []byte) error {
+	schema, ok := r.schemas[schemaKey{eventType, eventVersion}]
+	if !ok {
+		return fmt.Errorf("%w: %s %s", ErrSchemaNotFound, eventType, eventVersion)
+	}
+	return validatePayload(schema, payload)
+}
+
+func validatePayload(schema registeredSchema, payload []byte) error {
+	// Numbers stay json.Number so integer keywords see 3 and not 3.0.
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrPayloadInvalid, schema.file, err)
+	}
+	if err := schema.compiled.Validate(document); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrPayloadInvalid, schema.file, err)
+	}
+	return nil
+}
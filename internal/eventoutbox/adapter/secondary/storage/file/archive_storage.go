@@ -0,0 +1,93 @@
+// Package file provides the filesystem-backed archive storage adapter for the outbox context.
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// ErrObjectKeyOutsideRoot is returned when an object key would resolve outside the archive directory.
+var ErrObjectKeyOutsideRoot = errors.New("archive object key escapes the archive directory")
+
+// ArchiveStorage writes outbox archive files below one local directory.
+type ArchiveStorage struct {
+	log  logger.ContextLogger
+	root string
+}
+
+// NewArchiveStorage creates a filesystem archive rooted at dir.
+func NewArchiveStorage(dir string, log logger.ContextLogger) *ArchiveStorage {
+	return &ArchiveStorage{
+		log:  log,
+		root: filepath.Clean(dir),
+	}
+}
+
+// PutArchive writes one archive file atomically: readers never observe a partially written file.
+func (s *ArchiveStorage) PutArchive(ctx context.Context, object output.ArchiveObject) error {
+	ctx, span := otel.Tracer("eventoutbox.adapter.secondary.storage.file").Start(ctx, "eventoutbox.archive_storage.put")
+	defer span.End()
+
+	path := filepath.Join(s.root, filepath.FromSlash(strings.TrimLeft(object.ObjectKey, "/")))
+	span.SetAttributes(
+		attribute.String("archive.path", path),
+		attribute.String("archive.content_type", object.ContentType),
+		attribute.Int("archive.payload_bytes", len(object.Body)),
+	)
+
+	if err := s.write(path, object.Body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "archive_write_failed")
+		if s.log != nil {
+			s.log.ErrorwCtx(ctx, "failed to write outbox archive", "error", err, "path", path)
+		}
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "archive_written")
+	return nil
+}
+
+func (s *ArchiveStorage) write(path string, body []byte) error {
+	if rel, err := filepath.Rel(s.root, path); err != nil || strings.HasPrefix(rel, "..") {
+		return ErrObjectKeyOutsideRoot
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create archive directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".archive-*")
+	if err != nil {
+		return fmt.Errorf("create archive temp file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(body); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write archive file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("sync archive file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close archive file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("publish archive file: %w", err)
+	}
+	return nil
+}
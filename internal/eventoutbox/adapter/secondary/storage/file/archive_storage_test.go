@@ -0,0 +1,50 @@
+package file
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+)
+
+func TestPutArchiveWritesFileBelowRoot(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	storage := NewArchiveStorage(root, nil)
+
+	err := storage.PutArchive(t.Context(), output.ArchiveObject{
+		ObjectKey:   "event_outbox/published/2026/03/14/batch.ndjson",
+		ContentType: "application/x-ndjson",
+		Body:        []byte("{\"event_id\":\"evt-1\"}\n"),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	body, err := os.ReadFile(filepath.Join(root, "event_outbox", "published", "2026", "03", "14", "batch.ndjson"))
+	if err != nil {
+		t.Fatalf("read archive: %v", err)
+	}
+	if string(body) != "{\"event_id\":\"evt-1\"}\n" {
+		t.Fatalf("unexpected archive body %q", string(body))
+	}
+
+	leftovers, _ := filepath.Glob(filepath.Join(root, "event_outbox", "published", "2026", "03", "14", ".archive-*"))
+	if len(leftovers) != 0 {
+		t.Fatalf("expected no temp files, got %v", leftovers)
+	}
+}
+
+func TestPutArchiveRejectsKeysOutsideRoot(t *testing.T) {
+	t.Parallel()
+
+	storage := NewArchiveStorage(t.TempDir(), nil)
+
+	err := storage.PutArchive(t.Context(), output.ArchiveObject{ObjectKey: "../escape.ndjson", Body: []byte("{}")})
+	if !errors.Is(err, ErrObjectKeyOutsideRoot) {
+		t.Fatalf("expected ErrObjectKeyOutsideRoot, got %v", err)
+	}
+}
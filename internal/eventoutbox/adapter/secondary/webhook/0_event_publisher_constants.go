@@ -0,0 +1,40 @@
+// Package webhook publishes canonical outbox events as HTTP POST requests to a configured endpoint.
+package webhook
+
+const (
+	// PublisherTracerName is the tracer name used by the webhook outbox publisher adapter.
+	PublisherTracerName = "aion-api.eventoutbox.webhook.publisher"
+)
+
+const (
+	// SpanOutboxWebhookPublishBatch is the span name for one batch delivered over HTTP.
+	SpanOutboxWebhookPublishBatch = "eventoutbox.webhook.publish_batch"
+	// SpanOutboxWebhookSend is the producer span of one event POSTed to the endpoint.
+	SpanOutboxWebhookSend = "eventoutbox.webhook.send"
+)
+
+const (
+	// OpOutboxWebhookPublishBatch is the operation name for batched webhook publication.
+	OpOutboxWebhookPublishBatch = "event_outbox_webhook_publish_batch"
+)
+
+const (
+	// HeaderPrefix namespaces the routing metadata sent as HTTP headers, e.g. X-Aion-Event-Id.
+	HeaderPrefix = "X-Aion-"
+	// HeaderCloudEventsPrefix prefixes CloudEvents attributes in binary mode, as the HTTP protocol binding requires, e.g. ce-id.
+	HeaderCloudEventsPrefix = "ce-"
+	// HeaderTopic carries the topic the event would be routed to on a broker.
+	HeaderTopic = "X-Aion-Topic"
+	// ContentTypeJSON is the content type of every webhook body.
+	ContentTypeJSON = "application/json"
+)
+
+const (
+	// LogPublishedBatch indicates a batch was delivered to the webhook, possibly with per-event failures.
+	LogPublishedBatch = "outbox batch published to webhook"
+)
+
+const (
+	// ErrUnexpectedStatus is returned when the endpoint answers with a non-2xx status.
+	ErrUnexpectedStatus = "webhook responded with unexpected status"
+)
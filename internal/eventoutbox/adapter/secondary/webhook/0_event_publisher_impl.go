@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/httpclient"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+)
+
+// EventPublisher delivers durable outbox events to one HTTP endpoint.
+type EventPublisher struct {
+	client  httpclient.HTTPClient
+	logger  logger.ContextLogger
+	router  codec.Router
+	encoder codec.Encoder
+	url     string
+	timeout time.Duration
+}
+
+// NewEventPublisher creates a webhook-backed outbox publisher. Each event is POSTed on its own
+// and bounded by timeout, so one slow delivery cannot hold the publication lease indefinitely.
+func NewEventPublisher(
+	client httpclient.HTTPClient,
+	url string,
+	timeout time.Duration,
+	cfg config.KafkaConfig,
+	log logger.ContextLogger,
+) *EventPublisher {
+	return &EventPublisher{
+		client:  client,
+		logger:  log,
+		router:  codec.NewRouter(cfg),
+		encoder: codec.NewEncoder(codec.FormatLegacy),
+		url:     url,
+		timeout: timeout,
+	}
+}
+
+// WithEncoder sets the envelope format of published events; the default is codec.FormatLegacy.
+func (p *EventPublisher) WithEncoder(encoder codec.Encoder) *EventPublisher {
+	p.encoder = encoder
+	return p
+}
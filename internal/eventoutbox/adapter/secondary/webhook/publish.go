@@ -0,0 +1,12 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+)
+
+// Publish POSTs one outbox event to the configured endpoint.
+func (p *EventPublisher) Publish(ctx context.Context, event domain.Event) error {
+	return p.PublishBatch(ctx, []domain.Event{event})[0].Err
+}
@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PublishBatch POSTs the events one by one in input order. Any 2xx response counts as delivered;
+// every other outcome fails only the event it belongs to.
+func (p *EventPublisher) PublishBatch(ctx context.Context, events []domain.Event) []domain.PublishResult {
+	results := make([]domain.PublishResult, len(events))
+	if len(events) == 0 {
+		return results
+	}
+
+	tr := otel.Tracer(PublisherTracerName)
+	ctx, span := tr.Start(ctx, SpanOutboxWebhookPublishBatch, trace.WithAttributes(
+		attribute.String(commonkeys.Operation, OpOutboxWebhookPublishBatch),
+		attribute.Int("batch_size", len(events)),
+	))
+	defer span.End()
+
+	failed := 0
+	for i, event := range events {
+		results[i].EventID = event.EventID
+		results[i].Err = p.deliver(ctx, tr, event)
+		if results[i].Err != nil {
+			failed++
+			span.RecordError(results[i].Err, trace.WithAttributes(attribute.String("event_id", event.EventID)))
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("published_count", len(events)-failed),
+		attribute.Int("failed_count", failed),
+	)
+	if failed > 0 {
+		span.SetStatus(codes.Error, OpOutboxWebhookPublishBatch)
+	} else {
+		span.SetStatus(codes.Ok, LogPublishedBatch)
+	}
+	p.logger.InfowCtx(ctx, LogPublishedBatch,
+		"batch_size", len(events),
+		"published_count", len(events)-failed,
+		"failed_count", failed,
+	)
+
+	return results
+}
+
+func (p *EventPublisher) deliver(ctx context.Context, tr trace.Tracer, event domain.Event) (err error) {
+	ctx, span := codec.StartSendSpan(ctx, tr, SpanOutboxWebhookSend, event)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, OpOutboxWebhookPublishBatch)
+		}
+		span.End()
+	}()
+
+	topic, err := p.router.Topic(event)
+	if err != nil {
+		return err
+	}
+
+	message, err := p.encoder.Encode(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(message.Value))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", message.ContentType())
+	req.Header.Set(HeaderTopic, topic)
+	for _, header := range message.Headers {
+		if header.Key == codec.HeaderContentType {
+			continue
+		}
+		req.Header.Set(headerName(header.Key), header.Value)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	// Drain the body so the connection can be reused for the next event.
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s: %d", ErrUnexpectedStatus, resp.StatusCode)
+	}
+	return nil
+}
+
+// headerName turns a codec header key such as aggregate_sequence into X-Aion-Aggregate-Sequence.
+// W3C trace headers keep their standard names so HTTP receivers continue the trace natively.
+func headerName(key string) string {
+	if key == tracecontext.HeaderTraceParent || key == tracecontext.HeaderTraceState {
+		return key
+	}
+	if attribute, ok := strings.CutPrefix(key, codec.HeaderCloudEventsPrefix); ok {
+		return HeaderCloudEventsPrefix + attribute
+	}
+	return HeaderPrefix + http.CanonicalHeaderKey(strings.ReplaceAll(key, "_", "-"))
+}
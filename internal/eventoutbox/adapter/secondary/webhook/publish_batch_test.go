@@ -0,0 +1,165 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/webhook"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/httpclient"
+)
+
+type noopLogger struct{}
+
+func (noopLogger) Infof(string, ...any)                      {}
+func (noopLogger) Errorf(string, ...any)                     {}
+func (noopLogger) Debugf(string, ...any)                     {}
+func (noopLogger) Warnf(string, ...any)                      {}
+func (noopLogger) Infow(string, ...any)                      {}
+func (noopLogger) Errorw(string, ...any)                     {}
+func (noopLogger) Debugw(string, ...any)                     {}
+func (noopLogger) Warnw(string, ...any)                      {}
+func (noopLogger) InfowCtx(context.Context, string, ...any)  {}
+func (noopLogger) ErrorwCtx(context.Context, string, ...any) {}
+func (noopLogger) WarnwCtx(context.Context, string, ...any)  {}
+func (noopLogger) DebugwCtx(context.Context, string, ...any) {}
+
+type receivedRequest struct {
+	header   http.Header
+	envelope codec.Envelope
+}
+
+func newPublisher(t *testing.T, handler http.HandlerFunc, timeout time.Duration) *webhook.EventPublisher {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return webhook.NewEventPublisher(
+		httpclient.NewClient(server.Client()),
+		server.URL,
+		timeout,
+		config.KafkaConfig{RecordEventsTopic: "aion.record.events.v1"},
+		noopLogger{},
+	)
+}
+
+func TestPublishBatchPostsEnvelopeWithMetadataHeaders(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		received []receivedRequest
+	)
+	publisher := newPublisher(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var envelope codec.Envelope
+		_ = json.Unmarshal(body, &envelope)
+
+		mu.Lock()
+		received = append(received, receivedRequest{header: r.Header.Clone(), envelope: envelope})
+		mu.Unlock()
+
+		if envelope.EventID == "evt-2" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}, time.Second)
+
+	results := publisher.PublishBatch(context.Background(), []domain.Event{
+		{EventID: "evt-1", AggregateType: codec.RecordAggregateType, AggregateID: "42", AggregateSequence: 3},
+		{EventID: "evt-2", AggregateType: codec.RecordAggregateType, AggregateID: "43"},
+		{EventID: "evt-3", AggregateType: "unknown"},
+	})
+
+	if results[0].Err != nil {
+		t.Fatalf("expected evt-1 to be delivered, got %v", results[0].Err)
+	}
+	if results[1].Err == nil {
+		t.Fatal("expected evt-2 to fail on 503")
+	}
+	if results[2].Err == nil {
+		t.Fatal("expected evt-3 to fail routing")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(received) != 2 {
+		t.Fatalf("expected two requests, got %d", len(received))
+	}
+	first := received[0]
+	if first.envelope.EventID != "evt-1" {
+		t.Fatalf("expected events in input order, got %q first", first.envelope.EventID)
+	}
+	if got := first.header.Get("X-Aion-Aggregate-Sequence"); got != "3" {
+		t.Fatalf("expected aggregate sequence header 3, got %q", got)
+	}
+	if got := first.header.Get(webhook.HeaderTopic); got != "aion.record.events.v1" {
+		t.Fatalf("expected topic header, got %q", got)
+	}
+	if got := first.header.Get("Content-Type"); got != webhook.ContentTypeJSON {
+		t.Fatalf("expected JSON content type, got %q", got)
+	}
+}
+
+func TestPublishInCloudEventsBinaryModeUsesHTTPBindingHeaders(t *testing.T) {
+	t.Parallel()
+
+	var (
+		header http.Header
+		body   []byte
+	)
+	publisher := newPublisher(t, func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}, time.Second).WithEncoder(codec.NewEncoder(codec.FormatCloudEventsBinary))
+
+	err := publisher.Publish(context.Background(), domain.Event{
+		EventID: "evt-1", AggregateType: codec.RecordAggregateType, AggregateID: "42", EventType: "record.created",
+		PayloadJSON: []byte(`{"record_id":42}`),
+	})
+	if err != nil {
+		t.Fatalf("expected delivery, got %v", err)
+	}
+
+	if string(body) != `{"record_id":42}` {
+		t.Fatalf("expected the bare payload as body, got %s", string(body))
+	}
+	if header.Get("ce-id") != "evt-1" || header.Get("ce-type") != "record.created" || header.Get("ce-specversion") != "1.0" {
+		t.Fatalf("expected ce- headers, got %v", header)
+	}
+	if got := header.Get("Content-Type"); got != webhook.ContentTypeJSON {
+		t.Fatalf("expected JSON content type, got %q", got)
+	}
+	if got := header.Get("X-Aion-Content-Type"); got != "" {
+		t.Fatalf("expected content-type to be sent once, got X-Aion-Content-Type %q", got)
+	}
+}
+
+func TestPublishFailsWhenEndpointExceedsTimeout(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	publisher := newPublisher(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+		w.WriteHeader(http.StatusOK)
+	}, 20*time.Millisecond)
+	defer close(release)
+
+	if err := publisher.Publish(context.Background(), domain.Event{EventID: "evt-1", AggregateType: codec.RecordAggregateType}); err == nil {
+		t.Fatal("expected timeout error")
+	}
+}
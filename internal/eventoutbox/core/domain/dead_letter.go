@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// DeadLetterFilter selects dead-lettered outbox events for inspection or replay.
+// Empty fields are ignored; a filter with no selector matches every dead-lettered event.
+type DeadLetterFilter struct {
+	CreatedFromUTC *time.Time
+	CreatedToUTC   *time.Time
+	AggregateType  string
+	AggregateID    string
+	EventIDs       []string
+	Limit          int
+}
+
+// HasSelector reports whether the filter narrows the dead-letter set by id, aggregate, or time range.
+func (f DeadLetterFilter) HasSelector() bool {
+	return len(f.EventIDs) > 0 ||
+		f.AggregateType != "" ||
+		f.AggregateID != "" ||
+		f.CreatedFromUTC != nil ||
+		f.CreatedToUTC != nil
+}
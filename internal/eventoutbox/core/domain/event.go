@@ -4,20 +4,29 @@ package domain
 import "time"
 
 // Event represents one canonical domain event persisted in the outbox.
+// AggregateSequence numbers the events of one aggregate from 1 in enqueue order.
+// LeaseOwner and LeaseExpiresAtUTC identify the publisher currently holding a publishing row.
+// TraceParent and TraceState hold the W3C trace context of the enqueueing span, so publication
+// can continue the trace of the write that produced the event.
 type Event struct {
-	EventID        string
-	AggregateType  string
-	AggregateID    string
-	EventType      string
-	EventVersion   string
-	Source         string
-	Status         string
-	TraceID        string
-	RequestID      string
-	PayloadJSON    []byte
-	AttemptCount   int
-	AvailableAtUTC time.Time
-	PublishedAtUTC *time.Time
-	LastError      string
-	CreatedAt      time.Time
+	EventID           string
+	AggregateType     string
+	AggregateID       string
+	EventType         string
+	EventVersion      string
+	Source            string
+	Status            string
+	TraceID           string
+	TraceParent       string
+	TraceState        string
+	RequestID         string
+	PayloadJSON       []byte
+	AttemptCount      int
+	AggregateSequence int64
+	AvailableAtUTC    time.Time
+	PublishedAtUTC    *time.Time
+	LastError         string
+	CreatedAt         time.Time
+	LeaseOwner        string
+	LeaseExpiresAtUTC *time.Time
 }
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrLeaseLost reports that a publisher tried to settle a row it no longer holds: its lease expired
+// and another publisher reclaimed the row, which now belongs to that publisher.
+var ErrLeaseLost = errors.New("outbox lease lost")
+
+// Lease identifies the publisher instance claiming outbox rows and how long the claim holds.
+// A claimed row that is neither published nor rescheduled before the lease expires can be reclaimed by another publisher.
+type Lease struct {
+	Owner    string
+	Duration time.Duration
+}
@@ -0,0 +1,16 @@
+package domain
+
+// Ordering selects how the publisher sequences events that share an aggregate.
+type Ordering string
+
+const (
+	// OrderingNone publishes every available event independently of its aggregate.
+	OrderingNone Ordering = "none"
+	// OrderingAggregate holds back later events of an aggregate while an earlier one is pending or retrying.
+	OrderingAggregate Ordering = "aggregate"
+)
+
+// Valid reports whether the ordering is one of the supported modes.
+func (o Ordering) Valid() bool {
+	return o == OrderingNone || o == OrderingAggregate
+}
@@ -3,9 +3,13 @@ package domain
 import "time"
 
 // Stats aggregates operational counters and timestamps for the event outbox.
+// PendingCount includes rows currently leased by a publisher, which LeasedCount reports on their own.
+// FailedCount reports dead-lettered rows; RetryingCount reports pending rows that already failed at least once.
 type Stats struct {
 	PendingCount       int64
 	PublishedCount     int64
 	FailedCount        int64
+	RetryingCount      int64
+	LeasedCount        int64
 	OldestPendingAtUTC *time.Time
 }
@@ -0,0 +1,8 @@
+package domain
+
+// PublishResult reports the outcome of one event inside a batch publish.
+// A nil Err means the transport accepted the event.
+type PublishResult struct {
+	Err     error
+	EventID string
+}
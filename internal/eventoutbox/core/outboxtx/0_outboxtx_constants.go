@@ -0,0 +1,8 @@
+// Package outboxtx runs a bounded-context write and its outbox enqueue inside one database
+// transaction, and builds the canonical outbox event shared by every producing context.
+package outboxtx
+
+const (
+	// occurredAtLayout keeps microsecond precision to match the outbox timestamp columns.
+	occurredAtLayout = "2006-01-02T15:04:05.000000Z07:00"
+)
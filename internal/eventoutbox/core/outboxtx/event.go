@@ -0,0 +1,41 @@
+package outboxtx
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+)
+
+// NewEvent builds an outbox event for one aggregate change, carrying the trace and request ids
+// found in ctx and the JSON-encoded payload.
+func NewEvent(ctx context.Context, aggregateType string, aggregateID uint64, eventType, eventVersion string, payload any) (domain.Event, error) {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	traceID, _ := ctx.Value(ctxkeys.TraceID).(string)
+	requestID, _ := ctx.Value(ctxkeys.RequestID).(string)
+
+	return domain.Event{
+		AggregateType: aggregateType,
+		AggregateID:   strconv.FormatUint(aggregateID, 10),
+		EventType:     eventType,
+		EventVersion:  eventVersion,
+		TraceID:       traceID,
+		RequestID:     requestID,
+		PayloadJSON:   payloadJSON,
+	}, nil
+}
+
+// FormatTime renders a payload timestamp in UTC with the outbox precision.
+func FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(occurredAtLayout)
+}
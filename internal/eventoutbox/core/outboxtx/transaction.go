@@ -0,0 +1,48 @@
+package outboxtx
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
+)
+
+// RepositoryWithDB is implemented by repositories that can be rebound to a transaction handle.
+type RepositoryWithDB[R any] interface {
+	WithDB(database dbport.DB) R
+}
+
+// ServiceWithDB is implemented by outbox services that can be rebound to a transaction handle.
+type ServiceWithDB interface {
+	WithDB(database dbport.DB) input.Service
+}
+
+// Run executes fn with the repository and outbox service bound to the same transaction.
+// It falls back to the unbound dependencies when no transaction manager or outbox service is
+// configured, or when either dependency cannot be rebound, so callers keep working in tests
+// and in deployments without an outbox.
+func Run[R any](
+	ctx context.Context,
+	txManager dbport.DB,
+	repository R,
+	outboxService input.Service,
+	fn func(repository R, outboxService input.Service) error,
+) error {
+	if txManager == nil || outboxService == nil {
+		return fn(repository, outboxService)
+	}
+
+	repositoryFactory, ok := any(repository).(RepositoryWithDB[R])
+	if !ok {
+		return fn(repository, outboxService)
+	}
+
+	outboxFactory, ok := outboxService.(ServiceWithDB)
+	if !ok {
+		return fn(repository, outboxService)
+	}
+
+	return txManager.WithContext(ctx).Transaction(func(tx dbport.DB) error {
+		return fn(repositoryFactory.WithDB(tx), outboxFactory.WithDB(tx))
+	})
+}
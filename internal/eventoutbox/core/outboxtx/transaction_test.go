@@ -0,0 +1,148 @@
+package outboxtx_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/outboxtx"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	"github.com/lechitz/aion-api/tests/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+type repository interface {
+	Name() string
+}
+
+type namedRepository string
+
+func (r namedRepository) Name() string { return string(r) }
+
+type txAwareRepository struct {
+	namedRepository
+}
+
+func (txAwareRepository) WithDB(_ dbport.DB) repository {
+	return namedRepository("tx")
+}
+
+type namedOutbox string
+
+func (namedOutbox) Enqueue(context.Context, domain.Event) error { return nil }
+
+type txAwareOutbox struct {
+	namedOutbox
+}
+
+func (txAwareOutbox) WithDB(_ dbport.DB) input.Service {
+	return namedOutbox("tx")
+}
+
+func TestRunBindsRepositoryAndOutboxToTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	database := mocks.NewMockDB(ctrl)
+	tx := mocks.NewMockDB(ctrl)
+	database.EXPECT().WithContext(gomock.Any()).Return(database)
+	database.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(dbport.DB) error) error {
+		return fn(tx)
+	})
+
+	var repo repository = txAwareRepository{namedRepository: "root"}
+	err := outboxtx.Run(t.Context(), database, repo, txAwareOutbox{namedOutbox: "root"}, func(gotRepo repository, gotOutbox input.Service) error {
+		if gotRepo.Name() != "tx" {
+			t.Fatalf("expected transaction-bound repository, got %q", gotRepo.Name())
+		}
+		if gotOutbox != namedOutbox("tx") {
+			t.Fatalf("expected transaction-bound outbox, got %v", gotOutbox)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestRunReturnsCallbackErrorFromTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	database := mocks.NewMockDB(ctrl)
+	database.EXPECT().WithContext(gomock.Any()).Return(database)
+	database.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(dbport.DB) error) error {
+		return fn(mocks.NewMockDB(ctrl))
+	})
+
+	want := errors.New("write failed")
+	var repo repository = txAwareRepository{namedRepository: "root"}
+	err := outboxtx.Run(t.Context(), database, repo, txAwareOutbox{}, func(repository, input.Service) error {
+		return want
+	})
+	if !errors.Is(err, want) {
+		t.Fatalf("expected callback error, got %v", err)
+	}
+}
+
+func TestRunFallsBackWithoutTransactionSupport(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	database := mocks.NewMockDB(ctrl)
+
+	cases := map[string]struct {
+		txManager dbport.DB
+		repo      repository
+		outbox    input.Service
+	}{
+		"no transaction manager": {txManager: nil, repo: txAwareRepository{namedRepository: "root"}, outbox: txAwareOutbox{namedOutbox: "root"}},
+		"no outbox":              {txManager: database, repo: txAwareRepository{namedRepository: "root"}, outbox: nil},
+		"repository cannot bind": {txManager: database, repo: namedRepository("root"), outbox: txAwareOutbox{namedOutbox: "root"}},
+		"outbox cannot bind":     {txManager: database, repo: txAwareRepository{namedRepository: "root"}, outbox: namedOutbox("root")},
+	}
+	for name, tc := range cases {
+		called := false
+		err := outboxtx.Run(t.Context(), tc.txManager, tc.repo, tc.outbox, func(gotRepo repository, gotOutbox input.Service) error {
+			called = true
+			if gotRepo.Name() != "root" {
+				t.Fatalf("%s: expected root repository, got %q", name, gotRepo.Name())
+			}
+			if gotOutbox != tc.outbox {
+				t.Fatalf("%s: expected unbound outbox", name)
+			}
+			return nil
+		})
+		if err != nil || !called {
+			t.Fatalf("%s: expected direct call, err=%v called=%v", name, err, called)
+		}
+	}
+}
+
+func TestNewEventCarriesRequestMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(t.Context(), ctxkeys.TraceID, "trace-1")
+	ctx = context.WithValue(ctx, ctxkeys.RequestID, "req-1")
+
+	event, err := outboxtx.NewEvent(ctx, "tag", 42, "tag.updated", "v1", map[string]any{"tag_id": 42, "name": "Read"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if event.AggregateType != "tag" || event.AggregateID != "42" || event.EventType != "tag.updated" || event.EventVersion != "v1" {
+		t.Fatalf("unexpected event identity: %+v", event)
+	}
+	if event.TraceID != "trace-1" || event.RequestID != "req-1" {
+		t.Fatalf("expected trace and request ids from context, got %q %q", event.TraceID, event.RequestID)
+	}
+	if string(event.PayloadJSON) != `{"name":"Read","tag_id":42}` {
+		t.Fatalf("unexpected payload %s", event.PayloadJSON)
+	}
+
+	if _, err := outboxtx.NewEvent(ctx, "tag", 1, "tag.created", "v1", map[string]any{"bad": func() {}}); err == nil {
+		t.Fatal("expected marshal error")
+	}
+}
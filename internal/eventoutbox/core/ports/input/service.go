@@ -14,5 +14,19 @@ type Service interface {
 
 // PublisherService defines background publication of pending outbox rows.
 type PublisherService interface {
-	PublishPending(ctx context.Context, limit int) error
+	// PublishPending runs one publish cycle over at most limit rows and returns how many rows it claimed,
+	// so callers can keep draining while batches come back full.
+	PublishPending(ctx context.Context, limit int) (int, error)
+}
+
+// DeadLetterService defines operator inspection and replay of dead-lettered outbox rows.
+type DeadLetterService interface {
+	ListDeadLettered(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.Event, error)
+	GetEvent(ctx context.Context, eventID string) (domain.Event, error)
+	ReplayDeadLettered(ctx context.Context, filter domain.DeadLetterFilter) (int64, error)
+}
+
+// RetentionService defines removal of published outbox rows past their retention period.
+type RetentionService interface {
+	PurgePublished(ctx context.Context) (int64, error)
 }
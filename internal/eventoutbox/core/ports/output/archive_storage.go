@@ -0,0 +1,15 @@
+package output
+
+import "context"
+
+// ArchiveObject defines one archive file sent to storage adapters.
+type ArchiveObject struct {
+	ObjectKey   string
+	ContentType string
+	Body        []byte
+}
+
+// ArchiveStorage persists outbox archive files before their rows are purged.
+type ArchiveStorage interface {
+	PutArchive(ctx context.Context, object ArchiveObject) error
+}
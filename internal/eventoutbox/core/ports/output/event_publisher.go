@@ -10,4 +10,7 @@ import (
 // EventPublisher publishes canonical outbox events to the external event backbone.
 type EventPublisher interface {
 	Publish(ctx context.Context, event domain.Event) error
+	// PublishBatch sends events in a single transport write and returns one result per event, in input order,
+	// so a partially failed batch can be settled event by event.
+	PublishBatch(ctx context.Context, events []domain.Event) []domain.PublishResult
 }
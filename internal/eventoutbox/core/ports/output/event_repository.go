@@ -8,10 +8,29 @@ import (
 	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
 )
 
+// ArchiveFunc receives the rows a purge is about to delete. An error aborts the purge and keeps the rows.
+type ArchiveFunc func(ctx context.Context, events []domain.Event) error
+
 // EventRepository persists canonical outbox events.
 type EventRepository interface {
 	Save(ctx context.Context, event domain.Event) error
-	ListPending(ctx context.Context, limit int) ([]domain.Event, error)
-	MarkPublished(ctx context.Context, eventID string, publishedAt time.Time) error
-	Reschedule(ctx context.Context, eventID string, nextAvailableAt time.Time, lastError string) error
+	// NotifyPending wakes listening publishers once the enqueueing transaction commits.
+	NotifyPending(ctx context.Context, aggregateType string) error
+	// ListPending atomically claims up to limit publishable rows for the lease owner.
+	// Rows whose previous lease expired are reclaimed; rows leased by another live publisher are skipped.
+	// With OrderingAggregate only the earliest unpublished row of each aggregate is claimable.
+	ListPending(ctx context.Context, lease domain.Lease, ordering domain.Ordering, limit int) ([]domain.Event, error)
+	// MarkPublishedBatch marks every listed event as published in a single statement.
+	// MarkPublishedBatch, Reschedule and MarkDeadLettered only settle rows still publishing under leaseOwner;
+	// a row that was reclaimed by another publisher is left alone and reported with domain.ErrLeaseLost.
+	MarkPublishedBatch(ctx context.Context, leaseOwner string, eventIDs []string, publishedAt time.Time) error
+	Reschedule(ctx context.Context, leaseOwner string, eventID string, nextAvailableAt time.Time, lastError string) error
+	MarkDeadLettered(ctx context.Context, leaseOwner string, eventID string, lastError string) error
+	GetByEventID(ctx context.Context, eventID string) (domain.Event, error)
+	ListDeadLettered(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.Event, error)
+	ReplayDeadLettered(ctx context.Context, filter domain.DeadLetterFilter, availableAt time.Time) (int64, error)
+	GetStats(ctx context.Context) (domain.Stats, error)
+	// PurgePublished deletes up to limit rows published before cutoff, oldest first, and returns how many were removed.
+	// A non-nil archive runs inside the deleting transaction, so rows are only removed once archived.
+	PurgePublished(ctx context.Context, cutoff time.Time, limit int, archive ArchiveFunc) (int64, error)
 }
@@ -0,0 +1,10 @@
+package output
+
+import "context"
+
+// PendingListener wakes the publisher as soon as new outbox rows are committed.
+type PendingListener interface {
+	// Listen blocks until ctx is done. It sends on wake after every notification without blocking on a full
+	// channel, and reopens a dropped connection after a delay; callers keep polling in the meantime.
+	Listen(ctx context.Context, wake chan<- struct{})
+}
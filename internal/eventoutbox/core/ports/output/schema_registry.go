@@ -0,0 +1,8 @@
+package output
+
+// SchemaRegistry checks event payloads against the JSON Schema registered for their type and version,
+// and rewrites payloads stored at an older version into the current version of their type.
+type SchemaRegistry interface {
+	Validate(eventType, eventVersion string, payload []byte) error
+	Upcast(eventType, eventVersion string, payload []byte) (currentVersion string, upcast []byte, err error)
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+)
+
+// DeadLetterService exposes operator inspection and replay of dead-lettered outbox rows.
+type DeadLetterService struct {
+	repository output.EventRepository
+	logger     logger.ContextLogger
+	now        func() time.Time
+}
+
+// NewDeadLetterService creates a new dead-letter service implementation.
+func NewDeadLetterService(repository output.EventRepository, log logger.ContextLogger) input.DeadLetterService {
+	return &DeadLetterService{
+		repository: repository,
+		logger:     log,
+		now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
@@ -4,29 +4,79 @@ package usecase
 import (
 	"errors"
 	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
 )
 
 const (
 	// TracerName is the tracer name used by the event outbox use case layer.
 	TracerName = "aion-api.eventoutbox.usecase"
 
+	// MeterName is the meter name used by the event outbox use case layer.
+	MeterName = "aion-api.eventoutbox.usecase"
+
+	// MetricPublishBatchSize records how many rows one publish cycle claimed.
+	MetricPublishBatchSize = "aion.outbox.publish.batch_size"
+	// MetricPublishDuration records how long one batched transport write took.
+	MetricPublishDuration = "aion.outbox.publish.duration"
+	// MetricPendingBacklog observes the number of rows still waiting for publication.
+	MetricPendingBacklog = "aion.outbox.pending.backlog"
+	// MetricOldestPendingAge observes how long the oldest publishable row has been waiting.
+	MetricOldestPendingAge = "aion.outbox.pending.oldest_age"
+	// MetricRetentionRowsRemoved counts published rows deleted by the retention job.
+	MetricRetentionRowsRemoved = "aion.outbox.retention.rows_removed"
+	// MetricAttrArchived labels whether removed rows were exported before deletion.
+	MetricAttrArchived = "archived"
+	// MetricAttrOutcome labels one publish write as ok, partial, or failed.
+	MetricAttrOutcome = "outcome"
+	// PublishOutcomeOK marks a write in which every event was accepted.
+	PublishOutcomeOK = "ok"
+	// PublishOutcomePartial marks a write in which only some events were accepted.
+	PublishOutcomePartial = "partial"
+	// PublishOutcomeFailed marks a write in which no event was accepted.
+	PublishOutcomeFailed = "failed"
+
 	// SpanEnqueue covers input validation and persistence of one outbox event.
 	SpanEnqueue = "eventoutbox.enqueue"
 	// SpanPublishPending covers one publish loop over pending outbox rows.
 	SpanPublishPending = "eventoutbox.publish_pending"
+	// SpanPurgePublished covers one retention run over expired published rows.
+	SpanPurgePublished = "eventoutbox.purge_published"
+	// SpanListDeadLettered covers one dead-letter lookup.
+	SpanListDeadLettered = "eventoutbox.list_dead_lettered"
+	// SpanGetEvent covers one outbox event lookup by id.
+	SpanGetEvent = "eventoutbox.get_event"
+	// SpanReplayDeadLettered covers one dead-letter replay request.
+	SpanReplayDeadLettered = "eventoutbox.replay_dead_lettered"
 
 	// EventNormalizeInput records normalization of one enqueue request.
 	EventNormalizeInput = "eventoutbox.input.normalize"
 	// EventValidateInput records validation of one enqueue request.
 	EventValidateInput = "eventoutbox.input.validate"
+	// EventValidateSchema records validation of one payload against its registered JSON Schema.
+	EventValidateSchema = "eventoutbox.input.validate_schema"
+	// EventUpcastPayload records upcasting of stored payloads to the current event version before publication.
+	EventUpcastPayload = "eventoutbox.payload.upcast"
 	// EventRepositorySave records persistence of one outbox row.
 	EventRepositorySave = "eventoutbox.repository.save"
+	// EventRepositoryNotify records queueing the pending-row notification for listening publishers.
+	EventRepositoryNotify = "eventoutbox.repository.notify_pending"
 	// EventRepositoryList records retrieval of pending outbox rows.
 	EventRepositoryList = "eventoutbox.repository.list_pending"
-	// EventRepositoryPublish records marking one outbox row as published.
-	EventRepositoryPublish = "eventoutbox.repository.mark_published"
-	// EventPublish records the attempt to publish one outbox event externally.
+	// EventRepositoryPublish records marking a batch of outbox rows as published.
+	EventRepositoryPublish = "eventoutbox.repository.mark_published_batch"
+	// EventLeaseLost records that rows this publisher held were reclaimed before it could settle them.
+	EventLeaseLost = "eventoutbox.lease_lost"
+	// EventPublish records the attempt to publish a batch of outbox events externally.
 	EventPublish = "eventoutbox.publish"
+	// EventRepositoryDeadLetter records moving one outbox row to the dead-letter state.
+	EventRepositoryDeadLetter = "eventoutbox.repository.mark_dead_lettered"
+	// EventRepositoryReplay records returning dead-lettered rows to the pending state.
+	EventRepositoryReplay = "eventoutbox.repository.replay_dead_lettered"
+	// EventRepositoryPurge records deletion of one batch of expired published rows.
+	EventRepositoryPurge = "eventoutbox.repository.purge_published"
+	// EventArchiveWrite records export of one purge batch to archive storage.
+	EventArchiveWrite = "eventoutbox.archive.write"
 	// EventSuccess records successful completion of one outbox flow.
 	EventSuccess = "eventoutbox.success"
 
@@ -38,11 +88,38 @@ const (
 	DefaultEventStatus = "pending"
 	// DefaultSource is the canonical source label used for outbox events emitted by aion-api.
 	DefaultSource = "aion-api"
-	// DefaultPublishBackoff is the retry delay applied between publish attempts for transient failures.
+	// DeadLetteredEventStatus is the persisted status for rows that exhausted their publish attempts.
+	DeadLetteredEventStatus = "failed"
+	// DefaultPublishBackoff is the base retry delay applied after the first failed publish attempt.
 	DefaultPublishBackoff = 5 * time.Second
+	// DefaultMaxPublishBackoff caps the exponential retry delay between publish attempts.
+	DefaultMaxPublishBackoff = 10 * time.Minute
+	// DefaultMaxPublishAttempts is the number of failed attempts after which an event is dead-lettered.
+	DefaultMaxPublishAttempts = 10
+	// DefaultOrdering is the ordering mode applied when configuration leaves it unset.
+	DefaultOrdering = domain.OrderingAggregate
+	// DefaultLeaseDuration is how long one publisher keeps claimed rows before another publisher may reclaim them.
+	DefaultLeaseDuration = 30 * time.Second
+	// DefaultDeadLetterListLimit is the page size applied when a dead-letter lookup sets no limit.
+	DefaultDeadLetterListLimit = 50
+	// MaxDeadLetterListLimit caps the page size of one dead-letter lookup.
+	MaxDeadLetterListLimit = 500
+
+	// DefaultRetentionPeriod is how long published rows are kept when configuration leaves it unset.
+	DefaultRetentionPeriod = 7 * 24 * time.Hour
+	// DefaultRetentionBatchSize is how many rows one purge statement deletes when configuration leaves it unset.
+	DefaultRetentionBatchSize = 500
+	// DefaultRetentionMaxBatches caps the batches one retention run deletes, so a large backlog drains over several runs.
+	DefaultRetentionMaxBatches = 100
+	// ArchiveContentType is the content type of outbox archive files.
+	ArchiveContentType = "application/x-ndjson"
+	// ArchiveKeyPrefix is the object key prefix of outbox archive files.
+	ArchiveKeyPrefix = "event_outbox/published"
 
 	// StatusEventQueued is the success status attached after one outbox event is persisted.
 	StatusEventQueued = "event queued"
+	// StatusEventRescheduled is logged after one failed outbox event is deferred for another attempt.
+	StatusEventRescheduled = "outbox event rescheduled"
 
 	// LogKeyEventID stores the structured logger field name for the outbox event id.
 	LogKeyEventID = "event_id"
@@ -61,12 +138,51 @@ const (
 	LogOutboxEventQueued = "outbox event queued"
 	// LogFailedToEnqueueEvent is emitted when enqueueing an outbox event fails.
 	LogFailedToEnqueueEvent = "failed to enqueue outbox event"
+	// LogRejectedEventPayload is emitted when an event payload does not match its registered schema.
+	LogRejectedEventPayload = "outbox event payload rejected by schema"
+	// LogFailedToUpcastEvent is emitted when a stored payload cannot be upcast to the current event version.
+	LogFailedToUpcastEvent = "failed to upcast outbox event payload"
 	// LogPublishPendingEvents is emitted when the publisher loop starts processing pending rows.
 	LogPublishPendingEvents = "publishing pending outbox events"
-	// LogOutboxEventPublished is emitted after one outbox row is published successfully.
-	LogOutboxEventPublished = "outbox event published"
+	// LogOutboxEventPublished is emitted after a batch of outbox rows is published and marked.
+	LogOutboxEventPublished = "outbox events published"
 	// LogOutboxEventPublishFailed is emitted when publishing an outbox row fails.
 	LogOutboxEventPublishFailed = "failed to publish outbox event"
+	// LogOutboxLeaseLost is emitted when rows cannot be settled because another publisher reclaimed them.
+	LogOutboxLeaseLost = "outbox lease lost before settling events"
+	// LogOutboxEventDeadLettered is emitted when an outbox row exhausts its publish attempts.
+	LogOutboxEventDeadLettered = "outbox event dead-lettered after max publish attempts"
+	// LogPublisherMetricsUnavailable is emitted when publisher instruments cannot be registered.
+	LogPublisherMetricsUnavailable = "outbox publisher metrics unavailable"
+	// LogPurgedPublishedEvents is emitted after a retention run removes expired published rows.
+	LogPurgedPublishedEvents = "expired published outbox events purged"
+	// LogFailedToPurgePublished is emitted when a retention run fails.
+	LogFailedToPurgePublished = "failed to purge published outbox events"
+	// LogRetentionMetricsUnavailable is emitted when retention instruments cannot be registered.
+	LogRetentionMetricsUnavailable = "outbox retention metrics unavailable"
+	// LogDeadLetteredEventsReplayed is emitted after dead-lettered rows are returned to pending.
+	LogDeadLetteredEventsReplayed = "dead-lettered outbox events replayed"
+	// LogFailedToReplayDeadLettered is emitted when a dead-letter replay fails.
+	LogFailedToReplayDeadLettered = "failed to replay dead-lettered outbox events"
+
+	// LogKeyAttemptCount stores the structured logger field name for the publish attempt count.
+	LogKeyAttemptCount = "attempt_count"
+	// LogKeyNextAvailableAt stores the structured logger field name for the next publish attempt time.
+	LogKeyNextAvailableAt = "next_available_at_utc"
+	// LogKeyLeaseOwner stores the structured logger field name for the publisher lease owner.
+	LogKeyLeaseOwner = "lease_owner"
+	// LogKeyOrdering stores the structured logger field name for the publisher ordering mode.
+	LogKeyOrdering = "ordering"
+	// LogKeyPublishedCount stores the structured logger field name for the number of published rows.
+	LogKeyPublishedCount = "published_count"
+	// LogKeyFailedCount stores the structured logger field name for the number of failed rows.
+	LogKeyFailedCount = "failed_count"
+	// LogKeyRemovedCount stores the structured logger field name for the number of purged rows.
+	LogKeyRemovedCount = "removed_count"
+	// LogKeyCutoff stores the structured logger field name for the retention cutoff.
+	LogKeyCutoff = "cutoff_utc"
+	// LogKeyReplayedCount stores the structured logger field name for the number of replayed rows.
+	LogKeyReplayedCount = "replayed_count"
 
 	// EventIDRequired is the validation message returned when the event id is missing.
 	EventIDRequired = "event id is required"
@@ -82,6 +198,18 @@ const (
 	SourceRequired = "source is required"
 	// PayloadRequired is the validation message returned when the event payload is missing.
 	PayloadRequired = "payload is required"
+	// PayloadSchemaViolation is the validation message returned when the payload does not match its registered schema.
+	PayloadSchemaViolation = "payload does not match event schema"
+	// EventNotFound is returned when no outbox event matches the requested id.
+	EventNotFound = "outbox event not found"
+	// DeadLetterSelectorRequired is returned when a replay request does not narrow the dead-letter set.
+	DeadLetterSelectorRequired = "replay requires event ids, an aggregate, or a time range"
+	// PublishResultMissing is recorded when a publisher returns fewer results than events it was given.
+	PublishResultMissing = "publisher returned no result for event"
+	// DeadLetterTimeRangeInvalid is returned when the requested time range ends before it starts.
+	DeadLetterTimeRangeInvalid = "dead-letter time range end must be after its start"
+	// LeaseAttemptsExhausted is recorded when an event used up its attempts on publishers that lost its lease.
+	LeaseAttemptsExhausted = "publish attempts exhausted by expired leases"
 )
 
 var (
@@ -99,4 +227,16 @@ var (
 	ErrSourceRequired = errors.New(SourceRequired)
 	// ErrPayloadRequired indicates that the outbox event is missing its payload.
 	ErrPayloadRequired = errors.New(PayloadRequired)
+	// ErrPayloadSchemaViolation indicates that the payload does not match the schema of its event type and version.
+	ErrPayloadSchemaViolation = errors.New(PayloadSchemaViolation)
+	// ErrEventNotFound indicates that no outbox event matches the requested id.
+	ErrEventNotFound = errors.New(EventNotFound)
+	// ErrDeadLetterSelectorRequired indicates that a replay request would match every dead-lettered row.
+	ErrDeadLetterSelectorRequired = errors.New(DeadLetterSelectorRequired)
+	// ErrPublishResultMissing indicates that a batch publisher did not report the outcome of an event.
+	ErrPublishResultMissing = errors.New(PublishResultMissing)
+	// ErrDeadLetterTimeRangeInvalid indicates that the requested dead-letter time range is inverted.
+	ErrDeadLetterTimeRangeInvalid = errors.New(DeadLetterTimeRangeInvalid)
+	// ErrLeaseAttemptsExhausted indicates that an event was reclaimed after expired leases until no attempt was left.
+	ErrLeaseAttemptsExhausted = errors.New(LeaseAttemptsExhausted)
 )
@@ -10,16 +10,20 @@ import (
 )
 
 // Service provides durable enqueue operations for canonical outbox events.
+// A nil schema registry skips payload schema validation.
 type Service struct {
 	repository output.EventRepository
+	schemas    output.SchemaRegistry
 	logger     logger.ContextLogger
 	now        func() time.Time
 }
 
 // NewService creates a new outbox service implementation.
-func NewService(repository output.EventRepository, log logger.ContextLogger) input.Service {
+// When schemas is set, Enqueue rejects payloads that do not match the schema of their event type and version.
+func NewService(repository output.EventRepository, schemas output.SchemaRegistry, log logger.ContextLogger) input.Service {
 	return &Service{
 		repository: repository,
+		schemas:    schemas,
 		logger:     log,
 		now: func() time.Time {
 			return time.Now().UTC()
@@ -40,6 +44,7 @@ func (s *Service) WithDB(database dbport.DB) input.Service {
 	if repository, ok := s.repository.(eventRepositoryWithDB); ok {
 		return &Service{
 			repository: repository.WithDB(database),
+			schemas:    s.schemas,
 			logger:     s.logger,
 			now:        s.now,
 		}
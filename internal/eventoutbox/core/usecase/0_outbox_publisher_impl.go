@@ -1,35 +1,123 @@
 package usecase
 
 import (
+	"fmt"
+	"os"
 	"time"
 
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
 	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
 )
 
+// RetryPolicy controls how failed publish attempts are delayed and when an event is dead-lettered.
+type RetryPolicy struct {
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	MaxAttempts int
+}
+
+// DefaultRetryPolicy returns the retry policy used when configuration leaves values unset.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		BaseBackoff: DefaultPublishBackoff,
+		MaxBackoff:  DefaultMaxPublishBackoff,
+		MaxAttempts: DefaultMaxPublishAttempts,
+	}
+}
+
+// DefaultLeaseOwner identifies this process as a lease owner, so concurrent publishers never share a lease.
+func DefaultLeaseOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = DefaultSource
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
+// PublisherConfig groups the runtime knobs of the publisher loop. Zero values fall back to package defaults.
+// A nil MeterProvider uses the global OpenTelemetry provider; a nil Schemas publishes payloads as stored.
+type PublisherConfig struct {
+	MeterProvider metric.MeterProvider
+	Schemas       output.SchemaRegistry
+	Lease         domain.Lease
+	Ordering      domain.Ordering
+	Retry         RetryPolicy
+}
+
 // PublisherService publishes pending outbox rows to the event backbone.
 type PublisherService struct {
-	repository output.EventRepository
-	publisher  output.EventPublisher
-	logger     logger.ContextLogger
-	now        func() time.Time
-	backoff    time.Duration
+	repository  output.EventRepository
+	publisher   output.EventPublisher
+	schemas     output.SchemaRegistry
+	logger      logger.ContextLogger
+	metrics     *publisherMetrics
+	now         func() time.Time
+	jitter      func(time.Duration) time.Duration
+	lease       domain.Lease
+	ordering    domain.Ordering
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	maxAttempts int
 }
 
 // NewPublisherService creates a new background outbox publisher service.
+// Rows are claimed under lease; an empty owner defaults to DefaultLeaseOwner.
 func NewPublisherService(
 	repository output.EventRepository,
 	publisher output.EventPublisher,
 	log logger.ContextLogger,
+	cfg PublisherConfig,
 ) input.PublisherService {
+	policy, lease := cfg.Retry, cfg.Lease
+	defaults := DefaultRetryPolicy()
+	if policy.BaseBackoff <= 0 {
+		policy.BaseBackoff = defaults.BaseBackoff
+	}
+	if policy.MaxBackoff < policy.BaseBackoff {
+		policy.MaxBackoff = max(defaults.MaxBackoff, policy.BaseBackoff)
+	}
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaults.MaxAttempts
+	}
+	if lease.Owner == "" {
+		lease.Owner = DefaultLeaseOwner()
+	}
+	if lease.Duration <= 0 {
+		lease.Duration = DefaultLeaseDuration
+	}
+	if !cfg.Ordering.Valid() {
+		cfg.Ordering = DefaultOrdering
+	}
+	if cfg.MeterProvider == nil {
+		cfg.MeterProvider = otel.GetMeterProvider()
+	}
+
+	now := func() time.Time {
+		return time.Now().UTC()
+	}
+
+	metrics, err := newPublisherMetrics(cfg.MeterProvider, repository, now)
+	if err != nil {
+		log.Warnw(LogPublisherMetricsUnavailable, commonkeys.Error, err.Error())
+	}
+
 	return &PublisherService{
-		repository: repository,
-		publisher:  publisher,
-		logger:     log,
-		now: func() time.Time {
-			return time.Now().UTC()
-		},
-		backoff: DefaultPublishBackoff,
+		repository:  repository,
+		publisher:   publisher,
+		schemas:     cfg.Schemas,
+		logger:      log,
+		metrics:     metrics,
+		now:         now,
+		jitter:      equalJitter,
+		lease:       lease,
+		ordering:    cfg.Ordering,
+		backoff:     policy.BaseBackoff,
+		maxBackoff:  policy.MaxBackoff,
+		maxAttempts: policy.MaxAttempts,
 	}
 }
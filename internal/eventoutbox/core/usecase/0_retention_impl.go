@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// RetentionConfig controls how long published rows are kept and how they are purged.
+// Zero values fall back to package defaults; a nil MeterProvider uses the global OpenTelemetry provider.
+type RetentionConfig struct {
+	MeterProvider metric.MeterProvider
+	Period        time.Duration
+	BatchSize     int
+	MaxBatches    int
+}
+
+// RetentionService deletes published outbox rows past their retention period,
+// optionally exporting them to archive storage first.
+type RetentionService struct {
+	repository  output.EventRepository
+	archive     output.ArchiveStorage
+	logger      logger.ContextLogger
+	rowsRemoved metric.Int64Counter
+	now         func() time.Time
+	period      time.Duration
+	batchSize   int
+	maxBatches  int
+}
+
+// NewRetentionService creates the retention job use case. A nil archive deletes rows without exporting them.
+func NewRetentionService(
+	repository output.EventRepository,
+	archive output.ArchiveStorage,
+	log logger.ContextLogger,
+	cfg RetentionConfig,
+) input.RetentionService {
+	if cfg.Period <= 0 {
+		cfg.Period = DefaultRetentionPeriod
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultRetentionBatchSize
+	}
+	if cfg.MaxBatches <= 0 {
+		cfg.MaxBatches = DefaultRetentionMaxBatches
+	}
+	if cfg.MeterProvider == nil {
+		cfg.MeterProvider = otel.GetMeterProvider()
+	}
+
+	rowsRemoved, err := cfg.MeterProvider.Meter(MeterName).Int64Counter(MetricRetentionRowsRemoved,
+		metric.WithDescription("Published outbox rows deleted by the retention job."),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		log.Warnw(LogRetentionMetricsUnavailable, commonkeys.Error, err.Error())
+	}
+
+	return &RetentionService{
+		repository:  repository,
+		archive:     archive,
+		logger:      log,
+		rowsRemoved: rowsRemoved,
+		now: func() time.Time {
+			return time.Now().UTC()
+		},
+		period:     cfg.Period,
+		batchSize:  cfg.BatchSize,
+		maxBatches: cfg.MaxBatches,
+	}
+}
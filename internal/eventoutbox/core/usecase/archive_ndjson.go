@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// archivedEvent is one NDJSON line of an outbox archive file.
+// The payload is embedded as JSON rather than base64 so archives stay greppable.
+type archivedEvent struct {
+	PublishedAtUTC    *time.Time      `json:"published_at_utc,omitempty"`
+	EventID           string          `json:"event_id"`
+	AggregateType     string          `json:"aggregate_type"`
+	AggregateID       string          `json:"aggregate_id"`
+	EventType         string          `json:"event_type"`
+	EventVersion      string          `json:"event_version"`
+	Source            string          `json:"source"`
+	TraceID           string          `json:"trace_id,omitempty"`
+	RequestID         string          `json:"request_id,omitempty"`
+	CreatedAtUTC      time.Time       `json:"created_at_utc"`
+	Payload           json.RawMessage `json:"payload"`
+	AggregateSequence int64           `json:"aggregate_sequence"`
+	AttemptCount      int             `json:"attempt_count"`
+}
+
+// archiveBatch writes one purge batch as an NDJSON file before the rows are deleted.
+func (s *RetentionService) archiveBatch(ctx context.Context, events []domain.Event) error {
+	trace.SpanFromContext(ctx).AddEvent(EventArchiveWrite)
+
+	body, err := encodeArchive(events)
+	if err != nil {
+		return err
+	}
+
+	return s.archive.PutArchive(ctx, output.ArchiveObject{
+		ObjectKey:   archiveObjectKey(s.now(), events),
+		ContentType: ArchiveContentType,
+		Body:        body,
+	})
+}
+
+func encodeArchive(events []domain.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, event := range events {
+		line := archivedEvent{
+			PublishedAtUTC:    event.PublishedAtUTC,
+			EventID:           event.EventID,
+			AggregateType:     event.AggregateType,
+			AggregateID:       event.AggregateID,
+			EventType:         event.EventType,
+			EventVersion:      event.EventVersion,
+			Source:            event.Source,
+			TraceID:           event.TraceID,
+			RequestID:         event.RequestID,
+			CreatedAtUTC:      event.CreatedAt.UTC(),
+			Payload:           json.RawMessage(event.PayloadJSON),
+			AggregateSequence: event.AggregateSequence,
+			AttemptCount:      event.AttemptCount,
+		}
+		if len(line.Payload) == 0 {
+			line.Payload = json.RawMessage("null")
+		}
+		if err := encoder.Encode(line); err != nil {
+			return nil, fmt.Errorf("encode archived outbox event %s: %w", event.EventID, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// archiveObjectKey partitions archives by purge day; the first event id keeps keys unique across replicas.
+func archiveObjectKey(now time.Time, events []domain.Event) string {
+	return fmt.Sprintf("%s/%s/%s-%s.ndjson",
+		ArchiveKeyPrefix,
+		now.UTC().Format("2006/01/02"),
+		now.UTC().Format("20060102T150405Z"),
+		events[0].EventID,
+	)
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// ListDeadLettered returns dead-lettered outbox events matching the filter.
+func (s *DeadLetterService) ListDeadLettered(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.Event, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanListDeadLettered)
+	defer span.End()
+
+	filter, err := normalizeDeadLetterFilter(filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("limit", filter.Limit))
+
+	events, err := s.repository.ListDeadLettered(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("rows", len(events)))
+	span.SetStatus(codes.Ok, EventSuccess)
+	return events, nil
+}
+
+// GetEvent returns one outbox event by id, including its attempts and last error.
+func (s *DeadLetterService) GetEvent(ctx context.Context, eventID string) (domain.Event, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanGetEvent)
+	defer span.End()
+
+	eventID = strings.TrimSpace(eventID)
+	span.SetAttributes(attribute.String(LogKeyEventID, eventID))
+	if eventID == "" {
+		span.SetStatus(codes.Error, EventIDRequired)
+		return domain.Event{}, ErrEventIDRequired
+	}
+
+	event, err := s.repository.GetByEventID(ctx, eventID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.Event{}, err
+	}
+	if event.EventID == "" {
+		span.SetStatus(codes.Error, EventNotFound)
+		return domain.Event{}, ErrEventNotFound
+	}
+
+	span.SetStatus(codes.Ok, EventSuccess)
+	return event, nil
+}
+
+// ReplayDeadLettered returns matching dead-lettered events to pending so the publisher retries them
+// with a fresh attempt budget. The filter must select events by id, aggregate, or time range.
+func (s *DeadLetterService) ReplayDeadLettered(ctx context.Context, filter domain.DeadLetterFilter) (int64, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanReplayDeadLettered)
+	defer span.End()
+
+	if !filter.HasSelector() {
+		span.SetStatus(codes.Error, DeadLetterSelectorRequired)
+		return 0, ErrDeadLetterSelectorRequired
+	}
+
+	filter, err := normalizeDeadLetterFilter(filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, err
+	}
+
+	span.AddEvent(EventRepositoryReplay)
+	replayed, err := s.repository.ReplayDeadLettered(ctx, filter, s.now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, LogFailedToReplayDeadLettered)
+		s.logger.ErrorwCtx(ctx, LogFailedToReplayDeadLettered, commonkeys.Error, err.Error())
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int64(LogKeyReplayedCount, replayed))
+	span.SetStatus(codes.Ok, LogDeadLetteredEventsReplayed)
+	s.logger.InfowCtx(ctx, LogDeadLetteredEventsReplayed,
+		LogKeyReplayedCount, replayed,
+		LogKeyAggregateType, filter.AggregateType,
+		LogKeyAggregateID, filter.AggregateID,
+		"event_ids", filter.EventIDs,
+	)
+	return replayed, nil
+}
+
+func normalizeDeadLetterFilter(filter domain.DeadLetterFilter) (domain.DeadLetterFilter, error) {
+	filter.AggregateType = strings.TrimSpace(filter.AggregateType)
+	filter.AggregateID = strings.TrimSpace(filter.AggregateID)
+
+	eventIDs := make([]string, 0, len(filter.EventIDs))
+	for _, eventID := range filter.EventIDs {
+		if eventID = strings.TrimSpace(eventID); eventID != "" {
+			eventIDs = append(eventIDs, eventID)
+		}
+	}
+	filter.EventIDs = eventIDs
+
+	if filter.CreatedFromUTC != nil && filter.CreatedToUTC != nil && !filter.CreatedToUTC.After(*filter.CreatedFromUTC) {
+		return domain.DeadLetterFilter{}, ErrDeadLetterTimeRangeInvalid
+	}
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = DefaultDeadLetterListLimit
+	case filter.Limit > MaxDeadLetterListLimit:
+		filter.Limit = MaxDeadLetterListLimit
+	}
+	return filter, nil
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+)
+
+func newDeadLetterService(repo *stubEventRepository) *DeadLetterService {
+	return &DeadLetterService{repository: repo, logger: noopLogger{}, now: time.Now}
+}
+
+func TestListDeadLetteredNormalizesFilter(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubEventRepository{events: []domain.Event{{EventID: "evt-1", Status: DeadLetteredEventStatus}}}
+	service := newDeadLetterService(repo)
+
+	events, err := service.ListDeadLettered(t.Context(), domain.DeadLetterFilter{
+		AggregateType: " record ",
+		EventIDs:      []string{" evt-1 ", ""},
+		Limit:         MaxDeadLetterListLimit + 1,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected one event, got %d", len(events))
+	}
+
+	filter := repo.listFilters[0]
+	if filter.AggregateType != "record" || len(filter.EventIDs) != 1 || filter.EventIDs[0] != "evt-1" {
+		t.Fatalf("expected trimmed filter, got %#v", filter)
+	}
+	if filter.Limit != MaxDeadLetterListLimit {
+		t.Fatalf("expected capped limit, got %d", filter.Limit)
+	}
+}
+
+func TestListDeadLetteredRejectsInvertedRange(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, time.March, 14, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	service := newDeadLetterService(&stubEventRepository{})
+
+	_, err := service.ListDeadLettered(t.Context(), domain.DeadLetterFilter{CreatedFromUTC: &from, CreatedToUTC: &to})
+	if !errors.Is(err, ErrDeadLetterTimeRangeInvalid) {
+		t.Fatalf("expected ErrDeadLetterTimeRangeInvalid, got %v", err)
+	}
+}
+
+func TestGetEventReturnsNotFound(t *testing.T) {
+	t.Parallel()
+
+	service := newDeadLetterService(&stubEventRepository{})
+
+	if _, err := service.GetEvent(t.Context(), "evt-missing"); !errors.Is(err, ErrEventNotFound) {
+		t.Fatalf("expected ErrEventNotFound, got %v", err)
+	}
+	if _, err := service.GetEvent(t.Context(), " "); !errors.Is(err, ErrEventIDRequired) {
+		t.Fatalf("expected ErrEventIDRequired, got %v", err)
+	}
+}
+
+func TestReplayDeadLetteredRequiresSelector(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubEventRepository{}
+	service := newDeadLetterService(repo)
+
+	if _, err := service.ReplayDeadLettered(t.Context(), domain.DeadLetterFilter{}); !errors.Is(err, ErrDeadLetterSelectorRequired) {
+		t.Fatalf("expected ErrDeadLetterSelectorRequired, got %v", err)
+	}
+	if len(repo.replayFilters) != 0 {
+		t.Fatalf("expected no replay calls, got %d", len(repo.replayFilters))
+	}
+}
+
+func TestReplayDeadLetteredByAggregate(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubEventRepository{replayed: 3}
+	service := newDeadLetterService(repo)
+
+	replayed, err := service.ReplayDeadLettered(t.Context(), domain.DeadLetterFilter{AggregateType: "record", AggregateID: "42"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if replayed != 3 {
+		t.Fatalf("expected three replayed events, got %d", replayed)
+	}
+	if len(repo.replayFilters) != 1 || repo.replayFilters[0].AggregateID != "42" {
+		t.Fatalf("expected replay filtered by aggregate, got %#v", repo.replayFilters)
+	}
+}
+
+func TestReplayDeadLetteredPropagatesRepositoryError(t *testing.T) {
+	t.Parallel()
+
+	service := newDeadLetterService(&stubEventRepository{replayErr: errors.New("db down")})
+
+	if _, err := service.ReplayDeadLettered(t.Context(), domain.DeadLetterFilter{EventIDs: []string{"evt-1"}}); err == nil {
+		t.Fatal("expected error")
+	}
+}
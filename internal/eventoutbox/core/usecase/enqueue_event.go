@@ -2,10 +2,12 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
 	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -45,6 +47,23 @@ func (s *Service) Enqueue(ctx context.Context, event domain.Event) error {
 		return err
 	}
 
+	if s.schemas != nil {
+		span.AddEvent(EventValidateSchema)
+		if err := s.schemas.Validate(event.EventType, event.EventVersion, event.PayloadJSON); err != nil {
+			err = fmt.Errorf("%w: %w", ErrPayloadSchemaViolation, err)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, PayloadSchemaViolation)
+			s.logger.ErrorwCtx(ctx, LogRejectedEventPayload,
+				"error", err.Error(),
+				LogKeyEventID, event.EventID,
+				LogKeyEventType, event.EventType,
+				LogKeyEventVersion, event.EventVersion,
+				LogKeyAggregateType, event.AggregateType,
+			)
+			return err
+		}
+	}
+
 	span.AddEvent(EventRepositorySave)
 	if err := s.repository.Save(ctx, event); err != nil {
 		span.RecordError(err)
@@ -59,6 +78,19 @@ func (s *Service) Enqueue(ctx context.Context, event domain.Event) error {
 		return err
 	}
 
+	// The notification is transactional: publishers wake only after the enqueueing transaction commits.
+	span.AddEvent(EventRepositoryNotify)
+	if err := s.repository.NotifyPending(ctx, event.AggregateType); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, LogFailedToEnqueueEvent)
+		s.logger.ErrorwCtx(ctx, LogFailedToEnqueueEvent,
+			"error", err.Error(),
+			LogKeyEventID, event.EventID,
+			LogKeyAggregateType, event.AggregateType,
+		)
+		return err
+	}
+
 	span.AddEvent(EventSuccess)
 	span.SetStatus(codes.Ok, StatusEventQueued)
 	s.logger.InfowCtx(ctx, LogOutboxEventQueued,
@@ -92,6 +124,9 @@ func (s *Service) normalizeEvent(ctx context.Context, event domain.Event) domain
 	if strings.TrimSpace(event.TraceID) == "" {
 		event.TraceID = traceIDFromContext(ctx)
 	}
+	if strings.TrimSpace(event.TraceParent) == "" {
+		event.TraceParent, event.TraceState = tracecontext.Inject(ctx)
+	}
 	if strings.TrimSpace(event.RequestID) == "" {
 		if requestID, ok := ctx.Value(ctxkeys.RequestID).(string); ok {
 			event.RequestID = requestID
@@ -3,15 +3,21 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// PublishPending publishes one batch of pending outbox rows.
-func (s *PublisherService) PublishPending(ctx context.Context, limit int) error {
+// PublishPending claims one batch of pending outbox rows, publishes it in a single transport write,
+// marks the accepted rows as published in one statement, and retries or dead-letters the rest.
+// It returns how many rows the cycle claimed, including the ones that failed to publish.
+func (s *PublisherService) PublishPending(ctx context.Context, limit int) (int, error) {
 	if limit <= 0 {
 		limit = 1
 	}
@@ -20,57 +26,224 @@ func (s *PublisherService) PublishPending(ctx context.Context, limit int) error
 	ctx, span := tr.Start(ctx, SpanPublishPending)
 	defer span.End()
 
-	span.SetAttributes(attribute.Int("batch_limit", limit))
-	s.logger.InfowCtx(ctx, LogPublishPendingEvents, "limit", limit)
+	span.SetAttributes(
+		attribute.Int("batch_limit", limit),
+		attribute.String(LogKeyLeaseOwner, s.lease.Owner),
+		attribute.String(LogKeyOrdering, string(s.ordering)),
+	)
+	s.logger.InfowCtx(ctx, LogPublishPendingEvents, "limit", limit, LogKeyLeaseOwner, s.lease.Owner)
 
-	events, err := s.repository.ListPending(ctx, limit)
+	events, err := s.repository.ListPending(ctx, s.lease, s.ordering, limit)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, EventRepositoryList)
-		return err
+		return 0, err
+	}
+
+	claimed := len(events)
+	s.metrics.recordBatchSize(ctx, claimed)
+	if claimed == 0 {
+		span.SetStatus(codes.Ok, EventSuccess)
+		return 0, nil
+	}
+	linkOriginSpans(span, events)
+
+	events, settleErr := s.deadLetterExhaustedEvents(ctx, events)
+	if len(events) == 0 {
+		if settleErr != nil {
+			span.RecordError(settleErr)
+			span.SetStatus(codes.Error, EventRepositoryDeadLetter)
+		}
+		return claimed, settleErr
 	}
 
-	var publishErr error
+	events, publishErr := s.upcastEvents(ctx, events)
+	publishErr = errors.Join(settleErr, publishErr)
+	if len(events) == 0 {
+		span.RecordError(publishErr)
+		span.SetStatus(codes.Error, EventUpcastPayload)
+		return claimed, publishErr
+	}
+
+	started := time.Now()
+	results := s.publisher.PublishBatch(ctx, events)
+	elapsed := time.Since(started)
+
+	publishedIDs := make([]string, 0, len(events))
+	for i, event := range events {
+		err := ErrPublishResultMissing
+		if i < len(results) {
+			err = results[i].Err
+		}
+
+		if err == nil {
+			publishedIDs = append(publishedIDs, event.EventID)
+			continue
+		}
+
+		s.logger.ErrorwCtx(ctx, LogOutboxEventPublishFailed,
+			commonkeys.Error, err.Error(),
+			LogKeyEventID, event.EventID,
+			LogKeyEventType, event.EventType,
+			LogKeyAggregateType, event.AggregateType,
+			LogKeyAggregateID, event.AggregateID,
+		)
+
+		if retryErr := s.handlePublishFailure(ctx, event, err); retryErr != nil {
+			publishErr = errors.Join(publishErr, err, retryErr)
+			continue
+		}
+
+		publishErr = errors.Join(publishErr, err)
+	}
+
+	failed := len(events) - len(publishedIDs)
+	s.metrics.recordPublish(ctx, elapsed, len(events), failed)
+	span.SetAttributes(
+		attribute.Int(LogKeyPublishedCount, len(publishedIDs)),
+		attribute.Int(LogKeyFailedCount, failed),
+	)
+
+	if len(publishedIDs) > 0 {
+		span.AddEvent(EventRepositoryPublish)
+		err := s.repository.MarkPublishedBatch(ctx, s.lease.Owner, publishedIDs, s.now())
+		if errors.Is(err, domain.ErrLeaseLost) {
+			s.logLeaseLost(ctx, err)
+		} else if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, EventRepositoryPublish)
+			return claimed, errors.Join(publishErr, err)
+		}
+
+		s.logger.InfowCtx(ctx, LogOutboxEventPublished,
+			LogKeyPublishedCount, len(publishedIDs),
+			LogKeyFailedCount, failed,
+		)
+	}
+
+	if publishErr != nil {
+		span.RecordError(publishErr)
+		span.SetStatus(codes.Error, EventPublish)
+		return claimed, publishErr
+	}
+
+	span.SetStatus(codes.Ok, EventSuccess)
+	return claimed, nil
+}
+
+// deadLetterExhaustedEvents parks the claimed events that have no attempt left before publishing
+// them. Only reclaims get here: the claim counts a lease that expired as a failed attempt, so an event
+// whose publisher keeps crashing or stalling ends in the dead-letter state instead of cycling forever.
+func (s *PublisherService) deadLetterExhaustedEvents(ctx context.Context, events []domain.Event) ([]domain.Event, error) {
+	if s.maxAttempts <= 0 {
+		return events, nil
+	}
+
+	var settleErr error
+	ready := make([]domain.Event, 0, len(events))
+	for _, event := range events {
+		if event.AttemptCount < s.maxAttempts {
+			ready = append(ready, event)
+			continue
+		}
+		settleErr = errors.Join(settleErr, s.handlePublishFailure(ctx, event, ErrLeaseAttemptsExhausted))
+	}
+	return ready, settleErr
+}
+
+// upcastEvents rewrites rows stored at an older event version into the current version, so replayed
+// and long-retried rows reach consumers in the shape they expect. A row that cannot be upcast is
+// retried like any failed publish and left out of the batch.
+func (s *PublisherService) upcastEvents(ctx context.Context, events []domain.Event) ([]domain.Event, error) {
+	if s.schemas == nil {
+		return events, nil
+	}
+
+	var upcastErr error
+	ready := make([]domain.Event, 0, len(events))
 	for _, event := range events {
-		if err := s.publisher.Publish(ctx, event); err != nil {
-			s.logger.ErrorwCtx(ctx, LogOutboxEventPublishFailed,
+		version, payload, err := s.schemas.Upcast(event.EventType, event.EventVersion, event.PayloadJSON)
+		if err != nil {
+			s.logger.ErrorwCtx(ctx, LogFailedToUpcastEvent,
 				commonkeys.Error, err.Error(),
 				LogKeyEventID, event.EventID,
 				LogKeyEventType, event.EventType,
-				LogKeyAggregateType, event.AggregateType,
-				LogKeyAggregateID, event.AggregateID,
+				LogKeyEventVersion, event.EventVersion,
 			)
+			upcastErr = errors.Join(upcastErr, err, s.handlePublishFailure(ctx, event, err))
+			continue
+		}
 
-			if rescheduleErr := s.repository.Reschedule(ctx, event.EventID, s.now().Add(s.backoff), err.Error()); rescheduleErr != nil {
-				publishErr = errors.Join(publishErr, err, rescheduleErr)
-				continue
-			}
+		event.EventVersion, event.PayloadJSON = version, payload
+		ready = append(ready, event)
+	}
+	return ready, upcastErr
+}
 
-			publishErr = errors.Join(publishErr, err)
+// linkOriginSpans links the publish cycle to the span that enqueued each event. The cycle runs in
+// its own trace, so the links are what connects it to the writes that waited in the outbox.
+func linkOriginSpans(span trace.Span, events []domain.Event) {
+	for _, event := range events {
+		origin := tracecontext.SpanContext(event.TraceParent, event.TraceState)
+		if !origin.IsValid() {
 			continue
 		}
+		span.AddLink(trace.Link{
+			SpanContext: origin,
+			Attributes:  []attribute.KeyValue{attribute.String(LogKeyEventID, event.EventID)},
+		})
+	}
+}
 
-		publishedAt := s.now()
-		if err := s.repository.MarkPublished(ctx, event.EventID, publishedAt); err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, EventRepositoryPublish)
+// handlePublishFailure reschedules a failed event with exponential backoff or dead-letters it once
+// its attempt budget is exhausted.
+func (s *PublisherService) handlePublishFailure(ctx context.Context, event domain.Event, publishErr error) error {
+	if s.exhaustedAttempts(event) {
+		trace.SpanFromContext(ctx).AddEvent(EventRepositoryDeadLetter)
+		err := s.repository.MarkDeadLettered(ctx, s.lease.Owner, event.EventID, publishErr.Error())
+		if errors.Is(err, domain.ErrLeaseLost) {
+			s.logLeaseLost(ctx, err)
+			return nil
+		}
+		if err != nil {
 			return err
 		}
 
-		s.logger.InfowCtx(ctx, LogOutboxEventPublished,
+		s.logger.WarnwCtx(ctx, LogOutboxEventDeadLettered,
+			commonkeys.Error, publishErr.Error(),
 			LogKeyEventID, event.EventID,
 			LogKeyEventType, event.EventType,
 			LogKeyAggregateType, event.AggregateType,
 			LogKeyAggregateID, event.AggregateID,
+			LogKeyAttemptCount, event.AttemptCount+1,
 		)
+		return nil
 	}
 
-	if publishErr != nil {
-		span.RecordError(publishErr)
-		span.SetStatus(codes.Error, EventPublish)
-		return publishErr
+	nextAvailableAt := s.now().Add(s.nextBackoff(event.AttemptCount))
+	err := s.repository.Reschedule(ctx, s.lease.Owner, event.EventID, nextAvailableAt, publishErr.Error())
+	if errors.Is(err, domain.ErrLeaseLost) {
+		s.logLeaseLost(ctx, err)
+		return nil
+	}
+	if err != nil {
+		return err
 	}
 
-	span.SetStatus(codes.Ok, EventSuccess)
+	s.logger.InfowCtx(ctx, StatusEventRescheduled,
+		LogKeyEventID, event.EventID,
+		LogKeyAttemptCount, event.AttemptCount+1,
+		LogKeyNextAvailableAt, nextAvailableAt,
+	)
 	return nil
 }
+
+// logLeaseLost records that rows this publisher held were reclaimed by another publisher after the
+// lease expired. The new owner settles them, so a lost lease is not a publish failure.
+func (s *PublisherService) logLeaseLost(ctx context.Context, err error) {
+	trace.SpanFromContext(ctx).AddEvent(EventLeaseLost)
+	s.logger.WarnwCtx(ctx, LogOutboxLeaseLost,
+		commonkeys.Error, err.Error(),
+		LogKeyLeaseOwner, s.lease.Owner,
+	)
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestPublishPendingSettlesPartialBatchFailures(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 14, 10, 0, 0, 0, time.UTC)
+	repo := &stubEventRepository{
+		events: []domain.Event{
+			{EventID: "evt-1", AggregateType: "record", AggregateID: "1"},
+			{EventID: "evt-2", AggregateType: "record", AggregateID: "2"},
+			{EventID: "evt-3", AggregateType: "record", AggregateID: "3"},
+		},
+	}
+	publisher := &stubEventPublisher{
+		publishErrByEventID: map[string]error{"evt-2": errors.New("leader not available")},
+	}
+	service := &PublisherService{
+		repository:  repo,
+		publisher:   publisher,
+		logger:      noopLogger{},
+		now:         func() time.Time { return now },
+		jitter:      func(d time.Duration) time.Duration { return d },
+		backoff:     DefaultPublishBackoff,
+		maxBackoff:  DefaultMaxPublishBackoff,
+		maxAttempts: DefaultMaxPublishAttempts,
+	}
+
+	if _, err := service.PublishPending(t.Context(), 10); err == nil {
+		t.Fatal("expected the partial failure to be reported")
+	}
+
+	if len(publisher.batchSizes) != 1 || publisher.batchSizes[0] != 3 {
+		t.Fatalf("expected one batch write of 3 events, got %v", publisher.batchSizes)
+	}
+	if len(repo.markBatchSizes) != 1 || repo.markBatchSizes[0] != 2 {
+		t.Fatalf("expected one mark-published statement for 2 events, got %v", repo.markBatchSizes)
+	}
+	if repo.markPublishedCalls[0].eventID != "evt-1" || repo.markPublishedCalls[1].eventID != "evt-3" {
+		t.Fatalf("unexpected published events: %#v", repo.markPublishedCalls)
+	}
+	if len(repo.rescheduleCalls) != 1 || repo.rescheduleCalls[0].eventID != "evt-2" {
+		t.Fatalf("expected evt-2 to be rescheduled, got %#v", repo.rescheduleCalls)
+	}
+}
+
+func TestPublishPendingSkipsWriteForEmptyBatch(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubEventRepository{}
+	publisher := &stubEventPublisher{}
+	service := &PublisherService{
+		repository: repo,
+		publisher:  publisher,
+		logger:     noopLogger{},
+		now:        time.Now,
+	}
+
+	if _, err := service.PublishPending(t.Context(), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(publisher.batchSizes) != 0 || len(repo.markBatchSizes) != 0 {
+		t.Fatalf("expected no writes, got publish=%v mark=%v", publisher.batchSizes, repo.markBatchSizes)
+	}
+}
+
+func TestPublisherServiceRecordsMetrics(t *testing.T) {
+	t.Parallel()
+
+	oldest := time.Now().UTC().Add(-90 * time.Second)
+	repo := &stubEventRepository{
+		events: []domain.Event{
+			{EventID: "evt-1", AggregateType: "record", AggregateID: "1"},
+			{EventID: "evt-2", AggregateType: "record", AggregateID: "2"},
+		},
+		stats: domain.Stats{PendingCount: 42, OldestPendingAtUTC: &oldest},
+	}
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = provider.Shutdown(t.Context()) })
+
+	service := NewPublisherService(repo, &stubEventPublisher{}, noopLogger{}, PublisherConfig{MeterProvider: provider})
+	if _, err := service.PublishPending(t.Context(), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var collected metricdata.ResourceMetrics
+	if err := reader.Collect(t.Context(), &collected); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+	metrics := map[string]metricdata.Aggregation{}
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	batchSize, ok := metrics[MetricPublishBatchSize].(metricdata.Histogram[int64])
+	if !ok || len(batchSize.DataPoints) != 1 || batchSize.DataPoints[0].Sum != 2 {
+		t.Fatalf("expected batch size histogram with sum 2, got %#v", metrics[MetricPublishBatchSize])
+	}
+
+	duration, ok := metrics[MetricPublishDuration].(metricdata.Histogram[float64])
+	if !ok || len(duration.DataPoints) != 1 || duration.DataPoints[0].Count != 1 {
+		t.Fatalf("expected one publish duration sample, got %#v", metrics[MetricPublishDuration])
+	}
+	if outcome, _ := duration.DataPoints[0].Attributes.Value(MetricAttrOutcome); outcome.AsString() != PublishOutcomeOK {
+		t.Fatalf("expected outcome %q, got %q", PublishOutcomeOK, outcome.AsString())
+	}
+
+	backlog, ok := metrics[MetricPendingBacklog].(metricdata.Gauge[int64])
+	if !ok || len(backlog.DataPoints) != 1 || backlog.DataPoints[0].Value != 42 {
+		t.Fatalf("expected pending backlog 42, got %#v", metrics[MetricPendingBacklog])
+	}
+
+	age, ok := metrics[MetricOldestPendingAge].(metricdata.Gauge[float64])
+	if !ok || len(age.DataPoints) != 1 || age.DataPoints[0].Value < 90 {
+		t.Fatalf("expected oldest pending age of at least 90s, got %#v", metrics[MetricOldestPendingAge])
+	}
+}
+
+func TestLinkOriginSpansLinksEventsWithTraceContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, span := tracer.Start(context.Background(), SpanPublishPending)
+	linkOriginSpans(span, []domain.Event{
+		{EventID: "evt-1", TraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		{EventID: "evt-2"},
+		{EventID: "evt-3", TraceParent: "garbage"},
+	})
+	span.End()
+
+	links := recorder.Ended()[0].Links()
+	if len(links) != 1 {
+		t.Fatalf("expected one link, got %d", len(links))
+	}
+	if links[0].SpanContext.TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Fatalf("unexpected linked trace %s", links[0].SpanContext.TraceID())
+	}
+	if links[0].Attributes[0].Value.AsString() != "evt-1" {
+		t.Fatalf("expected link to carry evt-1, got %v", links[0].Attributes)
+	}
+}
@@ -0,0 +1,461 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+)
+
+// leasingEventRepository mimics the claim semantics of the Postgres repository:
+// ListPending claims rows atomically, so concurrent callers never receive the same row under a live lease,
+// and with aggregate ordering only the earliest unpublished row of each aggregate is claimable.
+type leasingEventRepository struct {
+	stubEventRepository
+
+	mu   sync.Mutex
+	now  func() time.Time
+	rows []*domain.Event
+}
+
+func newLeasingEventRepository(now func() time.Time, count int) *leasingEventRepository {
+	repo := &leasingEventRepository{now: now}
+	for i := range count {
+		repo.rows = append(repo.rows, &domain.Event{
+			EventID:           fmt.Sprintf("evt-%03d", i),
+			AggregateType:     "record",
+			AggregateID:       fmt.Sprintf("%d", i),
+			AggregateSequence: 1,
+			Status:            DefaultEventStatus,
+			AvailableAtUTC:    now().Add(-time.Minute),
+		})
+	}
+	return repo
+}
+
+func (r *leasingEventRepository) ListPending(
+	_ context.Context,
+	lease domain.Lease,
+	ordering domain.Ordering,
+	limit int,
+) ([]domain.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	claimed := make([]domain.Event, 0, limit)
+	for _, row := range r.rows {
+		if len(claimed) == limit {
+			break
+		}
+		available := row.Status == DefaultEventStatus && !row.AvailableAtUTC.After(now)
+		expired := row.Status == "publishing" && row.LeaseExpiresAtUTC != nil && !row.LeaseExpiresAtUTC.After(now)
+		if !available && !expired {
+			continue
+		}
+		if ordering == domain.OrderingAggregate && r.hasUnpublishedPredecessor(row) {
+			continue
+		}
+
+		// Like the claim SQL, a reclaim counts the attempt whose lease expired.
+		if expired {
+			row.AttemptCount++
+		}
+		expiresAt := now.Add(lease.Duration)
+		row.Status = "publishing"
+		row.LeaseOwner = lease.Owner
+		row.LeaseExpiresAtUTC = &expiresAt
+		claimed = append(claimed, *row)
+	}
+	return claimed, nil
+}
+
+func (r *leasingEventRepository) hasUnpublishedPredecessor(row *domain.Event) bool {
+	for _, prior := range r.rows {
+		if prior.AggregateType == row.AggregateType &&
+			prior.AggregateID == row.AggregateID &&
+			prior.AggregateSequence < row.AggregateSequence &&
+			(prior.Status == DefaultEventStatus || prior.Status == "publishing") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *leasingEventRepository) Reschedule(
+	_ context.Context,
+	leaseOwner string,
+	eventID string,
+	nextAvailableAt time.Time,
+	lastError string,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row := r.find(eventID)
+	if row == nil {
+		return errors.New("unknown event")
+	}
+	if !heldBy(row, leaseOwner) {
+		return domain.ErrLeaseLost
+	}
+	row.Status = DefaultEventStatus
+	row.AttemptCount++
+	row.AvailableAtUTC = nextAvailableAt
+	row.LastError = lastError
+	row.LeaseOwner = ""
+	row.LeaseExpiresAtUTC = nil
+	return nil
+}
+
+func (r *leasingEventRepository) MarkPublishedBatch(_ context.Context, leaseOwner string, eventIDs []string, publishedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var lost bool
+	for _, eventID := range eventIDs {
+		row := r.find(eventID)
+		if row == nil {
+			return errors.New("unknown event")
+		}
+		if !heldBy(row, leaseOwner) {
+			lost = true
+			continue
+		}
+		row.Status = "published"
+		row.PublishedAtUTC = &publishedAt
+		row.LeaseOwner = ""
+		row.LeaseExpiresAtUTC = nil
+	}
+	if lost {
+		return domain.ErrLeaseLost
+	}
+	return nil
+}
+
+func (r *leasingEventRepository) MarkDeadLettered(_ context.Context, leaseOwner string, eventID string, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row := r.find(eventID)
+	if row == nil {
+		return errors.New("unknown event")
+	}
+	if !heldBy(row, leaseOwner) {
+		return domain.ErrLeaseLost
+	}
+	row.Status = "failed"
+	row.AttemptCount++
+	row.LastError = lastError
+	row.LeaseOwner = ""
+	row.LeaseExpiresAtUTC = nil
+	return nil
+}
+
+// heldBy mirrors the lease_owner and status predicate the repository adds to every settling UPDATE.
+func heldBy(row *domain.Event, leaseOwner string) bool {
+	return row.Status == "publishing" && row.LeaseOwner == leaseOwner
+}
+
+func (r *leasingEventRepository) find(eventID string) *domain.Event {
+	for _, row := range r.rows {
+		if row.EventID == eventID {
+			return row
+		}
+	}
+	return nil
+}
+
+func (r *leasingEventRepository) pendingCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+	for _, row := range r.rows {
+		if row.Status != "published" {
+			count++
+		}
+	}
+	return count
+}
+
+type countingEventPublisher struct {
+	failures map[string]error
+	counts   map[string]int
+	order    []string
+	mu       sync.Mutex
+}
+
+func (p *countingEventPublisher) Publish(_ context.Context, event domain.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.failures[event.EventID]; err != nil {
+		return err
+	}
+	p.counts[event.EventID]++
+	p.order = append(p.order, event.EventID)
+	return nil
+}
+
+func (p *countingEventPublisher) PublishBatch(ctx context.Context, events []domain.Event) []domain.PublishResult {
+	results := make([]domain.PublishResult, len(events))
+	for i, event := range events {
+		results[i] = domain.PublishResult{EventID: event.EventID, Err: p.Publish(ctx, event)}
+	}
+	return results
+}
+
+func TestPublishPendingConcurrentPublishersDoNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		eventCount     = 200
+		publisherCount = 4
+	)
+
+	repo := newLeasingEventRepository(time.Now, eventCount)
+	publisher := &countingEventPublisher{counts: map[string]int{}}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, publisherCount)
+	for i := range publisherCount {
+		service := &PublisherService{
+			repository: repo,
+			publisher:  publisher,
+			logger:     noopLogger{},
+			now:        time.Now,
+			lease:      domain.Lease{Owner: fmt.Sprintf("publisher-%d", i), Duration: time.Minute},
+			ordering:   domain.OrderingAggregate,
+			backoff:    DefaultPublishBackoff,
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for repo.pendingCount() > 0 {
+				if _, err := service.PublishPending(t.Context(), 7); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("expected no publish error, got %v", err)
+	}
+	if len(publisher.counts) != eventCount {
+		t.Fatalf("expected %d distinct published events, got %d", eventCount, len(publisher.counts))
+	}
+	for eventID, count := range publisher.counts {
+		if count != 1 {
+			t.Fatalf("expected %s to be published once, got %d", eventID, count)
+		}
+	}
+}
+
+func TestPublishPendingReclaimsExpiredLease(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 14, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	repo := newLeasingEventRepository(clock, 1)
+
+	// A crashed publisher claims the row and never reports back.
+	lease := domain.Lease{Owner: "crashed", Duration: 30 * time.Second}
+	claimed, err := repo.ListPending(t.Context(), lease, domain.OrderingNone, 10)
+	if err != nil || len(claimed) != 1 {
+		t.Fatalf("expected crashed publisher to claim one row, got %d (%v)", len(claimed), err)
+	}
+
+	publisher := &countingEventPublisher{counts: map[string]int{}}
+	service := &PublisherService{
+		repository: repo,
+		publisher:  publisher,
+		logger:     noopLogger{},
+		now:        clock,
+		lease:      domain.Lease{Owner: "survivor", Duration: 30 * time.Second},
+		backoff:    DefaultPublishBackoff,
+	}
+
+	if _, err := service.PublishPending(t.Context(), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(publisher.counts) != 0 {
+		t.Fatalf("expected live lease to be skipped, got %#v", publisher.counts)
+	}
+
+	now = now.Add(31 * time.Second)
+	if _, err := service.PublishPending(t.Context(), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if publisher.counts["evt-000"] != 1 {
+		t.Fatalf("expected expired lease to be reclaimed and published once, got %#v", publisher.counts)
+	}
+}
+
+func TestPublishPendingDeadLettersEventWhoseLeasesKeepExpiring(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 14, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	repo := newLeasingEventRepository(clock, 1)
+	lease := domain.Lease{Owner: "crashed", Duration: 30 * time.Second}
+
+	// Every publisher that claims the event crashes before settling it.
+	for range 3 {
+		if claimed, err := repo.ListPending(t.Context(), lease, domain.OrderingNone, 10); err != nil || len(claimed) != 1 {
+			t.Fatalf("expected a crashing publisher to claim the row, got %d (%v)", len(claimed), err)
+		}
+		now = now.Add(31 * time.Second)
+	}
+
+	publisher := &countingEventPublisher{counts: map[string]int{}}
+	service := &PublisherService{
+		repository:  repo,
+		publisher:   publisher,
+		logger:      noopLogger{},
+		now:         clock,
+		lease:       domain.Lease{Owner: "survivor", Duration: 30 * time.Second},
+		backoff:     DefaultPublishBackoff,
+		maxAttempts: 3,
+	}
+
+	claimed, err := service.PublishPending(t.Context(), 10)
+	if err != nil || claimed != 1 {
+		t.Fatalf("expected the row to be claimed without error, got %d (%v)", claimed, err)
+	}
+	if len(publisher.counts) != 0 {
+		t.Fatalf("expected an event without attempts left not to be published, got %#v", publisher.counts)
+	}
+	row := repo.find("evt-000")
+	if row.Status != "failed" || row.LastError != LeaseAttemptsExhausted {
+		t.Fatalf("expected the row dead-lettered for exhausted leases, got %q (%q)", row.Status, row.LastError)
+	}
+}
+
+func TestPublishPendingHoldsBackLaterEventsOfRetryingAggregate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 14, 11, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	repo := newLeasingEventRepository(clock, 0)
+	for sequence, eventType := range []string{"record.updated", "record.deleted"} {
+		repo.rows = append(repo.rows, &domain.Event{
+			EventID:           fmt.Sprintf("evt-42-%d", sequence+1),
+			AggregateType:     "record",
+			AggregateID:       "42",
+			AggregateSequence: int64(sequence + 1),
+			EventType:         eventType,
+			Status:            DefaultEventStatus,
+			AvailableAtUTC:    now.Add(-time.Minute),
+		})
+	}
+
+	publisher := &countingEventPublisher{
+		counts:   map[string]int{},
+		failures: map[string]error{"evt-42-1": errors.New("broker timeout")},
+	}
+	service := &PublisherService{
+		repository: repo,
+		publisher:  publisher,
+		logger:     noopLogger{},
+		now:        clock,
+		lease:      domain.Lease{Owner: "publisher-a", Duration: 30 * time.Second},
+		ordering:   domain.OrderingAggregate,
+		backoff:    5 * time.Second,
+	}
+
+	if _, err := service.PublishPending(t.Context(), 10); err == nil {
+		t.Fatal("expected the first event to fail")
+	}
+	if len(publisher.order) != 0 {
+		t.Fatalf("expected the delete to be held back behind the retrying update, got %v", publisher.order)
+	}
+
+	now = now.Add(10 * time.Second)
+	delete(publisher.failures, "evt-42-1")
+	for range 2 {
+		if _, err := service.PublishPending(t.Context(), 10); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	if len(publisher.order) != 2 || publisher.order[0] != "evt-42-1" || publisher.order[1] != "evt-42-2" {
+		t.Fatalf("expected update before delete, got %v", publisher.order)
+	}
+}
+
+// stalledEventPublisher fails every event after running stall, which stands in for a transport write
+// that outlives the publisher lease.
+type stalledEventPublisher struct {
+	stall func()
+	err   error
+}
+
+func (p *stalledEventPublisher) Publish(context.Context, domain.Event) error {
+	p.stall()
+	return p.err
+}
+
+func (p *stalledEventPublisher) PublishBatch(_ context.Context, events []domain.Event) []domain.PublishResult {
+	p.stall()
+	results := make([]domain.PublishResult, len(events))
+	for i, event := range events {
+		results[i] = domain.PublishResult{EventID: event.EventID, Err: p.err}
+	}
+	return results
+}
+
+func TestPublishPendingStaleOwnerDoesNotRescheduleReclaimedRow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 14, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	repo := newLeasingEventRepository(clock, 1)
+	lease := 30 * time.Second
+
+	survivor := &PublisherService{
+		repository: repo,
+		publisher:  &countingEventPublisher{counts: map[string]int{}},
+		logger:     noopLogger{},
+		now:        clock,
+		lease:      domain.Lease{Owner: "survivor", Duration: lease},
+		backoff:    DefaultPublishBackoff,
+	}
+	stale := &PublisherService{
+		repository: repo,
+		publisher: &stalledEventPublisher{
+			err: errors.New("broker timeout"),
+			stall: func() {
+				// The write outlives the lease; the survivor reclaims and publishes the row meanwhile.
+				now = now.Add(lease + time.Second)
+				if _, err := survivor.PublishPending(t.Context(), 10); err != nil {
+					t.Errorf("expected survivor to publish, got %v", err)
+				}
+			},
+		},
+		logger:  noopLogger{},
+		now:     clock,
+		lease:   domain.Lease{Owner: "stale", Duration: lease},
+		backoff: DefaultPublishBackoff,
+	}
+
+	_, err := stale.PublishPending(t.Context(), 10)
+	if err == nil || errors.Is(err, domain.ErrLeaseLost) {
+		t.Fatalf("expected only the transport error, got %v", err)
+	}
+
+	// The expired lease is the only attempt counted; the stale owner's failure is not.
+	row := repo.find("evt-000")
+	if row.Status != "published" || row.AttemptCount != 1 {
+		t.Fatalf("expected the reclaimed row to stay published, got status %q after %d attempts", row.Status, row.AttemptCount)
+	}
+}
@@ -7,15 +7,27 @@ import (
 	"time"
 
 	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
 )
 
 type stubEventRepository struct {
 	events             []domain.Event
 	listPendingErr     error
 	markPublishedCalls []markPublishedCall
+	markBatchSizes     []int
 	rescheduleCalls    []rescheduleCall
+	deadLetterCalls    []deadLetterCall
+	replayFilters      []domain.DeadLetterFilter
+	listFilters        []domain.DeadLetterFilter
 	markPublishedErr   error
 	rescheduleErr      error
+	replayErr          error
+	eventByID          domain.Event
+	stats              domain.Stats
+	purgeBatches       [][]domain.Event
+	purgeCutoffs       []time.Time
+	purgeErr           error
+	replayed           int64
 }
 
 type markPublishedCall struct {
@@ -23,6 +35,11 @@ type markPublishedCall struct {
 	publishedAt time.Time
 }
 
+type deadLetterCall struct {
+	eventID   string
+	lastError string
+}
+
 type rescheduleCall struct {
 	eventID         string
 	nextAvailableAt time.Time
@@ -33,22 +50,29 @@ func (r *stubEventRepository) Save(context.Context, domain.Event) error {
 	return nil
 }
 
-func (r *stubEventRepository) ListPending(context.Context, int) ([]domain.Event, error) {
+func (r *stubEventRepository) NotifyPending(context.Context, string) error {
+	return nil
+}
+
+func (r *stubEventRepository) ListPending(context.Context, domain.Lease, domain.Ordering, int) ([]domain.Event, error) {
 	if r.listPendingErr != nil {
 		return nil, r.listPendingErr
 	}
 	return r.events, nil
 }
 
-func (r *stubEventRepository) MarkPublished(_ context.Context, eventID string, publishedAt time.Time) error {
+func (r *stubEventRepository) MarkPublishedBatch(_ context.Context, _ string, eventIDs []string, publishedAt time.Time) error {
 	if r.markPublishedErr != nil {
 		return r.markPublishedErr
 	}
-	r.markPublishedCalls = append(r.markPublishedCalls, markPublishedCall{eventID: eventID, publishedAt: publishedAt})
+	r.markBatchSizes = append(r.markBatchSizes, len(eventIDs))
+	for _, eventID := range eventIDs {
+		r.markPublishedCalls = append(r.markPublishedCalls, markPublishedCall{eventID: eventID, publishedAt: publishedAt})
+	}
 	return nil
 }
 
-func (r *stubEventRepository) Reschedule(_ context.Context, eventID string, nextAvailableAt time.Time, lastError string) error {
+func (r *stubEventRepository) Reschedule(_ context.Context, _ string, eventID string, nextAvailableAt time.Time, lastError string) error {
 	if r.rescheduleErr != nil {
 		return r.rescheduleErr
 	}
@@ -60,9 +84,60 @@ func (r *stubEventRepository) Reschedule(_ context.Context, eventID string, next
 	return nil
 }
 
+func (r *stubEventRepository) MarkDeadLettered(_ context.Context, _ string, eventID string, lastError string) error {
+	r.deadLetterCalls = append(r.deadLetterCalls, deadLetterCall{eventID: eventID, lastError: lastError})
+	return nil
+}
+
+func (r *stubEventRepository) GetByEventID(context.Context, string) (domain.Event, error) {
+	return r.eventByID, nil
+}
+
+func (r *stubEventRepository) ListDeadLettered(_ context.Context, filter domain.DeadLetterFilter) ([]domain.Event, error) {
+	r.listFilters = append(r.listFilters, filter)
+	return r.events, nil
+}
+
+func (r *stubEventRepository) ReplayDeadLettered(_ context.Context, filter domain.DeadLetterFilter, _ time.Time) (int64, error) {
+	if r.replayErr != nil {
+		return 0, r.replayErr
+	}
+	r.replayFilters = append(r.replayFilters, filter)
+	return r.replayed, nil
+}
+
+func (r *stubEventRepository) GetStats(context.Context) (domain.Stats, error) {
+	return r.stats, nil
+}
+
+func (r *stubEventRepository) PurgePublished(
+	ctx context.Context,
+	cutoff time.Time,
+	_ int,
+	archive output.ArchiveFunc,
+) (int64, error) {
+	r.purgeCutoffs = append(r.purgeCutoffs, cutoff)
+	if r.purgeErr != nil {
+		return 0, r.purgeErr
+	}
+	if len(r.purgeBatches) == 0 {
+		return 0, nil
+	}
+
+	batch := r.purgeBatches[0]
+	if archive != nil {
+		if err := archive(ctx, batch); err != nil {
+			return 0, err
+		}
+	}
+	r.purgeBatches = r.purgeBatches[1:]
+	return int64(len(batch)), nil
+}
+
 type stubEventPublisher struct {
 	publishErrByEventID map[string]error
 	publishedEventIDs   []string
+	batchSizes          []int
 }
 
 func (p *stubEventPublisher) Publish(_ context.Context, event domain.Event) error {
@@ -73,6 +148,15 @@ func (p *stubEventPublisher) Publish(_ context.Context, event domain.Event) erro
 	return nil
 }
 
+func (p *stubEventPublisher) PublishBatch(ctx context.Context, events []domain.Event) []domain.PublishResult {
+	p.batchSizes = append(p.batchSizes, len(events))
+	results := make([]domain.PublishResult, len(events))
+	for i, event := range events {
+		results[i] = domain.PublishResult{EventID: event.EventID, Err: p.Publish(ctx, event)}
+	}
+	return results
+}
+
 type noopLogger struct{}
 
 func (noopLogger) Infof(string, ...any)                      {}
@@ -111,9 +195,13 @@ func TestPublishPendingMarksPublishedOnSuccess(t *testing.T) {
 		backoff: DefaultPublishBackoff,
 	}
 
-	if err := service.PublishPending(t.Context(), 10); err != nil {
+	claimed, err := service.PublishPending(t.Context(), 10)
+	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
+	if claimed != 1 {
+		t.Fatalf("expected one claimed row, got %d", claimed)
+	}
 
 	if len(publisher.publishedEventIDs) != 1 || publisher.publishedEventIDs[0] != "evt-1" {
 		t.Fatalf("expected publisher to emit evt-1, got %#v", publisher.publishedEventIDs)
@@ -160,7 +248,7 @@ func TestPublishPendingReschedulesFailedEvents(t *testing.T) {
 		backoff: 5 * time.Second,
 	}
 
-	err := service.PublishPending(t.Context(), 10)
+	_, err := service.PublishPending(t.Context(), 10)
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -182,6 +270,91 @@ func TestPublishPendingReschedulesFailedEvents(t *testing.T) {
 	}
 }
 
+func TestPublishPendingBacksOffExponentially(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC)
+	repo := &stubEventRepository{
+		events: []domain.Event{{EventID: "evt-3", AttemptCount: 3}},
+	}
+	service := &PublisherService{
+		repository: repo,
+		publisher: &stubEventPublisher{
+			publishErrByEventID: map[string]error{"evt-3": errors.New("broker timeout")},
+		},
+		logger:      noopLogger{},
+		now:         func() time.Time { return now },
+		backoff:     5 * time.Second,
+		maxBackoff:  30 * time.Second,
+		maxAttempts: 10,
+	}
+
+	if _, err := service.PublishPending(t.Context(), 10); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(repo.rescheduleCalls) != 1 {
+		t.Fatalf("expected one reschedule call, got %d", len(repo.rescheduleCalls))
+	}
+	// 5s doubled three times is 40s, capped at the 30s maximum.
+	if !repo.rescheduleCalls[0].nextAvailableAt.Equal(now.Add(30 * time.Second)) {
+		t.Fatalf("expected capped backoff, got %v", repo.rescheduleCalls[0].nextAvailableAt)
+	}
+	if len(repo.deadLetterCalls) != 0 {
+		t.Fatalf("expected no dead-letter calls, got %d", len(repo.deadLetterCalls))
+	}
+}
+
+func TestPublishPendingDeadLettersExhaustedEvents(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubEventRepository{
+		events: []domain.Event{{EventID: "evt-4", AttemptCount: 2}},
+	}
+	service := &PublisherService{
+		repository: repo,
+		publisher: &stubEventPublisher{
+			publishErrByEventID: map[string]error{"evt-4": errors.New("schema rejected")},
+		},
+		logger:      noopLogger{},
+		now:         time.Now,
+		backoff:     DefaultPublishBackoff,
+		maxBackoff:  DefaultMaxPublishBackoff,
+		maxAttempts: 3,
+	}
+
+	if _, err := service.PublishPending(t.Context(), 10); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(repo.rescheduleCalls) != 0 {
+		t.Fatalf("expected no reschedule calls, got %d", len(repo.rescheduleCalls))
+	}
+	if len(repo.deadLetterCalls) != 1 || repo.deadLetterCalls[0].eventID != "evt-4" {
+		t.Fatalf("expected evt-4 to be dead-lettered, got %#v", repo.deadLetterCalls)
+	}
+	if repo.deadLetterCalls[0].lastError != "schema rejected" {
+		t.Fatalf("expected original publish error, got %q", repo.deadLetterCalls[0].lastError)
+	}
+}
+
+func TestNextBackoffAppliesJitterWithinBounds(t *testing.T) {
+	t.Parallel()
+
+	service := &PublisherService{
+		backoff:    time.Second,
+		maxBackoff: time.Minute,
+		jitter:     equalJitter,
+	}
+
+	for range 50 {
+		delay := service.nextBackoff(2)
+		if delay < 2*time.Second || delay > 4*time.Second {
+			t.Fatalf("expected jittered delay within [2s, 4s], got %v", delay)
+		}
+	}
+}
+
 func TestPublishPendingReturnsListPendingError(t *testing.T) {
 	t.Parallel()
 
@@ -194,8 +367,77 @@ func TestPublishPendingReturnsListPendingError(t *testing.T) {
 		backoff:    DefaultPublishBackoff,
 	}
 
-	err := service.PublishPending(t.Context(), 10)
+	_, err := service.PublishPending(t.Context(), 10)
 	if err == nil || err.Error() != "db down" {
 		t.Fatalf("expected db down error, got %v", err)
 	}
 }
+
+type stubSchemaRegistry struct {
+	errByEventType map[string]error
+}
+
+func (r *stubSchemaRegistry) Validate(string, string, []byte) error {
+	return nil
+}
+
+func (r *stubSchemaRegistry) Upcast(eventType, eventVersion string, payload []byte) (string, []byte, error) {
+	if err := r.errByEventType[eventType]; err != nil {
+		return eventVersion, payload, err
+	}
+	if eventVersion == "v1" {
+		return "v2", []byte(`{"upcast":true}`), nil
+	}
+	return eventVersion, payload, nil
+}
+
+type capturingEventPublisher struct {
+	stubEventPublisher
+	published []domain.Event
+}
+
+func (p *capturingEventPublisher) PublishBatch(ctx context.Context, events []domain.Event) []domain.PublishResult {
+	p.published = append(p.published, events...)
+	return p.stubEventPublisher.PublishBatch(ctx, events)
+}
+
+func TestPublishPendingUpcastsStoredPayloads(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 13, 12, 0, 0, 0, time.UTC)
+	repo := &stubEventRepository{
+		events: []domain.Event{
+			{EventID: "evt-old", AggregateType: "tag", AggregateID: "1", EventType: "tag.updated", EventVersion: "v1", PayloadJSON: []byte(`{}`)},
+			{EventID: "evt-bad", AggregateType: "tag", AggregateID: "2", EventType: "tag.broken", EventVersion: "v1", PayloadJSON: []byte(`{}`)},
+		},
+	}
+	publisher := &capturingEventPublisher{}
+	service := &PublisherService{
+		repository:  repo,
+		publisher:   publisher,
+		schemas:     &stubSchemaRegistry{errByEventType: map[string]error{"tag.broken": errors.New("no upcaster")}},
+		logger:      noopLogger{},
+		now:         func() time.Time { return now },
+		jitter:      func(d time.Duration) time.Duration { return d },
+		backoff:     DefaultPublishBackoff,
+		maxBackoff:  DefaultMaxPublishBackoff,
+		maxAttempts: DefaultMaxPublishAttempts,
+	}
+
+	if _, err := service.PublishPending(t.Context(), 10); err == nil {
+		t.Fatal("expected the failed upcast to be reported")
+	}
+
+	if len(publisher.published) != 1 || publisher.published[0].EventID != "evt-old" {
+		t.Fatalf("expected only evt-old to be published, got %#v", publisher.published)
+	}
+	if publisher.published[0].EventVersion != "v2" || string(publisher.published[0].PayloadJSON) != `{"upcast":true}` {
+		t.Fatalf("expected evt-old upcast to v2, got %s %s", publisher.published[0].EventVersion, publisher.published[0].PayloadJSON)
+	}
+	if len(repo.markPublishedCalls) != 1 || repo.markPublishedCalls[0].eventID != "evt-old" {
+		t.Fatalf("expected evt-old to be marked published, got %#v", repo.markPublishedCalls)
+	}
+	if len(repo.rescheduleCalls) != 1 || repo.rescheduleCalls[0].eventID != "evt-bad" {
+		t.Fatalf("expected evt-bad to be rescheduled, got %#v", repo.rescheduleCalls)
+	}
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// publisherMetrics holds the instruments used to tune OUTBOX_BATCH_SIZE and OUTBOX_PUBLISH_INTERVAL.
+// Batch size and write latency are recorded per cycle; backlog gauges are read from the repository
+// on each metric collection, so they cost one stats query per export rather than per cycle.
+type publisherMetrics struct {
+	batchSize       metric.Int64Histogram
+	publishDuration metric.Float64Histogram
+}
+
+func newPublisherMetrics(
+	provider metric.MeterProvider,
+	repository output.EventRepository,
+	now func() time.Time,
+) (*publisherMetrics, error) {
+	meter := provider.Meter(MeterName)
+
+	batchSize, err := meter.Int64Histogram(MetricPublishBatchSize,
+		metric.WithDescription("Rows claimed by one outbox publish cycle."),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	publishDuration, err := meter.Float64Histogram(MetricPublishDuration,
+		metric.WithDescription("Duration of one batched outbox transport write."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	backlog, err := meter.Int64ObservableGauge(MetricPendingBacklog,
+		metric.WithDescription("Outbox rows pending or leased and not yet published."),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	oldestAge, err := meter.Float64ObservableGauge(MetricOldestPendingAge,
+		metric.WithDescription("Age of the oldest publishable outbox row."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		stats, err := repository.GetStats(ctx)
+		if err != nil {
+			return err
+		}
+
+		observer.ObserveInt64(backlog, stats.PendingCount)
+
+		age := 0.0
+		if stats.OldestPendingAtUTC != nil {
+			// Rows rescheduled into the future are not overdue yet.
+			age = max(now().Sub(*stats.OldestPendingAtUTC).Seconds(), 0)
+		}
+		observer.ObserveFloat64(oldestAge, age)
+		return nil
+	}, backlog, oldestAge)
+	if err != nil {
+		return nil, err
+	}
+
+	return &publisherMetrics{
+		batchSize:       batchSize,
+		publishDuration: publishDuration,
+	}, nil
+}
+
+// recordBatchSize and recordPublish tolerate a nil receiver so a service without metrics still publishes.
+func (m *publisherMetrics) recordBatchSize(ctx context.Context, size int) {
+	if m == nil {
+		return
+	}
+	m.batchSize.Record(ctx, int64(size))
+}
+
+func (m *publisherMetrics) recordPublish(ctx context.Context, elapsed time.Duration, total, failed int) {
+	if m == nil {
+		return
+	}
+	outcome := PublishOutcomeOK
+	switch {
+	case failed == total:
+		outcome = PublishOutcomeFailed
+	case failed > 0:
+		outcome = PublishOutcomePartial
+	}
+	m.publishDuration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attribute.String(MetricAttrOutcome, outcome)))
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// PurgePublished deletes rows published before the retention cutoff in batches until none are left,
+// the batch budget of one run is spent, or ctx ends. It returns how many rows were removed.
+func (s *RetentionService) PurgePublished(ctx context.Context) (int64, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanPurgePublished)
+	defer span.End()
+
+	cutoff := s.now().Add(-s.period)
+	span.SetAttributes(
+		attribute.String(LogKeyCutoff, cutoff.Format("2006-01-02T15:04:05Z07:00")),
+		attribute.Int("batch_limit", s.batchSize),
+		attribute.Bool(MetricAttrArchived, s.archive != nil),
+	)
+
+	var archive output.ArchiveFunc
+	if s.archive != nil {
+		archive = s.archiveBatch
+	}
+
+	var total int64
+	for range s.maxBatches {
+		span.AddEvent(EventRepositoryPurge)
+		removed, err := s.repository.PurgePublished(ctx, cutoff, s.batchSize, archive)
+		s.recordRemoved(ctx, removed)
+		total += removed
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, LogFailedToPurgePublished)
+			s.logger.ErrorwCtx(ctx, LogFailedToPurgePublished,
+				commonkeys.Error, err.Error(),
+				LogKeyCutoff, cutoff,
+				LogKeyRemovedCount, total,
+			)
+			return total, err
+		}
+		if removed < int64(s.batchSize) || ctx.Err() != nil {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int64(LogKeyRemovedCount, total))
+	span.SetStatus(codes.Ok, LogPurgedPublishedEvents)
+	if total > 0 {
+		s.logger.InfowCtx(ctx, LogPurgedPublishedEvents,
+			LogKeyCutoff, cutoff,
+			LogKeyRemovedCount, total,
+		)
+	}
+	return total, nil
+}
+
+func (s *RetentionService) recordRemoved(ctx context.Context, removed int64) {
+	if s.rowsRemoved == nil || removed == 0 {
+		return
+	}
+	s.rowsRemoved.Add(ctx, removed, metric.WithAttributes(attribute.Bool(MetricAttrArchived, s.archive != nil)))
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type stubArchiveStorage struct {
+	err     error
+	objects []output.ArchiveObject
+}
+
+func (s *stubArchiveStorage) PutArchive(_ context.Context, object output.ArchiveObject) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.objects = append(s.objects, object)
+	return nil
+}
+
+func eventsNamed(ids ...string) []domain.Event {
+	events := make([]domain.Event, 0, len(ids))
+	for _, id := range ids {
+		events = append(events, domain.Event{EventID: id, AggregateType: "record", PayloadJSON: []byte(`{"record_id":1}`)})
+	}
+	return events
+}
+
+func newTestRetentionService(
+	t *testing.T,
+	repo output.EventRepository,
+	archive output.ArchiveStorage,
+	now time.Time,
+	cfg RetentionConfig,
+) (*RetentionService, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	cfg.MeterProvider = provider
+
+	service, ok := NewRetentionService(repo, archive, noopLogger{}, cfg).(*RetentionService)
+	if !ok {
+		t.Fatal("expected *RetentionService")
+	}
+	service.now = func() time.Time { return now }
+	return service, reader
+}
+
+func rowsRemovedSum(t *testing.T, reader *sdkmetric.ManualReader) int64 {
+	t.Helper()
+
+	var collected metricdata.ResourceMetrics
+	if err := reader.Collect(t.Context(), &collected); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+	var total int64
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != MetricRetentionRowsRemoved {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if !ok {
+				t.Fatalf("unexpected data type %T", m.Data)
+			}
+			for _, point := range sum.DataPoints {
+				total += point.Value
+			}
+		}
+	}
+	return total
+}
+
+func TestPurgePublishedDrainsExpiredBatches(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 14, 3, 0, 0, 0, time.UTC)
+	repo := &stubEventRepository{
+		purgeBatches: [][]domain.Event{
+			eventsNamed("evt-1", "evt-2"),
+			eventsNamed("evt-3", "evt-4"),
+			eventsNamed("evt-5"),
+		},
+	}
+	service, reader := newTestRetentionService(t, repo, nil, now, RetentionConfig{Period: 48 * time.Hour, BatchSize: 2})
+
+	removed, err := service.PurgePublished(t.Context())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if removed != 5 {
+		t.Fatalf("expected 5 rows removed, got %d", removed)
+	}
+	if len(repo.purgeCutoffs) != 3 {
+		t.Fatalf("expected 3 purge batches, got %d", len(repo.purgeCutoffs))
+	}
+	if want := now.Add(-48 * time.Hour); !repo.purgeCutoffs[0].Equal(want) {
+		t.Fatalf("expected cutoff %v, got %v", want, repo.purgeCutoffs[0])
+	}
+	if got := rowsRemovedSum(t, reader); got != 5 {
+		t.Fatalf("expected rows_removed metric 5, got %d", got)
+	}
+}
+
+func TestPurgePublishedStopsAtBatchBudget(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubEventRepository{
+		purgeBatches: [][]domain.Event{
+			eventsNamed("evt-1"),
+			eventsNamed("evt-2"),
+			eventsNamed("evt-3"),
+		},
+	}
+	service, _ := newTestRetentionService(t, repo, nil, time.Now(), RetentionConfig{BatchSize: 1, MaxBatches: 2})
+
+	removed, err := service.PurgePublished(t.Context())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if removed != 2 || len(repo.purgeBatches) != 1 {
+		t.Fatalf("expected 2 rows removed and one batch left, got %d removed, %d left", removed, len(repo.purgeBatches))
+	}
+}
+
+func TestPurgePublishedArchivesNDJSONBeforeDelete(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 14, 3, 0, 0, 0, time.UTC)
+	repo := &stubEventRepository{purgeBatches: [][]domain.Event{eventsNamed("evt-1", "evt-2")}}
+	archive := &stubArchiveStorage{}
+	service, _ := newTestRetentionService(t, repo, archive, now, RetentionConfig{BatchSize: 10})
+
+	if _, err := service.PurgePublished(t.Context()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(archive.objects) != 1 {
+		t.Fatalf("expected one archive file, got %d", len(archive.objects))
+	}
+
+	object := archive.objects[0]
+	if object.ObjectKey != "event_outbox/published/2026/03/14/20260314T030000Z-evt-1.ndjson" {
+		t.Fatalf("unexpected object key %q", object.ObjectKey)
+	}
+	if object.ContentType != ArchiveContentType {
+		t.Fatalf("unexpected content type %q", object.ContentType)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(object.Body)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 NDJSON lines, got %d", len(lines))
+	}
+	var first map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("decode first line: %v", err)
+	}
+	if !bytes.Equal(first["payload"], []byte(`{"record_id":1}`)) {
+		t.Fatalf("expected embedded JSON payload, got %s", first["payload"])
+	}
+}
+
+func TestPurgePublishedKeepsRowsWhenArchiveFails(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubEventRepository{purgeBatches: [][]domain.Event{eventsNamed("evt-1")}}
+	archive := &stubArchiveStorage{err: errors.New("disk full")}
+	service, reader := newTestRetentionService(t, repo, archive, time.Now(), RetentionConfig{})
+
+	removed, err := service.PurgePublished(t.Context())
+	if err == nil {
+		t.Fatal("expected archive failure to be returned")
+	}
+	if removed != 0 || len(repo.purgeBatches) != 1 {
+		t.Fatalf("expected rows to stay, got %d removed and %d batches left", removed, len(repo.purgeBatches))
+	}
+	if got := rowsRemovedSum(t, reader); got != 0 {
+		t.Fatalf("expected no rows_removed metric, got %d", got)
+	}
+}
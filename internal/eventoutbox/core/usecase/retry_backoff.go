@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+)
+
+// nextBackoff returns the delay before the next publish attempt of an event that already failed attemptCount times.
+// The delay doubles per attempt from the base backoff, is capped by maxBackoff, and is then jittered.
+func (s *PublisherService) nextBackoff(attemptCount int) time.Duration {
+	delay := s.backoff
+	for i := 0; i < attemptCount; i++ {
+		if s.maxBackoff > 0 && delay >= s.maxBackoff {
+			break
+		}
+		delay *= 2
+	}
+	if s.maxBackoff > 0 && delay > s.maxBackoff {
+		delay = s.maxBackoff
+	}
+	if s.jitter != nil {
+		delay = s.jitter(delay)
+	}
+	return delay
+}
+
+// exhaustedAttempts reports whether the current failure is the last one allowed for the event.
+// A non-positive maxAttempts disables dead-lettering.
+func (s *PublisherService) exhaustedAttempts(event domain.Event) bool {
+	return s.maxAttempts > 0 && event.AttemptCount+1 >= s.maxAttempts
+}
+
+// equalJitter keeps half of the delay and randomizes the other half so retries of a burst spread out.
+func equalJitter(delay time.Duration) time.Duration {
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	// #nosec G404 -- retry jitter does not need cryptographic randomness.
+	return half + rand.N(half+1)
+}
@@ -7,16 +7,19 @@ import (
 	"time"
 
 	"github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
 	"github.com/lechitz/aion-api/internal/eventoutbox/core/usecase"
+	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
 	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestEnqueue(t *testing.T) {
 	t.Run("success writes normalized event", func(t *testing.T) {
 		repo := &eventRepositoryStub{}
-		svc, ok := usecase.NewService(repo, noopLogger{}).(*usecase.Service)
+		svc, ok := usecase.NewService(repo, nil, noopLogger{}).(*usecase.Service)
 		require.True(t, ok)
 
 		ctx := context.WithValue(t.Context(), ctxkeys.TraceID, "trace-1")
@@ -37,18 +40,74 @@ func TestEnqueue(t *testing.T) {
 		require.Equal(t, "trace-1", repo.saved[0].TraceID)
 		require.Equal(t, "req-1", repo.saved[0].RequestID)
 		require.False(t, repo.saved[0].AvailableAtUTC.IsZero())
+		require.Equal(t, []string{"record"}, repo.notified)
+	})
+
+	t.Run("captures the enqueueing trace context", func(t *testing.T) {
+		repo := &eventRepositoryStub{}
+		svc := usecase.NewService(repo, nil, noopLogger{})
+
+		traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+		ctx := trace.ContextWithSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}))
+
+		require.NoError(t, svc.Enqueue(ctx, domain.Event{
+			AggregateType: "record",
+			AggregateID:   "123",
+			EventType:     "record.created",
+			PayloadJSON:   []byte(`{"record_id":123}`),
+		}))
+		require.Len(t, repo.saved, 1)
+		require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", repo.saved[0].TraceID)
+		require.Contains(t, repo.saved[0].TraceParent, "4bf92f3577b34da6a3ce929d0e0e4736")
 	})
 
 	t.Run("invalid command fails", func(t *testing.T) {
-		svc, ok := usecase.NewService(&eventRepositoryStub{}, noopLogger{}).(*usecase.Service)
+		svc, ok := usecase.NewService(&eventRepositoryStub{}, nil, noopLogger{}).(*usecase.Service)
 		require.True(t, ok)
 
 		err := svc.Enqueue(t.Context(), domain.Event{})
 		require.ErrorIs(t, err, usecase.ErrAggregateTypeRequired)
 	})
 
+	t.Run("payload rejected by schema registry is not saved", func(t *testing.T) {
+		repo := &eventRepositoryStub{}
+		schemas := &schemaRegistryStub{err: errors.New("missing property user_id")}
+		svc := usecase.NewService(repo, schemas, noopLogger{})
+
+		err := svc.Enqueue(t.Context(), domain.Event{
+			AggregateType: "record",
+			AggregateID:   "321",
+			EventType:     "record.updated",
+			PayloadJSON:   []byte(`{"record_id":321}`),
+		})
+		require.ErrorIs(t, err, usecase.ErrPayloadSchemaViolation)
+		require.Equal(t, []string{"record.updated v1"}, schemas.validated)
+		require.Empty(t, repo.saved)
+		require.Empty(t, repo.notified)
+	})
+
+	t.Run("transaction-bound service keeps validating payloads", func(t *testing.T) {
+		repo := &eventRepositoryStub{}
+		schemas := &schemaRegistryStub{err: errors.New("missing property user_id")}
+		svc, ok := usecase.NewService(repo, schemas, noopLogger{}).(*usecase.Service)
+		require.True(t, ok)
+
+		err := svc.WithDB(nil).Enqueue(t.Context(), domain.Event{
+			AggregateType: "record",
+			AggregateID:   "321",
+			EventType:     "record.updated",
+			PayloadJSON:   []byte(`{"record_id":321}`),
+		})
+		require.ErrorIs(t, err, usecase.ErrPayloadSchemaViolation)
+	})
+
 	t.Run("repository error propagates", func(t *testing.T) {
-		svc, ok := usecase.NewService(&eventRepositoryStub{err: errors.New("db fail")}, noopLogger{}).(*usecase.Service)
+		svc, ok := usecase.NewService(&eventRepositoryStub{err: errors.New("db fail")}, nil, noopLogger{}).(*usecase.Service)
 		require.True(t, ok)
 
 		err := svc.Enqueue(t.Context(), domain.Event{
@@ -59,11 +118,41 @@ func TestEnqueue(t *testing.T) {
 		})
 		require.Error(t, err)
 	})
+
+	t.Run("notify error propagates so the enqueueing transaction rolls back", func(t *testing.T) {
+		repo := &eventRepositoryStub{notifyErr: errors.New("notify fail")}
+		svc, ok := usecase.NewService(repo, nil, noopLogger{}).(*usecase.Service)
+		require.True(t, ok)
+
+		err := svc.Enqueue(t.Context(), domain.Event{
+			AggregateType: "record",
+			AggregateID:   "321",
+			EventType:     "record.updated",
+			PayloadJSON:   []byte(`{"record_id":321}`),
+		})
+		require.Error(t, err)
+	})
+}
+
+type schemaRegistryStub struct {
+	err       error
+	validated []string
+}
+
+func (s *schemaRegistryStub) Validate(eventType, eventVersion string, _ []byte) error {
+	s.validated = append(s.validated, eventType+" "+eventVersion)
+	return s.err
+}
+
+func (s *schemaRegistryStub) Upcast(_, eventVersion string, payload []byte) (string, []byte, error) {
+	return eventVersion, payload, nil
 }
 
 type eventRepositoryStub struct {
-	err   error
-	saved []domain.Event
+	err       error
+	notifyErr error
+	saved     []domain.Event
+	notified  []string
 }
 
 func (s *eventRepositoryStub) Save(_ context.Context, event domain.Event) error {
@@ -74,18 +163,54 @@ func (s *eventRepositoryStub) Save(_ context.Context, event domain.Event) error
 	return nil
 }
 
-func (s *eventRepositoryStub) ListPending(context.Context, int) ([]domain.Event, error) {
+func (s *eventRepositoryStub) NotifyPending(_ context.Context, aggregateType string) error {
+	if s.notifyErr != nil {
+		return s.notifyErr
+	}
+	s.notified = append(s.notified, aggregateType)
+	return nil
+}
+
+func (s *eventRepositoryStub) WithDB(dbport.DB) output.EventRepository {
+	return s
+}
+
+func (s *eventRepositoryStub) ListPending(context.Context, domain.Lease, domain.Ordering, int) ([]domain.Event, error) {
 	return nil, nil
 }
 
-func (s *eventRepositoryStub) MarkPublished(context.Context, string, time.Time) error {
+func (s *eventRepositoryStub) MarkPublishedBatch(context.Context, string, []string, time.Time) error {
+	return nil
+}
+
+func (s *eventRepositoryStub) Reschedule(context.Context, string, string, time.Time, string) error {
 	return nil
 }
 
-func (s *eventRepositoryStub) Reschedule(context.Context, string, time.Time, string) error {
+func (s *eventRepositoryStub) MarkDeadLettered(context.Context, string, string, string) error {
 	return nil
 }
 
+func (s *eventRepositoryStub) GetByEventID(context.Context, string) (domain.Event, error) {
+	return domain.Event{}, nil
+}
+
+func (s *eventRepositoryStub) ListDeadLettered(context.Context, domain.DeadLetterFilter) ([]domain.Event, error) {
+	return nil, nil
+}
+
+func (s *eventRepositoryStub) ReplayDeadLettered(context.Context, domain.DeadLetterFilter, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (s *eventRepositoryStub) GetStats(context.Context) (domain.Stats, error) {
+	return domain.Stats{}, nil
+}
+
+func (s *eventRepositoryStub) PurgePublished(context.Context, time.Time, int, output.ArchiveFunc) (int64, error) {
+	return 0, nil
+}
+
 type noopLogger struct{}
 
 func (noopLogger) Infof(string, ...any)                      {}
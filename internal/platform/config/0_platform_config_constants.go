@@ -39,11 +39,130 @@ const (
 	// MinOutboxBatchSize is the minimum allowed number of outbox rows per publish batch.
 	MinOutboxBatchSize = 1
 
+	// MinOutboxMaxAttempts is the minimum allowed number of publish attempts before an event is dead-lettered.
+	MinOutboxMaxAttempts = 1
+
+	// MinOutboxBackoffBase is the minimum allowed base delay between outbox publish retries.
+	MinOutboxBackoffBase = 100 * time.Millisecond
+
+	// MinOutboxLeaseDuration is the minimum time one publisher keeps claimed outbox rows.
+	MinOutboxLeaseDuration = 5 * time.Second
+
+	// MinOutboxListenRetry is the minimum delay before the outbox publisher reopens a dropped LISTEN connection.
+	MinOutboxListenRetry = 1 * time.Second
+
+	// MinOutboxRetentionPeriod is the minimum time published outbox rows are kept before the retention job may delete them.
+	MinOutboxRetentionPeriod = 1 * time.Hour
+
+	// MinOutboxRetentionInterval is the minimum delay between two outbox retention runs.
+	MinOutboxRetentionInterval = 1 * time.Minute
+
+	// MinOutboxRetentionBatchSize is the minimum number of rows one retention purge statement deletes.
+	MinOutboxRetentionBatchSize = 1
+
+	// MinRecordTrashRetentionDays is the minimum number of days a soft-deleted record stays restorable.
+	MinRecordTrashRetentionDays = 1
+
+	// MinRecordTrashPurgeInterval is the minimum delay between two record trash purge runs.
+	MinRecordTrashPurgeInterval = 1 * time.Minute
+
+	// MinRecordTrashPurgeBatchSize is the minimum number of records one purge statement deletes.
+	MinRecordTrashPurgeBatchSize = 1
+
+	// MinOutboxWebhookTimeout is the minimum time the webhook transport waits for one delivery.
+	MinOutboxWebhookTimeout = 100 * time.Millisecond
+
+	// MinGraphqlSubscriptionsPerConnection is the minimum number of concurrent subscriptions one
+	// GraphQL websocket connection may hold.
+	MinGraphqlSubscriptionsPerConnection = 1
+
 	// MinRealtimeHeartbeatInterval is the minimum allowed SSE heartbeat interval.
 	MinRealtimeHeartbeatInterval = 1 * time.Second
 
 	// MinRealtimeSubscriberBuffer is the minimum allowed subscriber buffer size.
 	MinRealtimeSubscriberBuffer = 1
+
+	// MinRealtimeReplayBuffer is the minimum number of events retained per user for SSE resumption.
+	MinRealtimeReplayBuffer = 1
+
+	// MinRealtimeReplayTTL is the minimum lifetime of Redis-backed replay entries.
+	MinRealtimeReplayTTL = 1 * time.Minute
+
+	// MinRealtimeTicketTTL and MaxRealtimeTicketTTL bound the lifetime of single-use stream tickets.
+	MinRealtimeTicketTTL = 1 * time.Second
+	MaxRealtimeTicketTTL = 5 * time.Minute
+
+	// MinRealtimeMaxStreams is the minimum per-user and per-instance realtime stream cap.
+	MinRealtimeMaxStreams = 1
+
+	// MinRealtimeShutdownRetry is the minimum reconnect delay suggested to streams closed on shutdown.
+	MinRealtimeShutdownRetry = 100 * time.Millisecond
+)
+
+// Outbox ordering modes accepted by OUTBOX_ORDERING.
+const (
+	// OutboxOrderingNone publishes every available outbox event independently.
+	OutboxOrderingNone = "none"
+	// OutboxOrderingAggregate holds back later events of an aggregate until earlier ones are published.
+	OutboxOrderingAggregate = "aggregate"
+)
+
+// Outbox transports accepted by OUTBOX_TRANSPORT.
+const (
+	// OutboxTransportKafka publishes outbox events to the configured Kafka brokers.
+	OutboxTransportKafka = "kafka"
+	// OutboxTransportInProcess hands outbox events to subscribers in the same process; the API then runs the publisher itself.
+	OutboxTransportInProcess = "inprocess"
+	// OutboxTransportWebhook POSTs each outbox event to OUTBOX_WEBHOOK_URL.
+	OutboxTransportWebhook = "webhook"
+	// OutboxTransportFile appends outbox events to the NDJSON file at OUTBOX_FILE_PATH.
+	OutboxTransportFile = "file"
+)
+
+// Outbox envelope formats accepted by OUTBOX_ENVELOPE_FORMAT.
+const (
+	// OutboxEnvelopeLegacy publishes the aion-api JSON envelope with event_id, event_type, ... headers.
+	OutboxEnvelopeLegacy = "legacy"
+	// OutboxEnvelopeCloudEventsBinary publishes the payload as the body and CloudEvents attributes as ce_* headers.
+	OutboxEnvelopeCloudEventsBinary = "cloudevents-binary"
+	// OutboxEnvelopeCloudEventsStructured publishes one CloudEvents JSON document carrying attributes and payload.
+	OutboxEnvelopeCloudEventsStructured = "cloudevents-structured"
+)
+
+// Realtime projection sources accepted by REALTIME_SOURCE.
+const (
+	// RealtimeSourceKafka reads projection-ready events from the Kafka projection topic.
+	RealtimeSourceKafka = "kafka"
+	// RealtimeSourceInProcess reads projection-ready events from the in-process event bus.
+	RealtimeSourceInProcess = "inprocess"
+)
+
+// Realtime replay stores accepted by REALTIME_REPLAY_STORE.
+const (
+	// RealtimeReplayStoreMemory keeps the replay buffer in the API process; ids restart with the process.
+	RealtimeReplayStoreMemory = "memory"
+	// RealtimeReplayStoreRedis keeps the replay buffer in Redis, shared by every API replica.
+	RealtimeReplayStoreRedis = "redis"
+)
+
+// Realtime fan-out modes accepted by REALTIME_FANOUT.
+const (
+	// RealtimeFanoutLocal delivers events to the streams of the instance that read them; every
+	// instance then consumes the whole projection topic.
+	RealtimeFanoutLocal = "local"
+	// RealtimeFanoutRedis publishes events to per-user Redis channels; instances share one consumer
+	// group and subscribe only for the users they have streams for.
+	RealtimeFanoutRedis = "redis"
+)
+
+// Slow-consumer policies accepted by REALTIME_SLOW_CONSUMER_POLICY.
+const (
+	// RealtimeSlowConsumerDropNewest discards events that arrive while a stream's buffer is full.
+	RealtimeSlowConsumerDropNewest = "drop_newest"
+	// RealtimeSlowConsumerDropOldest discards the oldest buffered event to make room.
+	RealtimeSlowConsumerDropOldest = "drop_oldest"
+	// RealtimeSlowConsumerDisconnect closes a full stream with resync_required.
+	RealtimeSlowConsumerDisconnect = "disconnect"
 )
 
 // ErrFailedToProcessEnvVars is returned when environment variables cannot be processed.
@@ -68,8 +187,9 @@ const (
 	ErrHTTPIdleTimeoutMin             = "HTTP idle timeout must be greater than 0"
 	ErrHTTPMaxHeaderBytesMin          = "HTTP max header bytes must be greater than 0"
 
-	ErrGraphqlPathRequired  = "GraphQL path is required"
-	ErrGraphqlPathMustStart = "GraphQL path must start with '/'"
+	ErrGraphqlPathRequired               = "GraphQL path is required"
+	ErrGraphqlPathMustStart              = "GraphQL path must start with '/'"
+	ErrGraphqlMaxSubscriptionsPerConnMin = "GRAPHQL_MAX_SUBSCRIPTIONS_PER_CONNECTION must be at least %d"
 
 	ErrCachePoolSizeMin = "CACHE_POOL_SIZE must be at least %d"
 	ErrCacheAddrEmpty   = "cache address cannot be empty"
@@ -93,8 +213,27 @@ const (
 	ErrKafkaBrokersEmpty                     = "KAFKA_BROKERS cannot be empty"
 	ErrKafkaRecordEventsTopicEmpty           = "KAFKA_TOPIC_RECORD_EVENTS cannot be empty"
 	ErrKafkaRecordProjectionEventsTopicEmpty = "KAFKA_TOPIC_RECORD_PROJECTION_EVENTS cannot be empty"
+	ErrKafkaTagEventsTopicEmpty              = "KAFKA_TOPIC_TAG_EVENTS cannot be empty"
+	ErrKafkaCategoryEventsTopicEmpty         = "KAFKA_TOPIC_CATEGORY_EVENTS cannot be empty"
+	ErrKafkaUserEventsTopicEmpty             = "KAFKA_TOPIC_USER_EVENTS cannot be empty"
+	ErrKafkaMetricDefinitionEventsTopicEmpty = "KAFKA_TOPIC_METRIC_DEFINITION_EVENTS cannot be empty"
 	ErrOutboxPublishIntervalMin              = "OUTBOX_PUBLISH_INTERVAL must be at least %v"
 	ErrOutboxBatchSizeMin                    = "OUTBOX_BATCH_SIZE must be at least %d"
+	ErrOutboxMaxAttemptsMin                  = "OUTBOX_MAX_ATTEMPTS must be at least %d"
+	ErrOutboxBackoffBaseMin                  = "OUTBOX_BACKOFF_BASE must be at least %v"
+	ErrOutboxBackoffMaxBelowBase             = "OUTBOX_BACKOFF_MAX must be greater than or equal to OUTBOX_BACKOFF_BASE"
+	ErrOutboxLeaseDurationMin                = "OUTBOX_LEASE_DURATION must be at least %v"
+	ErrOutboxOrderingInvalid                 = "OUTBOX_ORDERING must be %q or %q"
+	ErrOutboxListenRetryMin                  = "OUTBOX_LISTEN_RETRY must be at least %v"
+	ErrOutboxRetentionPeriodMin              = "OUTBOX_RETENTION_PERIOD must be at least %v"
+	ErrOutboxRetentionIntervalMin            = "OUTBOX_RETENTION_INTERVAL must be at least %v"
+	ErrOutboxRetentionBatchSizeMin           = "OUTBOX_RETENTION_BATCH_SIZE must be at least %d"
+	ErrOutboxTransportInvalid                = "OUTBOX_TRANSPORT must be one of %q, %q, %q, or %q"
+	ErrOutboxEnvelopeFormatInvalid           = "OUTBOX_ENVELOPE_FORMAT must be one of %q, %q, or %q"
+	ErrOutboxWebhookURLInvalid               = "OUTBOX_WEBHOOK_URL must be an absolute http or https URL"
+	ErrOutboxWebhookTimeoutMin               = "OUTBOX_WEBHOOK_TIMEOUT must be at least %v"
+	ErrOutboxFilePathEmpty                   = "OUTBOX_FILE_PATH is required when OUTBOX_TRANSPORT is \"file\""
+	ErrRealtimeSourceInvalid                 = "REALTIME_SOURCE must be %q or %q"
 	ErrRealtimeStreamPathEmpty               = "REALTIME_STREAM_PATH is required"
 	ErrRealtimeStreamPathMustStart           = "REALTIME_STREAM_PATH must start with '/'"
 	ErrRealtimeStreamPathTooShort            = "REALTIME_STREAM_PATH must be longer than '/'"
@@ -102,6 +241,22 @@ const (
 	ErrRealtimeHeartbeatIntervalMin          = "REALTIME_HEARTBEAT_INTERVAL must be at least %v"
 	ErrRealtimeSubscriberBufferMin           = "REALTIME_SUBSCRIBER_BUFFER must be at least %d"
 	ErrRealtimeConsumerGroupPrefixEmpty      = "REALTIME_CONSUMER_GROUP_PREFIX cannot be empty"
+	ErrRealtimeReplayStoreInvalid            = "REALTIME_REPLAY_STORE must be %q or %q"
+	ErrRealtimeReplayBufferMin               = "REALTIME_REPLAY_BUFFER must be at least %d"
+	ErrRealtimeReplayTTLMin                  = "REALTIME_REPLAY_TTL must be at least %v"
+	ErrRealtimeTicketTTLRange                = "REALTIME_TICKET_TTL must be between %v and %v"
+	ErrRealtimeSlowConsumerPolicyInvalid     = "REALTIME_SLOW_CONSUMER_POLICY must be %q, %q or %q"
+	ErrRealtimeMaxStreamsPerUserMin          = "REALTIME_MAX_STREAMS_PER_USER must be at least %d"
+	ErrRealtimeMaxStreamsMin                 = "REALTIME_MAX_STREAMS must be at least REALTIME_MAX_STREAMS_PER_USER (%d)"
+	ErrRealtimeShutdownRetryMin              = "REALTIME_SHUTDOWN_RETRY must be at least %v"
+	ErrRealtimeShutdownRetryJitterNegative   = "REALTIME_SHUTDOWN_RETRY_JITTER cannot be negative"
+	ErrRealtimeFanoutInvalid                 = "REALTIME_FANOUT must be %q or %q"
+	ErrRealtimeFanoutNeedsRedisReplay        = "REALTIME_FANOUT=redis requires REALTIME_REPLAY_STORE=redis so every instance assigns the same event ids"
+	ErrRecordProjectorConsumerGroupEmpty     = "RECORD_PROJECTOR_CONSUMER_GROUP cannot be empty"
+	ErrRecordProjectorTransportInvalid       = "RECORD_PROJECTOR_ENABLED requires OUTBOX_TRANSPORT %q or %q"
+	ErrRecordTrashRetentionDaysMin           = "RECORD_TRASH_RETENTION_DAYS must be at least %d"
+	ErrRecordTrashPurgeIntervalMin           = "RECORD_TRASH_PURGE_INTERVAL must be at least %v"
+	ErrRecordTrashPurgeBatchSizeMin          = "RECORD_TRASH_PURGE_BATCH_SIZE must be at least %d"
 
 	ErrAppContextReqMin      = "context request timeout must be at least %v"
 	ErrAppShutdownTimeoutMin = "shutdown timeout must be at least %s second"
@@ -4,24 +4,28 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 )
 
 // Config holds all configuration sections required to bootstrap the application.
 type Config struct {
-	Realtime      RealtimeConfig
-	Kafka         KafkaConfig
-	General       GeneralConfig
-	Secret        Secret
-	AvatarStorage AvatarStorageConfig
-	Observability ObservabilityConfig
-	AionChat      AionChatConfig
-	Cookie        CookieConfig
-	ServerHTTP    ServerHTTP
-	DB            DBConfig
-	ServerGraphql ServerGraphql
-	Cache         CacheConfig
-	Outbox        OutboxConfig
-	Application   Application
+	Realtime        RealtimeConfig
+	Kafka           KafkaConfig
+	General         GeneralConfig
+	Secret          Secret
+	AvatarStorage   AvatarStorageConfig
+	Observability   ObservabilityConfig
+	AionChat        AionChatConfig
+	Cookie          CookieConfig
+	ServerHTTP      ServerHTTP
+	DB              DBConfig
+	ServerGraphql   ServerGraphql
+	Cache           CacheConfig
+	Outbox          OutboxConfig
+	RecordProjector RecordProjectorConfig
+	RecordTrash     RecordTrashConfig
+	Application     Application
 }
 
 // Validate checks if the configuration is valid, returning the first validation error encountered.
@@ -44,6 +48,12 @@ func (c *Config) Validate() error {
 	if err := c.validateKafka(); err != nil {
 		return err
 	}
+	if err := c.validateRecordProjector(); err != nil {
+		return err
+	}
+	if err := c.validateRecordTrash(); err != nil {
+		return err
+	}
 	if err := c.validateApp(); err != nil {
 		return err
 	}
@@ -60,12 +70,62 @@ func (c *Config) validateKafka() error {
 	if c.Kafka.RecordProjectionEventsTopic == "" {
 		return errors.New(ErrKafkaRecordProjectionEventsTopicEmpty)
 	}
+	if c.Kafka.TagEventsTopic == "" {
+		return errors.New(ErrKafkaTagEventsTopicEmpty)
+	}
+	if c.Kafka.CategoryEventsTopic == "" {
+		return errors.New(ErrKafkaCategoryEventsTopicEmpty)
+	}
+	if c.Kafka.UserEventsTopic == "" {
+		return errors.New(ErrKafkaUserEventsTopicEmpty)
+	}
+	if c.Kafka.MetricDefinitionEventsTopic == "" {
+		return errors.New(ErrKafkaMetricDefinitionEventsTopicEmpty)
+	}
 	if c.Outbox.PublishInterval < MinOutboxPublishInterval {
 		return fmt.Errorf(ErrOutboxPublishIntervalMin, MinOutboxPublishInterval)
 	}
 	if c.Outbox.BatchSize < MinOutboxBatchSize {
 		return fmt.Errorf(ErrOutboxBatchSizeMin, MinOutboxBatchSize)
 	}
+	if c.Outbox.MaxAttempts < MinOutboxMaxAttempts {
+		return fmt.Errorf(ErrOutboxMaxAttemptsMin, MinOutboxMaxAttempts)
+	}
+	if c.Outbox.BackoffBase < MinOutboxBackoffBase {
+		return fmt.Errorf(ErrOutboxBackoffBaseMin, MinOutboxBackoffBase)
+	}
+	if c.Outbox.BackoffMax < c.Outbox.BackoffBase {
+		return errors.New(ErrOutboxBackoffMaxBelowBase)
+	}
+	if c.Outbox.LeaseDuration < MinOutboxLeaseDuration {
+		return fmt.Errorf(ErrOutboxLeaseDurationMin, MinOutboxLeaseDuration)
+	}
+	if c.Outbox.Ordering != OutboxOrderingNone && c.Outbox.Ordering != OutboxOrderingAggregate {
+		return fmt.Errorf(ErrOutboxOrderingInvalid, OutboxOrderingNone, OutboxOrderingAggregate)
+	}
+	if err := c.validateOutboxTransport(); err != nil {
+		return err
+	}
+	switch c.Outbox.EnvelopeFormat {
+	case OutboxEnvelopeLegacy, OutboxEnvelopeCloudEventsBinary, OutboxEnvelopeCloudEventsStructured:
+	default:
+		return fmt.Errorf(ErrOutboxEnvelopeFormatInvalid,
+			OutboxEnvelopeLegacy, OutboxEnvelopeCloudEventsBinary, OutboxEnvelopeCloudEventsStructured)
+	}
+	if c.Outbox.ListenEnabled && c.Outbox.ListenRetry < MinOutboxListenRetry {
+		return fmt.Errorf(ErrOutboxListenRetryMin, MinOutboxListenRetry)
+	}
+	if c.Outbox.RetentionEnabled {
+		if c.Outbox.RetentionPeriod < MinOutboxRetentionPeriod {
+			return fmt.Errorf(ErrOutboxRetentionPeriodMin, MinOutboxRetentionPeriod)
+		}
+		if c.Outbox.RetentionInterval < MinOutboxRetentionInterval {
+			return fmt.Errorf(ErrOutboxRetentionIntervalMin, MinOutboxRetentionInterval)
+		}
+		if c.Outbox.RetentionBatchSize < MinOutboxRetentionBatchSize {
+			return fmt.Errorf(ErrOutboxRetentionBatchSizeMin, MinOutboxRetentionBatchSize)
+		}
+	}
 	if c.Realtime.Enabled {
 		if err := validateHTTPPath(
 			c.Realtime.StreamPath,
@@ -86,10 +146,111 @@ func (c *Config) validateKafka() error {
 		if c.Realtime.ConsumerGroupPrefix == "" {
 			return errors.New(ErrRealtimeConsumerGroupPrefixEmpty)
 		}
+		if c.Realtime.Source != RealtimeSourceKafka && c.Realtime.Source != RealtimeSourceInProcess {
+			return fmt.Errorf(ErrRealtimeSourceInvalid, RealtimeSourceKafka, RealtimeSourceInProcess)
+		}
+		if c.Realtime.ReplayStore != RealtimeReplayStoreMemory && c.Realtime.ReplayStore != RealtimeReplayStoreRedis {
+			return fmt.Errorf(ErrRealtimeReplayStoreInvalid, RealtimeReplayStoreMemory, RealtimeReplayStoreRedis)
+		}
+		if c.Realtime.ReplayBuffer < MinRealtimeReplayBuffer {
+			return fmt.Errorf(ErrRealtimeReplayBufferMin, MinRealtimeReplayBuffer)
+		}
+		if c.Realtime.ReplayStore == RealtimeReplayStoreRedis && c.Realtime.ReplayTTL < MinRealtimeReplayTTL {
+			return fmt.Errorf(ErrRealtimeReplayTTLMin, MinRealtimeReplayTTL)
+		}
+		if c.Realtime.TicketTTL < MinRealtimeTicketTTL || c.Realtime.TicketTTL > MaxRealtimeTicketTTL {
+			return fmt.Errorf(ErrRealtimeTicketTTLRange, MinRealtimeTicketTTL, MaxRealtimeTicketTTL)
+		}
+		switch c.Realtime.SlowConsumerPolicy {
+		case RealtimeSlowConsumerDropNewest, RealtimeSlowConsumerDropOldest, RealtimeSlowConsumerDisconnect:
+		default:
+			return fmt.Errorf(
+				ErrRealtimeSlowConsumerPolicyInvalid,
+				RealtimeSlowConsumerDropNewest, RealtimeSlowConsumerDropOldest, RealtimeSlowConsumerDisconnect,
+			)
+		}
+		if c.Realtime.MaxStreamsPerUser < MinRealtimeMaxStreams {
+			return fmt.Errorf(ErrRealtimeMaxStreamsPerUserMin, MinRealtimeMaxStreams)
+		}
+		if c.Realtime.MaxStreams < c.Realtime.MaxStreamsPerUser {
+			return fmt.Errorf(ErrRealtimeMaxStreamsMin, c.Realtime.MaxStreamsPerUser)
+		}
+		if c.Realtime.ShutdownRetry < MinRealtimeShutdownRetry {
+			return fmt.Errorf(ErrRealtimeShutdownRetryMin, MinRealtimeShutdownRetry)
+		}
+		if c.Realtime.ShutdownRetryJitter < 0 {
+			return errors.New(ErrRealtimeShutdownRetryJitterNegative)
+		}
+		switch c.Realtime.Fanout {
+		case RealtimeFanoutLocal:
+		case RealtimeFanoutRedis:
+			if c.Realtime.ReplayStore != RealtimeReplayStoreRedis {
+				return errors.New(ErrRealtimeFanoutNeedsRedisReplay)
+			}
+		default:
+			return fmt.Errorf(ErrRealtimeFanoutInvalid, RealtimeFanoutLocal, RealtimeFanoutRedis)
+		}
+	}
+	return nil
+}
+
+// validateRecordProjector checks the built-in projector only when it is enabled. It reads the
+// record events from Kafka or the in-process bus, so the outbox must publish to one of them.
+func (c *Config) validateRecordProjector() error {
+	if !c.RecordProjector.Enabled {
+		return nil
+	}
+	if c.RecordProjector.ConsumerGroup == "" {
+		return errors.New(ErrRecordProjectorConsumerGroupEmpty)
+	}
+	if c.Outbox.Transport != OutboxTransportKafka && c.Outbox.Transport != OutboxTransportInProcess {
+		return fmt.Errorf(ErrRecordProjectorTransportInvalid, OutboxTransportKafka, OutboxTransportInProcess)
+	}
+	return nil
+}
+
+// validateRecordTrash checks the purge job settings only when the job is enabled.
+func (c *Config) validateRecordTrash() error {
+	if !c.RecordTrash.PurgeEnabled {
+		return nil
+	}
+	if c.RecordTrash.RetentionDays < MinRecordTrashRetentionDays {
+		return fmt.Errorf(ErrRecordTrashRetentionDaysMin, MinRecordTrashRetentionDays)
+	}
+	if c.RecordTrash.PurgeInterval < MinRecordTrashPurgeInterval {
+		return fmt.Errorf(ErrRecordTrashPurgeIntervalMin, MinRecordTrashPurgeInterval)
+	}
+	if c.RecordTrash.PurgeBatchSize < MinRecordTrashPurgeBatchSize {
+		return fmt.Errorf(ErrRecordTrashPurgeBatchSizeMin, MinRecordTrashPurgeBatchSize)
 	}
 	return nil
 }
 
+// validateOutboxTransport checks the settings of the selected OUTBOX_TRANSPORT only.
+func (c *Config) validateOutboxTransport() error {
+	switch c.Outbox.Transport {
+	case OutboxTransportKafka, OutboxTransportInProcess:
+		return nil
+	case OutboxTransportWebhook:
+		target, err := url.Parse(c.Outbox.WebhookURL)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return errors.New(ErrOutboxWebhookURLInvalid)
+		}
+		if c.Outbox.WebhookTimeout < MinOutboxWebhookTimeout {
+			return fmt.Errorf(ErrOutboxWebhookTimeoutMin, MinOutboxWebhookTimeout)
+		}
+		return nil
+	case OutboxTransportFile:
+		if strings.TrimSpace(c.Outbox.FilePath) == "" {
+			return errors.New(ErrOutboxFilePathEmpty)
+		}
+		return nil
+	default:
+		return fmt.Errorf(ErrOutboxTransportInvalid,
+			OutboxTransportKafka, OutboxTransportInProcess, OutboxTransportWebhook, OutboxTransportFile)
+	}
+}
+
 func (c *Config) validateHTTP() error {
 	if c.ServerHTTP.Host == "" {
 		return errors.New(ErrHTTPHostRequired)
@@ -185,6 +346,9 @@ func (c *Config) validateGraphQL() error {
 	if c.ServerGraphql.Path[0] != '/' {
 		return errors.New(ErrGraphqlPathMustStart)
 	}
+	if c.ServerGraphql.MaxSubscriptionsPerConnection < MinGraphqlSubscriptionsPerConnection {
+		return fmt.Errorf(ErrGraphqlMaxSubscriptionsPerConnMin, MinGraphqlSubscriptionsPerConnection)
+	}
 	return nil
 }
 
@@ -25,7 +25,8 @@ func baseConfig() config.Config {
 			MaxHeaderBytes:    1024,
 		},
 		ServerGraphql: config.ServerGraphql{
-			Path: "/graphql",
+			Path:                          "/graphql",
+			MaxSubscriptionsPerConnection: 10,
 		},
 		Cache: config.CacheConfig{
 			Addr:     "redis:6379",
@@ -54,17 +55,46 @@ func baseConfig() config.Config {
 			Brokers:                     "kafka:9092",
 			RecordEventsTopic:           "aion.record.events.v1",
 			RecordProjectionEventsTopic: "aion.record_projection.events.v1",
+			TagEventsTopic:              "aion.tag.events.v1",
+			CategoryEventsTopic:         "aion.category.events.v1",
+			UserEventsTopic:             "aion.user.events.v1",
+			MetricDefinitionEventsTopic: "aion.metric_definition.events.v1",
 		},
 		Outbox: config.OutboxConfig{
-			PublishEnabled:  true,
-			PublishInterval: 2 * time.Second,
-			BatchSize:       50,
+			PublishEnabled:     true,
+			ListenEnabled:      true,
+			PublishInterval:    2 * time.Second,
+			BatchSize:          50,
+			MaxAttempts:        10,
+			BackoffBase:        5 * time.Second,
+			BackoffMax:         10 * time.Minute,
+			LeaseDuration:      30 * time.Second,
+			Ordering:           "aggregate",
+			Transport:          "kafka",
+			EnvelopeFormat:     "legacy",
+			WebhookTimeout:     5 * time.Second,
+			ListenRetry:        5 * time.Second,
+			RetentionEnabled:   true,
+			RetentionPeriod:    168 * time.Hour,
+			RetentionInterval:  time.Hour,
+			RetentionBatchSize: 500,
 		},
 		Realtime: config.RealtimeConfig{
 			Enabled:             true,
 			StreamPath:          "/events/stream",
+			Source:              "kafka",
 			HeartbeatInterval:   15 * time.Second,
 			SubscriberBuffer:    32,
+			ReplayStore:         "memory",
+			ReplayBuffer:        256,
+			ReplayTTL:           24 * time.Hour,
+			Fanout:              "local",
+			TicketTTL:           30 * time.Second,
+			SlowConsumerPolicy:  "drop_newest",
+			MaxStreamsPerUser:   5,
+			MaxStreams:          10000,
+			ShutdownRetry:       time.Second,
+			ShutdownRetryJitter: 5 * time.Second,
 			ConsumerGroupPrefix: "aion-api-realtime",
 		},
 		Application: config.Application{
@@ -95,6 +125,10 @@ func TestConfigValidate_HTTPAndGraphQLErrors(t *testing.T) {
 	cfg = baseConfig()
 	cfg.ServerGraphql.Path = "graphql"
 	require.EqualError(t, cfg.Validate(), config.ErrGraphqlPathMustStart)
+
+	cfg = baseConfig()
+	cfg.ServerGraphql.MaxSubscriptionsPerConnection = 0
+	require.EqualError(t, cfg.Validate(), "GRAPHQL_MAX_SUBSCRIPTIONS_PER_CONNECTION must be at least 1")
 }
 
 func TestConfigValidate_HTTPPathEdgeCases(t *testing.T) {
@@ -176,10 +210,125 @@ func TestConfigValidate_ObservabilityAndAppErrors(t *testing.T) {
 	cfg.Outbox.BatchSize = 0
 	require.EqualError(t, cfg.Validate(), "OUTBOX_BATCH_SIZE must be at least 1")
 
+	cfg = baseConfig()
+	cfg.Outbox.MaxAttempts = 0
+	require.EqualError(t, cfg.Validate(), "OUTBOX_MAX_ATTEMPTS must be at least 1")
+
+	cfg = baseConfig()
+	cfg.Outbox.BackoffBase = 10 * time.Millisecond
+	require.EqualError(t, cfg.Validate(), "OUTBOX_BACKOFF_BASE must be at least 100ms")
+
+	cfg = baseConfig()
+	cfg.Outbox.BackoffMax = time.Second
+	require.EqualError(t, cfg.Validate(), config.ErrOutboxBackoffMaxBelowBase)
+
+	cfg = baseConfig()
+	cfg.Outbox.LeaseDuration = time.Second
+	require.EqualError(t, cfg.Validate(), "OUTBOX_LEASE_DURATION must be at least 5s")
+
+	cfg = baseConfig()
+	cfg.Outbox.Ordering = "fifo"
+	require.EqualError(t, cfg.Validate(), `OUTBOX_ORDERING must be "none" or "aggregate"`)
+
+	cfg = baseConfig()
+	cfg.Outbox.ListenRetry = 100 * time.Millisecond
+	require.EqualError(t, cfg.Validate(), "OUTBOX_LISTEN_RETRY must be at least 1s")
+
+	cfg.Outbox.ListenEnabled = false
+	require.NoError(t, cfg.Validate())
+
+	cfg = baseConfig()
+	cfg.Outbox.RetentionPeriod = time.Minute
+	require.EqualError(t, cfg.Validate(), "OUTBOX_RETENTION_PERIOD must be at least 1h0m0s")
+
+	cfg = baseConfig()
+	cfg.Outbox.RetentionInterval = time.Second
+	require.EqualError(t, cfg.Validate(), "OUTBOX_RETENTION_INTERVAL must be at least 1m0s")
+
+	cfg = baseConfig()
+	cfg.Outbox.RetentionBatchSize = 0
+	require.EqualError(t, cfg.Validate(), "OUTBOX_RETENTION_BATCH_SIZE must be at least 1")
+
+	cfg.Outbox.RetentionEnabled = false
+	require.NoError(t, cfg.Validate())
+
+	cfg = baseConfig()
+	cfg.Outbox.Transport = "nats"
+	require.EqualError(t, cfg.Validate(), `OUTBOX_TRANSPORT must be one of "kafka", "inprocess", "webhook", or "file"`)
+
+	cfg.Outbox.Transport = config.OutboxTransportInProcess
+	require.NoError(t, cfg.Validate())
+
+	cfg = baseConfig()
+	cfg.Outbox.Transport = config.OutboxTransportWebhook
+	cfg.Outbox.WebhookURL = "hooks.local/outbox"
+	require.EqualError(t, cfg.Validate(), config.ErrOutboxWebhookURLInvalid)
+
+	cfg.Outbox.WebhookURL = "http://hooks.local/outbox"
+	cfg.Outbox.WebhookTimeout = time.Millisecond
+	require.EqualError(t, cfg.Validate(), "OUTBOX_WEBHOOK_TIMEOUT must be at least 100ms")
+
+	cfg.Outbox.WebhookTimeout = time.Second
+	require.NoError(t, cfg.Validate())
+
+	cfg = baseConfig()
+	cfg.Outbox.Transport = config.OutboxTransportFile
+	cfg.Outbox.FilePath = " "
+	require.EqualError(t, cfg.Validate(), config.ErrOutboxFilePathEmpty)
+
+	cfg.Outbox.FilePath = "/var/lib/aion/outbox.ndjson"
+	require.NoError(t, cfg.Validate())
+
+	cfg = baseConfig()
+	cfg.Outbox.EnvelopeFormat = "avro"
+	require.EqualError(t, cfg.Validate(), `OUTBOX_ENVELOPE_FORMAT must be one of "legacy", "cloudevents-binary", or "cloudevents-structured"`)
+
+	cfg.Outbox.EnvelopeFormat = config.OutboxEnvelopeCloudEventsBinary
+	require.NoError(t, cfg.Validate())
+
+	cfg = baseConfig()
+	cfg.Realtime.Source = "redis"
+	require.EqualError(t, cfg.Validate(), `REALTIME_SOURCE must be "kafka" or "inprocess"`)
+
+	cfg = baseConfig()
+	cfg.RecordProjector.Enabled = true
+	require.EqualError(t, cfg.Validate(), config.ErrRecordProjectorConsumerGroupEmpty)
+
+	cfg.RecordProjector.ConsumerGroup = "aion-api-record-projector"
+	cfg.Outbox.Transport = config.OutboxTransportFile
+	cfg.Outbox.FilePath = "./tmp/outbox/events.ndjson"
+	require.EqualError(t, cfg.Validate(), `RECORD_PROJECTOR_ENABLED requires OUTBOX_TRANSPORT "kafka" or "inprocess"`)
+
+	cfg.Outbox.Transport = config.OutboxTransportInProcess
+	require.NoError(t, cfg.Validate())
+
+	cfg = baseConfig()
+	cfg.RecordTrash = config.RecordTrashConfig{PurgeEnabled: true, PurgeInterval: time.Hour, PurgeBatchSize: 500}
+	require.EqualError(t, cfg.Validate(), "RECORD_TRASH_RETENTION_DAYS must be at least 1")
+
+	cfg.RecordTrash.RetentionDays = 30
+	cfg.RecordTrash.PurgeInterval = time.Second
+	require.EqualError(t, cfg.Validate(), "RECORD_TRASH_PURGE_INTERVAL must be at least 1m0s")
+
+	cfg.RecordTrash.PurgeInterval = time.Hour
+	cfg.RecordTrash.PurgeBatchSize = 0
+	require.EqualError(t, cfg.Validate(), "RECORD_TRASH_PURGE_BATCH_SIZE must be at least 1")
+
+	cfg.RecordTrash.PurgeBatchSize = 500
+	require.NoError(t, cfg.Validate())
+
 	cfg = baseConfig()
 	cfg.Kafka.RecordProjectionEventsTopic = ""
 	require.EqualError(t, cfg.Validate(), config.ErrKafkaRecordProjectionEventsTopicEmpty)
 
+	cfg = baseConfig()
+	cfg.Kafka.TagEventsTopic = ""
+	require.EqualError(t, cfg.Validate(), config.ErrKafkaTagEventsTopicEmpty)
+
+	cfg = baseConfig()
+	cfg.Kafka.MetricDefinitionEventsTopic = ""
+	require.EqualError(t, cfg.Validate(), config.ErrKafkaMetricDefinitionEventsTopicEmpty)
+
 	cfg = baseConfig()
 	cfg.Realtime.StreamPath = "/"
 	require.EqualError(t, cfg.Validate(), config.ErrRealtimeStreamPathTooShort)
@@ -187,4 +336,52 @@ func TestConfigValidate_ObservabilityAndAppErrors(t *testing.T) {
 	cfg = baseConfig()
 	cfg.Realtime.HeartbeatInterval = 500 * time.Millisecond
 	require.EqualError(t, cfg.Validate(), "REALTIME_HEARTBEAT_INTERVAL must be at least 1s")
+
+	cfg = baseConfig()
+	cfg.Realtime.ReplayStore = "postgres"
+	require.EqualError(t, cfg.Validate(), `REALTIME_REPLAY_STORE must be "memory" or "redis"`)
+
+	cfg = baseConfig()
+	cfg.Realtime.ReplayBuffer = 0
+	require.EqualError(t, cfg.Validate(), "REALTIME_REPLAY_BUFFER must be at least 1")
+
+	cfg = baseConfig()
+	cfg.Realtime.ReplayStore = config.RealtimeReplayStoreRedis
+	cfg.Realtime.ReplayTTL = time.Second
+	require.EqualError(t, cfg.Validate(), "REALTIME_REPLAY_TTL must be at least 1m0s")
+
+	cfg = baseConfig()
+	cfg.Realtime.TicketTTL = 10 * time.Minute
+	require.EqualError(t, cfg.Validate(), "REALTIME_TICKET_TTL must be between 1s and 5m0s")
+
+	cfg = baseConfig()
+	cfg.Realtime.SlowConsumerPolicy = "block"
+	require.EqualError(t, cfg.Validate(), `REALTIME_SLOW_CONSUMER_POLICY must be "drop_newest", "drop_oldest" or "disconnect"`)
+
+	cfg = baseConfig()
+	cfg.Realtime.MaxStreamsPerUser = 0
+	require.EqualError(t, cfg.Validate(), "REALTIME_MAX_STREAMS_PER_USER must be at least 1")
+
+	cfg = baseConfig()
+	cfg.Realtime.MaxStreams = 4
+	require.EqualError(t, cfg.Validate(), "REALTIME_MAX_STREAMS must be at least REALTIME_MAX_STREAMS_PER_USER (5)")
+
+	cfg = baseConfig()
+	cfg.Realtime.ShutdownRetry = 0
+	require.EqualError(t, cfg.Validate(), "REALTIME_SHUTDOWN_RETRY must be at least 100ms")
+
+	cfg = baseConfig()
+	cfg.Realtime.ShutdownRetryJitter = -time.Second
+	require.EqualError(t, cfg.Validate(), "REALTIME_SHUTDOWN_RETRY_JITTER cannot be negative")
+
+	cfg = baseConfig()
+	cfg.Realtime.Fanout = "nats"
+	require.EqualError(t, cfg.Validate(), `REALTIME_FANOUT must be "local" or "redis"`)
+
+	cfg = baseConfig()
+	cfg.Realtime.Fanout = config.RealtimeFanoutRedis
+	require.EqualError(t, cfg.Validate(), config.ErrRealtimeFanoutNeedsRedisReplay)
+
+	cfg.Realtime.ReplayStore = config.RealtimeReplayStoreRedis
+	require.NoError(t, cfg.Validate())
 }
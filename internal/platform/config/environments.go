@@ -31,24 +31,74 @@ type KafkaConfig struct {
 	Brokers                     string `envconfig:"KAFKA_BROKERS"                        default:"kafka:9092"`
 	RecordEventsTopic           string `envconfig:"KAFKA_TOPIC_RECORD_EVENTS"            default:"aion.record.events.v1"`
 	RecordProjectionEventsTopic string `envconfig:"KAFKA_TOPIC_RECORD_PROJECTION_EVENTS" default:"aion.record_projection.events.v1"`
+	TagEventsTopic              string `envconfig:"KAFKA_TOPIC_TAG_EVENTS"               default:"aion.tag.events.v1"`
+	CategoryEventsTopic         string `envconfig:"KAFKA_TOPIC_CATEGORY_EVENTS"          default:"aion.category.events.v1"`
+	UserEventsTopic             string `envconfig:"KAFKA_TOPIC_USER_EVENTS"              default:"aion.user.events.v1"`
+	MetricDefinitionEventsTopic string `envconfig:"KAFKA_TOPIC_METRIC_DEFINITION_EVENTS" default:"aion.metric_definition.events.v1"`
 }
 
 // OutboxConfig holds runtime controls for the outbox publisher loop.
+// LeaseOwner defaults to hostname and pid when empty.
 type OutboxConfig struct {
-	PublishEnabled  bool          `envconfig:"OUTBOX_PUBLISH_ENABLED"  default:"true"`
-	PublishInterval time.Duration `envconfig:"OUTBOX_PUBLISH_INTERVAL" default:"2s"`
-	BatchSize       int           `envconfig:"OUTBOX_BATCH_SIZE"       default:"50"`
+	LeaseOwner         string        `envconfig:"OUTBOX_LEASE_OWNER"`
+	Ordering           string        `envconfig:"OUTBOX_ORDERING"             default:"aggregate"`
+	ArchiveDir         string        `envconfig:"OUTBOX_ARCHIVE_DIR"`
+	Transport          string        `envconfig:"OUTBOX_TRANSPORT"            default:"kafka"`
+	EnvelopeFormat     string        `envconfig:"OUTBOX_ENVELOPE_FORMAT"      default:"legacy"`
+	WebhookURL         string        `envconfig:"OUTBOX_WEBHOOK_URL"`
+	FilePath           string        `envconfig:"OUTBOX_FILE_PATH"            default:"./tmp/outbox/events.ndjson"`
+	PublishEnabled     bool          `envconfig:"OUTBOX_PUBLISH_ENABLED"      default:"true"`
+	ListenEnabled      bool          `envconfig:"OUTBOX_LISTEN_ENABLED"       default:"true"`
+	RetentionEnabled   bool          `envconfig:"OUTBOX_RETENTION_ENABLED"    default:"true"`
+	PublishInterval    time.Duration `envconfig:"OUTBOX_PUBLISH_INTERVAL"     default:"2s"`
+	BatchSize          int           `envconfig:"OUTBOX_BATCH_SIZE"           default:"50"`
+	MaxAttempts        int           `envconfig:"OUTBOX_MAX_ATTEMPTS"         default:"10"`
+	BackoffBase        time.Duration `envconfig:"OUTBOX_BACKOFF_BASE"         default:"5s"`
+	BackoffMax         time.Duration `envconfig:"OUTBOX_BACKOFF_MAX"          default:"10m"`
+	LeaseDuration      time.Duration `envconfig:"OUTBOX_LEASE_DURATION"       default:"30s"`
+	ListenRetry        time.Duration `envconfig:"OUTBOX_LISTEN_RETRY"         default:"5s"`
+	RetentionPeriod    time.Duration `envconfig:"OUTBOX_RETENTION_PERIOD"     default:"168h"`
+	RetentionInterval  time.Duration `envconfig:"OUTBOX_RETENTION_INTERVAL"   default:"1h"`
+	RetentionBatchSize int           `envconfig:"OUTBOX_RETENTION_BATCH_SIZE" default:"500"`
+	WebhookTimeout     time.Duration `envconfig:"OUTBOX_WEBHOOK_TIMEOUT"      default:"5s"`
 }
 
 // RealtimeConfig holds runtime controls for SSE and projection event fanout.
 type RealtimeConfig struct {
 	StreamPath          string        `envconfig:"REALTIME_STREAM_PATH"           default:"/events/stream"`
+	Source              string        `envconfig:"REALTIME_SOURCE"                default:"kafka"`
 	ConsumerGroupPrefix string        `envconfig:"REALTIME_CONSUMER_GROUP_PREFIX" default:"aion-api-realtime"`
 	HeartbeatInterval   time.Duration `envconfig:"REALTIME_HEARTBEAT_INTERVAL"    default:"15s"`
 	SubscriberBuffer    int           `envconfig:"REALTIME_SUBSCRIBER_BUFFER"     default:"32"`
+	ReplayStore         string        `envconfig:"REALTIME_REPLAY_STORE"          default:"memory"`
+	ReplayBuffer        int           `envconfig:"REALTIME_REPLAY_BUFFER"         default:"256"`
+	ReplayTTL           time.Duration `envconfig:"REALTIME_REPLAY_TTL"            default:"24h"`
+	Fanout              string        `envconfig:"REALTIME_FANOUT"                default:"local"`
+	TicketTTL           time.Duration `envconfig:"REALTIME_TICKET_TTL"            default:"30s"`
+	SlowConsumerPolicy  string        `envconfig:"REALTIME_SLOW_CONSUMER_POLICY"  default:"drop_newest"`
+	MaxStreamsPerUser   int           `envconfig:"REALTIME_MAX_STREAMS_PER_USER"  default:"5"`
+	MaxStreams          int           `envconfig:"REALTIME_MAX_STREAMS"           default:"10000"`
+	ShutdownRetry       time.Duration `envconfig:"REALTIME_SHUTDOWN_RETRY"        default:"1s"`
+	ShutdownRetryJitter time.Duration `envconfig:"REALTIME_SHUTDOWN_RETRY_JITTER" default:"5s"`
 	Enabled             bool          `envconfig:"REALTIME_ENABLED"               default:"true"`
 }
 
+// RecordProjectorConfig controls the built-in record projector, which materializes
+// aion_derived.record_projection_v1 when aion-streams is not deployed.
+type RecordProjectorConfig struct {
+	ConsumerGroup string `envconfig:"RECORD_PROJECTOR_CONSUMER_GROUP" default:"aion-api-record-projector"`
+	Enabled       bool   `envconfig:"RECORD_PROJECTOR_ENABLED"        default:"false"`
+}
+
+// RecordTrashConfig controls how long soft-deleted records stay restorable before the purge
+// job deletes them for good.
+type RecordTrashConfig struct {
+	PurgeEnabled   bool          `envconfig:"RECORD_TRASH_PURGE_ENABLED"    default:"true"`
+	RetentionDays  int           `envconfig:"RECORD_TRASH_RETENTION_DAYS"   default:"30"`
+	PurgeInterval  time.Duration `envconfig:"RECORD_TRASH_PURGE_INTERVAL"   default:"1h"`
+	PurgeBatchSize int           `envconfig:"RECORD_TRASH_PURGE_BATCH_SIZE" default:"500"`
+}
+
 // CacheConfig holds Redis cache configuration.
 // Each bounded context uses a separate Redis database for isolation.
 type CacheConfig struct {
@@ -61,6 +111,7 @@ type CacheConfig struct {
 	RecordDB   int `envconfig:"CACHE_RECORD_DB"   default:"3"`
 	UserDB     int `envconfig:"CACHE_USER_DB"     default:"4"`
 	ChatDB     int `envconfig:"CACHE_CHAT_DB"     default:"5"`
+	RealtimeDB int `envconfig:"CACHE_REALTIME_DB" default:"6"`
 
 	PoolSize       int           `envconfig:"CACHE_POOL_SIZE"       default:"10"`
 	ConnectTimeout time.Duration `envconfig:"CACHE_CONNECT_TIMEOUT" default:"5s"`
@@ -87,6 +138,9 @@ type ServerGraphql struct {
 	ReadHeaderTimeout time.Duration `envconfig:"GRAPHQL_READ_HEADER_TIMEOUT" default:"5s"`
 	IdleTimeout       time.Duration `envconfig:"GRAPHQL_IDLE_TIMEOUT"        default:"60s"`
 	MaxHeaderBytes    int           `envconfig:"GRAPHQL_MAX_HEADER_BYTES"    default:"1048576"`
+
+	// MaxSubscriptionsPerConnection caps the concurrent subscriptions one websocket connection may run.
+	MaxSubscriptionsPerConnection int `envconfig:"GRAPHQL_MAX_SUBSCRIPTIONS_PER_CONNECTION" default:"10"`
 }
 
 // ServerHTTP holds HTTP server configuration.
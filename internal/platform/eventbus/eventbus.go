@@ -0,0 +1,87 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+)
+
+// Message is one event delivered through the bus. Key and Headers mirror the broker message
+// metadata so adapters can encode the same envelope for every transport.
+type Message struct {
+	Topic   string
+	Key     string
+	Value   []byte
+	Headers map[string]string
+}
+
+// Bus fans messages out to every subscriber of a topic inside the current process.
+// Nothing is retained: a message published while a topic has no subscriber is dropped.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[*subscription]struct{}
+}
+
+type subscription struct {
+	ch   chan Message
+	done chan struct{}
+	once sync.Once
+}
+
+// New creates an empty bus.
+func New() *Bus {
+	return &Bus{subscribers: make(map[string]map[*subscription]struct{})}
+}
+
+// Publish delivers msg to every current subscriber of msg.Topic. It waits for room in each
+// subscriber buffer, so a slow reader applies backpressure to the publisher; the wait ends
+// with ctx.Err() once ctx is done.
+func (b *Bus) Publish(ctx context.Context, msg Message) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subscribers[msg.Topic] {
+		select {
+		case sub.ch <- msg:
+		case <-sub.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Subscribe registers a reader for topic. The returned cancel function unregisters it and
+// closes the channel; it is safe to call more than once.
+func (b *Bus) Subscribe(topic string, buffer int) (<-chan Message, func()) {
+	if buffer <= 0 {
+		buffer = DefaultSubscriberBuffer
+	}
+	sub := &subscription{
+		ch:   make(chan Message, buffer),
+		done: make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	if b.subscribers[topic] == nil {
+		b.subscribers[topic] = make(map[*subscription]struct{})
+	}
+	b.subscribers[topic][sub] = struct{}{}
+	b.mu.Unlock()
+
+	cancel := func() {
+		sub.once.Do(func() {
+			// Release publishers blocked on this subscriber before waiting for the write lock.
+			close(sub.done)
+
+			b.mu.Lock()
+			delete(b.subscribers[topic], sub)
+			if len(b.subscribers[topic]) == 0 {
+				delete(b.subscribers, topic)
+			}
+			b.mu.Unlock()
+
+			close(sub.ch)
+		})
+	}
+	return sub.ch, cancel
+}
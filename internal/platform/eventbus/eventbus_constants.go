@@ -0,0 +1,7 @@
+// Package eventbus provides an in-process topic bus used when the application runs without a broker.
+package eventbus
+
+const (
+	// DefaultSubscriberBuffer is the channel capacity used when Subscribe receives a non-positive buffer.
+	DefaultSubscriberBuffer = 64
+)
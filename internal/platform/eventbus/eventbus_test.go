@@ -0,0 +1,98 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+)
+
+func TestPublishFansOutToTopicSubscribers(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	first, cancelFirst := bus.Subscribe("records", 1)
+	defer cancelFirst()
+	second, cancelSecond := bus.Subscribe("records", 1)
+	defer cancelSecond()
+	other, cancelOther := bus.Subscribe("tags", 1)
+	defer cancelOther()
+
+	msg := eventbus.Message{Topic: "records", Key: "42", Value: []byte(`{}`)}
+	if err := bus.Publish(context.Background(), msg); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	for _, ch := range []<-chan eventbus.Message{first, second} {
+		got := <-ch
+		if got.Key != "42" {
+			t.Fatalf("unexpected message: %#v", got)
+		}
+	}
+	select {
+	case got := <-other:
+		t.Fatalf("unexpected delivery to another topic: %#v", got)
+	default:
+	}
+}
+
+func TestPublishWithoutSubscribersDropsMessage(t *testing.T) {
+	t.Parallel()
+
+	if err := eventbus.New().Publish(context.Background(), eventbus.Message{Topic: "records"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestPublishBlocksUntilContextDoneWhenSubscriberIsFull(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	_, cancel := bus.Subscribe("records", 1)
+	defer cancel()
+
+	if err := bus.Publish(context.Background(), eventbus.Message{Topic: "records"}); err != nil {
+		t.Fatalf("first publish: %v", err)
+	}
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancelCtx()
+	if err := bus.Publish(ctx, eventbus.Message{Topic: "records"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestCancelReleasesBlockedPublisherAndClosesChannel(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	ch, cancel := bus.Subscribe("records", 1)
+	if err := bus.Publish(context.Background(), eventbus.Message{Topic: "records"}); err != nil {
+		t.Fatalf("first publish: %v", err)
+	}
+
+	published := make(chan error, 1)
+	go func() {
+		published <- bus.Publish(context.Background(), eventbus.Message{Topic: "records"})
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	cancel()
+
+	select {
+	case err := <-published:
+		if err != nil {
+			t.Fatalf("expected blocked publish to return cleanly, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("publisher still blocked after cancel")
+	}
+
+	<-ch
+	if _, ok := <-ch; ok {
+		t.Fatal("expected channel to be closed after cancel")
+	}
+}
@@ -1,6 +1,8 @@
 // Package fxapp provides constants for dependency injection wiring.
 package fxapp
 
+import "time"
+
 const (
 	// Context names for cache initialization logging.
 	contextNameAuth     = "auth"
@@ -9,6 +11,7 @@ const (
 	contextNameRecord   = "record"
 	contextNameUser     = "user"
 	contextNameChat     = "chat"
+	contextNameRealtime = "realtime"
 
 	// Log messages for infrastructure lifecycle.
 	logMsgConfigLoaded      = "configuration loaded"
@@ -20,9 +23,31 @@ const (
 	logMsgServerStarting    = "servers starting..."
 	logMsgServerError       = "http server error"
 
+	logMsgRealtimeFanoutStarted = "realtime fan-out receiver started"
+	logMsgRealtimeFanoutStopped = "realtime fan-out receiver stopped"
+	logMsgRealtimeFanoutFailed  = "realtime fan-out receive failed"
+	logMsgRealtimeDraining      = "realtime streams signalled to reconnect, draining before http shutdown"
+
+	logMsgRecordProjectorDisabled     = "record projector disabled by configuration"
+	logMsgRecordProjectorStarted      = "record projector started"
+	logMsgRecordProjectorStopped      = "record projector stopped"
+	logMsgRecordProjectorReadFailed   = "record projector read failed"
+	logMsgRecordProjectorSkipped      = "record projector gave up on event, projection may drift until reconciled"
+	logMsgRecordProjectorCommitFailed = "record projector commit failed"
+
+	logMsgRecordTrashPurgeDisabled = "record trash purge disabled by configuration"
+	logMsgRecordTrashPurgeStarted  = "record trash purge started"
+	logMsgRecordTrashPurgeStopped  = "record trash purge stopped"
+	logMsgRecordTrashPurgeFailed   = "record trash purge run failed"
+
+	// Record projector retry policy for failed projection writes.
+	recordProjectorAttempts   = 5
+	recordProjectorRetryDelay = 500 * time.Millisecond
+
 	// Database type identifier.
 	dbTypePostgresql = "postgresql"
 
 	// Error message formatting.
-	errMsgHTTPShutdown = "http shutdown: %w"
+	errMsgHTTPShutdown                 = "http shutdown: %w"
+	errMsgInProcessTransportStandalone = "OUTBOX_TRANSPORT=inprocess only works inside the api process; run cmd/api instead of the standalone publisher"
 )
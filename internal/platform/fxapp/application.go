@@ -18,6 +18,7 @@ import (
 	chatClient "github.com/lechitz/aion-api/internal/chat/adapter/secondary/http"
 	chat "github.com/lechitz/aion-api/internal/chat/core/usecase"
 	eventOutboxRepo "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/repository"
+	eventOutboxOutput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
 	eventOutbox "github.com/lechitz/aion-api/internal/eventoutbox/core/usecase"
 	"github.com/lechitz/aion-api/internal/platform/app"
 	"github.com/lechitz/aion-api/internal/platform/config"
@@ -25,6 +26,9 @@ import (
 	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/httpclient"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	realtimeDomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
+	realtimeInput "github.com/lechitz/aion-api/internal/realtime/core/ports/input"
+	realtimeOutput "github.com/lechitz/aion-api/internal/realtime/core/ports/output"
 	realtime "github.com/lechitz/aion-api/internal/realtime/core/usecase"
 	recordCache "github.com/lechitz/aion-api/internal/record/adapter/secondary/cache"
 	recordRepo "github.com/lechitz/aion-api/internal/record/adapter/secondary/db/repository"
@@ -60,6 +64,10 @@ type appDepsParams struct {
 	UserCache     cache.Cache `name:"userCache"`
 	ChatCache     cache.Cache `name:"chatCache"`
 	HTTPClient    httpclient.HTTPClient
+	Schemas       eventOutboxOutput.SchemaRegistry
+	Replay        realtimeOutput.ReplayStore
+	Fanout        realtimeOutput.FanoutBroker
+	Tickets       realtimeOutput.TicketStore
 	Log           logger.ContextLogger
 }
 
@@ -94,31 +102,56 @@ func ProvideAppDependencies(deps appDepsParams) *AppDependencies {
 	chatHistoryCacheStore := chatCache.NewStore(deps.ChatCache, deps.Log)
 	chatHTTPClient := chatClient.New(deps.HTTPClient, deps.Cfg.AionChat.BaseURL, deps.Log)
 	auditService := audit.NewService(auditActionEventRepository, deps.Log)
-	outboxService := eventOutbox.NewService(eventOutboxRepository, deps.Log)
-	realtimeService := realtime.NewService(deps.Log, deps.Cfg.Realtime.SubscriberBuffer)
+	outboxService := eventOutbox.NewService(eventOutboxRepository, deps.Schemas, deps.Log)
+	deadLetterService := eventOutbox.NewDeadLetterService(eventOutboxRepository, deps.Log)
+	realtimeService := realtime.NewService(deps.Log, deps.Cfg.Realtime.SubscriberBuffer).
+		WithReplayStore(deps.Replay).
+		WithFanout(deps.Fanout).
+		WithSlowConsumerPolicy(realtimeDomain.SlowConsumerPolicy(deps.Cfg.Realtime.SlowConsumerPolicy)).
+		WithStreamLimits(deps.Cfg.Realtime.MaxStreamsPerUser, deps.Cfg.Realtime.MaxStreams)
+	realtimeTicketService := realtime.NewTicketService(deps.Tickets, deps.Cfg.Realtime.TicketTTL, deps.Log).
+		WithAudit(auditService)
+
+	// Producers announce committed changes only while streams are served.
+	var realtimePublisher realtimeInput.Publisher
+	if deps.Cfg.Realtime.Enabled {
+		realtimePublisher = realtimeService
+	}
 
 	authService := auth.NewService(adminRepository, authCacheStore, userRepository, userCacheStore, authCacheStore, tokenProvider, hasherProvider, deps.Log)
-	userService := user.NewService(userRepository, userRepository, userCacheStore, avatarStorage, authCacheStore, tokenProvider, hasherProvider, deps.Log)
+	userService := user.NewService(userRepository, userRepository, userCacheStore, avatarStorage, authCacheStore, tokenProvider, hasherProvider, deps.Log).
+		WithOutbox(outboxService).
+		WithTransactionManager(deps.DB)
 	adminService := admin.NewService(adminRepository, authCacheStore, authCacheStore, deps.Log)
-	categoryService := category.NewService(categoryRepository, categoryCacheStore, deps.Log)
-	tagService := tag.NewService(tagRepository, tagCacheStore, deps.Log)
+	categoryService := category.NewService(categoryRepository, categoryCacheStore, deps.Log).
+		WithOutbox(outboxService).
+		WithTransactionManager(deps.DB).
+		WithRealtime(realtimePublisher)
+	tagService := tag.NewService(tagRepository, tagCacheStore, deps.Log).
+		WithOutbox(outboxService).
+		WithTransactionManager(deps.DB).
+		WithRealtime(realtimePublisher)
 	recordService := record.NewService(recordRepository, recordCacheStore, tagRepository, deps.Log).
 		WithOutbox(outboxService).
 		WithTransactionManager(deps.DB).
-		WithProjectionReader(recordRepository)
-	chatService := chat.NewService(chatHTTPClient, chatHistoryRepository, chatHistoryCacheStore, auditService, deps.Log)
+		WithProjectionReader(recordRepository).
+		WithRealtime(realtimePublisher)
+	chatService := chat.NewService(chatHTTPClient, chatHistoryRepository, chatHistoryCacheStore, auditService, deps.Log).
+		WithRealtime(realtimePublisher)
 
 	return &AppDependencies{
-		AuthService:     authService,
-		UserService:     userService,
-		AdminService:    adminService,
-		CategoryService: categoryService,
-		TagService:      tagService,
-		RecordService:   recordService,
-		ChatService:     chatService,
-		AuditService:    auditService,
-		OutboxService:   outboxService,
-		RealtimeService: realtimeService,
-		Logger:          deps.Log,
+		AuthService:           authService,
+		UserService:           userService,
+		AdminService:          adminService,
+		CategoryService:       categoryService,
+		TagService:            tagService,
+		RecordService:         recordService,
+		ChatService:           chatService,
+		AuditService:          auditService,
+		OutboxService:         outboxService,
+		DeadLetterService:     deadLetterService,
+		RealtimeService:       realtimeService,
+		RealtimeTicketService: realtimeTicketService,
+		Logger:                deps.Log,
 	}
 }
@@ -8,8 +8,22 @@ import (
 	"testing"
 	"time"
 
+	categoryRepo "github.com/lechitz/aion-api/internal/category/adapter/secondary/db/repository"
+	categoryOutput "github.com/lechitz/aion-api/internal/category/core/ports/output"
+	category "github.com/lechitz/aion-api/internal/category/core/usecase"
+	eventOutboxRepo "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/repository"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/outboxtx"
+	eventOutbox "github.com/lechitz/aion-api/internal/eventoutbox/core/usecase"
 	"github.com/lechitz/aion-api/internal/platform/config"
 	httpclientPort "github.com/lechitz/aion-api/internal/platform/ports/output/httpclient"
+	recordRepo "github.com/lechitz/aion-api/internal/record/adapter/secondary/db/repository"
+	recordOutput "github.com/lechitz/aion-api/internal/record/core/ports/output"
+	record "github.com/lechitz/aion-api/internal/record/core/usecase"
+	tagRepo "github.com/lechitz/aion-api/internal/tag/adapter/secondary/db/repository"
+	tagOutput "github.com/lechitz/aion-api/internal/tag/core/ports/output"
+	tag "github.com/lechitz/aion-api/internal/tag/core/usecase"
+	userRepo "github.com/lechitz/aion-api/internal/user/adapter/secondary/db/repository"
+	userOutput "github.com/lechitz/aion-api/internal/user/core/ports/output"
 	"github.com/stretchr/testify/require"
 )
 
@@ -68,6 +82,33 @@ func TestProvideAppDependencies(t *testing.T) {
 	require.NotNil(t, got.RecordService)
 	require.NotNil(t, got.ChatService)
 	require.NotNil(t, got.RealtimeService)
+	require.NotNil(t, got.RealtimeTicketService)
+}
+
+func TestProvideAppDependencies_WiresRealtimeProducersOnlyWhenEnabled(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		got := ProvideAppDependencies(appDepsParams{
+			Cfg:        &config.Config{Realtime: config.RealtimeConfig{Enabled: enabled}},
+			HTTPClient: stubHTTPClient{},
+			Log:        noopLoggerFx{},
+		})
+
+		require.Equal(t, enabled, got.TagService.(*tag.Service).Realtime != nil)
+		require.Equal(t, enabled, got.CategoryService.(*category.Service).Realtime != nil)
+		require.Equal(t, enabled, got.RecordService.(*record.Service).Realtime != nil)
+	}
+}
+
+// TestRepositoriesBindToOutboxTransactions guards the WithDB signatures outboxtx.Run asserts on;
+// a concrete return type silently disables the shared write+enqueue transaction.
+func TestRepositoriesBindToOutboxTransactions(t *testing.T) {
+	log := noopLoggerFx{}
+
+	require.Implements(t, (*outboxtx.RepositoryWithDB[recordOutput.RecordRepository])(nil), recordRepo.New(nil, log))
+	require.Implements(t, (*outboxtx.RepositoryWithDB[tagOutput.TagRepository])(nil), tagRepo.New(nil, log))
+	require.Implements(t, (*outboxtx.RepositoryWithDB[categoryOutput.CategoryRepository])(nil), categoryRepo.New(nil, log))
+	require.Implements(t, (*outboxtx.RepositoryWithDB[userOutput.UserRepository])(nil), userRepo.New(nil, log, nil))
+	require.Implements(t, (*outboxtx.ServiceWithDB)(nil), eventOutbox.NewService(eventOutboxRepo.NewEventRepository(nil, log), nil, log))
 }
 
 func TestProvideHTTPClientAndKeyGenerator(t *testing.T) {
@@ -9,7 +9,10 @@ import (
 	"github.com/lechitz/aion-api/internal/adapter/secondary/contextlogger"
 	"github.com/lechitz/aion-api/internal/adapter/secondary/crypto"
 	"github.com/lechitz/aion-api/internal/adapter/secondary/db/postgres"
+	"github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/schemaregistry"
+	eventOutboxOutput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
 	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
 	"github.com/lechitz/aion-api/internal/platform/httpclient"
 	"github.com/lechitz/aion-api/internal/platform/observability/metric"
 	"github.com/lechitz/aion-api/internal/platform/observability/tracer"
@@ -18,11 +21,17 @@ import (
 	httpclientPort "github.com/lechitz/aion-api/internal/platform/ports/output/httpclient"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/keygen"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	realtimeFanoutRedis "github.com/lechitz/aion-api/internal/realtime/adapter/secondary/fanout/redis"
+	realtimeReplayMemory "github.com/lechitz/aion-api/internal/realtime/adapter/secondary/replay/memory"
+	realtimeReplayRedis "github.com/lechitz/aion-api/internal/realtime/adapter/secondary/replay/redis"
+	realtimeTicketRedis "github.com/lechitz/aion-api/internal/realtime/adapter/secondary/ticket/redis"
+	realtimeOutput "github.com/lechitz/aion-api/internal/realtime/core/ports/output"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"go.uber.org/fx"
 )
 
-// InfraModule bundles core infrastructure providers (logger, config, tracer/metrics, cache, database, http client).
+// InfraModule bundles core infrastructure providers (logger, config, tracer/metrics, cache, database, http client, event bus,
+// event schema registry, realtime replay store, fan-out broker and stream ticket store).
 //
 //nolint:gochecknoglobals // Fx modules are intended as package-level options.
 var InfraModule = fx.Options(
@@ -33,6 +42,11 @@ var InfraModule = fx.Options(
 		ProvideCache,
 		ProvideDatabase,
 		ProvideHTTPClient,
+		ProvideEventBus,
+		ProvideEventSchemaRegistry,
+		ProvideRealtimeReplayStore,
+		ProvideRealtimeFanout,
+		ProvideRealtimeTicketStore,
 	),
 	fx.Invoke(InitObservability),
 )
@@ -221,3 +235,76 @@ func ProvideHTTPClient(cfg *config.Config) httpclientPort.HTTPClient {
 
 	return httpclient.NewClient(instrumentedHTTPClient)
 }
+
+// ProvideEventBus exposes the process-wide in-process event bus shared by the in-process
+// outbox publisher and the in-process realtime reader.
+func ProvideEventBus() *eventbus.Bus {
+	return eventbus.New()
+}
+
+// ProvideEventSchemaRegistry compiles the event payload schemas shared by outbox enqueue validation
+// and publisher upcasting. A broken schema file stops the process at startup.
+func ProvideEventSchemaRegistry() (eventOutboxOutput.SchemaRegistry, error) {
+	return schemaregistry.NewDefault()
+}
+
+// ProvideRealtimeReplayStore builds the realtime replay buffer selected by REALTIME_REPLAY_STORE: process
+// memory, or a Redis database shared by every API replica, closed on shutdown.
+func ProvideRealtimeReplayStore(lc fx.Lifecycle, cfg *config.Config, log logger.ContextLogger) (realtimeOutput.ReplayStore, error) {
+	if cfg.Realtime.ReplayStore != config.RealtimeReplayStoreRedis {
+		return realtimeReplayMemory.NewReplayStore(cfg.Realtime.ReplayBuffer), nil
+	}
+
+	store, err := realtimeReplayRedis.NewReplayStore(
+		context.Background(),
+		cfg.Cache,
+		cfg.Cache.RealtimeDB,
+		cfg.Realtime.ReplayBuffer,
+		cfg.Realtime.ReplayTTL,
+		log,
+	)
+	if err != nil {
+		return nil, err
+	}
+	log.Infow(logMsgCacheInit, commonkeys.Context, contextNameRealtime, commonkeys.DbNum, cfg.Cache.RealtimeDB)
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return store.Close()
+		},
+	})
+	return store, nil
+}
+
+// ProvideRealtimeFanout builds the cross-instance fan-out broker for REALTIME_FANOUT=redis and
+// returns nil for local fan-out, where each instance delivers the events it reads itself.
+func ProvideRealtimeFanout(lc fx.Lifecycle, cfg *config.Config, log logger.ContextLogger) (realtimeOutput.FanoutBroker, error) {
+	if cfg.Realtime.Fanout != config.RealtimeFanoutRedis {
+		return nil, nil
+	}
+
+	broker, err := realtimeFanoutRedis.NewFanoutBroker(context.Background(), cfg.Cache, cfg.Cache.RealtimeDB, log)
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return broker.Close()
+		},
+	})
+	return broker, nil
+}
+
+// ProvideRealtimeTicketStore keeps single-use stream tickets in the auth Redis database, so a ticket
+// issued by one API replica can be redeemed on any other; the connection is closed on shutdown.
+func ProvideRealtimeTicketStore(lc fx.Lifecycle, cfg *config.Config, log logger.ContextLogger) (realtimeOutput.TicketStore, error) {
+	store, err := realtimeTicketRedis.NewTicketStore(context.Background(), cfg.Cache, cfg.Cache.AuthDB, log)
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return store.Close()
+		},
+	})
+	return store, nil
+}
@@ -156,3 +156,45 @@ func TestProvideDatabaseReturnsErrorWhenPostgresUnavailable(t *testing.T) {
 	require.Nil(t, dbConn)
 	require.Empty(t, lc.hooks)
 }
+
+func TestProvideEventSchemaRegistryValidatesPayloads(t *testing.T) {
+	registry, err := ProvideEventSchemaRegistry()
+	require.NoError(t, err)
+
+	require.Error(t, registry.Validate("tag.created", "v1", []byte(`{"tag_id":1}`)))
+}
+
+func TestProvideRealtimeReplayStoreDefaultsToMemory(t *testing.T) {
+	lc := &fakeLifecycle{}
+	cfg := &config.Config{Realtime: config.RealtimeConfig{ReplayStore: config.RealtimeReplayStoreMemory, ReplayBuffer: 8}}
+
+	store, err := ProvideRealtimeReplayStore(lc, cfg, noopLoggerFx{})
+	require.NoError(t, err)
+	require.NotNil(t, store)
+	require.Empty(t, lc.hooks)
+}
+
+func TestProvideRealtimeReplayStoreReturnsErrorWhenRedisUnavailable(t *testing.T) {
+	lc := &fakeLifecycle{}
+	cfg := &config.Config{
+		Realtime: config.RealtimeConfig{ReplayStore: config.RealtimeReplayStoreRedis, ReplayBuffer: 8, ReplayTTL: time.Hour},
+		Cache:    config.CacheConfig{Addr: "127.0.0.1:1", RealtimeDB: 6, PoolSize: 1, ConnectTimeout: 10 * time.Millisecond},
+	}
+
+	store, err := ProvideRealtimeReplayStore(lc, cfg, noopLoggerFx{})
+	require.Error(t, err)
+	require.Nil(t, store)
+	require.Empty(t, lc.hooks)
+}
+
+func TestProvideRealtimeTicketStoreReturnsErrorWhenRedisUnavailable(t *testing.T) {
+	lc := &fakeLifecycle{}
+	cfg := &config.Config{
+		Cache: config.CacheConfig{Addr: "127.0.0.1:1", AuthDB: 0, PoolSize: 1, ConnectTimeout: 10 * time.Millisecond},
+	}
+
+	store, err := ProvideRealtimeTicketStore(lc, cfg, noopLoggerFx{})
+	require.Error(t, err)
+	require.Nil(t, store)
+	require.Empty(t, lc.hooks)
+}
@@ -3,16 +3,26 @@ package fxapp
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
+	"github.com/lechitz/aion-api/internal/adapter/secondary/db/postgres"
+	eventOutboxCodec "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/codec"
+	eventOutboxListener "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/listener"
 	eventOutboxRepo "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/repository"
+	eventOutboxInProcess "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/inprocess"
 	eventOutboxKafka "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/kafka"
+	eventOutboxNDJSON "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/ndjson"
+	eventOutboxWebhook "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/webhook"
+	eventOutboxDomain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
 	eventOutboxInput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	eventOutboxOutput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
 	eventOutbox "github.com/lechitz/aion-api/internal/eventoutbox/core/usecase"
 	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/httpclient"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"go.uber.org/fx"
@@ -22,12 +32,27 @@ import (
 //
 //nolint:gochecknoglobals // Fx modules are intended as package-level options.
 var OutboxPublisherModule = fx.Options(
-	fx.Provide(
-		ProvideOutboxEventRepository,
-		ProvideKafkaEventPublisher,
-		ProvideOutboxPublisherService,
-	),
-	fx.Invoke(RunOutboxPublisher),
+	outboxPublisherProviders,
+	fx.Invoke(RejectInProcessOutboxTransport, RunOutboxPublisher, RunOutboxRetention),
+)
+
+// EmbeddedOutboxPublisherModule runs the outbox publisher inside the API process when
+// OUTBOX_TRANSPORT=inprocess, so events reach the in-process realtime reader without a broker.
+// With any other transport it stays idle and the dedicated publisher process does the work.
+//
+//nolint:gochecknoglobals // Fx modules are intended as package-level options.
+var EmbeddedOutboxPublisherModule = fx.Options(
+	outboxPublisherProviders,
+	fx.Invoke(RunEmbeddedOutboxPublisher),
+)
+
+//nolint:gochecknoglobals // Shared provider set of the standalone and embedded publisher modules.
+var outboxPublisherProviders = fx.Provide(
+	ProvideOutboxEventRepository,
+	ProvideOutboxEventPublisher,
+	ProvideOutboxPendingListener,
+	ProvideOutboxPublisherService,
+	ProvideOutboxRetentionService,
 )
 
 type outboxPublisherParams struct {
@@ -37,6 +62,7 @@ type outboxPublisherParams struct {
 	Log       logger.ContextLogger
 	Repo      eventOutboxOutput.EventRepository
 	Publisher eventOutboxOutput.EventPublisher
+	Schemas   eventOutboxOutput.SchemaRegistry
 }
 
 // ProvideOutboxEventRepository exposes the durable outbox repository for the publisher process.
@@ -44,31 +70,124 @@ func ProvideOutboxEventRepository(database db.DB, log logger.ContextLogger) even
 	return eventOutboxRepo.NewEventRepository(database, log)
 }
 
-// ProvideKafkaEventPublisher exposes the Kafka-backed outbox publisher and registers its cleanup.
-func ProvideKafkaEventPublisher(
-	lc fx.Lifecycle,
-	cfg *config.Config,
-	log logger.ContextLogger,
-) eventOutboxOutput.EventPublisher {
-	publisher := eventOutboxKafka.NewEventPublisher(cfg.Kafka, log)
-	lc.Append(fx.Hook{
-		OnStop: func(context.Context) error {
-			return publisher.Close()
-		},
-	})
-	return publisher
+type outboxTransportParams struct {
+	fx.In
+
+	Lc         fx.Lifecycle
+	Cfg        *config.Config
+	Log        logger.ContextLogger
+	Bus        *eventbus.Bus
+	HTTPClient httpclient.HTTPClient
+}
+
+// ProvideOutboxEventPublisher exposes the outbox publisher selected by OUTBOX_TRANSPORT, encoding
+// events in OUTBOX_ENVELOPE_FORMAT, and registers its cleanup.
+func ProvideOutboxEventPublisher(params outboxTransportParams) eventOutboxOutput.EventPublisher {
+	cfg := params.Cfg
+	encoder := eventOutboxCodec.NewEncoder(eventOutboxCodec.Format(cfg.Outbox.EnvelopeFormat))
+
+	switch cfg.Outbox.Transport {
+	case config.OutboxTransportInProcess:
+		return eventOutboxInProcess.NewEventPublisher(params.Bus, cfg.Kafka, params.Log).WithEncoder(encoder)
+	case config.OutboxTransportWebhook:
+		return eventOutboxWebhook.NewEventPublisher(
+			params.HTTPClient,
+			cfg.Outbox.WebhookURL,
+			cfg.Outbox.WebhookTimeout,
+			cfg.Kafka,
+			params.Log,
+		).WithEncoder(encoder)
+	case config.OutboxTransportFile:
+		publisher := eventOutboxNDJSON.NewEventPublisher(cfg.Outbox.FilePath, cfg.Kafka, params.Log).WithEncoder(encoder)
+		params.Lc.Append(fx.Hook{
+			OnStop: func(context.Context) error {
+				return publisher.Close()
+			},
+		})
+		return publisher
+	default:
+		publisher := eventOutboxKafka.NewEventPublisher(cfg.Kafka, params.Log).WithEncoder(encoder)
+		params.Lc.Append(fx.Hook{
+			OnStop: func(context.Context) error {
+				return publisher.Close()
+			},
+		})
+		return publisher
+	}
+}
+
+// RejectInProcessOutboxTransport stops the dedicated publisher process from starting with
+// OUTBOX_TRANSPORT=inprocess: it has no in-process subscribers and would mark events published
+// that nobody received. The API process publishes in that mode instead.
+func RejectInProcessOutboxTransport(cfg *config.Config) error {
+	if cfg.Outbox.Transport == config.OutboxTransportInProcess {
+		return errors.New(errMsgInProcessTransportStandalone)
+	}
+	return nil
+}
+
+type embeddedOutboxParams struct {
+	fx.In
+
+	Lc        fx.Lifecycle
+	Cfg       *config.Config
+	Log       logger.ContextLogger
+	Publisher eventOutboxInput.PublisherService
+	Retention eventOutboxInput.RetentionService
+	Listener  eventOutboxOutput.PendingListener
+}
+
+// RunEmbeddedOutboxPublisher starts the publisher and retention loops in the API process when
+// OUTBOX_TRANSPORT=inprocess and does nothing otherwise.
+func RunEmbeddedOutboxPublisher(params embeddedOutboxParams) {
+	if params.Cfg.Outbox.Transport != config.OutboxTransportInProcess {
+		return
+	}
+	params.Log.Infow("outbox publisher embedded in api process", "transport", params.Cfg.Outbox.Transport)
+
+	RunOutboxPublisher(params.Lc, params.Cfg, params.Publisher, params.Listener, params.Log)
+	RunOutboxRetention(params.Lc, params.Cfg, params.Retention, params.Log)
+}
+
+// ProvideOutboxPendingListener exposes the LISTEN/NOTIFY wakeup, or nil when OUTBOX_LISTEN_ENABLED is off
+// and the publisher relies on polling alone.
+func ProvideOutboxPendingListener(cfg *config.Config, log logger.ContextLogger) eventOutboxOutput.PendingListener {
+	if !cfg.Outbox.ListenEnabled {
+		return nil
+	}
+	return eventOutboxListener.NewPendingListener(
+		postgres.ConnectionString(cfg.DB),
+		eventOutboxRepo.PendingNotifyChannel,
+		cfg.Outbox.ListenRetry,
+		log,
+	)
 }
 
 // ProvideOutboxPublisherService creates the batch publication use case.
 func ProvideOutboxPublisherService(params outboxPublisherParams) eventOutboxInput.PublisherService {
-	return eventOutbox.NewPublisherService(params.Repo, params.Publisher, params.Log)
+	return eventOutbox.NewPublisherService(params.Repo, params.Publisher, params.Log, eventOutbox.PublisherConfig{
+		Schemas: params.Schemas,
+		Lease: eventOutboxDomain.Lease{
+			Owner:    params.Cfg.Outbox.LeaseOwner,
+			Duration: params.Cfg.Outbox.LeaseDuration,
+		},
+		Ordering: eventOutboxDomain.Ordering(params.Cfg.Outbox.Ordering),
+		Retry: eventOutbox.RetryPolicy{
+			BaseBackoff: params.Cfg.Outbox.BackoffBase,
+			MaxBackoff:  params.Cfg.Outbox.BackoffMax,
+			MaxAttempts: params.Cfg.Outbox.MaxAttempts,
+		},
+	})
 }
 
-// RunOutboxPublisher starts the periodic background loop for Kafka publication.
+// RunOutboxPublisher starts the background publication loop for the configured transport. The backlog is drained on
+// every OUTBOX_PUBLISH_INTERVAL tick and, when a listener is wired, as soon as an enqueue commits;
+// polling keeps the loop going while the listen connection is down.
 func RunOutboxPublisher(
 	lc fx.Lifecycle,
 	cfg *config.Config,
 	service eventOutboxInput.PublisherService,
+	listener eventOutboxOutput.PendingListener,
 	log logger.ContextLogger,
 ) {
 	if !cfg.Outbox.PublishEnabled {
@@ -86,6 +205,16 @@ func RunOutboxPublisher(
 			// #nosec G118 -- Cancel is stored here and invoked during Fx OnStop.
 			workerCtx, workerCancel := context.WithCancel(context.Background())
 			cancel = workerCancel
+			wake := make(chan struct{}, 1)
+
+			if listener != nil {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					listener.Listen(workerCtx, wake)
+				}()
+			}
+
 			wg.Add(1)
 
 			go func() {
@@ -94,24 +223,22 @@ func RunOutboxPublisher(
 				defer ticker.Stop()
 
 				for {
-					if err := service.PublishPending(workerCtx, cfg.Outbox.BatchSize); err != nil && workerCtx.Err() == nil {
-						log.ErrorwCtx(workerCtx, "outbox publish cycle failed",
-							commonkeys.Error, err.Error(),
-							"batch_size", cfg.Outbox.BatchSize,
-						)
-					}
+					drainOutbox(workerCtx, cfg, service, log)
 
 					select {
 					case <-workerCtx.Done():
 						return
 					case <-ticker.C:
+					case <-wake:
 					}
 				}
 			}()
 
 			log.Infow("outbox publisher started",
+				"transport", cfg.Outbox.Transport,
 				"publish_interval", cfg.Outbox.PublishInterval.String(),
 				"batch_size", cfg.Outbox.BatchSize,
+				"listen_enabled", listener != nil,
 			)
 			return nil
 		},
@@ -136,3 +263,27 @@ func RunOutboxPublisher(
 		},
 	})
 }
+
+// drainOutbox runs publish cycles back to back until the backlog is exhausted, a cycle fails, or ctx ends,
+// so a burst larger than one batch does not wait a poll interval per batch. A short batch ends the drain,
+// except under aggregate ordering: there a claim holds back the later events of each aggregate, which
+// become claimable as soon as it is published, so only an empty batch proves the backlog is drained.
+func drainOutbox(ctx context.Context, cfg *config.Config, service eventOutboxInput.PublisherService, log logger.ContextLogger) {
+	ordered := cfg.Outbox.Ordering != config.OutboxOrderingNone
+
+	for ctx.Err() == nil {
+		claimed, err := service.PublishPending(ctx, cfg.Outbox.BatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.ErrorwCtx(ctx, "outbox publish cycle failed",
+					commonkeys.Error, err.Error(),
+					"batch_size", cfg.Outbox.BatchSize,
+				)
+			}
+			return
+		}
+		if claimed == 0 || (claimed < cfg.Outbox.BatchSize && !ordered) {
+			return
+		}
+	}
+}
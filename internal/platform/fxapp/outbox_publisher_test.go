@@ -0,0 +1,253 @@
+//nolint:testpackage // tests exercise package-private wiring helpers.
+package fxapp
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	eventOutboxInProcess "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/inprocess"
+	eventOutboxKafka "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/kafka"
+	eventOutboxNDJSON "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/ndjson"
+	eventOutboxWebhook "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/webhook"
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+	"github.com/stretchr/testify/require"
+)
+
+type countingPublisherService struct {
+	cycles chan struct{}
+}
+
+func (s *countingPublisherService) PublishPending(context.Context, int) (int, error) {
+	select {
+	case s.cycles <- struct{}{}:
+	default:
+	}
+	return 0, nil
+}
+
+// aggregatePublisherService mimics aggregate ordering: one cycle can claim only the earliest pending event
+// of an aggregate, so the next one becomes claimable only after it is published.
+type aggregatePublisherService struct {
+	mu        sync.Mutex
+	pending   []string
+	published chan string
+}
+
+func (s *aggregatePublisherService) enqueue(eventIDs ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = append(s.pending, eventIDs...)
+}
+
+func (s *aggregatePublisherService) PublishPending(context.Context, int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.pending) == 0 {
+		return 0, nil
+	}
+	s.published <- s.pending[0]
+	s.pending = s.pending[1:]
+	return 1, nil
+}
+
+type triggerListener struct {
+	trigger chan struct{}
+}
+
+func (l *triggerListener) Listen(ctx context.Context, wake chan<- struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-l.trigger:
+			wake <- struct{}{}
+		}
+	}
+}
+
+func TestRunOutboxPublisherWakesOnNotification(t *testing.T) {
+	cfg := &config.Config{Outbox: config.OutboxConfig{
+		PublishEnabled:  true,
+		PublishInterval: time.Hour,
+		BatchSize:       10,
+	}}
+	service := &countingPublisherService{cycles: make(chan struct{}, 4)}
+	listener := &triggerListener{trigger: make(chan struct{})}
+	lc := &fakeLifecycle{}
+
+	RunOutboxPublisher(lc, cfg, service, listener, noopLoggerFx{})
+	require.Len(t, lc.hooks, 1)
+	require.NoError(t, lc.hooks[0].OnStart(t.Context()))
+	t.Cleanup(func() { require.NoError(t, lc.hooks[0].OnStop(context.Background())) })
+
+	expectCycle := func() {
+		t.Helper()
+		select {
+		case <-service.cycles:
+		case <-time.After(time.Second):
+			t.Fatal("expected a publish cycle")
+		}
+	}
+
+	// The first cycle runs on start; the second must not wait for the hour-long poll interval.
+	expectCycle()
+	listener.trigger <- struct{}{}
+	expectCycle()
+}
+
+func TestRunOutboxPublisherDrainsAggregateWithinOneWake(t *testing.T) {
+	cfg := &config.Config{Outbox: config.OutboxConfig{
+		PublishEnabled:  true,
+		PublishInterval: time.Hour,
+		BatchSize:       10,
+		Ordering:        config.OutboxOrderingAggregate,
+	}}
+	service := &aggregatePublisherService{published: make(chan string, 2)}
+	listener := &triggerListener{trigger: make(chan struct{})}
+	lc := &fakeLifecycle{}
+
+	RunOutboxPublisher(lc, cfg, service, listener, noopLoggerFx{})
+	require.NoError(t, lc.hooks[0].OnStart(t.Context()))
+	t.Cleanup(func() { require.NoError(t, lc.hooks[0].OnStop(context.Background())) })
+
+	// Both events belong to one aggregate, so each cycle claims a single-event short batch.
+	service.enqueue("evt-42-1", "evt-42-2")
+	listener.trigger <- struct{}{}
+
+	for _, want := range []string{"evt-42-1", "evt-42-2"} {
+		select {
+		case got := <-service.published:
+			require.Equal(t, want, got)
+		case <-time.After(time.Second):
+			t.Fatalf("expected %s to publish within the wake, not the hour-long poll interval", want)
+		}
+	}
+}
+
+func TestRunOutboxPublisherPollsWithoutListener(t *testing.T) {
+	cfg := &config.Config{Outbox: config.OutboxConfig{
+		PublishEnabled:  true,
+		PublishInterval: 10 * time.Millisecond,
+		BatchSize:       10,
+	}}
+	service := &countingPublisherService{cycles: make(chan struct{}, 4)}
+	lc := &fakeLifecycle{}
+
+	RunOutboxPublisher(lc, cfg, service, nil, noopLoggerFx{})
+	require.NoError(t, lc.hooks[0].OnStart(t.Context()))
+	t.Cleanup(func() { require.NoError(t, lc.hooks[0].OnStop(context.Background())) })
+
+	for range 2 {
+		select {
+		case <-service.cycles:
+		case <-time.After(time.Second):
+			t.Fatal("expected polling to keep publishing")
+		}
+	}
+}
+
+type countingRetentionService struct {
+	runs chan struct{}
+}
+
+func (s *countingRetentionService) PurgePublished(context.Context) (int64, error) {
+	select {
+	case s.runs <- struct{}{}:
+	default:
+	}
+	return 0, nil
+}
+
+func TestRunOutboxRetention(t *testing.T) {
+	t.Run("disabled registers no hooks", func(t *testing.T) {
+		lc := &fakeLifecycle{}
+		RunOutboxRetention(lc, &config.Config{}, &countingRetentionService{}, noopLoggerFx{})
+		require.Empty(t, lc.hooks)
+	})
+
+	t.Run("enabled purges on start and stops cleanly", func(t *testing.T) {
+		cfg := &config.Config{Outbox: config.OutboxConfig{
+			RetentionEnabled:  true,
+			RetentionInterval: time.Hour,
+		}}
+		service := &countingRetentionService{runs: make(chan struct{}, 1)}
+		lc := &fakeLifecycle{}
+
+		RunOutboxRetention(lc, cfg, service, noopLoggerFx{})
+		require.Len(t, lc.hooks, 1)
+		require.NoError(t, lc.hooks[0].OnStart(t.Context()))
+
+		select {
+		case <-service.runs:
+		case <-time.After(time.Second):
+			t.Fatal("expected a retention run on start")
+		}
+		require.NoError(t, lc.hooks[0].OnStop(context.Background()))
+	})
+}
+
+func TestProvideOutboxEventPublisherSelectsTransport(t *testing.T) {
+	tests := []struct {
+		transport string
+		want      any
+		hooks     int
+	}{
+		{transport: config.OutboxTransportKafka, want: &eventOutboxKafka.EventPublisher{}, hooks: 1},
+		{transport: config.OutboxTransportInProcess, want: &eventOutboxInProcess.EventPublisher{}, hooks: 0},
+		{transport: config.OutboxTransportWebhook, want: &eventOutboxWebhook.EventPublisher{}, hooks: 0},
+		{transport: config.OutboxTransportFile, want: &eventOutboxNDJSON.EventPublisher{}, hooks: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.transport, func(t *testing.T) {
+			lc := &fakeLifecycle{}
+			publisher := ProvideOutboxEventPublisher(outboxTransportParams{
+				Lc: lc,
+				Cfg: &config.Config{
+					Kafka:  config.KafkaConfig{Brokers: "kafka:9092", RecordEventsTopic: "aion.record.events.v1"},
+					Outbox: config.OutboxConfig{Transport: tt.transport, WebhookURL: "http://hooks.local", FilePath: t.TempDir() + "/events.ndjson"},
+				},
+				Log: noopLoggerFx{},
+				Bus: eventbus.New(),
+			})
+
+			require.IsType(t, tt.want, publisher)
+			require.Len(t, lc.hooks, tt.hooks)
+			for _, hook := range lc.hooks {
+				require.NoError(t, hook.OnStop(context.Background()))
+			}
+		})
+	}
+}
+
+func TestRejectInProcessOutboxTransport(t *testing.T) {
+	require.Error(t, RejectInProcessOutboxTransport(&config.Config{Outbox: config.OutboxConfig{Transport: config.OutboxTransportInProcess}}))
+	require.NoError(t, RejectInProcessOutboxTransport(&config.Config{Outbox: config.OutboxConfig{Transport: config.OutboxTransportKafka}}))
+}
+
+func TestRunEmbeddedOutboxPublisherOnlyRunsForInProcessTransport(t *testing.T) {
+	params := embeddedOutboxParams{
+		Cfg: &config.Config{Outbox: config.OutboxConfig{
+			Transport:        config.OutboxTransportKafka,
+			PublishEnabled:   true,
+			RetentionEnabled: true,
+		}},
+		Log:       noopLoggerFx{},
+		Publisher: &countingPublisherService{cycles: make(chan struct{}, 1)},
+	}
+
+	idle := &fakeLifecycle{}
+	params.Lc = idle
+	RunEmbeddedOutboxPublisher(params)
+	require.Empty(t, idle.hooks)
+
+	embedded := &fakeLifecycle{}
+	params.Lc = embedded
+	params.Cfg.Outbox.Transport = config.OutboxTransportInProcess
+	RunEmbeddedOutboxPublisher(params)
+	require.Len(t, embedded.hooks, 2)
+}
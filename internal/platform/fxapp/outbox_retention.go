@@ -0,0 +1,105 @@
+package fxapp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	eventOutboxArchive "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/storage/file"
+	eventOutboxInput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	eventOutboxOutput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	eventOutbox "github.com/lechitz/aion-api/internal/eventoutbox/core/usecase"
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.uber.org/fx"
+)
+
+// ProvideOutboxRetentionService creates the retention job use case. Rows are exported to
+// NDJSON files under OUTBOX_ARCHIVE_DIR before deletion when that directory is configured.
+func ProvideOutboxRetentionService(
+	cfg *config.Config,
+	repo eventOutboxOutput.EventRepository,
+	log logger.ContextLogger,
+) eventOutboxInput.RetentionService {
+	var archive eventOutboxOutput.ArchiveStorage
+	if cfg.Outbox.ArchiveDir != "" {
+		archive = eventOutboxArchive.NewArchiveStorage(cfg.Outbox.ArchiveDir, log)
+	}
+
+	return eventOutbox.NewRetentionService(repo, archive, log, eventOutbox.RetentionConfig{
+		Period:    cfg.Outbox.RetentionPeriod,
+		BatchSize: cfg.Outbox.RetentionBatchSize,
+	})
+}
+
+// RunOutboxRetention purges expired published outbox rows every OUTBOX_RETENTION_INTERVAL.
+func RunOutboxRetention(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	service eventOutboxInput.RetentionService,
+	log logger.ContextLogger,
+) {
+	if !cfg.Outbox.RetentionEnabled {
+		log.Infow("outbox retention disabled by configuration")
+		return
+	}
+
+	var (
+		wg     sync.WaitGroup
+		cancel context.CancelFunc
+	)
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			// #nosec G118 -- Cancel is stored here and invoked during Fx OnStop.
+			workerCtx, workerCancel := context.WithCancel(context.Background())
+			cancel = workerCancel
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				ticker := time.NewTicker(cfg.Outbox.RetentionInterval)
+				defer ticker.Stop()
+
+				for {
+					if _, err := service.PurgePublished(workerCtx); err != nil && workerCtx.Err() == nil {
+						log.ErrorwCtx(workerCtx, "outbox retention run failed", commonkeys.Error, err.Error())
+					}
+
+					select {
+					case <-workerCtx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+
+			log.Infow("outbox retention started",
+				"retention_period", cfg.Outbox.RetentionPeriod.String(),
+				"retention_interval", cfg.Outbox.RetentionInterval.String(),
+				"archive_enabled", cfg.Outbox.ArchiveDir != "",
+			)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if cancel != nil {
+				cancel()
+			}
+
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+
+			select {
+			case <-done:
+				log.Infow("outbox retention stopped")
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package fxapp
+
+import (
+	eventOutboxRepo "github.com/lechitz/aion-api/internal/eventoutbox/adapter/secondary/db/repository"
+	eventOutboxOutput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
+	eventOutbox "github.com/lechitz/aion-api/internal/eventoutbox/core/usecase"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	recordRepo "github.com/lechitz/aion-api/internal/record/adapter/secondary/db/repository"
+	recordInput "github.com/lechitz/aion-api/internal/record/core/ports/input"
+	record "github.com/lechitz/aion-api/internal/record/core/usecase"
+	"go.uber.org/fx"
+)
+
+// ProjectionReconcileModule wires the record projection reconciler used by cmd/projection-reconcile.
+// Repairs go through the durable outbox, so whichever publisher serves OUTBOX_TRANSPORT delivers them.
+//
+//nolint:gochecknoglobals // Fx modules are declared as package-level options across the application wiring.
+var ProjectionReconcileModule = fx.Options(
+	fx.Provide(ProvideRecordProjectionReconciler),
+)
+
+// ProvideRecordProjectionReconciler builds the reconciler on the record repository and the outbox service.
+func ProvideRecordProjectionReconciler(
+	database db.DB,
+	schemas eventOutboxOutput.SchemaRegistry,
+	log logger.ContextLogger,
+) recordInput.RecordProjectionReconciler {
+	outboxService := eventOutbox.NewService(eventOutboxRepo.NewEventRepository(database, log), schemas, log)
+	return record.NewReconciler(recordRepo.NewProjectionReconcile(database, log), outboxService, log, nil).
+		WithTransactionManager(database)
+}
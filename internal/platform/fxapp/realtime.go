@@ -2,12 +2,17 @@ package fxapp
 
 import (
 	"context"
+	"errors"
 	"os"
 	"sync"
 
 	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	realtimeFanoutRedis "github.com/lechitz/aion-api/internal/realtime/adapter/secondary/fanout/redis"
+	realtimeInProcess "github.com/lechitz/aion-api/internal/realtime/adapter/secondary/inprocess"
 	realtimeKafka "github.com/lechitz/aion-api/internal/realtime/adapter/secondary/kafka"
+	realtimeOutput "github.com/lechitz/aion-api/internal/realtime/core/ports/output"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"go.uber.org/fx"
 )
@@ -18,25 +23,42 @@ import (
 var RealtimeModule = fx.Options(
 	fx.Provide(ProvideRealtimeProjectionReader),
 	fx.Invoke(RunRealtimeProjectionConsumer),
+	fx.Invoke(RunRealtimeFanoutReceiver),
 )
 
-// ProvideRealtimeProjectionReader builds the Kafka reader used by the realtime consumer.
+// ProvideRealtimeProjectionReader builds the projection reader selected by REALTIME_SOURCE:
+// a Kafka consumer on the projection topic, or a subscriber of the same topic on the in-process bus.
+// With local fan-out every instance must read every event, so the Kafka consumer group is unique per
+// host; with REALTIME_FANOUT=redis the instances share one group and split the partitions.
 func ProvideRealtimeProjectionReader(
 	lc fx.Lifecycle,
 	cfg *config.Config,
+	bus *eventbus.Bus,
 	log logger.ContextLogger,
-) *realtimeKafka.ProjectionEventReader {
-	groupID := cfg.Realtime.ConsumerGroupPrefix
-	if hostname, err := os.Hostname(); err == nil && hostname != "" {
-		groupID += "-" + hostname
+) realtimeOutput.ProjectionEventReader {
+	var reader realtimeOutput.ProjectionEventReader
+	if cfg.Realtime.Source == config.RealtimeSourceInProcess {
+		reader = realtimeInProcess.NewProjectionEventReader(
+			bus,
+			cfg.Kafka.RecordProjectionEventsTopic,
+			cfg.Realtime.SubscriberBuffer,
+		)
+	} else {
+		groupID := cfg.Realtime.ConsumerGroupPrefix
+		if cfg.Realtime.Fanout != config.RealtimeFanoutRedis {
+			if hostname, err := os.Hostname(); err == nil && hostname != "" {
+				groupID += "-" + hostname
+			}
+		}
+
+		reader = realtimeKafka.NewProjectionEventReader(
+			cfg.Kafka.Brokers,
+			groupID,
+			cfg.Kafka.RecordProjectionEventsTopic,
+			log,
+		)
 	}
 
-	reader := realtimeKafka.NewProjectionEventReader(
-		cfg.Kafka.Brokers,
-		groupID,
-		cfg.Kafka.RecordProjectionEventsTopic,
-		log,
-	)
 	lc.Append(fx.Hook{
 		OnStop: func(context.Context) error {
 			return reader.Close()
@@ -49,7 +71,7 @@ func ProvideRealtimeProjectionReader(
 func RunRealtimeProjectionConsumer(
 	lc fx.Lifecycle,
 	cfg *config.Config,
-	reader *realtimeKafka.ProjectionEventReader,
+	reader realtimeOutput.ProjectionEventReader,
 	deps *AppDependencies,
 	log logger.ContextLogger,
 ) {
@@ -77,7 +99,8 @@ func RunRealtimeProjectionConsumer(
 			go func() {
 				defer wg.Done()
 				log.Infow(realtimeKafka.LogRealtimeConsumerStarted,
-					"kafka_topic", cfg.Kafka.RecordProjectionEventsTopic,
+					"source", cfg.Realtime.Source,
+					"topic", cfg.Kafka.RecordProjectionEventsTopic,
 				)
 
 				for {
@@ -113,3 +136,66 @@ func RunRealtimeProjectionConsumer(
 		},
 	})
 }
+
+// RunRealtimeFanoutReceiver hands events received from the fan-out broker to the streams of this
+// instance. It does nothing with local fan-out, where Publish delivers directly.
+func RunRealtimeFanoutReceiver(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	broker realtimeOutput.FanoutBroker,
+	deps *AppDependencies,
+	log logger.ContextLogger,
+) {
+	if !cfg.Realtime.Enabled || broker == nil || deps == nil || deps.RealtimeService == nil {
+		return
+	}
+
+	var (
+		wg     sync.WaitGroup
+		cancel context.CancelFunc
+	)
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			// #nosec G118 -- Cancel is stored here and invoked during Fx OnStop.
+			workerCtx, workerCancel := context.WithCancel(context.Background())
+			cancel = workerCancel
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				log.Infow(logMsgRealtimeFanoutStarted, "fanout", cfg.Realtime.Fanout)
+
+				for {
+					event, err := broker.Receive(workerCtx)
+					if err != nil {
+						if workerCtx.Err() != nil || errors.Is(err, realtimeFanoutRedis.ErrBrokerClosed) {
+							return
+						}
+						log.ErrorwCtx(workerCtx, logMsgRealtimeFanoutFailed, commonkeys.Error, err.Error())
+						continue
+					}
+					deps.RealtimeService.Deliver(workerCtx, event)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if cancel != nil {
+				cancel()
+			}
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+				log.Infow(logMsgRealtimeFanoutStopped)
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+}
@@ -0,0 +1,90 @@
+//nolint:testpackage // tests exercise package-private wiring helpers.
+package fxapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+	realtimeInProcess "github.com/lechitz/aion-api/internal/realtime/adapter/secondary/inprocess"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	realtimeUsecase "github.com/lechitz/aion-api/internal/realtime/core/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvideRealtimeProjectionReaderUsesInProcessSource(t *testing.T) {
+	bus := eventbus.New()
+	lc := &fakeLifecycle{}
+	cfg := &config.Config{
+		Kafka:    config.KafkaConfig{RecordProjectionEventsTopic: "aion.record_projection.events.v1"},
+		Realtime: config.RealtimeConfig{Source: config.RealtimeSourceInProcess, SubscriberBuffer: 1},
+	}
+
+	reader := ProvideRealtimeProjectionReader(lc, cfg, bus, noopLoggerFx{})
+	require.IsType(t, &realtimeInProcess.ProjectionEventReader{}, reader)
+
+	require.NoError(t, bus.Publish(context.Background(), eventbus.Message{
+		Topic: "aion.record_projection.events.v1",
+		Value: []byte(`{"event_type":"record.projection.created","user_id":1,"record_id":2,"projected_at_utc":"2026-03-13T13:00:00Z"}`),
+	}))
+	event, err := reader.Read(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), event.RecordID)
+
+	require.Len(t, lc.hooks, 1)
+	require.NoError(t, lc.hooks[0].OnStop(context.Background()))
+}
+
+type chanFanoutBroker struct {
+	events chan domain.Event
+}
+
+func (b *chanFanoutBroker) Publish(_ context.Context, event domain.Event) error {
+	b.events <- event
+	return nil
+}
+func (*chanFanoutBroker) Join(context.Context, uint64) error  { return nil }
+func (*chanFanoutBroker) Leave(context.Context, uint64) error { return nil }
+func (b *chanFanoutBroker) Receive(ctx context.Context) (domain.Event, error) {
+	select {
+	case <-ctx.Done():
+		return domain.Event{}, ctx.Err()
+	case event := <-b.events:
+		return event, nil
+	}
+}
+func (*chanFanoutBroker) Close() error { return nil }
+
+func TestRunRealtimeFanoutReceiverDeliversBrokerEvents(t *testing.T) {
+	broker := &chanFanoutBroker{events: make(chan domain.Event, 1)}
+	service := realtimeUsecase.NewService(noopLoggerFx{}, 1).WithFanout(broker)
+	stream, cleanup, err := service.Subscribe(t.Context(), 14, domain.Filter{})
+	require.NoError(t, err)
+	defer cleanup()
+
+	lc := &fakeLifecycle{}
+	cfg := &config.Config{Realtime: config.RealtimeConfig{Enabled: true, Fanout: config.RealtimeFanoutRedis}}
+	RunRealtimeFanoutReceiver(lc, cfg, broker, &AppDependencies{RealtimeService: service}, noopLoggerFx{})
+	require.Len(t, lc.hooks, 1)
+	require.NoError(t, lc.hooks[0].OnStart(context.Background()))
+
+	service.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 42})
+
+	select {
+	case event := <-stream:
+		require.Equal(t, uint64(42), event.RecordID)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the fanned-out event")
+	}
+	require.NoError(t, lc.hooks[0].OnStop(context.Background()))
+}
+
+func TestRunRealtimeFanoutReceiverSkipsLocalFanout(t *testing.T) {
+	lc := &fakeLifecycle{}
+	cfg := &config.Config{Realtime: config.RealtimeConfig{Enabled: true, Fanout: config.RealtimeFanoutLocal}}
+
+	RunRealtimeFanoutReceiver(lc, cfg, nil, &AppDependencies{}, noopLoggerFx{})
+	require.Empty(t, lc.hooks)
+}
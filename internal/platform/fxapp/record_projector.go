@@ -0,0 +1,192 @@
+package fxapp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	recordRepo "github.com/lechitz/aion-api/internal/record/adapter/secondary/db/repository"
+	recordProjectorInProcess "github.com/lechitz/aion-api/internal/record/adapter/secondary/projector/inprocess"
+	recordProjectorKafka "github.com/lechitz/aion-api/internal/record/adapter/secondary/projector/kafka"
+	recordDomain "github.com/lechitz/aion-api/internal/record/core/domain"
+	recordInput "github.com/lechitz/aion-api/internal/record/core/ports/input"
+	recordOutput "github.com/lechitz/aion-api/internal/record/core/ports/output"
+	record "github.com/lechitz/aion-api/internal/record/core/usecase"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	tagRepo "github.com/lechitz/aion-api/internal/tag/adapter/secondary/db/repository"
+	"go.uber.org/fx"
+)
+
+// RecordProjectorModule runs the built-in record projector when RECORD_PROJECTOR_ENABLED is set,
+// so one aion-api deployment serves the derived read path and realtime without aion-streams.
+//
+//nolint:gochecknoglobals // Fx modules are declared as package-level options across the application wiring.
+var RecordProjectorModule = fx.Options(
+	fx.Provide(ProvideRecordEventReader, ProvideProjectionReadyPublisher, ProvideRecordProjector),
+	fx.Invoke(RunRecordProjector),
+)
+
+// ProvideRecordEventReader reads record events from where OUTBOX_TRANSPORT publishes them: the
+// in-process bus, or the record events topic within RECORD_PROJECTOR_CONSUMER_GROUP. It returns
+// nil while the projector is disabled, so no idle bus subscriber holds back the outbox publisher.
+func ProvideRecordEventReader(lc fx.Lifecycle, cfg *config.Config, bus *eventbus.Bus) recordOutput.RecordEventReader {
+	if !cfg.RecordProjector.Enabled {
+		return nil
+	}
+
+	var reader recordOutput.RecordEventReader
+	if cfg.Outbox.Transport == config.OutboxTransportInProcess {
+		reader = recordProjectorInProcess.NewRecordEventReader(bus, cfg.Kafka.RecordEventsTopic, 0)
+	} else {
+		reader = recordProjectorKafka.NewRecordEventReader(
+			cfg.Kafka.Brokers,
+			cfg.RecordProjector.ConsumerGroup,
+			cfg.Kafka.RecordEventsTopic,
+		)
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return reader.Close()
+		},
+	})
+	return reader
+}
+
+// ProvideProjectionReadyPublisher publishes projection-ready events to where REALTIME_SOURCE reads
+// them: the in-process bus, or the record projection topic. It returns nil while the projector is disabled.
+func ProvideProjectionReadyPublisher(lc fx.Lifecycle, cfg *config.Config, bus *eventbus.Bus) recordOutput.ProjectionReadyPublisher {
+	if !cfg.RecordProjector.Enabled {
+		return nil
+	}
+
+	if cfg.Realtime.Source == config.RealtimeSourceInProcess {
+		return recordProjectorInProcess.NewProjectionReadyPublisher(bus, cfg.Kafka.RecordProjectionEventsTopic)
+	}
+
+	publisher := recordProjectorKafka.NewProjectionReadyPublisher(cfg.Kafka.Brokers, cfg.Kafka.RecordProjectionEventsTopic)
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return publisher.Close()
+		},
+	})
+	return publisher
+}
+
+// ProvideRecordProjector builds the projection use case on the record and tag repositories.
+func ProvideRecordProjector(
+	database db.DB,
+	publisher recordOutput.ProjectionReadyPublisher,
+	log logger.ContextLogger,
+) recordInput.RecordProjector {
+	return record.NewProjector(recordRepo.New(database, log), tagRepo.New(database, log), publisher, log)
+}
+
+// RunRecordProjector starts the projector loop when the feature is enabled. Each event is committed
+// once projected; a write that still fails after recordProjectorAttempts tries is logged and
+// skipped so one bad row cannot stall the stream, and messages that cannot be decoded are skipped.
+func RunRecordProjector(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	reader recordOutput.RecordEventReader,
+	projector recordInput.RecordProjector,
+	log logger.ContextLogger,
+) {
+	if !cfg.RecordProjector.Enabled || reader == nil {
+		log.Infow(logMsgRecordProjectorDisabled)
+		return
+	}
+
+	var (
+		wg     sync.WaitGroup
+		cancel context.CancelFunc
+	)
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			// #nosec G118 -- Cancel is stored here and invoked during Fx OnStop.
+			workerCtx, workerCancel := context.WithCancel(context.Background())
+			cancel = workerCancel
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				log.Infow(logMsgRecordProjectorStarted,
+					"source", cfg.Outbox.Transport,
+					"sink", cfg.Realtime.Source,
+					"topic", cfg.Kafka.RecordEventsTopic,
+				)
+
+				for {
+					event, err := reader.Read(workerCtx)
+					if err != nil {
+						if workerCtx.Err() != nil || errors.Is(err, recordProjectorInProcess.ErrReaderClosed) {
+							return
+						}
+						log.ErrorwCtx(workerCtx, logMsgRecordProjectorReadFailed, commonkeys.Error, err.Error())
+						if event.Topic == "" {
+							continue
+						}
+					} else if !projectWithRetry(workerCtx, projector, event) {
+						if workerCtx.Err() != nil {
+							return
+						}
+						log.ErrorwCtx(workerCtx, logMsgRecordProjectorSkipped,
+							"event_id", event.EventID,
+							"topic", event.Topic,
+							"partition", event.Partition,
+							"offset", event.Offset,
+						)
+					}
+
+					if err := reader.Commit(workerCtx, event); err != nil && workerCtx.Err() == nil {
+						log.ErrorwCtx(workerCtx, logMsgRecordProjectorCommitFailed, commonkeys.Error, err.Error())
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if cancel != nil {
+				cancel()
+			}
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+				log.Infow(logMsgRecordProjectorStopped)
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+}
+
+// projectWithRetry applies event, retrying failed writes with a doubling delay. It reports
+// whether the event was projected; the use case logs each failed attempt.
+func projectWithRetry(ctx context.Context, projector recordInput.RecordProjector, event recordDomain.RecordEvent) bool {
+	delay := recordProjectorRetryDelay
+	for attempt := 1; ; attempt++ {
+		if err := projector.Project(ctx, event); err == nil {
+			return true
+		}
+		if attempt == recordProjectorAttempts {
+			return false
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
@@ -0,0 +1,136 @@
+//nolint:testpackage // tests exercise package-private wiring helpers.
+package fxapp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+	recordProjectorInProcess "github.com/lechitz/aion-api/internal/record/adapter/secondary/projector/inprocess"
+	recordDomain "github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvideRecordProjectorAdaptersAreNilWhileDisabled(t *testing.T) {
+	lc := &fakeLifecycle{}
+	cfg := &config.Config{Outbox: config.OutboxConfig{Transport: config.OutboxTransportInProcess}}
+	bus := eventbus.New()
+
+	require.Nil(t, ProvideRecordEventReader(lc, cfg, bus))
+	require.Nil(t, ProvideProjectionReadyPublisher(lc, cfg, bus))
+	require.Empty(t, lc.hooks)
+
+	// Without a projector subscriber the bus drops record events instead of waiting for a reader.
+	require.NoError(t, bus.Publish(context.Background(), eventbus.Message{Topic: "aion.record.events.v1"}))
+}
+
+func TestProvideRecordProjectorAdaptersUseTheBusInProcess(t *testing.T) {
+	lc := &fakeLifecycle{}
+	cfg := &config.Config{
+		Kafka: config.KafkaConfig{
+			RecordEventsTopic:           "aion.record.events.v1",
+			RecordProjectionEventsTopic: "aion.record_projection.events.v1",
+		},
+		Outbox:          config.OutboxConfig{Transport: config.OutboxTransportInProcess},
+		Realtime:        config.RealtimeConfig{Source: config.RealtimeSourceInProcess},
+		RecordProjector: config.RecordProjectorConfig{Enabled: true},
+	}
+	bus := eventbus.New()
+
+	reader := ProvideRecordEventReader(lc, cfg, bus)
+	require.IsType(t, &recordProjectorInProcess.RecordEventReader{}, reader)
+	publisher := ProvideProjectionReadyPublisher(lc, cfg, bus)
+	require.IsType(t, &recordProjectorInProcess.ProjectionReadyPublisher{}, publisher)
+
+	require.Len(t, lc.hooks, 1)
+	require.NoError(t, lc.hooks[0].OnStop(context.Background()))
+	_, err := reader.Read(context.Background())
+	require.ErrorIs(t, err, recordProjectorInProcess.ErrReaderClosed)
+}
+
+type scriptedRecordEventReader struct {
+	mu        sync.Mutex
+	reads     []scriptedRead
+	committed []string
+}
+
+type scriptedRead struct {
+	event recordDomain.RecordEvent
+	err   error
+}
+
+func (r *scriptedRecordEventReader) Read(ctx context.Context) (recordDomain.RecordEvent, error) {
+	r.mu.Lock()
+	if len(r.reads) > 0 {
+		next := r.reads[0]
+		r.reads = r.reads[1:]
+		r.mu.Unlock()
+		return next.event, next.err
+	}
+	r.mu.Unlock()
+	<-ctx.Done()
+	return recordDomain.RecordEvent{}, ctx.Err()
+}
+
+func (r *scriptedRecordEventReader) Commit(_ context.Context, event recordDomain.RecordEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.committed = append(r.committed, event.EventID)
+	return nil
+}
+
+func (r *scriptedRecordEventReader) Close() error { return nil }
+
+func (r *scriptedRecordEventReader) commits() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.committed...)
+}
+
+type recordingProjector struct {
+	projected chan string
+}
+
+func (p recordingProjector) Project(_ context.Context, event recordDomain.RecordEvent) error {
+	p.projected <- event.EventID
+	return nil
+}
+
+func TestRunRecordProjectorProjectsAndCommits(t *testing.T) {
+	reader := &scriptedRecordEventReader{reads: []scriptedRead{
+		{event: recordDomain.RecordEvent{EventID: "evt-1", Topic: "aion.record.events.v1"}},
+		{event: recordDomain.RecordEvent{EventID: "poison", Topic: "aion.record.events.v1"}, err: errors.New("bad json")},
+		{err: errors.New("fetch failed")},
+		{event: recordDomain.RecordEvent{EventID: "evt-2", Topic: "aion.record.events.v1"}},
+	}}
+	projector := recordingProjector{projected: make(chan string, 2)}
+
+	lc := &fakeLifecycle{}
+	cfg := &config.Config{RecordProjector: config.RecordProjectorConfig{Enabled: true}}
+	RunRecordProjector(lc, cfg, reader, projector, noopLoggerFx{})
+	require.Len(t, lc.hooks, 1)
+	require.NoError(t, lc.hooks[0].OnStart(context.Background()))
+
+	for _, want := range []string{"evt-1", "evt-2"} {
+		select {
+		case got := <-projector.projected:
+			require.Equal(t, want, got)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %s", want)
+		}
+	}
+	require.NoError(t, lc.hooks[0].OnStop(context.Background()))
+
+	// The undecodable message is committed past without projecting it; the failed fetch has no position.
+	require.Equal(t, []string{"evt-1", "poison", "evt-2"}, reader.commits())
+}
+
+func TestRunRecordProjectorDisabled(t *testing.T) {
+	lc := &fakeLifecycle{}
+	RunRecordProjector(lc, &config.Config{}, nil, nil, noopLoggerFx{})
+	require.Empty(t, lc.hooks)
+}
@@ -0,0 +1,102 @@
+package fxapp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	recordRepo "github.com/lechitz/aion-api/internal/record/adapter/secondary/db/repository"
+	recordInput "github.com/lechitz/aion-api/internal/record/core/ports/input"
+	record "github.com/lechitz/aion-api/internal/record/core/usecase"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.uber.org/fx"
+)
+
+// RecordTrashModule hard-purges soft-deleted records once they outlive RECORD_TRASH_RETENTION_DAYS.
+//
+//nolint:gochecknoglobals // Fx modules are declared as package-level options across the application wiring.
+var RecordTrashModule = fx.Options(
+	fx.Provide(ProvideRecordTrashPurger),
+	fx.Invoke(RunRecordTrashPurge),
+)
+
+// ProvideRecordTrashPurger creates the trash retention job use case.
+func ProvideRecordTrashPurger(cfg *config.Config, database db.DB, log logger.ContextLogger) recordInput.RecordTrashPurger {
+	return record.NewTrashPurger(recordRepo.New(database, log), log, record.TrashPurgeConfig{
+		Retention: time.Duration(cfg.RecordTrash.RetentionDays) * 24 * time.Hour,
+		BatchSize: cfg.RecordTrash.PurgeBatchSize,
+	})
+}
+
+// RunRecordTrashPurge purges expired soft-deleted records every RECORD_TRASH_PURGE_INTERVAL.
+func RunRecordTrashPurge(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	purger recordInput.RecordTrashPurger,
+	log logger.ContextLogger,
+) {
+	if !cfg.RecordTrash.PurgeEnabled {
+		log.Infow(logMsgRecordTrashPurgeDisabled)
+		return
+	}
+
+	var (
+		wg     sync.WaitGroup
+		cancel context.CancelFunc
+	)
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			// #nosec G118 -- Cancel is stored here and invoked during Fx OnStop.
+			workerCtx, workerCancel := context.WithCancel(context.Background())
+			cancel = workerCancel
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				ticker := time.NewTicker(cfg.RecordTrash.PurgeInterval)
+				defer ticker.Stop()
+
+				for {
+					if _, err := purger.PurgeDeleted(workerCtx); err != nil && workerCtx.Err() == nil {
+						log.ErrorwCtx(workerCtx, logMsgRecordTrashPurgeFailed, commonkeys.Error, err.Error())
+					}
+
+					select {
+					case <-workerCtx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+
+			log.Infow(logMsgRecordTrashPurgeStarted,
+				"retention_days", cfg.RecordTrash.RetentionDays,
+				"purge_interval", cfg.RecordTrash.PurgeInterval.String(),
+			)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if cancel != nil {
+				cancel()
+			}
+
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+
+			select {
+			case <-done:
+				log.Infow(logMsgRecordTrashPurgeStopped)
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+}
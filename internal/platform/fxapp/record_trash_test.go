@@ -0,0 +1,52 @@
+//nolint:testpackage // tests exercise package-private wiring helpers.
+package fxapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/stretchr/testify/require"
+)
+
+type countingTrashPurger struct {
+	runs chan struct{}
+}
+
+func (p *countingTrashPurger) PurgeDeleted(context.Context) (int64, error) {
+	select {
+	case p.runs <- struct{}{}:
+	default:
+	}
+	return 0, nil
+}
+
+func TestRunRecordTrashPurge(t *testing.T) {
+	t.Run("disabled registers no hooks", func(t *testing.T) {
+		lc := &fakeLifecycle{}
+		RunRecordTrashPurge(lc, &config.Config{}, &countingTrashPurger{}, noopLoggerFx{})
+		require.Empty(t, lc.hooks)
+	})
+
+	t.Run("enabled purges on start and stops cleanly", func(t *testing.T) {
+		cfg := &config.Config{RecordTrash: config.RecordTrashConfig{
+			PurgeEnabled:  true,
+			RetentionDays: 30,
+			PurgeInterval: time.Hour,
+		}}
+		purger := &countingTrashPurger{runs: make(chan struct{}, 1)}
+		lc := &fakeLifecycle{}
+
+		RunRecordTrashPurge(lc, cfg, purger, noopLoggerFx{})
+		require.Len(t, lc.hooks, 1)
+		require.NoError(t, lc.hooks[0].OnStart(t.Context()))
+
+		select {
+		case <-purger.runs:
+		case <-time.After(time.Second):
+			t.Fatal("expected a purge run on start")
+		}
+		require.NoError(t, lc.hooks[0].OnStop(context.Background()))
+	})
+}
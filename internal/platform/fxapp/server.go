@@ -37,7 +37,10 @@ func ProvideHTTPServer(cfg *config.Config, handler http.Handler, log logger.Cont
 }
 
 // RunHTTPServer starts the HTTP server and shuts it down gracefully via lifecycle hooks.
-func RunHTTPServer(lc fx.Lifecycle, srv *http.Server, cfg *config.Config, log logger.ContextLogger) {
+// On stop, realtime streams are told to reconnect elsewhere before the server stops accepting
+// requests; srv.Shutdown then waits, within SHUTDOWN_TIMEOUT, for them to finish writing.
+// Without this, SSE responses never go idle and every stream is cut when the timeout expires.
+func RunHTTPServer(lc fx.Lifecycle, srv *http.Server, cfg *config.Config, deps *AppDependencies, log logger.ContextLogger) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			_ = ctx
@@ -52,6 +55,10 @@ func RunHTTPServer(lc fx.Lifecycle, srv *http.Server, cfg *config.Config, log lo
 		OnStop: func(ctx context.Context) error {
 			shutdownCtx, cancel := context.WithTimeout(ctx, cfg.Application.Timeout)
 			defer cancel()
+			if deps != nil && deps.RealtimeService != nil {
+				deps.RealtimeService.Shutdown(shutdownCtx)
+				log.Infow(logMsgRealtimeDraining)
+			}
 			if err := srv.Shutdown(shutdownCtx); err != nil {
 				return fmt.Errorf(errMsgHTTPShutdown, err)
 			}
@@ -2,12 +2,21 @@
 package fxapp
 
 import (
+	"bufio"
+	"context"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/lechitz/aion-api/internal/platform/config"
+	realtimeHandler "github.com/lechitz/aion-api/internal/realtime/adapter/primary/http/handler"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	realtimeUsecase "github.com/lechitz/aion-api/internal/realtime/core/usecase"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/fx"
 )
@@ -90,9 +99,59 @@ func TestRunHTTPServerRegistersLifecycleHooks(t *testing.T) {
 	}
 
 	lc := &fakeLifecycle{}
-	RunHTTPServer(lc, srv, cfg, log)
+	RunHTTPServer(lc, srv, cfg, &AppDependencies{}, log)
 	require.Len(t, lc.hooks, 1)
 
 	require.NoError(t, lc.hooks[0].OnStart(t.Context()))
 	require.NoError(t, lc.hooks[0].OnStop(t.Context()))
 }
+
+func TestRunHTTPServerDrainsRealtimeStreamsBeforeShutdown(t *testing.T) {
+	cfg := testFxConfig()
+	cfg.Realtime.ShutdownRetry = 1500 * time.Millisecond
+	log := noopLoggerFx{}
+	service := realtimeUsecase.NewService(log, 4)
+	stream := realtimeHandler.New(service, cfg, log)
+
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			stream.Stream(w, r.WithContext(context.WithValue(r.Context(), ctxkeys.UserID, uint64(14))))
+		}),
+		ReadHeaderTimeout: time.Second,
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = srv.Serve(listener) }()
+
+	lc := &fakeLifecycle{}
+	RunHTTPServer(lc, srv, cfg, &AppDependencies{RealtimeService: service}, log)
+	require.Len(t, lc.hooks, 1)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://"+listener.Addr().String()+"/events/stream", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "event: connected\n", line)
+
+	service.Publish(t.Context(), domain.Event{Type: domain.EventTypeRecordProjectionChanged, UserID: 14, RecordID: 42})
+
+	started := time.Now()
+	require.NoError(t, lc.hooks[0].OnStop(t.Context()))
+	require.Less(t, time.Since(started), cfg.Application.Timeout, "streams should drain before SHUTDOWN_TIMEOUT")
+
+	rest, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	var events []string
+	for _, line := range strings.Split(string(rest), "\n") {
+		if strings.HasPrefix(line, "event: ") || strings.HasPrefix(line, "retry: ") {
+			events = append(events, line)
+		}
+	}
+	require.Equal(t, []string{"event: record_projection_changed", "retry: 1500", "event: server_shutdown"}, events)
+	require.ErrorIs(t, srv.Serve(listener), http.ErrServerClosed)
+}
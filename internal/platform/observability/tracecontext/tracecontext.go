@@ -0,0 +1,49 @@
+// Package tracecontext carries W3C trace context across asynchronous boundaries such as the
+// outbox table and broker message headers, where no request context survives.
+package tracecontext
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// HeaderTraceParent is the W3C traceparent header name.
+	HeaderTraceParent = "traceparent"
+	// HeaderTraceState is the W3C tracestate header name.
+	HeaderTraceState = "tracestate"
+)
+
+// The W3C format is used explicitly rather than the global propagator, so stored values keep
+// one format regardless of how the process configured propagation.
+//
+//nolint:gochecknoglobals // Stateless propagator shared by every helper.
+var w3c = propagation.TraceContext{}
+
+// Inject returns the traceparent and tracestate of the span in ctx, or empty strings when ctx
+// carries no valid span.
+func Inject(ctx context.Context) (traceParent string, traceState string) {
+	carrier := propagation.MapCarrier{}
+	w3c.Inject(ctx, carrier)
+	return carrier.Get(HeaderTraceParent), carrier.Get(HeaderTraceState)
+}
+
+// Extract returns ctx with the remote span described by traceParent and traceState as its parent.
+// ctx is returned unchanged when traceParent is empty or malformed.
+func Extract(ctx context.Context, traceParent string, traceState string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+	return w3c.Extract(ctx, propagation.MapCarrier{
+		HeaderTraceParent: traceParent,
+		HeaderTraceState:  traceState,
+	})
+}
+
+// SpanContext decodes traceParent and traceState into a remote span context. The result is
+// invalid when traceParent is empty or malformed.
+func SpanContext(traceParent string, traceState string) trace.SpanContext {
+	return trace.SpanContextFromContext(Extract(context.Background(), traceParent, traceState))
+}
@@ -0,0 +1,60 @@
+package tracecontext_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInjectAndExtractRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	state, _ := trace.ParseTraceState("vendor=value")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		TraceState: state,
+	}))
+
+	parent, traceState := tracecontext.Inject(ctx)
+	if parent != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Fatalf("unexpected traceparent %q", parent)
+	}
+	if traceState != "vendor=value" {
+		t.Fatalf("unexpected tracestate %q", traceState)
+	}
+
+	remote := tracecontext.SpanContext(parent, traceState)
+	if !remote.IsValid() || !remote.IsRemote() {
+		t.Fatalf("expected a valid remote span context, got %#v", remote)
+	}
+	if remote.TraceID() != traceID || remote.SpanID() != spanID || !remote.IsSampled() {
+		t.Fatalf("unexpected span context %#v", remote)
+	}
+}
+
+func TestInjectWithoutSpanReturnsEmpty(t *testing.T) {
+	t.Parallel()
+
+	parent, traceState := tracecontext.Inject(context.Background())
+	if parent != "" || traceState != "" {
+		t.Fatalf("expected empty values, got %q %q", parent, traceState)
+	}
+}
+
+func TestExtractIgnoresMissingOrMalformedParent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	if got := tracecontext.Extract(ctx, "", ""); got != ctx {
+		t.Fatal("expected ctx to be returned unchanged")
+	}
+	if tracecontext.SpanContext("not-a-traceparent", "").IsValid() {
+		t.Fatal("expected malformed traceparent to yield an invalid span context")
+	}
+}
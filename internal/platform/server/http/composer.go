@@ -18,6 +18,7 @@ import (
 	audithandler "github.com/lechitz/aion-api/internal/audit/adapter/primary/http/handler"
 	authhandler "github.com/lechitz/aion-api/internal/auth/adapter/primary/http/handler"
 	chathandler "github.com/lechitz/aion-api/internal/chat/adapter/primary/http/handler"
+	outboxhandler "github.com/lechitz/aion-api/internal/eventoutbox/adapter/primary/http/handler"
 	realtimehandler "github.com/lechitz/aion-api/internal/realtime/adapter/primary/http/handler"
 	userhandler "github.com/lechitz/aion-api/internal/user/adapter/primary/http/handler"
 
@@ -157,8 +158,13 @@ func registerDomainRoutes(v1 ports.Router, cfg *config.Config, deps *app.Depende
 		audithandler.RegisterHTTP(v1, ah, deps.AuthService, log)
 	}
 
+	if deps.DeadLetterService != nil {
+		oh := outboxhandler.New(deps.DeadLetterService, log)
+		outboxhandler.RegisterHTTP(v1, oh, deps.AuthService, log)
+	}
+
 	if deps.RealtimeService != nil {
-		rh := realtimehandler.New(deps.RealtimeService, cfg, log)
+		rh := realtimehandler.New(deps.RealtimeService, cfg, log).WithTickets(deps.RealtimeTicketService)
 		realtimehandler.RegisterHTTP(v1, rh, deps.AuthService, log)
 	}
 
@@ -169,6 +175,7 @@ func registerDomainRoutes(v1 ports.Router, cfg *config.Config, deps *app.Depende
 		deps.RecordService,
 		deps.ChatService,
 		deps.UserService,
+		deps.RealtimeService,
 		log,
 		cfg,
 	)
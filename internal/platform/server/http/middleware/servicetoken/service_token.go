@@ -21,6 +21,9 @@ const (
 	// HeaderServiceUser is the HTTP header name used to pass an optional service user id.
 	HeaderServiceUser = "X-Service-User-Id"
 
+	// AionChatServiceName identifies callers holding AION_CHAT_SERVICE_KEY, the only key this middleware accepts.
+	AionChatServiceName = "aion-chat"
+
 	// ErrServiceTokenInvalid is the error message returned when the service token is invalid.
 	ErrServiceTokenInvalid = "service token invalid"
 
@@ -68,7 +71,9 @@ func New(cfg *config.Config, log logger.ContextLogger) func(next http.Handler) h
 				return
 			}
 
+			// The caller is named after the key it proved, not after anything it reports about itself.
 			ctx := context.WithValue(r.Context(), ctxkeys.ServiceAccount, true)
+			ctx = context.WithValue(ctx, ctxkeys.ServiceName, AionChatServiceName)
 
 			if userIDStr := r.Header.Get(HeaderServiceUser); userIDStr != "" {
 				if userID, err := strconv.ParseUint(userIDStr, 10, 64); err == nil {
@@ -130,3 +130,24 @@ func TestServiceTokenMiddleware_ValidKeyInvalidUserID_StillPasses(t *testing.T)
 		t.Fatalf("expected no user id in context for invalid header value")
 	}
 }
+
+func TestServiceTokenMiddleware_ValidKey_NamesCallerAfterKey(t *testing.T) {
+	mw := servicetoken.New(&config.Config{AionChat: config.AionChatConfig{ServiceKey: "expected"}}, &fakeLogger{})
+
+	var serviceName string
+	h := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serviceName, _ = r.Context().Value(ctxkeys.ServiceName).(string)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/path", nil)
+	req.Header.Set(servicetoken.HeaderServiceKey, "expected")
+	// A self-reported name does not override the identity the key proves.
+	req.Header.Set("X-Service-Name", "someone-else")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if serviceName != servicetoken.AionChatServiceName {
+		t.Fatalf("expected service name %q, got %q", servicetoken.AionChatServiceName, serviceName)
+	}
+}
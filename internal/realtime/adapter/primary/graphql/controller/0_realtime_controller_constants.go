@@ -0,0 +1,73 @@
+// Package controller contains GraphQL-facing controllers for the Realtime context.
+package controller
+
+import "errors"
+
+// =============================================================================
+// TRACING - OpenTelemetry Instrumentation
+// =============================================================================
+
+// TracerName is the name of the tracer for Realtime GraphQL controllers.
+// Format: aion-api.<domain>.<layer> .
+const TracerName = "aion-api.realtime.controller"
+
+// -----------------------------------------------------------------------------
+// Span Names
+// Format: <domain>.<operation>
+// -----------------------------------------------------------------------------
+
+const (
+	// SpanRecordProjectionChanged is the span name for opening a recordProjectionChanged subscription.
+	SpanRecordProjectionChanged = "realtime.controller.record_projection_changed"
+)
+
+// -----------------------------------------------------------------------------
+// Status Descriptions
+// -----------------------------------------------------------------------------
+
+const (
+	// StatusSubscribed indicates that a subscription has been opened.
+	StatusSubscribed = "subscribed"
+)
+
+// =============================================================================
+// BUSINESS LOGIC - Error and Log Messages
+// =============================================================================
+
+// -----------------------------------------------------------------------------
+// Log Messages
+// -----------------------------------------------------------------------------
+
+const (
+	// MsgSubscribed is the log message for when a subscription is opened.
+	MsgSubscribed = "record projection subscription opened"
+
+	// MsgUnsubscribed is the log message for when a subscription ends.
+	MsgUnsubscribed = "record projection subscription closed"
+
+	// MsgSubscriptionOverrun is the log message for when the realtime service drops a subscription
+	// that fell behind; the client resubscribes and refetches its state.
+	MsgSubscriptionOverrun = "record projection subscription fell behind and was closed"
+
+	// MsgSubscriptionShutdown is the log message for when a subscription ends because the instance is shutting down.
+	MsgSubscriptionShutdown = "record projection subscription closed for shutdown"
+)
+
+// -----------------------------------------------------------------------------
+// Sentinel Errors
+// Use errors.Is() for type-safe error comparison
+// -----------------------------------------------------------------------------
+
+var (
+	// ErrUserIDNotFound is the error when the user ID is missing or invalid.
+	ErrUserIDNotFound = errors.New("user id not found")
+
+	// ErrInvalidTagID is the error when a tag ID filter cannot be parsed.
+	ErrInvalidTagID = errors.New("invalid tag id")
+
+	// ErrInvalidCategoryID is the error when the category ID filter cannot be parsed.
+	ErrInvalidCategoryID = errors.New("invalid category id")
+
+	// ErrInvalidAction is the error when an action filter is not a known record projection action.
+	ErrInvalidAction = errors.New("invalid record projection action")
+)
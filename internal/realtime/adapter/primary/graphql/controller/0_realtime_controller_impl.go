@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/adapter/primary/graphql/model"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/realtime/core/ports/input"
+)
+
+// RealtimeController is the contract used by GraphQL resolvers.
+type RealtimeController interface {
+	RecordProjectionChanged(
+		ctx context.Context,
+		filter *model.RecordProjectionChangedFilter,
+		userID uint64,
+	) (<-chan *model.RecordProjectionChange, error)
+}
+
+// controller is the controller for the realtime service.
+type controller struct {
+	RealtimeService input.Service
+	Logger          logger.ContextLogger
+}
+
+// NewController wires dependencies and returns a Controller.
+func NewController(svc input.Service, logger logger.ContextLogger) RealtimeController {
+	return &controller{
+		RealtimeService: svc,
+		Logger:          logger,
+	}
+}
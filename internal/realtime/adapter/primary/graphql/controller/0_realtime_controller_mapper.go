@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/adapter/primary/graphql/model"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// toModelOut maps a realtime event to the GraphQL record projection change.
+func toModelOut(event domain.Event) *model.RecordProjectionChange {
+	out := &model.RecordProjectionChange{
+		RecordID:       strconv.FormatUint(event.RecordID, 10),
+		UserID:         strconv.FormatUint(event.UserID, 10),
+		Action:         toModelAction(event.Action),
+		ProjectedAtUtc: event.ProjectedAtUTC.UTC().Format(time.RFC3339Nano),
+		SourceEventID:  optionalString(event.SourceEventID),
+		TraceID:        optionalString(event.TraceID),
+		RequestID:      optionalString(event.RequestID),
+	}
+	if event.ID != 0 {
+		id := strconv.FormatUint(event.ID, 10)
+		out.EventID = &id
+	}
+	return out
+}
+
+// toModelAction maps the lowercase realtime action to the GraphQL enum; unknown actions are updates,
+// the same fallback the projection-ready codec applies.
+func toModelAction(action string) model.RecordProjectionAction {
+	out := model.RecordProjectionAction(strings.ToUpper(action))
+	if !out.IsValid() {
+		return model.RecordProjectionActionUpdated
+	}
+	return out
+}
+
+// toDomainAction maps a GraphQL action enum to the lowercase realtime action.
+func toDomainAction(action model.RecordProjectionAction) (string, error) {
+	if !action.IsValid() {
+		return "", ErrInvalidAction
+	}
+	return strings.ToLower(string(action)), nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// toDomainFilter maps the GraphQL filter to the realtime subscription filter and validates it.
+// The subscription only carries record projection changes.
+func toDomainFilter(in *model.RecordProjectionChangedFilter) (domain.Filter, error) {
+	out := domain.Filter{Types: []string{domain.EventTypeRecordProjectionChanged}}
+	if in == nil {
+		return out, nil
+	}
+
+	for _, action := range in.Actions {
+		value, err := toDomainAction(action)
+		if err != nil {
+			return domain.Filter{}, err
+		}
+		out.Actions = append(out.Actions, value)
+	}
+
+	for _, raw := range in.TagIds {
+		tagID, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return domain.Filter{}, ErrInvalidTagID
+		}
+		out.TagIDs = append(out.TagIDs, tagID)
+	}
+
+	if in.CategoryID != nil {
+		categoryID, err := strconv.ParseUint(*in.CategoryID, 10, 64)
+		if err != nil {
+			return domain.Filter{}, ErrInvalidCategoryID
+		}
+		out.CategoryIDs = []uint64{categoryID}
+	}
+
+	if err := out.Validate(); err != nil {
+		return domain.Filter{}, err
+	}
+	return out, nil
+}
@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/lechitz/aion-api/internal/adapter/primary/graphql/model"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// RecordProjectionChanged opens a realtime subscription for the authenticated user and streams the
+// record projection changes that match filter until ctx ends. The filter is applied by the realtime
+// service before events are enqueued, on the tag and category the events carry.
+func (h *controller) RecordProjectionChanged(
+	ctx context.Context,
+	filter *model.RecordProjectionChangedFilter,
+	userID uint64,
+) (<-chan *model.RecordProjectionChange, error) {
+	tr := otel.Tracer(TracerName)
+	spanCtx, span := tr.Start(ctx, SpanRecordProjectionChanged)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanRecordProjectionChanged),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+	)
+
+	if userID == 0 {
+		span.SetStatus(codes.Error, ErrUserIDNotFound.Error())
+		h.Logger.ErrorwCtx(spanCtx, ErrUserIDNotFound.Error(), commonkeys.UserID, userID)
+		return nil, ErrUserIDNotFound
+	}
+
+	parsed, err := toDomainFilter(filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		h.Logger.ErrorwCtx(spanCtx, err.Error(), commonkeys.UserID, userID)
+		return nil, err
+	}
+
+	// The subscription outlives this span, so it is bound to the resolver context.
+	events, unsubscribe, err := h.RealtimeService.Subscribe(ctx, userID, parsed)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		h.Logger.WarnwCtx(spanCtx, err.Error(), commonkeys.UserID, userID)
+		return nil, err
+	}
+	out := make(chan *model.RecordProjectionChange)
+	go h.forward(ctx, userID, events, unsubscribe, out)
+
+	span.SetStatus(codes.Ok, StatusSubscribed)
+	h.Logger.InfowCtx(spanCtx, MsgSubscribed, commonkeys.UserID, strconv.FormatUint(userID, 10))
+	return out, nil
+}
+
+// forward relays events to out and releases the realtime subscription when ctx ends or the
+// service closes the event channel, which it does after a resync_required event when the
+// subscription fell behind and after a server_shutdown event when the instance stops.
+func (h *controller) forward(
+	ctx context.Context,
+	userID uint64,
+	events <-chan domain.Event,
+	unsubscribe func(),
+	out chan<- *model.RecordProjectionChange,
+) {
+	defer close(out)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.Logger.InfowCtx(ctx, MsgUnsubscribed, commonkeys.UserID, strconv.FormatUint(userID, 10))
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if event.Type == domain.EventTypeResyncRequired {
+				h.Logger.WarnwCtx(ctx, MsgSubscriptionOverrun, commonkeys.UserID, strconv.FormatUint(userID, 10))
+				return
+			}
+			if event.Type == domain.EventTypeServerShutdown {
+				h.Logger.InfowCtx(ctx, MsgSubscriptionShutdown, commonkeys.UserID, strconv.FormatUint(userID, 10))
+				return
+			}
+			select {
+			case out <- toModelOut(event):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gmodel "github.com/lechitz/aion-api/internal/adapter/primary/graphql/model"
+	"github.com/lechitz/aion-api/internal/realtime/adapter/primary/graphql/controller"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/realtime/core/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+type realtimeLoggerStub struct{}
+
+func (realtimeLoggerStub) Infof(string, ...any)                      {}
+func (realtimeLoggerStub) Errorf(string, ...any)                     {}
+func (realtimeLoggerStub) Debugf(string, ...any)                     {}
+func (realtimeLoggerStub) Warnf(string, ...any)                      {}
+func (realtimeLoggerStub) Infow(string, ...any)                      {}
+func (realtimeLoggerStub) Errorw(string, ...any)                     {}
+func (realtimeLoggerStub) Debugw(string, ...any)                     {}
+func (realtimeLoggerStub) Warnw(string, ...any)                      {}
+func (realtimeLoggerStub) InfowCtx(context.Context, string, ...any)  {}
+func (realtimeLoggerStub) ErrorwCtx(context.Context, string, ...any) {}
+func (realtimeLoggerStub) WarnwCtx(context.Context, string, ...any)  {}
+func (realtimeLoggerStub) DebugwCtx(context.Context, string, ...any) {}
+
+func receive(t *testing.T, ch <-chan *gmodel.RecordProjectionChange) *gmodel.RecordProjectionChange {
+	t.Helper()
+	select {
+	case change := <-ch:
+		return change
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a record projection change")
+		return nil
+	}
+}
+
+func TestRecordProjectionChangedFiltersByActionTagAndCategory(t *testing.T) {
+	t.Parallel()
+
+	svc := usecase.NewService(realtimeLoggerStub{}, 8)
+	ctrl := controller.NewController(svc, realtimeLoggerStub{})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	categoryID := "100"
+	changes, err := ctrl.RecordProjectionChanged(ctx, &gmodel.RecordProjectionChangedFilter{
+		TagIds:     []string{"10", "20"},
+		CategoryID: &categoryID,
+		Actions:    []gmodel.RecordProjectionAction{gmodel.RecordProjectionActionCreated, gmodel.RecordProjectionActionUpdated},
+	}, 7)
+	require.NoError(t, err)
+
+	projectedAt := time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC)
+	publish := func(recordID, tagID, categoryID uint64, action, sourceEventID string) {
+		svc.Publish(t.Context(), domain.Event{
+			Type:           domain.EventTypeRecordProjectionChanged,
+			UserID:         7,
+			RecordID:       recordID,
+			TagID:          tagID,
+			CategoryID:     categoryID,
+			Action:         action,
+			ProjectedAtUTC: projectedAt,
+			SourceEventID:  sourceEventID,
+		})
+	}
+	publish(1, 10, 100, domain.ActionDeleted, "")
+	publish(2, 20, 200, domain.ActionCreated, "")
+	publish(99, 30, 100, domain.ActionCreated, "")
+	publish(1, 10, 100, domain.ActionCreated, "evt-1")
+	publish(3, 10, 100, domain.ActionUpdated, "")
+
+	first := receive(t, changes)
+	require.Equal(t, "1", first.RecordID)
+	require.Equal(t, "7", first.UserID)
+	require.Equal(t, gmodel.RecordProjectionActionCreated, first.Action)
+	require.Equal(t, "2026-03-13T13:00:00Z", first.ProjectedAtUtc)
+	require.NotNil(t, first.SourceEventID)
+	require.Equal(t, "evt-1", *first.SourceEventID)
+	require.Nil(t, first.EventID)
+
+	second := receive(t, changes)
+	require.Equal(t, "3", second.RecordID)
+	require.Equal(t, gmodel.RecordProjectionActionUpdated, second.Action)
+
+	cancel()
+	select {
+	case _, ok := <-changes:
+		require.False(t, ok, "expected the channel to close after the subscription ends")
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the subscription to close")
+	}
+}
+
+func TestRecordProjectionChangedRejectsInvalidFilter(t *testing.T) {
+	t.Parallel()
+
+	ctrl := controller.NewController(usecase.NewService(realtimeLoggerStub{}, 1), realtimeLoggerStub{})
+
+	_, err := ctrl.RecordProjectionChanged(t.Context(), &gmodel.RecordProjectionChangedFilter{TagIds: []string{"abc"}}, 7)
+	require.ErrorIs(t, err, controller.ErrInvalidTagID)
+
+	bad := "x"
+	_, err = ctrl.RecordProjectionChanged(t.Context(), &gmodel.RecordProjectionChangedFilter{CategoryID: &bad}, 7)
+	require.ErrorIs(t, err, controller.ErrInvalidCategoryID)
+
+	_, err = ctrl.RecordProjectionChanged(t.Context(), &gmodel.RecordProjectionChangedFilter{TagIds: []string{"0"}}, 7)
+	require.ErrorIs(t, err, domain.ErrFilterInvalidID)
+
+	_, err = ctrl.RecordProjectionChanged(t.Context(), &gmodel.RecordProjectionChangedFilter{
+		Actions: []gmodel.RecordProjectionAction{"ARCHIVED"},
+	}, 7)
+	require.ErrorIs(t, err, controller.ErrInvalidAction)
+
+	_, err = ctrl.RecordProjectionChanged(t.Context(), nil, 0)
+	require.ErrorIs(t, err, controller.ErrUserIDNotFound)
+}
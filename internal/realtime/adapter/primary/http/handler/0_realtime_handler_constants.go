@@ -1,12 +1,59 @@
 package handler
 
+import "github.com/lechitz/aion-api/internal/realtime/core/domain"
+
+const (
+	// TracerName is the tracer name used by the realtime HTTP handler.
+	TracerName = "aion-api.realtime.handler"
+	// MeterName is the meter name used by the realtime HTTP handler.
+	MeterName = "aion-api.realtime.handler"
+
+	// MetricDeliveryLatency observes the time from projection (ProjectedAtUTC) to the SSE write.
+	MetricDeliveryLatency = "aion.realtime.delivery.latency"
+	// MetricAttrReplayed marks latencies of events written during Last-Event-ID replay.
+	MetricAttrReplayed = "replayed"
+
+	// MetricTicketsRejected counts stream tickets that failed to open a stream, by MetricAttrReason.
+	MetricTicketsRejected = "aion.realtime.tickets.rejected"
+	// MetricAttrReason is ticketRejectedInvalid or ticketRejectedReused.
+	MetricAttrReason = "reason"
+
+	// SpanDeliver is the span name for writing one event to an SSE stream.
+	SpanDeliver = "realtime.sse.deliver"
+
+	// SpanIssueTicket is the span name for POST /realtime/ticket.
+	SpanIssueTicket = "realtime.handler.issue_ticket"
+
+	// SpanTicketAuth is the span name for authenticating a stream request with a ticket.
+	SpanTicketAuth = "realtime.handler.ticket_auth"
+
+	// SpanTicketRedeem is the span name for spending the ticket of a stream request whose slot is reserved.
+	SpanTicketRedeem = "realtime.handler.ticket_redeem"
+)
+
 const (
 	streamRoute = "/events/stream"
+	ticketRoute = "/ticket"
 
 	headerContentType    = "Content-Type"
 	headerCacheControl   = "Cache-Control"
 	headerConnection     = "Connection"
 	headerAccelBuffering = "X-Accel-Buffering"
+	headerLastEventID    = "Last-Event-ID"
+	headerRetryAfter     = "Retry-After"
+
+	// queryLastEventID lets clients that cannot set headers, such as a fresh EventSource after an
+	// app restart, resume from a stored id.
+	queryLastEventID = "lastEventId"
+
+	// queryTicket carries a single-use stream ticket in place of the Authorization header.
+	queryTicket = "ticket"
+
+	// Stream filter parameters; each accepts a comma-separated list and may be repeated.
+	queryTypes       = "types"
+	queryActions     = "actions"
+	queryTagIDs      = "tagIds"
+	queryCategoryIDs = "categoryIds"
 
 	contentTypeEventStream = "text/event-stream"
 	cacheControlNoCache    = "no-cache"
@@ -14,11 +61,37 @@ const (
 	accelBufferingNo       = "no"
 
 	sseEventConnected               = "connected"
-	sseEventRecordProjectionChanged = "record_projection_changed"
+	sseEventRecordProjectionChanged = domain.EventTypeRecordProjectionChanged
+	sseEventResyncRequired          = "resync_required"
+	sseEventServerShutdown          = "server_shutdown"
 	sseDataPrefix                   = "data: "
 	sseEventPrefix                  = "event: "
+	sseIDPrefix                     = "id: "
+	sseRetryPrefix                  = "retry: "
 	sseCommentHeartbeat             = ": keepalive\n\n"
 
-	logRealtimeConnected    = "realtime stream connected"
-	logRealtimeDisconnected = "realtime stream disconnected"
+	logRealtimeConnected          = "realtime stream connected"
+	logRealtimeDisconnected       = "realtime stream disconnected"
+	logRealtimeReplayFailed       = "realtime replay failed, asking client to resync"
+	logRealtimeStreamOverrun      = "realtime stream fell behind and was closed with resync_required"
+	logRealtimeMetricsUnavailable = "realtime delivery metrics unavailable"
+	logRealtimeShutdown           = "realtime stream closed for shutdown"
+	logRealtimeTicketRejected     = "realtime stream ticket rejected"
+
+	// Ticket rejection reasons: the ticket was unknown, expired, or already used when the request
+	// arrived, or it was valid then but another request redeemed it before this stream could.
+	ticketRejectedInvalid = "invalid"
+	ticketRejectedReused  = "reused"
+
+	// resync_required reasons: the replay no longer covers the client's id, or the live stream
+	// was dropped by the disconnect slow-consumer policy.
+	resyncReasonReplayGap    = "replay_gap"
+	resyncReasonSlowConsumer = "slow_consumer"
+
+	errInvalidFilterID = "invalid %s value %q"
+
+	errTicketUnavailable = "stream tickets are not enabled"
+	errTicketInvalid     = "invalid stream ticket"
+	errIssueTicket       = "failed to issue stream ticket"
+	msgTicketIssued      = "stream ticket issued"
 )
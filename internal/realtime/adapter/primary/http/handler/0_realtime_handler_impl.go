@@ -2,27 +2,51 @@
 package handler
 
 import (
+	"math/rand/v2"
 	"time"
 
 	"github.com/lechitz/aion-api/internal/platform/config"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
 	realtimeInput "github.com/lechitz/aion-api/internal/realtime/core/ports/input"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // Handler serves realtime SSE endpoints.
 type Handler struct {
 	Service realtimeInput.Service
+	Tickets realtimeInput.TicketService
 	Config  *config.Config
 	Logger  logger.ContextLogger
+	metrics *streamMetrics
 }
 
-// New creates a realtime HTTP handler.
+// New creates a realtime HTTP handler whose delivery metrics go to the global OpenTelemetry meter provider.
 func New(service realtimeInput.Service, cfg *config.Config, log logger.ContextLogger) *Handler {
-	return &Handler{
+	h := &Handler{
 		Service: service,
 		Config:  cfg,
 		Logger:  log,
 	}
+	return h.WithMeterProvider(otel.GetMeterProvider())
+}
+
+// WithMeterProvider moves the delivery metrics to the given provider.
+func (h *Handler) WithMeterProvider(provider metric.MeterProvider) *Handler {
+	metrics, err := newStreamMetrics(provider)
+	if err != nil {
+		h.Logger.Warnw(logRealtimeMetricsUnavailable, commonkeys.Error, err.Error())
+		return h
+	}
+	h.metrics = metrics
+	return h
+}
+
+// WithTickets enables POST /realtime/ticket and ?ticket= authentication on the stream route.
+func (h *Handler) WithTickets(tickets realtimeInput.TicketService) *Handler {
+	h.Tickets = tickets
+	return h
 }
 
 func (h *Handler) heartbeatInterval() time.Duration {
@@ -32,6 +56,20 @@ func (h *Handler) heartbeatInterval() time.Duration {
 	return h.Config.Realtime.HeartbeatInterval
 }
 
+// shutdownRetry is the reconnect delay suggested to a stream closed on shutdown: the configured
+// delay plus a random share of the configured jitter.
+func (h *Handler) shutdownRetry() time.Duration {
+	if h.Config == nil || h.Config.Realtime.ShutdownRetry <= 0 {
+		return time.Second
+	}
+	retry := h.Config.Realtime.ShutdownRetry
+	if jitter := h.Config.Realtime.ShutdownRetryJitter; jitter > 0 {
+		// #nosec G404 -- reconnect jitter does not need cryptographic randomness.
+		retry += rand.N(jitter)
+	}
+	return retry
+}
+
 func (h *Handler) streamPath() string {
 	if h.Config == nil || h.Config.Realtime.StreamPath == "" {
 		return streamRoute
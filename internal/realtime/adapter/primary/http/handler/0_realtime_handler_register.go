@@ -9,14 +9,20 @@ import (
 	"github.com/lechitz/aion-api/internal/platform/server/http/ports"
 )
 
-// RegisterHTTP mounts realtime routes behind the auth middleware.
+// RegisterHTTP mounts realtime routes behind the auth middleware. The stream route also accepts a
+// single-use ?ticket= in place of the Authorization header when tickets are enabled.
 func RegisterHTTP(r ports.Router, h *Handler, authService authInput.AuthService, log logger.ContextLogger) {
 	if h == nil || authService == nil {
 		return
 	}
 
+	auth := authmw.New(authService, log).Auth
 	r.Group("/realtime", func(rr ports.Router) {
-		rr.Use(authmw.New(authService, log).Auth)
-		rr.GET(h.streamPath(), http.HandlerFunc(h.Stream))
+		rr.GET(h.streamPath(), h.streamAuth(auth)(http.HandlerFunc(h.Stream)))
+		if h.Tickets != nil {
+			rr.GroupWith(auth, func(ar ports.Router) {
+				ar.POST(ticketRoute, http.HandlerFunc(h.IssueTicket))
+			})
+		}
 	})
 }
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// requestFilter reads the stream filter from the query string and validates it, so a filter that
+// could never match is rejected before the stream opens.
+func requestFilter(r *http.Request) (domain.Filter, error) {
+	query := r.URL.Query()
+
+	tagIDs, err := queryIDs(query[queryTagIDs], queryTagIDs)
+	if err != nil {
+		return domain.Filter{}, err
+	}
+	categoryIDs, err := queryIDs(query[queryCategoryIDs], queryCategoryIDs)
+	if err != nil {
+		return domain.Filter{}, err
+	}
+
+	filter := domain.Filter{
+		Types:       queryValues(query[queryTypes]),
+		Actions:     queryValues(query[queryActions]),
+		TagIDs:      tagIDs,
+		CategoryIDs: categoryIDs,
+	}
+	if err := filter.Validate(); err != nil {
+		return domain.Filter{}, err
+	}
+	return filter, nil
+}
+
+// queryValues flattens repeated and comma-separated parameter values, dropping empty entries.
+func queryValues(raw []string) []string {
+	var values []string
+	for _, item := range raw {
+		for _, value := range strings.Split(item, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+func queryIDs(raw []string, name string) ([]uint64, error) {
+	values := queryValues(raw)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uint64, 0, len(values))
+	for _, value := range values {
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(errInvalidFilterID, name, value)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
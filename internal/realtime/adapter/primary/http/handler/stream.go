@@ -3,13 +3,22 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Stream serves the authenticated realtime SSE stream for the current user.
@@ -26,20 +35,35 @@ func (h *Handler) Stream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set(headerContentType, contentTypeEventStream)
-	w.Header().Set(headerCacheControl, cacheControlNoCache)
-	w.Header().Set(headerConnection, connectionKeepAlive)
-	w.Header().Set(headerAccelBuffering, accelBufferingNo)
+	filter, err := requestFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	ctx, cancel := context.WithCancel(r.Context())
 	defer cancel()
 
-	events, cleanup := h.Service.Subscribe(ctx, userID)
+	events, cleanup, err := h.Service.Subscribe(ctx, userID, filter)
+	if err != nil {
+		h.writeSubscribeError(w, err)
+		return
+	}
 	defer cleanup()
 
+	// The stream slot is held now; only this spends a ticket, so refused requests can retry with it.
+	if !h.redeemPendingTicket(w, r, userID) {
+		return
+	}
+
+	w.Header().Set(headerContentType, contentTypeEventStream)
+	w.Header().Set(headerCacheControl, cacheControlNoCache)
+	w.Header().Set(headerConnection, connectionKeepAlive)
+	w.Header().Set(headerAccelBuffering, accelBufferingNo)
+
 	h.Logger.InfowCtx(ctx, logRealtimeConnected, commonkeys.UserID, strconv.FormatUint(userID, 10))
 
-	if err := writeSSE(w, sseEventConnected, map[string]any{
+	if err := writeSSE(w, 0, sseEventConnected, map[string]any{
 		"type":           "connected",
 		"userId":         strconv.FormatUint(userID, 10),
 		"connectedAtUTC": time.Now().UTC().Format(time.RFC3339Nano),
@@ -47,6 +71,15 @@ func (h *Handler) Stream(w http.ResponseWriter, r *http.Request) {
 		flusher.Flush()
 	}
 
+	// The subscription above is already buffering live events, so replayed events may show up
+	// again on the channel; anything up to the last replayed id is skipped below.
+	var delivered uint64
+	if lastEventID := requestLastEventID(r); lastEventID != "" {
+		if delivered, err = h.resume(ctx, w, flusher, userID, lastEventID, filter); err != nil {
+			return
+		}
+	}
+
 	heartbeat := time.NewTicker(h.heartbeatInterval())
 	defer heartbeat.Stop()
 
@@ -57,12 +90,30 @@ func (h *Handler) Stream(w http.ResponseWriter, r *http.Request) {
 			return
 		case event, ok := <-events:
 			if !ok {
+				// Only shutdown closes a stream without a final event, when its buffer had no room
+				// left for server_shutdown; everything buffered was delivered, so end it the same way.
+				h.Logger.InfowCtx(ctx, logRealtimeShutdown, commonkeys.UserID, strconv.FormatUint(userID, 10))
+				_ = h.writeShutdown(w, flusher, userID)
 				return
 			}
-			if err := writeSSE(w, sseEventRecordProjectionChanged, event); err != nil {
+			if event.Type == domain.EventTypeResyncRequired {
+				// The service dropped this stream for falling behind; the client refetches and
+				// reconnects from the id carried here.
+				h.Logger.WarnwCtx(ctx, logRealtimeStreamOverrun, commonkeys.UserID, strconv.FormatUint(userID, 10))
+				_ = h.writeResync(w, flusher, userID, event.ID, "", resyncReasonSlowConsumer)
+				return
+			}
+			if event.Type == domain.EventTypeServerShutdown {
+				h.Logger.InfowCtx(ctx, logRealtimeShutdown, commonkeys.UserID, strconv.FormatUint(userID, 10))
+				_ = h.writeShutdown(w, flusher, userID)
+				return
+			}
+			if event.ID != 0 && event.ID <= delivered {
+				continue
+			}
+			if err := h.deliver(ctx, w, flusher, event, false); err != nil {
 				return
 			}
-			flusher.Flush()
 		case <-heartbeat.C:
 			if _, err := fmt.Fprint(w, sseCommentHeartbeat); err != nil {
 				return
@@ -72,12 +123,163 @@ func (h *Handler) Stream(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func writeSSE(w http.ResponseWriter, eventName string, payload any) error {
+// writeSubscribeError answers a refused subscription: 429 at a stream cap and 503 during shutdown,
+// both with a Retry-After hint, and 500 otherwise.
+func (h *Handler) writeSubscribeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, domain.ErrUserStreamLimit) || errors.Is(err, domain.ErrStreamLimit) {
+		w.Header().Set(headerRetryAfter, strconv.Itoa(int(h.heartbeatInterval().Seconds())))
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	if errors.Is(err, domain.ErrShuttingDown) {
+		w.Header().Set(headerRetryAfter, strconv.Itoa(int(math.Ceil(h.shutdownRetry().Seconds()))))
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+// requestLastEventID returns the id the client last saw, from the Last-Event-ID header an
+// EventSource sends on reconnect or, failing that, the lastEventId query parameter.
+func requestLastEventID(r *http.Request) string {
+	if value := strings.TrimSpace(r.Header.Get(headerLastEventID)); value != "" {
+		return value
+	}
+	return strings.TrimSpace(r.URL.Query().Get(queryLastEventID))
+}
+
+// resume replays the events passing filter that the client missed after lastEventID and returns
+// the latest id the replay accounted for; live events up to it were either replayed or filtered
+// out. When the events are no longer retained, the id is unknown, or the replay cannot be
+// read, it sends resync_required instead, carrying the latest id so the next reconnect after the
+// client refetched its state resumes from there.
+func (h *Handler) resume(
+	ctx context.Context,
+	w http.ResponseWriter,
+	flusher http.Flusher,
+	userID uint64,
+	lastEventID string,
+	filter domain.Filter,
+) (uint64, error) {
+	// An id that does not parse was not issued by this server; asking for everything after the
+	// largest possible id yields only the latest id and a resync.
+	after, err := strconv.ParseUint(lastEventID, 10, 64)
+	if err != nil {
+		after = math.MaxUint64
+	}
+
+	replay, err := h.Service.Replay(ctx, userID, after, filter)
+	if err != nil {
+		h.Logger.WarnwCtx(ctx, logRealtimeReplayFailed, commonkeys.UserID, strconv.FormatUint(userID, 10), commonkeys.Error, err.Error())
+		replay = domain.Replay{ResyncRequired: true}
+	}
+
+	if replay.ResyncRequired {
+		if err := h.writeResync(w, flusher, userID, replay.LatestID, lastEventID, resyncReasonReplayGap); err != nil {
+			return 0, err
+		}
+		return replay.LatestID, nil
+	}
+
+	for _, event := range replay.Events {
+		if err := h.deliver(ctx, w, flusher, event, true); err != nil {
+			return 0, err
+		}
+	}
+	return replay.LatestID, nil
+}
+
+// writeResync tells the client to refetch its state and resume after latestID. lastEventID is
+// the id the client resumed from, empty when the stream was dropped while live.
+func (h *Handler) writeResync(
+	w http.ResponseWriter,
+	flusher http.Flusher,
+	userID, latestID uint64,
+	lastEventID, reason string,
+) error {
+	payload := map[string]any{
+		"type":          sseEventResyncRequired,
+		"userId":        strconv.FormatUint(userID, 10),
+		"latestEventId": strconv.FormatUint(latestID, 10),
+		"reason":        reason,
+	}
+	if lastEventID != "" {
+		payload["lastEventId"] = lastEventID
+	}
+	if err := writeSSE(w, latestID, sseEventResyncRequired, payload); err != nil {
+		return err
+	}
+	flusher.Flush()
+	return nil
+}
+
+// writeShutdown tells the client the instance is going away. The SSE retry field makes EventSource
+// wait the configured delay plus a random share of the jitter before reconnecting, so the streams
+// of a stopping instance spread their reconnects over the remaining ones instead of arriving at once.
+func (h *Handler) writeShutdown(w http.ResponseWriter, flusher http.Flusher, userID uint64) error {
+	retry := h.shutdownRetry()
+	if _, err := fmt.Fprintf(w, "%s%d\n", sseRetryPrefix, retry.Milliseconds()); err != nil {
+		return err
+	}
+	if err := writeSSE(w, 0, sseEventServerShutdown, map[string]any{
+		"type":    sseEventServerShutdown,
+		"userId":  strconv.FormatUint(userID, 10),
+		"retryMs": retry.Milliseconds(),
+	}); err != nil {
+		return err
+	}
+	flusher.Flush()
+	return nil
+}
+
+// deliver writes one event under a span that continues the event's trace, so the SSE write
+// closes the trace that started with the producing request, and records the delay from
+// projection to write.
+func (h *Handler) deliver(ctx context.Context, w http.ResponseWriter, flusher http.Flusher, event domain.Event, replayed bool) error {
+	spanCtx, span := otel.Tracer(TracerName).Start(
+		tracecontext.Extract(ctx, event.TraceParent, event.TraceState),
+		SpanDeliver,
+		trace.WithAttributes(
+			attribute.String("event_type", sseEventName(event)),
+			attribute.String("record_id", strconv.FormatUint(event.RecordID, 10)),
+			attribute.String("action", event.Action),
+			attribute.String("event_id", strconv.FormatUint(event.ID, 10)),
+		),
+	)
+	defer span.End()
+
+	if err := writeSSE(w, event.ID, sseEventName(event), event); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	flusher.Flush()
+	h.metrics.recordDelivery(spanCtx, event, replayed)
+	return nil
+}
+
+// sseEventName is the SSE event field of event: its type, or record_projection_changed for events
+// from producers that predate typed events and leave the type empty.
+func sseEventName(event domain.Event) string {
+	if event.Type == "" {
+		return sseEventRecordProjectionChanged
+	}
+	return event.Type
+}
+
+// writeSSE writes one SSE frame; a zero id leaves out the id field so the client keeps its last one.
+func writeSSE(w http.ResponseWriter, id uint64, eventName string, payload any) error {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return err
 	}
 
+	if id != 0 {
+		if _, err := fmt.Fprintf(w, "%s%d\n", sseIDPrefix, id); err != nil {
+			return err
+		}
+	}
+
 	if _, err := fmt.Fprintf(w, "%s%s\n", sseEventPrefix, eventName); err != nil {
 		return err
 	}
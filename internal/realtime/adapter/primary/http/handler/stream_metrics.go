@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// streamMetrics holds the SSE delivery and stream ticket instruments.
+type streamMetrics struct {
+	latency         metric.Float64Histogram
+	ticketsRejected metric.Int64Counter
+}
+
+func newStreamMetrics(provider metric.MeterProvider) (*streamMetrics, error) {
+	meter := provider.Meter(MeterName)
+
+	latency, err := meter.Float64Histogram(MetricDeliveryLatency,
+		metric.WithDescription("Time from record projection to the realtime SSE write."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketsRejected, err := meter.Int64Counter(MetricTicketsRejected,
+		metric.WithDescription("Realtime stream tickets that could not open a stream."),
+		metric.WithUnit("{ticket}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &streamMetrics{latency: latency, ticketsRejected: ticketsRejected}, nil
+}
+
+// recordDelivery tolerates a nil receiver and skips events without a projection time.
+func (m *streamMetrics) recordDelivery(ctx context.Context, event domain.Event, replayed bool) {
+	if m == nil || event.ProjectedAtUTC.IsZero() {
+		return
+	}
+	m.latency.Record(ctx, max(time.Since(event.ProjectedAtUTC).Seconds(), 0),
+		metric.WithAttributes(attribute.Bool(MetricAttrReplayed, replayed)),
+	)
+}
+
+// recordTicketRejected tolerates a nil receiver like recordDelivery.
+func (m *streamMetrics) recordTicketRejected(ctx context.Context, reason string) {
+	if m == nil {
+		return
+	}
+	m.ticketsRejected.Add(ctx, 1, metric.WithAttributes(attribute.String(MetricAttrReason, reason)))
+}
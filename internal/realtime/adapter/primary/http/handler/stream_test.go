@@ -6,13 +6,17 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/realtime/adapter/secondary/replay/memory"
 	"github.com/lechitz/aion-api/internal/realtime/core/domain"
 	realtimeUsecase "github.com/lechitz/aion-api/internal/realtime/core/usecase"
 	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 func TestStreamWritesSSEEvent(t *testing.T) {
@@ -61,6 +65,314 @@ func TestStreamWritesSSEEvent(t *testing.T) {
 	}
 }
 
+func streamOnce(t *testing.T, handler *Handler, target, lastEventID string, publish func()) string {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, target, nil)
+	if lastEventID != "" {
+		req.Header.Set(headerLastEventID, lastEventID)
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	req = req.WithContext(context.WithValue(ctx, ctxkeys.UserID, uint64(14)))
+	rec := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Stream(rec, req)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	if publish != nil {
+		publish()
+		time.Sleep(20 * time.Millisecond)
+	}
+	cancel()
+	<-done
+	return rec.Body.String()
+}
+
+func TestStreamReplaysMissedEventsAfterLastEventID(t *testing.T) {
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 4).WithReplayStore(memory.NewReplayStore(8))
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: time.Minute}}, noopRealtimeHandlerLogger{})
+	for recordID := uint64(1); recordID <= 3; recordID++ {
+		service.Publish(t.Context(), domain.Event{Type: "record_projection_changed", UserID: 14, RecordID: recordID})
+	}
+
+	body := streamOnce(t, handler, "/events/stream", "1", func() {
+		service.Publish(t.Context(), domain.Event{Type: "record_projection_changed", UserID: 14, RecordID: 4})
+	})
+
+	for _, id := range []string{"id: 2\n", "id: 3\n", "id: 4\n"} {
+		if strings.Count(body, id) != 1 {
+			t.Fatalf("expected %q exactly once, got %q", id, body)
+		}
+	}
+	if strings.Contains(body, "id: 1\n") || strings.Contains(body, sseEventResyncRequired) {
+		t.Fatalf("expected only events after id 1, got %q", body)
+	}
+	if strings.Index(body, "id: 2\n") > strings.Index(body, "id: 4\n") {
+		t.Fatalf("expected replayed events before live ones, got %q", body)
+	}
+}
+
+func TestStreamSendsResyncRequiredWhenGapIsTooLarge(t *testing.T) {
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 4).WithReplayStore(memory.NewReplayStore(2))
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: time.Minute}}, noopRealtimeHandlerLogger{})
+	for recordID := uint64(1); recordID <= 5; recordID++ {
+		service.Publish(t.Context(), domain.Event{Type: "record_projection_changed", UserID: 14, RecordID: recordID})
+	}
+
+	body := streamOnce(t, handler, "/events/stream", "1", nil)
+
+	if !strings.Contains(body, "id: 5\nevent: resync_required\n") {
+		t.Fatalf("expected resync_required carrying the latest id, got %q", body)
+	}
+	if strings.Contains(body, "event: record_projection_changed") {
+		t.Fatalf("expected no partial replay, got %q", body)
+	}
+}
+
+func TestStreamAppliesQueryFilter(t *testing.T) {
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 4).WithReplayStore(memory.NewReplayStore(8))
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: time.Minute}}, noopRealtimeHandlerLogger{})
+	service.Publish(t.Context(), domain.Event{Type: "record_projection_changed", UserID: 14, RecordID: 1, TagID: 7, Action: "created"})
+	service.Publish(t.Context(), domain.Event{Type: "record_projection_changed", UserID: 14, RecordID: 2, TagID: 8, Action: "created"})
+
+	body := streamOnce(t, handler, "/events/stream?actions=created,updated&tagIds=7", "0", func() {
+		service.Publish(t.Context(), domain.Event{Type: "record_projection_changed", UserID: 14, RecordID: 3, TagID: 7, Action: "deleted"})
+		service.Publish(t.Context(), domain.Event{Type: "record_projection_changed", UserID: 14, RecordID: 4, TagID: 7, Action: "updated"})
+	})
+
+	if strings.Count(body, "event: record_projection_changed") != 2 {
+		t.Fatalf("expected two matching events, got %q", body)
+	}
+	if !strings.Contains(body, "\"recordId\":1") || !strings.Contains(body, "\"recordId\":4") {
+		t.Fatalf("expected records 1 and 4, got %q", body)
+	}
+}
+
+func TestStreamRejectsInvalidQueryFilter(t *testing.T) {
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 4)
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: time.Minute}}, noopRealtimeHandlerLogger{})
+
+	for _, target := range []string{"/events/stream?actions=archived", "/events/stream?tagIds=abc", "/events/stream?categoryIds=0"} {
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, target, nil)
+		req = req.WithContext(context.WithValue(req.Context(), ctxkeys.UserID, uint64(14)))
+		rec := httptest.NewRecorder()
+
+		handler.Stream(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected status 400, got %d", target, rec.Code)
+		}
+	}
+}
+
+func TestStreamRejectsStreamsBeyondTheCap(t *testing.T) {
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 4).WithStreamLimits(1, 10)
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: 15 * time.Second}}, noopRealtimeHandlerLogger{})
+	_, cleanup, err := service.Subscribe(t.Context(), 14, domain.Filter{})
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	defer cleanup()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream", nil)
+	req = req.WithContext(context.WithValue(req.Context(), ctxkeys.UserID, uint64(14)))
+	rec := httptest.NewRecorder()
+
+	handler.Stream(rec, req)
+
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", rec.Code)
+	}
+	if got := rec.Header().Get(headerRetryAfter); got != "15" {
+		t.Fatalf("expected Retry-After 15, got %q", got)
+	}
+	if got := rec.Header().Get(headerContentType); got == contentTypeEventStream {
+		t.Fatalf("expected no event stream on a refused connection")
+	}
+}
+
+// gatedRecorder holds the first write until gate is closed, standing in for a client that stops reading.
+type gatedRecorder struct {
+	*httptest.ResponseRecorder
+
+	gate    chan struct{}
+	blocked chan struct{}
+	once    sync.Once
+}
+
+func (g *gatedRecorder) Write(p []byte) (int, error) {
+	g.once.Do(func() {
+		close(g.blocked)
+		<-g.gate
+	})
+	return g.ResponseRecorder.Write(p)
+}
+
+func TestStreamSendsResyncRequiredWhenDisconnectedForFallingBehind(t *testing.T) {
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 1).
+		WithReplayStore(memory.NewReplayStore(8)).
+		WithSlowConsumerPolicy(domain.SlowConsumerDisconnect)
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: time.Minute}}, noopRealtimeHandlerLogger{})
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream", nil)
+	req = req.WithContext(context.WithValue(req.Context(), ctxkeys.UserID, uint64(14)))
+	rec := &gatedRecorder{ResponseRecorder: httptest.NewRecorder(), gate: make(chan struct{}), blocked: make(chan struct{})}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Stream(rec, req)
+	}()
+
+	<-rec.blocked
+	for recordID := uint64(1); recordID <= 2; recordID++ {
+		service.Publish(t.Context(), domain.Event{Type: domain.EventTypeRecordProjectionChanged, UserID: 14, RecordID: recordID})
+	}
+	close(rec.gate)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the overrun stream to end")
+	}
+
+	body := rec.Body.String()
+	if !strings.Contains(body, "id: 2\nevent: resync_required\n") || !strings.Contains(body, `"reason":"slow_consumer"`) {
+		t.Fatalf("expected resync_required for event 2 with reason slow_consumer, got %q", body)
+	}
+	if strings.Contains(body, "event: record_projection_changed") {
+		t.Fatalf("expected buffered events to be discarded, got %q", body)
+	}
+}
+
+func TestStreamRecordsDeliveryLatency(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = provider.Shutdown(t.Context()) })
+
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 4)
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: time.Minute}}, noopRealtimeHandlerLogger{}).
+		WithMeterProvider(provider)
+
+	streamOnce(t, handler, "/events/stream", "", func() {
+		service.Publish(t.Context(), domain.Event{Type: domain.EventTypeRecordProjectionChanged, UserID: 14, RecordID: 1, ProjectedAtUTC: time.Now().UTC()})
+		service.Publish(t.Context(), domain.Event{Type: domain.EventTypeRecordProjectionChanged, UserID: 14, RecordID: 2})
+	})
+
+	var collected metricdata.ResourceMetrics
+	if err := reader.Collect(t.Context(), &collected); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+	var count uint64
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if histogram, ok := m.Data.(metricdata.Histogram[float64]); ok && m.Name == MetricDeliveryLatency {
+				for _, point := range histogram.DataPoints {
+					count += point.Count
+				}
+			}
+		}
+	}
+	if count != 1 {
+		t.Fatalf("expected one latency sample for the projected event, got %d", count)
+	}
+}
+
+func TestStreamEndsWithServerShutdownAndRetryHint(t *testing.T) {
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 4)
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{
+		HeartbeatInterval: time.Minute,
+		ShutdownRetry:     2 * time.Second,
+	}}, noopRealtimeHandlerLogger{})
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream", nil)
+	req = req.WithContext(context.WithValue(req.Context(), ctxkeys.UserID, uint64(14)))
+	rec := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Stream(rec, req)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	service.Publish(t.Context(), domain.Event{Type: domain.EventTypeRecordProjectionChanged, UserID: 14, RecordID: 42})
+	service.Shutdown(t.Context())
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the stream to end after shutdown")
+	}
+
+	body := rec.Body.String()
+	event := strings.Index(body, "event: record_projection_changed")
+	shutdown := strings.Index(body, "retry: 2000\nevent: server_shutdown\n")
+	if event < 0 || shutdown < event {
+		t.Fatalf("expected the record event before server_shutdown with retry 2000, got %q", body)
+	}
+	if !strings.HasSuffix(body, "\"retryMs\":2000,\"type\":\"server_shutdown\",\"userId\":\"14\"}\n\n") {
+		t.Fatalf("expected server_shutdown to be the last frame, got %q", body)
+	}
+
+	late := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream", nil)
+	late = late.WithContext(context.WithValue(late.Context(), ctxkeys.UserID, uint64(14)))
+	lateRec := httptest.NewRecorder()
+	handler.Stream(lateRec, late)
+	if lateRec.Code != http.StatusServiceUnavailable || lateRec.Header().Get(headerRetryAfter) != "2" {
+		t.Fatalf("expected 503 with Retry-After 2 during shutdown, got %d %q", lateRec.Code, lateRec.Header().Get(headerRetryAfter))
+	}
+}
+
+func TestShutdownRetryAddsJitterWithinBounds(t *testing.T) {
+	handler := New(realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 1), &config.Config{Realtime: config.RealtimeConfig{
+		ShutdownRetry:       time.Second,
+		ShutdownRetryJitter: 500 * time.Millisecond,
+	}}, noopRealtimeHandlerLogger{})
+
+	for range 50 {
+		if retry := handler.shutdownRetry(); retry < time.Second || retry >= 1500*time.Millisecond {
+			t.Fatalf("expected a retry in [1s, 1.5s), got %v", retry)
+		}
+	}
+}
+
+func TestStreamNamesEventsAfterTheirType(t *testing.T) {
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 8)
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: time.Minute}}, noopRealtimeHandlerLogger{})
+
+	body := streamOnce(t, handler, "/events/stream?types=tag_changed,goal_status_changed", "", func() {
+		service.Publish(t.Context(), domain.Event{Type: domain.EventTypeRecordProjectionChanged, UserID: 14, RecordID: 1})
+		service.Publish(t.Context(), domain.NewTagChangedEvent(14, domain.ActionUpdated, domain.TagChanged{TagID: 10, CategoryID: 3, Name: "Water"}))
+		service.Publish(t.Context(), domain.NewGoalStatusChangedEvent(14, domain.GoalStatusChanged{GoalID: 4, PreviousStatus: "pending", Status: "completed"}))
+	})
+
+	if strings.Contains(body, "event: record_projection_changed") {
+		t.Fatalf("expected record events to be filtered out, got %q", body)
+	}
+	tag := strings.Index(body, "event: tag_changed\ndata: {\"type\":\"tag_changed\"")
+	goal := strings.Index(body, "event: goal_status_changed\ndata: {\"type\":\"goal_status_changed\"")
+	if tag < 0 || goal < tag {
+		t.Fatalf("expected tag_changed then goal_status_changed frames, got %q", body)
+	}
+	if !strings.Contains(body, `"tag":{"tagId":10,"categoryId":3,"name":"Water"`) || !strings.Contains(body, `"status":"completed"`) {
+		t.Fatalf("expected typed payloads, got %q", body)
+	}
+}
+
+func TestSSEEventNameDefaultsToRecordProjectionChanged(t *testing.T) {
+	if got := sseEventName(domain.Event{}); got != sseEventRecordProjectionChanged {
+		t.Fatalf("expected %q for an untyped event, got %q", sseEventRecordProjectionChanged, got)
+	}
+	if got := sseEventName(domain.Event{Type: domain.EventTypeChatResponseReady}); got != "chat_response_ready" {
+		t.Fatalf("expected the event type as name, got %q", got)
+	}
+}
+
 type noopRealtimeHandlerLogger struct{}
 
 func (noopRealtimeHandlerLogger) Infof(string, ...any)                      {}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/server/http/ports"
+	"github.com/lechitz/aion-api/internal/platform/server/http/utils/httpresponse"
+	"github.com/lechitz/aion-api/internal/platform/server/http/utils/sharederrors"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	"github.com/lechitz/aion-api/internal/shared/constants/tracingkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ticketResponse struct {
+	Ticket       string `json:"ticket"`
+	ExpiresAtUTC string `json:"expiresAtUTC"`
+}
+
+// IssueTicket handles POST /realtime/ticket: it issues a single-use ticket that opens one stream for
+// the authenticated user through ?ticket=, for clients that cannot send an Authorization header.
+func (h *Handler) IssueTicket(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer(TracerName).Start(r.Context(), SpanIssueTicket)
+	defer span.End()
+
+	userID, ok := ctx.Value(ctxkeys.UserID).(uint64)
+	if !ok || userID == 0 {
+		httpresponse.WriteAuthErrorSpan(ctx, w, span, sharederrors.ErrMissingUserID(), h.Logger)
+		return
+	}
+	span.SetAttributes(attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)))
+
+	ticket, err := h.Tickets.Issue(ctx, userID)
+	if err != nil {
+		httpresponse.WriteDomainErrorSpan(ctx, w, span, err, errIssueTicket, h.Logger)
+		return
+	}
+
+	span.SetAttributes(attribute.Int(tracingkeys.HTTPStatusCodeKey, http.StatusCreated))
+	span.SetStatus(codes.Ok, msgTicketIssued)
+	httpresponse.WriteSuccess(w, http.StatusCreated, ticketResponse{
+		Ticket:       ticket.Token,
+		ExpiresAtUTC: ticket.ExpiresAtUTC.UTC().Format(time.RFC3339Nano),
+	}, msgTicketIssued)
+}
+
+// pendingTicketKey carries the token of a looked-up ticket from streamAuth to Stream, which redeems it.
+type pendingTicketKey struct{}
+
+// streamAuth authenticates stream requests that carry ?ticket= by looking the ticket up, and hands
+// every other request to auth, the regular header or cookie authentication. The ticket is not spent
+// here: Stream redeems it once the request passed its checks and holds a stream slot, so a request
+// refused with 400, 429 or 503 leaves the ticket usable for a retry.
+func (h *Handler) streamAuth(auth ports.Middleware) ports.Middleware {
+	return func(next http.Handler) http.Handler {
+		authenticated := auth(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := r.URL.Query().Get(queryTicket)
+			if token == "" {
+				authenticated.ServeHTTP(w, r)
+				return
+			}
+
+			userID, ok := h.lookupTicket(w, r, token)
+			if !ok {
+				return
+			}
+			ctx := context.WithValue(r.Context(), ctxkeys.UserID, userID)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, pendingTicketKey{}, token)))
+		})
+	}
+}
+
+// lookupTicket returns the user of token without consuming it, writing a 401 when the ticket cannot be used.
+func (h *Handler) lookupTicket(w http.ResponseWriter, r *http.Request, token string) (uint64, bool) {
+	ctx, span := otel.Tracer(TracerName).Start(r.Context(), SpanTicketAuth)
+	defer span.End()
+
+	if h.Tickets == nil {
+		httpresponse.WriteAuthErrorSpan(ctx, w, span, sharederrors.ErrUnauthorized(errTicketUnavailable), h.Logger)
+		return 0, false
+	}
+
+	ticket, err := h.Tickets.Lookup(ctx, token)
+	if err != nil {
+		h.rejectTicket(ctx, w, span, ticketRejectedInvalid, 0, err)
+		return 0, false
+	}
+
+	span.SetAttributes(attribute.String(commonkeys.UserID, strconv.FormatUint(ticket.UserID, 10)))
+	span.SetStatus(codes.Ok, "")
+	return ticket.UserID, true
+}
+
+// redeemPendingTicket spends the ticket streamAuth looked up for r, if any, writing a 401 when another
+// request redeemed it in the meantime. Requests authenticated without a ticket pass through.
+func (h *Handler) redeemPendingTicket(w http.ResponseWriter, r *http.Request, userID uint64) bool {
+	token, ok := r.Context().Value(pendingTicketKey{}).(string)
+	if !ok {
+		return true
+	}
+
+	ctx, span := otel.Tracer(TracerName).Start(r.Context(), SpanTicketRedeem)
+	defer span.End()
+	span.SetAttributes(attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)))
+
+	ticket, err := h.Tickets.Redeem(ctx, token)
+	if err == nil && ticket.UserID != userID {
+		err = domain.ErrTicketInvalid
+	}
+	if err != nil {
+		h.rejectTicket(ctx, w, span, ticketRejectedReused, userID, err)
+		return false
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return true
+}
+
+// rejectTicket logs and counts a ticket that could not open a stream and answers with a 401. userID
+// is zero when the ticket was rejected before its user was known.
+func (h *Handler) rejectTicket(ctx context.Context, w http.ResponseWriter, span trace.Span, reason string, userID uint64, err error) {
+	h.Logger.WarnwCtx(ctx, logRealtimeTicketRejected,
+		MetricAttrReason, reason,
+		commonkeys.UserID, strconv.FormatUint(userID, 10),
+		commonkeys.Error, err.Error(),
+	)
+	h.metrics.recordTicketRejected(ctx, reason)
+	httpresponse.WriteAuthErrorSpan(ctx, w, span, sharederrors.ErrUnauthorized(errTicketInvalid), h.Logger)
+}
@@ -0,0 +1,250 @@
+//nolint:testpackage // Tests exercise the package-private stream authentication wrapper.
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	realtimeUsecase "github.com/lechitz/aion-api/internal/realtime/core/usecase"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type ticketStoreStub struct {
+	mu      sync.Mutex
+	tickets map[string]domain.StreamTicket
+}
+
+func (s *ticketStoreStub) Save(_ context.Context, ticket domain.StreamTicket, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tickets[ticket.Token] = ticket
+	return nil
+}
+
+func (s *ticketStoreStub) Lookup(_ context.Context, token string) (domain.StreamTicket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ticket, ok := s.tickets[token]
+	if !ok {
+		return domain.StreamTicket{}, domain.ErrTicketInvalid
+	}
+	return ticket, nil
+}
+
+func (s *ticketStoreStub) Redeem(_ context.Context, token string) (domain.StreamTicket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ticket, ok := s.tickets[token]
+	if !ok {
+		return domain.StreamTicket{}, domain.ErrTicketInvalid
+	}
+	delete(s.tickets, token)
+	return ticket, nil
+}
+
+// contestedTicketStore lets another request redeem every ticket between lookup and redemption.
+type contestedTicketStore struct {
+	*ticketStoreStub
+}
+
+func (s contestedTicketStore) Redeem(ctx context.Context, token string) (domain.StreamTicket, error) {
+	if _, err := s.ticketStoreStub.Redeem(ctx, token); err != nil {
+		return domain.StreamTicket{}, err
+	}
+	return s.ticketStoreStub.Redeem(ctx, token)
+}
+
+func rejectAll(http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+}
+
+func issueTicket(t *testing.T, handler *Handler) string {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/realtime/ticket", nil)
+	req = req.WithContext(context.WithValue(req.Context(), ctxkeys.UserID, uint64(14)))
+	rec := httptest.NewRecorder()
+	handler.IssueTicket(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Result ticketResponse `json:"result"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode ticket response: %v", err)
+	}
+	if body.Result.Ticket == "" || body.Result.ExpiresAtUTC == "" {
+		t.Fatalf("expected a ticket and its expiry, got %#v", body.Result)
+	}
+	return body.Result.Ticket
+}
+
+func TestStreamAcceptsTicketOnce(t *testing.T) {
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 4)
+	tickets := realtimeUsecase.NewTicketService(&ticketStoreStub{tickets: map[string]domain.StreamTicket{}}, time.Minute, noopRealtimeHandlerLogger{})
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: time.Minute}}, noopRealtimeHandlerLogger{}).
+		WithTickets(tickets)
+	stream := handler.streamAuth(rejectAll)(http.HandlerFunc(handler.Stream))
+	ticket := issueTicket(t, handler)
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream?ticket="+ticket, nil)
+	ctx, cancel := context.WithCancel(req.Context())
+	req = req.WithContext(ctx)
+	rec := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		stream.ServeHTTP(rec, req)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	service.Publish(t.Context(), domain.Event{Type: "record_projection_changed", UserID: 14, RecordID: 42})
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	<-done
+
+	if !strings.Contains(rec.Body.String(), "\"recordId\":42") {
+		t.Fatalf("expected the ticket to open the user's stream, got %q", rec.Body.String())
+	}
+
+	replayed := httptest.NewRecorder()
+	stream.ServeHTTP(replayed, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream?ticket="+ticket, nil))
+	if replayed.Code != http.StatusUnauthorized {
+		t.Fatalf("expected a reused ticket to be rejected with 401, got %d", replayed.Code)
+	}
+}
+
+func TestStreamWithoutTicketUsesRegularAuth(t *testing.T) {
+	handler := New(realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 1), &config.Config{}, noopRealtimeHandlerLogger{})
+	stream := handler.streamAuth(rejectAll)(http.HandlerFunc(handler.Stream))
+
+	rec := httptest.NewRecorder()
+	stream.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream", nil))
+	if rec.Code != http.StatusTeapot {
+		t.Fatalf("expected the regular auth middleware to handle the request, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	stream.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream?ticket=abc", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected tickets to be rejected when not enabled, got %d", rec.Code)
+	}
+}
+
+func TestStreamKeepsTicketWhenRequestIsRefused(t *testing.T) {
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 4).WithStreamLimits(1, 10)
+	store := &ticketStoreStub{tickets: map[string]domain.StreamTicket{}}
+	tickets := realtimeUsecase.NewTicketService(store, time.Minute, noopRealtimeHandlerLogger{})
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: time.Minute}}, noopRealtimeHandlerLogger{}).
+		WithTickets(tickets)
+	stream := handler.streamAuth(rejectAll)(http.HandlerFunc(handler.Stream))
+	ticket := issueTicket(t, handler)
+
+	rec := httptest.NewRecorder()
+	stream.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream?tagIds=abc&ticket="+ticket, nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected an invalid filter to be rejected with 400, got %d", rec.Code)
+	}
+
+	_, cleanup, err := service.Subscribe(t.Context(), 14, domain.Filter{})
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	rec = httptest.NewRecorder()
+	stream.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream?ticket="+ticket, nil))
+	cleanup()
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected a user at the stream cap to be rejected with 429, got %d", rec.Code)
+	}
+
+	if _, err := store.Lookup(t.Context(), ticket); err != nil {
+		t.Fatalf("expected the refused requests to leave the ticket unspent, got %v", err)
+	}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream?ticket="+ticket, nil)
+	ctx, cancel := context.WithCancel(req.Context())
+	rec = httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		stream.ServeHTTP(rec, req.WithContext(ctx))
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	<-done
+
+	if !strings.Contains(rec.Body.String(), "event: connected") {
+		t.Fatalf("expected the retried ticket to open the stream, got %d %q", rec.Code, rec.Body.String())
+	}
+	if _, err := store.Lookup(t.Context(), ticket); err == nil {
+		t.Fatal("expected the opened stream to spend the ticket")
+	}
+}
+
+func TestStreamCountsRejectedTickets(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	service := realtimeUsecase.NewService(noopRealtimeHandlerLogger{}, 4).WithStreamLimits(1, 10)
+	store := &ticketStoreStub{tickets: map[string]domain.StreamTicket{}}
+	tickets := realtimeUsecase.NewTicketService(contestedTicketStore{store}, time.Minute, noopRealtimeHandlerLogger{})
+	handler := New(service, &config.Config{Realtime: config.RealtimeConfig{HeartbeatInterval: time.Minute}}, noopRealtimeHandlerLogger{}).
+		WithTickets(tickets).
+		WithMeterProvider(provider)
+	stream := handler.streamAuth(rejectAll)(http.HandlerFunc(handler.Stream))
+
+	rec := httptest.NewRecorder()
+	stream.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream?ticket=unknown", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected an unknown ticket to be rejected with 401, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	stream.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/events/stream?ticket="+issueTicket(t, handler), nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected a ticket redeemed by another request to be rejected with 401, got %d", rec.Code)
+	}
+	if got := rec.Header().Get(headerContentType); got == contentTypeEventStream {
+		t.Fatal("expected no event stream for a ticket lost to another request")
+	}
+
+	var collected metricdata.ResourceMetrics
+	if err := reader.Collect(t.Context(), &collected); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+	counts := map[string]int64{}
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if !ok || m.Name != MetricTicketsRejected {
+				continue
+			}
+			for _, point := range sum.DataPoints {
+				reason, _ := point.Attributes.Value(MetricAttrReason)
+				counts[reason.AsString()] += point.Value
+			}
+		}
+	}
+	if counts[ticketRejectedInvalid] != 1 || counts[ticketRejectedReused] != 1 {
+		t.Fatalf("expected one invalid and one reused ticket, got %v", counts)
+	}
+	_, cleanup, err := service.Subscribe(t.Context(), 14, domain.Filter{})
+	if err != nil {
+		t.Fatalf("expected the lost ticket to release its stream slot, got %v", err)
+	}
+	cleanup()
+}
@@ -0,0 +1,21 @@
+// Package codec decodes projection-ready payloads shared by every realtime reader adapter.
+package codec
+
+// Projection-ready messages arrive in the legacy flat JSON layout or as CloudEvents 1.0, either in
+// binary mode (ce_* headers, projection payload as the body) or structured mode (one JSON document
+// with the projection payload under data).
+const (
+	// HeaderCloudEventsPrefix prefixes every CloudEvents attribute carried as a message header.
+	HeaderCloudEventsPrefix = "ce_"
+	// HeaderContentType carries the media type of the message body.
+	HeaderContentType = "content-type"
+	// ContentTypeCloudEventsJSON marks a structured-mode CloudEvents body.
+	ContentTypeCloudEventsJSON = "application/cloudevents+json"
+
+	headerSpecVersion  = HeaderCloudEventsPrefix + "specversion"
+	headerType         = HeaderCloudEventsPrefix + "type"
+	headerTime         = HeaderCloudEventsPrefix + "time"
+	headerEventVersion = HeaderCloudEventsPrefix + "eventversion"
+	headerTraceID      = HeaderCloudEventsPrefix + "traceid"
+	headerRequestID    = HeaderCloudEventsPrefix + "requestid"
+)
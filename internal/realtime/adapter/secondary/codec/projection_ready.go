@@ -0,0 +1,122 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+type projectionReadyEnvelope struct {
+	EventType       string `json:"event_type"`
+	EventVersion    string `json:"event_version"`
+	UserID          uint64 `json:"user_id"`
+	RecordID        uint64 `json:"record_id"`
+	TagID           uint64 `json:"tag_id"`
+	CategoryID      uint64 `json:"category_id"`
+	SourceEventID   string `json:"source_event_id"`
+	SourceEventType string `json:"source_event_type"`
+	ProjectedAtUTC  string `json:"projected_at_utc"`
+	TraceID         string `json:"trace_id"`
+	RequestID       string `json:"request_id"`
+}
+
+// cloudEventAttributes holds the CloudEvents attributes the realtime stream needs, whether they
+// came from ce_* headers or from a structured document.
+type cloudEventAttributes struct {
+	SpecVersion  string          `json:"specversion"`
+	Type         string          `json:"type"`
+	Time         string          `json:"time"`
+	EventVersion string          `json:"eventversion"`
+	TraceID      string          `json:"traceid"`
+	RequestID    string          `json:"requestid"`
+	Data         json.RawMessage `json:"data"`
+}
+
+// DecodeProjectionReady maps one projection-ready message to a realtime event. It accepts the legacy
+// flat JSON value as well as CloudEvents in binary mode (detected by the ce_specversion header) and
+// structured mode (detected by the content type or a specversion attribute in the body).
+func DecodeProjectionReady(value []byte, headers map[string]string) (domain.Event, error) {
+	if headers[headerSpecVersion] != "" {
+		return decodeCloudEvent(value, cloudEventAttributes{
+			SpecVersion:  headers[headerSpecVersion],
+			Type:         headers[headerType],
+			Time:         headers[headerTime],
+			EventVersion: headers[headerEventVersion],
+			TraceID:      headers[headerTraceID],
+			RequestID:    headers[headerRequestID],
+		})
+	}
+
+	var structured cloudEventAttributes
+	if err := json.Unmarshal(value, &structured); err != nil {
+		return domain.Event{}, fmt.Errorf("decode projection ready event: %w", err)
+	}
+	if structured.SpecVersion != "" || strings.HasPrefix(headers[HeaderContentType], ContentTypeCloudEventsJSON) {
+		return decodeCloudEvent(structured.Data, structured)
+	}
+
+	var envelope projectionReadyEnvelope
+	if err := json.Unmarshal(value, &envelope); err != nil {
+		return domain.Event{}, fmt.Errorf("decode projection ready event: %w", err)
+	}
+	return toEvent(envelope)
+}
+
+// decodeCloudEvent reads the projection payload carried as CloudEvents data. The attributes take
+// precedence over the same fields in the payload; time stands in for a missing projected_at_utc.
+func decodeCloudEvent(data []byte, attributes cloudEventAttributes) (domain.Event, error) {
+	var envelope projectionReadyEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return domain.Event{}, fmt.Errorf("decode projection ready event data: %w", err)
+	}
+
+	envelope.EventType = firstNonEmpty(attributes.Type, envelope.EventType)
+	envelope.EventVersion = firstNonEmpty(attributes.EventVersion, envelope.EventVersion)
+	envelope.TraceID = firstNonEmpty(attributes.TraceID, envelope.TraceID)
+	envelope.RequestID = firstNonEmpty(attributes.RequestID, envelope.RequestID)
+	envelope.ProjectedAtUTC = firstNonEmpty(envelope.ProjectedAtUTC, attributes.Time)
+	return toEvent(envelope)
+}
+
+func toEvent(envelope projectionReadyEnvelope) (domain.Event, error) {
+	projectedAtUTC, err := time.Parse(time.RFC3339Nano, envelope.ProjectedAtUTC)
+	if err != nil {
+		return domain.Event{}, fmt.Errorf("parse projected_at_utc: %w", err)
+	}
+
+	return domain.Event{
+		Type:           domain.EventTypeRecordProjectionChanged,
+		UserID:         envelope.UserID,
+		RecordID:       envelope.RecordID,
+		TagID:          envelope.TagID,
+		CategoryID:     envelope.CategoryID,
+		Action:         actionFromEventType(envelope.EventType),
+		ProjectedAtUTC: projectedAtUTC.UTC(),
+		SourceEventID:  envelope.SourceEventID,
+		TraceID:        envelope.TraceID,
+		RequestID:      envelope.RequestID,
+	}, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func actionFromEventType(eventType string) string {
+	switch eventType {
+	case "record.projection.created":
+		return domain.ActionCreated
+	case "record.projection.deleted":
+		return domain.ActionDeleted
+	default:
+		return domain.ActionUpdated
+	}
+}
@@ -0,0 +1,134 @@
+//nolint:testpackage // Tests validate package-private parsing helpers directly.
+package codec
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+func TestActionFromEventType(t *testing.T) {
+	tests := []struct {
+		eventType string
+		want      string
+	}{
+		{eventType: "record.projection.created", want: "created"},
+		{eventType: "record.projection.updated", want: "updated"},
+		{eventType: "record.projection.deleted", want: "deleted"},
+	}
+
+	for _, tt := range tests {
+		if got := actionFromEventType(tt.eventType); got != tt.want {
+			t.Fatalf("eventType=%s want=%s got=%s", tt.eventType, tt.want, got)
+		}
+	}
+}
+
+func TestDecodeProjectionReady(t *testing.T) {
+	projectedAt := time.Now().UTC().Truncate(time.Second)
+	payload, err := json.Marshal(projectionReadyEnvelope{
+		EventType:       "record.projection.created",
+		EventVersion:    "v1",
+		UserID:          14,
+		RecordID:        42,
+		TagID:           7,
+		CategoryID:      3,
+		SourceEventID:   "evt-1",
+		SourceEventType: "record.created",
+		ProjectedAtUTC:  projectedAt.Format(time.RFC3339Nano),
+		TraceID:         "trace-1",
+		RequestID:       "req-1",
+	})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+
+	got, err := DecodeProjectionReady(payload, nil)
+	if err != nil {
+		t.Fatalf("parse payload: %v", err)
+	}
+
+	want := domain.Event{
+		Type:           "record_projection_changed",
+		UserID:         14,
+		RecordID:       42,
+		TagID:          7,
+		CategoryID:     3,
+		Action:         "created",
+		ProjectedAtUTC: projectedAt,
+		SourceEventID:  "evt-1",
+		TraceID:        "trace-1",
+		RequestID:      "req-1",
+	}
+
+	if got != want {
+		t.Fatalf("unexpected event: %#v", got)
+	}
+}
+
+func TestDecodeProjectionReadyCloudEventsBinary(t *testing.T) {
+	headers := map[string]string{
+		"ce_specversion":  "1.0",
+		"ce_id":           "evt-9",
+		"ce_type":         "record.projection.deleted",
+		"ce_time":         "2026-03-13T13:00:00.000000Z",
+		"ce_eventversion": "v1",
+		"ce_traceid":      "trace-1",
+		"content-type":    "application/json",
+	}
+	value := []byte(`{"user_id":14,"record_id":42,"source_event_id":"evt-1","request_id":"req-1"}`)
+
+	got, err := DecodeProjectionReady(value, headers)
+	if err != nil {
+		t.Fatalf("parse payload: %v", err)
+	}
+
+	want := domain.Event{
+		Type:           "record_projection_changed",
+		UserID:         14,
+		RecordID:       42,
+		Action:         "deleted",
+		ProjectedAtUTC: time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC),
+		SourceEventID:  "evt-1",
+		TraceID:        "trace-1",
+		RequestID:      "req-1",
+	}
+	if got != want {
+		t.Fatalf("unexpected event: %#v", got)
+	}
+}
+
+func TestDecodeProjectionReadyCloudEventsStructured(t *testing.T) {
+	projectedAt := time.Date(2026, time.March, 13, 13, 0, 1, 0, time.UTC)
+	value := []byte(`{
+		"specversion":"1.0",
+		"id":"evt-9",
+		"type":"record.projection.created",
+		"time":"2026-03-13T13:00:00.000000Z",
+		"traceid":"trace-1",
+		"data":{"user_id":14,"record_id":42,"source_event_id":"evt-1","projected_at_utc":"2026-03-13T13:00:01Z"}
+	}`)
+
+	got, err := DecodeProjectionReady(value, map[string]string{"content-type": ContentTypeCloudEventsJSON})
+	if err != nil {
+		t.Fatalf("parse payload: %v", err)
+	}
+
+	if got.Action != "created" || got.UserID != 14 || got.RecordID != 42 || got.SourceEventID != "evt-1" {
+		t.Fatalf("unexpected event: %#v", got)
+	}
+	if !got.ProjectedAtUTC.Equal(projectedAt) {
+		t.Fatalf("expected projected_at_utc from data, got %s", got.ProjectedAtUTC)
+	}
+	if got.TraceID != "trace-1" {
+		t.Fatalf("expected trace id from the traceid attribute, got %q", got.TraceID)
+	}
+}
+
+func TestDecodeProjectionReadyRejectsCloudEventWithoutData(t *testing.T) {
+	if _, err := DecodeProjectionReady([]byte(`{"specversion":"1.0","type":"record.projection.created"}`), nil); err == nil {
+		t.Fatal("expected an error for a structured event without data")
+	}
+}
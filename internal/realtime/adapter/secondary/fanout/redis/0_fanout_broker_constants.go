@@ -0,0 +1,16 @@
+// Package redis fans realtime events out between API instances over Redis pub/sub.
+package redis
+
+import "errors"
+
+const (
+	// channelPrefix is followed by the user id; one channel per user lets an instance subscribe
+	// only to the users it has streams for.
+	channelPrefix = "realtime:user:"
+
+	// LogFanoutConnectFailed is logged when the fan-out broker cannot reach Redis at startup.
+	LogFanoutConnectFailed = "failed to connect realtime fan-out broker to Redis"
+)
+
+// ErrBrokerClosed is returned by Receive once the broker has been closed.
+var ErrBrokerClosed = errors.New("realtime fan-out broker closed")
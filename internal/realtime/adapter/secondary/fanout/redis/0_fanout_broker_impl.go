@@ -0,0 +1,68 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// FanoutBroker publishes realtime events to per-user Redis channels and receives the events of
+// the users this instance joined. Pub/sub is fire-and-forget: an instance that is not subscribed
+// when an event is published misses it, and its clients recover through the replay store.
+type FanoutBroker struct {
+	client   *goredis.Client
+	pubsub   *goredis.PubSub
+	messages <-chan *goredis.Message
+}
+
+// wireEvent keeps the id and trace context that domain.Event leaves out of its JSON form.
+type wireEvent struct {
+	domain.Event
+	ID          uint64 `json:"id"`
+	TraceParent string `json:"traceParent,omitempty"`
+	TraceState  string `json:"traceState,omitempty"`
+}
+
+// NewFanoutBroker connects to Redis, checks it is reachable, and opens the pub/sub connection
+// that later joins add channels to.
+func NewFanoutBroker(ctx context.Context, cfg config.CacheConfig, db int, log logger.ContextLogger) (*FanoutBroker, error) {
+	client := goredis.NewClient(&goredis.Options{
+		Addr:     cfg.Addr,
+		Password: cfg.Password,
+		DB:       db,
+		PoolSize: cfg.PoolSize,
+	})
+
+	pingCtx, cancel := context.WithTimeout(ctx, cfg.ConnectTimeout)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		log.Errorw(LogFanoutConnectFailed, commonkeys.Error, err)
+		_ = client.Close()
+		return nil, err
+	}
+
+	pubsub := client.Subscribe(ctx)
+	return &FanoutBroker{
+		client:   client,
+		pubsub:   pubsub,
+		messages: pubsub.Channel(),
+	}, nil
+}
+
+// Close ends the pub/sub connection, which makes Receive return ErrBrokerClosed, and releases the pool.
+func (b *FanoutBroker) Close() error {
+	pubsubErr := b.pubsub.Close()
+	if err := b.client.Close(); err != nil {
+		return err
+	}
+	return pubsubErr
+}
+
+func userChannel(userID uint64) string {
+	return channelPrefix + strconv.FormatUint(userID, 10)
+}
@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// Publish sends the event to the channel of its user.
+func (b *FanoutBroker) Publish(ctx context.Context, event domain.Event) error {
+	payload, err := json.Marshal(wireEvent{
+		Event:       event,
+		ID:          event.ID,
+		TraceParent: event.TraceParent,
+		TraceState:  event.TraceState,
+	})
+	if err != nil {
+		return fmt.Errorf("encode fan-out event: %w", err)
+	}
+	if err := b.client.Publish(ctx, userChannel(event.UserID), payload).Err(); err != nil {
+		return fmt.Errorf("publish fan-out event: %w", err)
+	}
+	return nil
+}
+
+// Join subscribes this instance to the channel of userID.
+func (b *FanoutBroker) Join(ctx context.Context, userID uint64) error {
+	return b.pubsub.Subscribe(ctx, userChannel(userID))
+}
+
+// Leave unsubscribes this instance from the channel of userID.
+func (b *FanoutBroker) Leave(ctx context.Context, userID uint64) error {
+	return b.pubsub.Unsubscribe(ctx, userChannel(userID))
+}
+
+// Receive blocks for the next event on a joined channel.
+func (b *FanoutBroker) Receive(ctx context.Context) (domain.Event, error) {
+	select {
+	case <-ctx.Done():
+		return domain.Event{}, ctx.Err()
+	case message, ok := <-b.messages:
+		if !ok {
+			return domain.Event{}, ErrBrokerClosed
+		}
+		return decodeEvent([]byte(message.Payload))
+	}
+}
+
+func decodeEvent(payload []byte) (domain.Event, error) {
+	var wire wireEvent
+	if err := json.Unmarshal(payload, &wire); err != nil {
+		return domain.Event{}, fmt.Errorf("decode fan-out event: %w", err)
+	}
+	event := wire.Event
+	event.ID = wire.ID
+	event.TraceParent, event.TraceState = wire.TraceParent, wire.TraceState
+	return event, nil
+}
@@ -0,0 +1,41 @@
+//nolint:testpackage // Tests validate the package-private wire format directly.
+package redis
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+func TestWireEventRoundTripKeepsIDAndTraceContext(t *testing.T) {
+	event := domain.Event{
+		ID:             7,
+		Type:           "record_projection_changed",
+		UserID:         14,
+		RecordID:       42,
+		Action:         "updated",
+		ProjectedAtUTC: time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC),
+		SourceEventID:  "evt-1",
+		TraceParent:    "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	}
+
+	payload, err := json.Marshal(wireEvent{Event: event, ID: event.ID, TraceParent: event.TraceParent})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	got, err := decodeEvent(payload)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got != event {
+		t.Fatalf("expected %#v, got %#v", event, got)
+	}
+}
+
+func TestUserChannel(t *testing.T) {
+	if got := userChannel(14); got != "realtime:user:14" {
+		t.Fatalf("unexpected channel %q", got)
+	}
+}
@@ -0,0 +1,7 @@
+// Package inprocess reads projection-ready events from the in-process event bus for realtime fan-out.
+package inprocess
+
+import "errors"
+
+// ErrReaderClosed is returned by Read after Close.
+var ErrReaderClosed = errors.New("realtime in-process reader closed")
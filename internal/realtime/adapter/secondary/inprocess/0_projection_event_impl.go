@@ -0,0 +1,24 @@
+package inprocess
+
+import (
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+)
+
+// ProjectionEventReader reads projection-ready events published on the in-process bus.
+type ProjectionEventReader struct {
+	messages <-chan eventbus.Message
+	cancel   func()
+}
+
+// NewProjectionEventReader subscribes to topic right away, so no event published after
+// construction is missed while the consumer loop starts.
+func NewProjectionEventReader(bus *eventbus.Bus, topic string, buffer int) *ProjectionEventReader {
+	messages, cancel := bus.Subscribe(topic, buffer)
+	return &ProjectionEventReader{messages: messages, cancel: cancel}
+}
+
+// Close unsubscribes from the bus.
+func (r *ProjectionEventReader) Close() error {
+	r.cancel()
+	return nil
+}
@@ -0,0 +1,29 @@
+package inprocess
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+	"github.com/lechitz/aion-api/internal/realtime/adapter/secondary/codec"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// Read blocks for the next projection-ready message on the bus and decodes it, keeping the
+// W3C trace context from the message headers.
+func (r *ProjectionEventReader) Read(ctx context.Context) (domain.Event, error) {
+	select {
+	case <-ctx.Done():
+		return domain.Event{}, ctx.Err()
+	case message, ok := <-r.messages:
+		if !ok {
+			return domain.Event{}, ErrReaderClosed
+		}
+		event, err := codec.DecodeProjectionReady(message.Value, message.Headers)
+		if err != nil {
+			return domain.Event{}, err
+		}
+		event.TraceParent = message.Headers[tracecontext.HeaderTraceParent]
+		event.TraceState = message.Headers[tracecontext.HeaderTraceState]
+		return event, nil
+	}
+}
@@ -0,0 +1,66 @@
+package inprocess_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+	"github.com/lechitz/aion-api/internal/realtime/adapter/secondary/inprocess"
+)
+
+const projectionTopic = "aion.record_projection.events.v1"
+
+func TestReadDecodesProjectionReadyMessage(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	reader := inprocess.NewProjectionEventReader(bus, projectionTopic, 1)
+	defer reader.Close()
+
+	err := bus.Publish(context.Background(), eventbus.Message{
+		Topic: projectionTopic,
+		Key:   "42",
+		Headers: map[string]string{
+			"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
+		Value: []byte(`{"event_type":"record.projection.deleted","user_id":14,"record_id":42,"source_event_id":"evt-1","projected_at_utc":"2026-03-13T13:00:00Z"}`),
+	})
+	if err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	event, err := reader.Read(context.Background())
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if event.UserID != 14 || event.RecordID != 42 || event.Action != "deleted" {
+		t.Fatalf("unexpected event: %#v", event)
+	}
+	if event.TraceParent != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Fatalf("expected trace context from headers, got %q", event.TraceParent)
+	}
+	if !event.ProjectedAtUTC.Equal(time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected projected time: %s", event.ProjectedAtUTC)
+	}
+}
+
+func TestReadReturnsOnContextAndClose(t *testing.T) {
+	t.Parallel()
+
+	reader := inprocess.NewProjectionEventReader(eventbus.New(), projectionTopic, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := reader.Read(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+
+	if err := reader.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if _, err := reader.Read(context.Background()); !errors.Is(err, inprocess.ErrReaderClosed) {
+		t.Fatalf("expected closed reader error, got %v", err)
+	}
+}
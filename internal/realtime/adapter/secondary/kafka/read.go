@@ -2,64 +2,36 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"time"
 
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+	"github.com/lechitz/aion-api/internal/realtime/adapter/secondary/codec"
 	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	kafkago "github.com/segmentio/kafka-go"
 )
 
-type projectionReadyEnvelope struct {
-	EventType       string `json:"event_type"`
-	EventVersion    string `json:"event_version"`
-	UserID          uint64 `json:"user_id"`
-	RecordID        uint64 `json:"record_id"`
-	SourceEventID   string `json:"source_event_id"`
-	SourceEventType string `json:"source_event_type"`
-	ProjectedAtUTC  string `json:"projected_at_utc"`
-	TraceID         string `json:"trace_id"`
-	RequestID       string `json:"request_id"`
-}
-
+// Read blocks for the next projection-ready message and decodes it, keeping the W3C trace
+// context from the message headers so delivery continues the producer trace.
 func (r *ProjectionEventReader) Read(ctx context.Context) (domain.Event, error) {
 	message, err := r.reader.ReadMessage(ctx)
 	if err != nil {
 		return domain.Event{}, err
 	}
 
-	return parseProjectionReadyPayload(message.Value)
-}
-
-func parseProjectionReadyPayload(payload []byte) (domain.Event, error) {
-	var envelope projectionReadyEnvelope
-	if err := json.Unmarshal(payload, &envelope); err != nil {
-		return domain.Event{}, fmt.Errorf("decode projection ready event: %w", err)
-	}
-
-	projectedAtUTC, err := time.Parse(time.RFC3339Nano, envelope.ProjectedAtUTC)
+	headers := headerMap(message.Headers)
+	event, err := codec.DecodeProjectionReady(message.Value, headers)
 	if err != nil {
-		return domain.Event{}, fmt.Errorf("parse projected_at_utc: %w", err)
+		return domain.Event{}, err
 	}
-
-	return domain.Event{
-		Type:           "record_projection_changed",
-		UserID:         envelope.UserID,
-		RecordID:       envelope.RecordID,
-		Action:         actionFromEventType(envelope.EventType),
-		ProjectedAtUTC: projectedAtUTC.UTC(),
-		SourceEventID:  envelope.SourceEventID,
-		TraceID:        envelope.TraceID,
-		RequestID:      envelope.RequestID,
-	}, nil
+	event.TraceParent = headers[tracecontext.HeaderTraceParent]
+	event.TraceState = headers[tracecontext.HeaderTraceState]
+	return event, nil
 }
 
-func actionFromEventType(eventType string) string {
-	switch eventType {
-	case "record.projection.created":
-		return "created"
-	case "record.projection.deleted":
-		return "deleted"
-	default:
-		return "updated"
+// headerMap indexes Kafka headers by key; a repeated key keeps its last value.
+func headerMap(headers []kafkago.Header) map[string]string {
+	out := make(map[string]string, len(headers))
+	for _, header := range headers {
+		out[header.Key] = string(header.Value)
 	}
+	return out
 }
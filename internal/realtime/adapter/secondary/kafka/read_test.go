@@ -1,65 +1,30 @@
-//nolint:testpackage // Tests validate package-private parsing helpers directly.
+//nolint:testpackage // Tests validate package-private header helpers directly.
 package kafka
 
 import (
-	"encoding/json"
 	"testing"
-	"time"
 
-	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	kafkago "github.com/segmentio/kafka-go"
 )
 
-func TestActionFromEventType(t *testing.T) {
-	tests := []struct {
-		eventType string
-		want      string
-	}{
-		{eventType: "record.projection.created", want: "created"},
-		{eventType: "record.projection.updated", want: "updated"},
-		{eventType: "record.projection.deleted", want: "deleted"},
-	}
-
-	for _, tt := range tests {
-		if got := actionFromEventType(tt.eventType); got != tt.want {
-			t.Fatalf("eventType=%s want=%s got=%s", tt.eventType, tt.want, got)
-		}
-	}
-}
-
-func TestParseProjectionReadyPayload(t *testing.T) {
-	projectedAt := time.Now().UTC().Truncate(time.Second)
-	payload, err := json.Marshal(projectionReadyEnvelope{
-		EventType:       "record.projection.created",
-		EventVersion:    "v1",
-		UserID:          14,
-		RecordID:        42,
-		SourceEventID:   "evt-1",
-		SourceEventType: "record.created",
-		ProjectedAtUTC:  projectedAt.Format(time.RFC3339Nano),
-		TraceID:         "trace-1",
-		RequestID:       "req-1",
+func TestHeaderMap(t *testing.T) {
+	headers := headerMap([]kafkago.Header{
+		{Key: "event_id", Value: []byte("evt-1")},
+		{Key: "traceparent", Value: []byte("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")},
+		{Key: "tracestate", Value: []byte("vendor=value")},
 	})
-	if err != nil {
-		t.Fatalf("marshal payload: %v", err)
-	}
 
-	got, err := parseProjectionReadyPayload(payload)
-	if err != nil {
-		t.Fatalf("parse payload: %v", err)
+	if headers["traceparent"] != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Fatalf("unexpected traceparent %q", headers["traceparent"])
 	}
-
-	want := domain.Event{
-		Type:           "record_projection_changed",
-		UserID:         14,
-		RecordID:       42,
-		Action:         "created",
-		ProjectedAtUTC: projectedAt,
-		SourceEventID:  "evt-1",
-		TraceID:        "trace-1",
-		RequestID:      "req-1",
+	if headers["tracestate"] != "vendor=value" {
+		t.Fatalf("unexpected tracestate %q", headers["tracestate"])
+	}
+	if headers["event_id"] != "evt-1" {
+		t.Fatalf("unexpected event_id %q", headers["event_id"])
 	}
 
-	if got != want {
-		t.Fatalf("unexpected event: %#v", got)
+	if traceParent := headerMap(nil)["traceparent"]; traceParent != "" {
+		t.Fatalf("expected empty traceparent, got %q", traceParent)
 	}
 }
@@ -0,0 +1,30 @@
+// Package memory keeps the realtime replay buffer in the API process.
+package memory
+
+import (
+	"sync"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// ReplayStore retains the last size events of each user in process memory. Ids restart at one
+// with the process, so a client resuming across a restart is asked to resync.
+type ReplayStore struct {
+	size int
+
+	mu    sync.Mutex
+	users map[uint64]*userBuffer
+}
+
+type userBuffer struct {
+	latest uint64
+	events []domain.Event
+}
+
+// NewReplayStore creates a store that keeps up to size events per user.
+func NewReplayStore(size int) *ReplayStore {
+	if size <= 0 {
+		size = 1
+	}
+	return &ReplayStore{size: size, users: make(map[uint64]*userBuffer)}
+}
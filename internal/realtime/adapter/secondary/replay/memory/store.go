@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// Append assigns the next id of the event's user and evicts the oldest event once the buffer is full.
+func (s *ReplayStore) Append(_ context.Context, event domain.Event) (domain.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buffer, ok := s.users[event.UserID]
+	if !ok {
+		buffer = &userBuffer{events: make([]domain.Event, 0, s.size)}
+		s.users[event.UserID] = buffer
+	}
+
+	buffer.latest++
+	event.ID = buffer.latest
+	if len(buffer.events) == s.size {
+		copy(buffer.events, buffer.events[1:])
+		buffer.events = buffer.events[:s.size-1]
+	}
+	buffer.events = append(buffer.events, event)
+	return event, nil
+}
+
+// Since returns a copy of the retained events of userID after lastEventID.
+func (s *ReplayStore) Since(_ context.Context, userID, lastEventID uint64) ([]domain.Event, domain.ReplayWindow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buffer, ok := s.users[userID]
+	if !ok || len(buffer.events) == 0 {
+		return nil, domain.ReplayWindow{}, nil
+	}
+
+	window := domain.ReplayWindow{Oldest: buffer.events[0].ID, Latest: buffer.latest}
+	var events []domain.Event
+	for _, event := range buffer.events {
+		if event.ID > lastEventID {
+			events = append(events, event)
+		}
+	}
+	return events, window, nil
+}
@@ -0,0 +1,76 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lechitz/aion-api/internal/realtime/adapter/secondary/replay/memory"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+func TestReplayStoreAssignsIDsPerUser(t *testing.T) {
+	t.Parallel()
+
+	store := memory.NewReplayStore(4)
+	ctx := context.Background()
+
+	first, _ := store.Append(ctx, domain.Event{UserID: 14, RecordID: 1})
+	second, _ := store.Append(ctx, domain.Event{UserID: 14, RecordID: 2})
+	other, _ := store.Append(ctx, domain.Event{UserID: 99, RecordID: 3})
+
+	if first.ID != 1 || second.ID != 2 {
+		t.Fatalf("expected ids 1 and 2 for user 14, got %d and %d", first.ID, second.ID)
+	}
+	if other.ID != 1 {
+		t.Fatalf("expected an independent sequence for user 99, got %d", other.ID)
+	}
+
+	events, window, err := store.Since(ctx, 14, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(events) != 1 || events[0].RecordID != 2 {
+		t.Fatalf("expected only the event after id 1, got %#v", events)
+	}
+	if window != (domain.ReplayWindow{Oldest: 1, Latest: 2}) {
+		t.Fatalf("unexpected window %#v", window)
+	}
+}
+
+func TestReplayStoreEvictsOldestEvents(t *testing.T) {
+	t.Parallel()
+
+	store := memory.NewReplayStore(2)
+	ctx := context.Background()
+	for recordID := uint64(1); recordID <= 5; recordID++ {
+		if _, err := store.Append(ctx, domain.Event{UserID: 14, RecordID: recordID}); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+
+	events, window, err := store.Since(ctx, 14, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(events) != 2 || events[0].ID != 4 || events[1].ID != 5 {
+		t.Fatalf("expected the last two events, got %#v", events)
+	}
+	if window.Covers(2) {
+		t.Fatal("expected a gap after id 2 to require a resync")
+	}
+	if !window.Covers(3) {
+		t.Fatal("expected id 3 to be resumable from the retained events")
+	}
+}
+
+func TestReplayStoreUnknownUser(t *testing.T) {
+	t.Parallel()
+
+	events, window, err := memory.NewReplayStore(2).Since(context.Background(), 14, 7)
+	if err != nil || len(events) != 0 {
+		t.Fatalf("expected no events, got %#v, %v", events, err)
+	}
+	if window.Covers(7) {
+		t.Fatal("expected an id unknown to the store to require a resync")
+	}
+}
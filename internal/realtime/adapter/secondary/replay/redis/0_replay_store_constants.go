@@ -0,0 +1,40 @@
+// Package redis keeps the realtime replay buffer in Redis so every API replica assigns the same ids.
+package redis
+
+import "time"
+
+const (
+	defaultTTL = 24 * time.Hour
+
+	keyPrefix       = "realtime:replay:"
+	keySuffixSeq    = ":seq"
+	keySuffixEvents = ":events"
+	keySuffixSource = ":source:"
+
+	// memberSeparator splits the id prefix from the JSON event in a sorted-set member; the prefix
+	// keeps two identical payloads from collapsing into one member.
+	memberSeparator = " "
+
+	// LogReplayStoreConnectFailed is logged when the replay store cannot reach Redis at startup.
+	LogReplayStoreConnectFailed = "failed to connect realtime replay store to Redis"
+)
+
+// appendScript assigns the next id and retains the event atomically. When the event has a
+// source key, an id already assigned to that source is returned instead, so replicas that all
+// read the same projection event store it once.
+//
+// KEYS: seq, events, source. ARGV: event JSON, buffer size, ttl seconds, has source ("1" or "0").
+const appendScript = `
+if ARGV[4] == '1' then
+	local seen = redis.call('GET', KEYS[3])
+	if seen then return tonumber(seen) end
+end
+local id = redis.call('INCR', KEYS[1])
+redis.call('ZADD', KEYS[2], id, id .. ' ' .. ARGV[1])
+redis.call('ZREMRANGEBYRANK', KEYS[2], 0, -tonumber(ARGV[2]) - 1)
+redis.call('EXPIRE', KEYS[2], ARGV[3])
+if ARGV[4] == '1' then
+	redis.call('SET', KEYS[3], id, 'EX', ARGV[3])
+end
+return id
+`
@@ -0,0 +1,78 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// ReplayStore retains the last size events of each user in a Redis sorted set scored by id.
+// The per-user sequence never expires, so ids keep increasing across restarts; retained events
+// and source markers expire after ttl without new events.
+type ReplayStore struct {
+	client *goredis.Client
+	append *goredis.Script
+	size   int
+	ttl    time.Duration
+}
+
+// storedEvent keeps the trace context that domain.Event leaves out of its JSON form.
+type storedEvent struct {
+	domain.Event
+	TraceParent string `json:"traceParent,omitempty"`
+	TraceState  string `json:"traceState,omitempty"`
+}
+
+// NewReplayStore connects to the given Redis database and checks it is reachable. A ttl below one
+// second, which EXPIRE would treat as immediate deletion, falls back to one day.
+func NewReplayStore(
+	ctx context.Context,
+	cfg config.CacheConfig,
+	db int,
+	size int,
+	ttl time.Duration,
+	log logger.ContextLogger,
+) (*ReplayStore, error) {
+	client := goredis.NewClient(&goredis.Options{
+		Addr:     cfg.Addr,
+		Password: cfg.Password,
+		DB:       db,
+		PoolSize: cfg.PoolSize,
+	})
+
+	pingCtx, cancel := context.WithTimeout(ctx, cfg.ConnectTimeout)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		log.Errorw(LogReplayStoreConnectFailed, commonkeys.Error, err)
+		_ = client.Close()
+		return nil, err
+	}
+
+	if size <= 0 {
+		size = 1
+	}
+	if ttl < time.Second {
+		ttl = defaultTTL
+	}
+	return &ReplayStore{
+		client: client,
+		append: goredis.NewScript(appendScript),
+		size:   size,
+		ttl:    ttl,
+	}, nil
+}
+
+// Close releases the Redis connection pool.
+func (s *ReplayStore) Close() error {
+	return s.client.Close()
+}
+
+func userKey(userID uint64, suffix string) string {
+	return keyPrefix + strconv.FormatUint(userID, 10) + suffix
+}
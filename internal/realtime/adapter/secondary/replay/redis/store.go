@@ -0,0 +1,100 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Append stores the event under the next id of its user. Events that carry a source event id are
+// deduplicated per source and action, since every replica consumes the same projection stream.
+func (s *ReplayStore) Append(ctx context.Context, event domain.Event) (domain.Event, error) {
+	payload, err := json.Marshal(storedEvent{Event: event, TraceParent: event.TraceParent, TraceState: event.TraceState})
+	if err != nil {
+		return event, fmt.Errorf("encode replay event: %w", err)
+	}
+
+	hasSource := "0"
+	sourceKey := userKey(event.UserID, keySuffixSource)
+	if event.SourceEventID != "" {
+		hasSource = "1"
+		sourceKey += event.SourceEventID + ":" + event.Action
+	}
+
+	id, err := s.append.Run(ctx, s.client,
+		[]string{userKey(event.UserID, keySuffixSeq), userKey(event.UserID, keySuffixEvents), sourceKey},
+		payload, s.size, int64(s.ttl.Seconds()), hasSource,
+	).Uint64()
+	if err != nil {
+		return event, fmt.Errorf("append replay event: %w", err)
+	}
+	event.ID = id
+	return event, nil
+}
+
+// Since reads the sequence, the oldest retained id, and the events after lastEventID in one transaction.
+func (s *ReplayStore) Since(ctx context.Context, userID, lastEventID uint64) ([]domain.Event, domain.ReplayWindow, error) {
+	eventsKey := userKey(userID, keySuffixEvents)
+
+	var (
+		latest *goredis.StringCmd
+		oldest *goredis.ZSliceCmd
+		after  *goredis.ZSliceCmd
+	)
+	_, err := s.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		latest = pipe.Get(ctx, userKey(userID, keySuffixSeq))
+		oldest = pipe.ZRangeWithScores(ctx, eventsKey, 0, 0)
+		after = pipe.ZRangeArgsWithScores(ctx, goredis.ZRangeArgs{
+			Key:     eventsKey,
+			Start:   "(" + strconv.FormatUint(lastEventID, 10),
+			Stop:    "+inf",
+			ByScore: true,
+		})
+		return nil
+	})
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		return nil, domain.ReplayWindow{}, fmt.Errorf("read replay events: %w", err)
+	}
+
+	var window domain.ReplayWindow
+	if value, err := latest.Uint64(); err == nil {
+		window.Latest = value
+	}
+	if members := oldest.Val(); len(members) > 0 {
+		window.Oldest = uint64(members[0].Score)
+	}
+
+	members := after.Val()
+	events := make([]domain.Event, 0, len(members))
+	for _, member := range members {
+		event, err := decodeMember(member)
+		if err != nil {
+			return nil, domain.ReplayWindow{}, err
+		}
+		events = append(events, event)
+	}
+	return events, window, nil
+}
+
+func decodeMember(member goredis.Z) (domain.Event, error) {
+	raw, ok := member.Member.(string)
+	if !ok {
+		return domain.Event{}, fmt.Errorf("unexpected replay member type %T", member.Member)
+	}
+	_, body, _ := strings.Cut(raw, memberSeparator)
+
+	var stored storedEvent
+	if err := json.Unmarshal([]byte(body), &stored); err != nil {
+		return domain.Event{}, fmt.Errorf("decode replay event: %w", err)
+	}
+	event := stored.Event
+	event.ID = uint64(member.Score)
+	event.TraceParent, event.TraceState = stored.TraceParent, stored.TraceState
+	return event, nil
+}
@@ -0,0 +1,10 @@
+// Package redis keeps realtime stream tickets in the auth Redis database until they are redeemed or expire.
+package redis
+
+const (
+	// keyPrefix is followed by the SHA-256 of the token, so the stored keys cannot be used as tickets.
+	keyPrefix = "realtime:ticket:"
+
+	// LogTicketStoreConnectFailed is logged when the ticket store cannot reach Redis at startup.
+	LogTicketStoreConnectFailed = "failed to connect realtime ticket store to Redis"
+)
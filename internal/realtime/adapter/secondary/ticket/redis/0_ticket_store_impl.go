@@ -0,0 +1,47 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/lechitz/aion-api/internal/platform/config"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// TicketStore keeps each stream ticket under the hash of its token with the ticket ttl as the key
+// expiry; redemption reads and deletes the key with one GETDEL, so a token opens at most one stream.
+type TicketStore struct {
+	client *goredis.Client
+}
+
+// NewTicketStore connects to the given Redis database and checks it is reachable.
+func NewTicketStore(ctx context.Context, cfg config.CacheConfig, db int, log logger.ContextLogger) (*TicketStore, error) {
+	client := goredis.NewClient(&goredis.Options{
+		Addr:     cfg.Addr,
+		Password: cfg.Password,
+		DB:       db,
+		PoolSize: cfg.PoolSize,
+	})
+
+	pingCtx, cancel := context.WithTimeout(ctx, cfg.ConnectTimeout)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		log.Errorw(LogTicketStoreConnectFailed, commonkeys.Error, err)
+		_ = client.Close()
+		return nil, err
+	}
+	return &TicketStore{client: client}, nil
+}
+
+// Close releases the Redis connection pool.
+func (s *TicketStore) Close() error {
+	return s.client.Close()
+}
+
+func ticketKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return keyPrefix + hex.EncodeToString(sum[:])
+}
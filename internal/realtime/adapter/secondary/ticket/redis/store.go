@@ -0,0 +1,96 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// storedTicket is the stored form of a ticket; the token itself is only part of the key hash.
+type storedTicket struct {
+	ID           string    `json:"id"`
+	UserID       uint64    `json:"userId"`
+	IssuedAtUTC  time.Time `json:"issuedAtUTC"`
+	ExpiresAtUTC time.Time `json:"expiresAtUTC"`
+}
+
+// Save stores ticket until ttl elapses. SET NX keeps a colliding token from replacing another ticket.
+func (s *TicketStore) Save(ctx context.Context, ticket domain.StreamTicket, ttl time.Duration) error {
+	payload, err := encodeTicket(ticket)
+	if err != nil {
+		return err
+	}
+	stored, err := s.client.SetNX(ctx, ticketKey(ticket.Token), payload, ttl).Result()
+	if err != nil {
+		return fmt.Errorf("save realtime ticket: %w", err)
+	}
+	if !stored {
+		return errors.New("save realtime ticket: token already in use")
+	}
+	return nil
+}
+
+// Lookup returns the ticket stored for token and leaves it in place.
+func (s *TicketStore) Lookup(ctx context.Context, token string) (domain.StreamTicket, error) {
+	payload, err := s.client.Get(ctx, ticketKey(token)).Bytes()
+	if errors.Is(err, goredis.Nil) {
+		return domain.StreamTicket{}, domain.ErrTicketInvalid
+	}
+	if err != nil {
+		return domain.StreamTicket{}, fmt.Errorf("look up realtime ticket: %w", err)
+	}
+	return storedTicketFor(token, payload)
+}
+
+// Redeem removes and returns the ticket stored for token.
+func (s *TicketStore) Redeem(ctx context.Context, token string) (domain.StreamTicket, error) {
+	payload, err := s.client.GetDel(ctx, ticketKey(token)).Bytes()
+	if errors.Is(err, goredis.Nil) {
+		return domain.StreamTicket{}, domain.ErrTicketInvalid
+	}
+	if err != nil {
+		return domain.StreamTicket{}, fmt.Errorf("redeem realtime ticket: %w", err)
+	}
+	return storedTicketFor(token, payload)
+}
+
+// storedTicketFor decodes payload and restores the token the key was derived from.
+func storedTicketFor(token string, payload []byte) (domain.StreamTicket, error) {
+	ticket, err := decodeTicket(payload)
+	if err != nil {
+		return domain.StreamTicket{}, err
+	}
+	ticket.Token = token
+	return ticket, nil
+}
+
+func encodeTicket(ticket domain.StreamTicket) ([]byte, error) {
+	payload, err := json.Marshal(storedTicket{
+		ID:           ticket.ID,
+		UserID:       ticket.UserID,
+		IssuedAtUTC:  ticket.IssuedAtUTC,
+		ExpiresAtUTC: ticket.ExpiresAtUTC,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode realtime ticket: %w", err)
+	}
+	return payload, nil
+}
+
+func decodeTicket(payload []byte) (domain.StreamTicket, error) {
+	var stored storedTicket
+	if err := json.Unmarshal(payload, &stored); err != nil {
+		return domain.StreamTicket{}, fmt.Errorf("decode realtime ticket: %w", err)
+	}
+	return domain.StreamTicket{
+		ID:           stored.ID,
+		UserID:       stored.UserID,
+		IssuedAtUTC:  stored.IssuedAtUTC,
+		ExpiresAtUTC: stored.ExpiresAtUTC,
+	}, nil
+}
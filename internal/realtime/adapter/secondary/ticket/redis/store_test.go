@@ -0,0 +1,48 @@
+//nolint:testpackage // Tests validate the package-private stored form and key layout directly.
+package redis
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+func TestTicketRoundTripLeavesTokenOutOfPayload(t *testing.T) {
+	issuedAt := time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC)
+	ticket := domain.StreamTicket{
+		ID:           "ticket-1",
+		Token:        "secret-token",
+		UserID:       14,
+		IssuedAtUTC:  issuedAt,
+		ExpiresAtUTC: issuedAt.Add(30 * time.Second),
+	}
+
+	payload, err := encodeTicket(ticket)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if strings.Contains(string(payload), ticket.Token) {
+		t.Fatalf("expected the token to stay out of the stored payload, got %s", payload)
+	}
+
+	got, err := decodeTicket(payload)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	ticket.Token = ""
+	if got != ticket {
+		t.Fatalf("expected %#v, got %#v", ticket, got)
+	}
+}
+
+func TestTicketKeyHashesToken(t *testing.T) {
+	key := ticketKey("secret-token")
+	if !strings.HasPrefix(key, keyPrefix) || strings.Contains(key, "secret-token") {
+		t.Fatalf("unexpected ticket key %q", key)
+	}
+	if key != ticketKey("secret-token") || key == ticketKey("other-token") {
+		t.Fatalf("expected a stable per-token key, got %q", key)
+	}
+}
@@ -3,14 +3,77 @@ package domain
 
 import "time"
 
+// Realtime event types. Each is also the SSE event name the stream writes the event under.
+const (
+	// EventTypeRecordProjectionChanged announces that a record projection changed.
+	EventTypeRecordProjectionChanged = "record_projection_changed"
+
+	// EventTypeDashboardSnapshotInvalidated announces that records feeding dashboard metrics changed;
+	// the payload is in Event.Dashboard.
+	EventTypeDashboardSnapshotInvalidated = "dashboard_snapshot_invalidated"
+
+	// EventTypeTagChanged announces that a tag was created, updated or deleted; the payload is in Event.Tag.
+	EventTypeTagChanged = "tag_changed"
+
+	// EventTypeCategoryChanged announces that a category was created, updated or deleted; the payload
+	// is in Event.Category.
+	EventTypeCategoryChanged = "category_changed"
+
+	// EventTypeChatResponseReady announces that the answer to a chat message was stored; the payload
+	// is in Event.Chat.
+	EventTypeChatResponseReady = "chat_response_ready"
+
+	// EventTypeGoalStatusChanged announces that a daily goal of the dashboard snapshot changed status,
+	// for example from pending to completed; the payload is in Event.Goal.
+	EventTypeGoalStatusChanged = "goal_status_changed"
+)
+
+// StreamEventTypes lists the event types a stream delivers, in the order they are documented.
+func StreamEventTypes() []string {
+	return []string{
+		EventTypeRecordProjectionChanged,
+		EventTypeDashboardSnapshotInvalidated,
+		EventTypeTagChanged,
+		EventTypeCategoryChanged,
+		EventTypeChatResponseReady,
+		EventTypeGoalStatusChanged,
+	}
+}
+
+// Change actions carried by record projection, tag and category events.
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+	ActionDeleted = "deleted"
+)
+
 // Event is the payload delivered to realtime subscribers.
+// ID is the per-user sequence number assigned when the event enters the replay buffer; it is
+// written as the SSE id field rather than inside the payload and stays zero without a replay store.
+// TagID and CategoryID come from the projection-ready event, or from the tag or category payload, so
+// subscriptions can be filtered without a lookup; they stay zero when the producer did not send them.
+// RecordID, Action and ProjectedAtUTC belong to record projection changes and are omitted from the
+// JSON of other types, which carry their typed payload in the field named after them instead.
+// TraceParent and TraceState carry the W3C trace context of the upstream message; they stay
+// out of the JSON payload and only connect delivery spans to the producing trace.
 type Event struct {
+	ID             uint64    `json:"-"`
 	Type           string    `json:"type"`
 	UserID         uint64    `json:"userId"`
-	RecordID       uint64    `json:"recordId"`
-	Action         string    `json:"action"`
-	ProjectedAtUTC time.Time `json:"projectedAtUTC"`
+	RecordID       uint64    `json:"recordId,omitempty"`
+	TagID          uint64    `json:"tagId,omitempty"`
+	CategoryID     uint64    `json:"categoryId,omitempty"`
+	Action         string    `json:"action,omitempty"`
+	ProjectedAtUTC time.Time `json:"projectedAtUTC,omitzero"`
 	SourceEventID  string    `json:"sourceEventId,omitempty"`
 	TraceID        string    `json:"traceId,omitempty"`
 	RequestID      string    `json:"requestId,omitempty"`
+	TraceParent    string    `json:"-"`
+	TraceState     string    `json:"-"`
+
+	Dashboard *DashboardSnapshotInvalidated `json:"dashboard,omitempty"`
+	Tag       *TagChanged                   `json:"tag,omitempty"`
+	Category  *CategoryChanged              `json:"category,omitempty"`
+	Chat      *ChatResponseReady            `json:"chat,omitempty"`
+	Goal      *GoalStatusChanged            `json:"goal,omitempty"`
 }
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+)
+
+// MaxFilterValues bounds the values of one filter criterion so a subscription cannot make every
+// publish scan an arbitrarily long list.
+const MaxFilterValues = 100
+
+var (
+	// ErrFilterUnknownType is returned when a filter names an event type the stream does not emit.
+	ErrFilterUnknownType = errors.New("unknown realtime event type")
+
+	// ErrFilterUnknownAction is returned when a filter names an action events never carry.
+	ErrFilterUnknownAction = errors.New("unknown realtime action")
+
+	// ErrFilterInvalidID is returned when a tag or category filter contains a zero id.
+	ErrFilterInvalidID = errors.New("realtime filter ids must be positive")
+
+	// ErrFilterTooManyValues is returned when one criterion lists more than MaxFilterValues values.
+	ErrFilterTooManyValues = errors.New("too many realtime filter values")
+)
+
+// Filter narrows a subscription to the events a client asked for. Criteria are combined with AND
+// and the values of one criterion with OR; an empty criterion matches every event. Tag and category
+// criteria only match events that carry a tag or category, and action criteria only events that
+// carry an action: record projection, tag and category changes.
+type Filter struct {
+	Types       []string
+	Actions     []string
+	TagIDs      []uint64
+	CategoryIDs []uint64
+}
+
+// Validate reports the first criterion that could never match or is too long.
+func (f Filter) Validate() error {
+	if len(f.Types) > MaxFilterValues || len(f.Actions) > MaxFilterValues ||
+		len(f.TagIDs) > MaxFilterValues || len(f.CategoryIDs) > MaxFilterValues {
+		return ErrFilterTooManyValues
+	}
+	for _, eventType := range f.Types {
+		if !slices.Contains(StreamEventTypes(), eventType) {
+			return ErrFilterUnknownType
+		}
+	}
+	for _, action := range f.Actions {
+		if action != ActionCreated && action != ActionUpdated && action != ActionDeleted {
+			return ErrFilterUnknownAction
+		}
+	}
+	if slices.Contains(f.TagIDs, 0) || slices.Contains(f.CategoryIDs, 0) {
+		return ErrFilterInvalidID
+	}
+	return nil
+}
+
+// Matches reports whether event passes every criterion of the filter.
+func (f Filter) Matches(event Event) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, event.Type) {
+		return false
+	}
+	if len(f.Actions) > 0 && !slices.Contains(f.Actions, event.Action) {
+		return false
+	}
+	if len(f.TagIDs) > 0 && !slices.Contains(f.TagIDs, event.TagID) {
+		return false
+	}
+	if len(f.CategoryIDs) > 0 && !slices.Contains(f.CategoryIDs, event.CategoryID) {
+		return false
+	}
+	return true
+}
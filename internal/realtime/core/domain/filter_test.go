@@ -0,0 +1,63 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+func TestFilterMatches(t *testing.T) {
+	event := domain.Event{
+		Type:       domain.EventTypeRecordProjectionChanged,
+		Action:     domain.ActionUpdated,
+		TagID:      7,
+		CategoryID: 3,
+	}
+
+	tests := []struct {
+		name   string
+		filter domain.Filter
+		want   bool
+	}{
+		{name: "empty filter", filter: domain.Filter{}, want: true},
+		{name: "all criteria match", filter: domain.Filter{
+			Types:       []string{domain.EventTypeRecordProjectionChanged},
+			Actions:     []string{domain.ActionCreated, domain.ActionUpdated},
+			TagIDs:      []uint64{1, 7},
+			CategoryIDs: []uint64{3},
+		}, want: true},
+		{name: "action mismatch", filter: domain.Filter{Actions: []string{domain.ActionDeleted}}, want: false},
+		{name: "tag mismatch", filter: domain.Filter{TagIDs: []uint64{8}}, want: false},
+		{name: "category mismatch", filter: domain.Filter{CategoryIDs: []uint64{4}}, want: false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Matches(event); got != tt.want {
+			t.Fatalf("%s: want %v, got %v", tt.name, tt.want, got)
+		}
+	}
+
+	if (domain.Filter{TagIDs: []uint64{7}}).Matches(domain.Event{}) {
+		t.Fatal("expected an event without a tag not to match a tag filter")
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter domain.Filter
+		want   error
+	}{
+		{name: "valid", filter: domain.Filter{Types: []string{domain.EventTypeRecordProjectionChanged}, Actions: []string{domain.ActionCreated}, TagIDs: []uint64{1}}},
+		{name: "other stream types", filter: domain.Filter{Types: []string{domain.EventTypeTagChanged, domain.EventTypeGoalStatusChanged}}},
+		{name: "unknown type", filter: domain.Filter{Types: []string{"habit_logged"}}, want: domain.ErrFilterUnknownType},
+		{name: "unknown action", filter: domain.Filter{Actions: []string{"archived"}}, want: domain.ErrFilterUnknownAction},
+		{name: "zero category", filter: domain.Filter{CategoryIDs: []uint64{0}}, want: domain.ErrFilterInvalidID},
+		{name: "too many tags", filter: domain.Filter{TagIDs: make([]uint64, domain.MaxFilterValues+1)}, want: domain.ErrFilterTooManyValues},
+	}
+	for _, tt := range tests {
+		if err := tt.filter.Validate(); !errors.Is(err, tt.want) {
+			t.Fatalf("%s: want %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package domain
+
+import "time"
+
+// DashboardSnapshotInvalidated tells a client that the dashboard snapshot of Date no longer matches
+// the records it was computed from, so it refetches the listed metrics. Date is the local day of the
+// snapshot (YYYY-MM-DD) in Timezone; without a date the change spanned too many days to list, and
+// every snapshot of the listed metrics is stale.
+type DashboardSnapshotInvalidated struct {
+	Date             string    `json:"date,omitempty"`
+	Timezone         string    `json:"timezone,omitempty"`
+	MetricKeys       []string  `json:"metricKeys"`
+	InvalidatedAtUTC time.Time `json:"invalidatedAtUTC"`
+}
+
+// TagChanged describes a tag after the change, or before it for a deletion.
+type TagChanged struct {
+	TagID        uint64    `json:"tagId"`
+	CategoryID   uint64    `json:"categoryId"`
+	Name         string    `json:"name,omitempty"`
+	ChangedAtUTC time.Time `json:"changedAtUTC"`
+}
+
+// CategoryChanged describes a category after the change, or before it for a deletion.
+type CategoryChanged struct {
+	CategoryID   uint64    `json:"categoryId"`
+	Name         string    `json:"name,omitempty"`
+	ChangedAtUTC time.Time `json:"changedAtUTC"`
+}
+
+// ChatResponseReady points a client at a stored chat answer. The answer text stays out of the event
+// so the replay buffer never holds conversation content; the client reads it from the chat history.
+type ChatResponseReady struct {
+	ChatID         uint64    `json:"chatId"`
+	SessionID      string    `json:"sessionId,omitempty"`
+	TokensUsed     int       `json:"tokensUsed,omitempty"`
+	RespondedAtUTC time.Time `json:"respondedAtUTC"`
+}
+
+// GoalStatusChanged reports a daily goal of the dashboard snapshot of Date moving from PreviousStatus
+// to Status, with the values that caused it. Statuses are the dashboard goal statuses.
+type GoalStatusChanged struct {
+	GoalID         uint64    `json:"goalId"`
+	MetricKey      string    `json:"metricKey"`
+	Date           string    `json:"date"`
+	PreviousStatus string    `json:"previousStatus"`
+	Status         string    `json:"status"`
+	Current        float64   `json:"current"`
+	Target         float64   `json:"target"`
+	ChangedAtUTC   time.Time `json:"changedAtUTC"`
+}
+
+// NewDashboardSnapshotInvalidatedEvent builds the event announcing payload to userID.
+func NewDashboardSnapshotInvalidatedEvent(userID uint64, payload DashboardSnapshotInvalidated) Event {
+	return Event{Type: EventTypeDashboardSnapshotInvalidated, UserID: userID, Dashboard: &payload}
+}
+
+// NewTagChangedEvent builds the event announcing payload to userID. The tag and category ids are
+// copied to the event so tag and category filters match it.
+func NewTagChangedEvent(userID uint64, action string, payload TagChanged) Event {
+	return Event{
+		Type:       EventTypeTagChanged,
+		UserID:     userID,
+		TagID:      payload.TagID,
+		CategoryID: payload.CategoryID,
+		Action:     action,
+		Tag:        &payload,
+	}
+}
+
+// NewCategoryChangedEvent builds the event announcing payload to userID. The category id is copied
+// to the event so category filters match it.
+func NewCategoryChangedEvent(userID uint64, action string, payload CategoryChanged) Event {
+	return Event{
+		Type:       EventTypeCategoryChanged,
+		UserID:     userID,
+		CategoryID: payload.CategoryID,
+		Action:     action,
+		Category:   &payload,
+	}
+}
+
+// NewChatResponseReadyEvent builds the event announcing payload to userID.
+func NewChatResponseReadyEvent(userID uint64, payload ChatResponseReady) Event {
+	return Event{Type: EventTypeChatResponseReady, UserID: userID, Chat: &payload}
+}
+
+// NewGoalStatusChangedEvent builds the event announcing payload to userID.
+func NewGoalStatusChangedEvent(userID uint64, payload GoalStatusChanged) Event {
+	return Event{Type: EventTypeGoalStatusChanged, UserID: userID, Goal: &payload}
+}
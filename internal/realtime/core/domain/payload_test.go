@@ -0,0 +1,103 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+func TestEventConstructorsSetTypeAndPayload(t *testing.T) {
+	at := time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		event domain.Event
+		want  string
+	}{
+		{
+			name:  "dashboard snapshot invalidated",
+			event: domain.NewDashboardSnapshotInvalidatedEvent(7, domain.DashboardSnapshotInvalidated{Date: "2026-03-13", Timezone: "UTC", MetricKeys: []string{"water_ml"}, InvalidatedAtUTC: at}),
+			want:  `{"type":"dashboard_snapshot_invalidated","userId":7,"dashboard":{"date":"2026-03-13","timezone":"UTC","metricKeys":["water_ml"],"invalidatedAtUTC":"2026-03-13T13:00:00Z"}}`,
+		},
+		{
+			name:  "tag changed",
+			event: domain.NewTagChangedEvent(7, domain.ActionUpdated, domain.TagChanged{TagID: 10, CategoryID: 3, Name: "Water", ChangedAtUTC: at}),
+			want:  `{"type":"tag_changed","userId":7,"tagId":10,"categoryId":3,"action":"updated","tag":{"tagId":10,"categoryId":3,"name":"Water","changedAtUTC":"2026-03-13T13:00:00Z"}}`,
+		},
+		{
+			name:  "category changed",
+			event: domain.NewCategoryChangedEvent(7, domain.ActionDeleted, domain.CategoryChanged{CategoryID: 3, ChangedAtUTC: at}),
+			want:  `{"type":"category_changed","userId":7,"categoryId":3,"action":"deleted","category":{"categoryId":3,"changedAtUTC":"2026-03-13T13:00:00Z"}}`,
+		},
+		{
+			name:  "chat response ready",
+			event: domain.NewChatResponseReadyEvent(7, domain.ChatResponseReady{ChatID: 99, SessionID: "s-1", TokensUsed: 120, RespondedAtUTC: at}),
+			want:  `{"type":"chat_response_ready","userId":7,"chat":{"chatId":99,"sessionId":"s-1","tokensUsed":120,"respondedAtUTC":"2026-03-13T13:00:00Z"}}`,
+		},
+		{
+			name: "goal status changed",
+			event: domain.NewGoalStatusChangedEvent(7, domain.GoalStatusChanged{
+				GoalID: 4, MetricKey: "water_ml", Date: "2026-03-13",
+				PreviousStatus: "pending", Status: "completed", Current: 2000, Target: 2000, ChangedAtUTC: at,
+			}),
+			want: `{"type":"goal_status_changed","userId":7,"goal":{"goalId":4,"metricKey":"water_ml","date":"2026-03-13","previousStatus":"pending","status":"completed","current":2000,"target":2000,"changedAtUTC":"2026-03-13T13:00:00Z"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.event)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(body) != tt.want {
+				t.Fatalf("unexpected payload\n got: %s\nwant: %s", body, tt.want)
+			}
+
+			var decoded domain.Event
+			if err := json.Unmarshal(body, &decoded); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if decoded.Type != tt.event.Type {
+				t.Fatalf("expected type %q to survive a round trip, got %q", tt.event.Type, decoded.Type)
+			}
+		})
+	}
+}
+
+func TestStreamEventTypesAreFilterable(t *testing.T) {
+	for _, eventType := range domain.StreamEventTypes() {
+		if err := (domain.Filter{Types: []string{eventType}}).Validate(); err != nil {
+			t.Fatalf("%s: %v", eventType, err)
+		}
+	}
+
+	event := domain.NewTagChangedEvent(7, domain.ActionCreated, domain.TagChanged{TagID: 10, CategoryID: 3})
+	if !(domain.Filter{TagIDs: []uint64{10}, CategoryIDs: []uint64{3}}).Matches(event) {
+		t.Fatal("expected tag and category filters to match a tag change")
+	}
+	goal := domain.NewGoalStatusChangedEvent(7, domain.GoalStatusChanged{GoalID: 4})
+	if (domain.Filter{Actions: []string{domain.ActionUpdated}}).Matches(goal) {
+		t.Fatal("expected action filters to skip events without an action")
+	}
+}
+
+func TestRecordProjectionEventKeepsItsPayloadShape(t *testing.T) {
+	body, err := json.Marshal(domain.Event{
+		Type:           domain.EventTypeRecordProjectionChanged,
+		UserID:         7,
+		RecordID:       42,
+		Action:         domain.ActionCreated,
+		ProjectedAtUTC: time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	want := `{"type":"record_projection_changed","userId":7,"recordId":42,"action":"created","projectedAtUTC":"2026-03-13T13:00:00Z"}`
+	if string(body) != want || strings.Contains(string(body), "dashboard") {
+		t.Fatalf("unexpected payload\n got: %s\nwant: %s", body, want)
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "errors"
+
+// SlowConsumerPolicy decides what happens to an event that arrives while a stream's buffer is full.
+type SlowConsumerPolicy string
+
+const (
+	// SlowConsumerDropNewest discards the arriving event and keeps the buffered ones.
+	SlowConsumerDropNewest SlowConsumerPolicy = "drop_newest"
+
+	// SlowConsumerDropOldest discards the oldest buffered event to make room for the arriving one.
+	SlowConsumerDropOldest SlowConsumerPolicy = "drop_oldest"
+
+	// SlowConsumerDisconnect discards the buffer, sends one EventTypeResyncRequired event and closes
+	// the stream, so the client refetches its state instead of silently missing updates.
+	SlowConsumerDisconnect SlowConsumerPolicy = "disconnect"
+)
+
+// EventTypeResyncRequired marks the last event of a stream closed by SlowConsumerDisconnect. Its ID
+// is the id of the event that overflowed the buffer, the id the client can resume after.
+const EventTypeResyncRequired = "resync_required"
+
+var (
+	// ErrUserStreamLimit is returned by Subscribe when the user already has the maximum number of streams open.
+	ErrUserStreamLimit = errors.New("too many realtime streams open for this user")
+
+	// ErrStreamLimit is returned by Subscribe when the instance already serves the maximum number of streams.
+	ErrStreamLimit = errors.New("too many realtime streams open on this instance")
+)
@@ -0,0 +1,31 @@
+package domain
+
+// ReplayWindow is the range of event ids a replay store still holds for one user.
+// Oldest is zero when nothing is retained; Latest is the last id assigned to the user.
+type ReplayWindow struct {
+	Oldest uint64
+	Latest uint64
+}
+
+// Covers reports whether every event after lastEventID is still retained, so a client that saw
+// lastEventID can resume without losing updates. An id newer than Latest was issued before the
+// store lost its state and cannot be resumed either.
+func (w ReplayWindow) Covers(lastEventID uint64) bool {
+	if lastEventID > w.Latest {
+		return false
+	}
+	if lastEventID == w.Latest {
+		return true
+	}
+	return w.Oldest != 0 && w.Oldest <= lastEventID+1
+}
+
+// Replay is the outcome of resuming a stream after lastEventID.
+// Events are the retained events after that id, oldest first. ResyncRequired reports that some
+// of them were evicted or the id is unknown; the client must then refetch its state, and LatestID
+// is the id it can resume from afterwards.
+type Replay struct {
+	Events         []Event
+	LatestID       uint64
+	ResyncRequired bool
+}
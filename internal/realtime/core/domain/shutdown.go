@@ -0,0 +1,12 @@
+package domain
+
+import "errors"
+
+// EventTypeServerShutdown marks the last event of a stream closed because the instance is shutting
+// down. Events buffered before it are still delivered; the client reconnects, ideally to another
+// instance, and resumes from its last id. A stream with a full buffer is closed without it, so no
+// buffered event is given up.
+const EventTypeServerShutdown = "server_shutdown"
+
+// ErrShuttingDown is returned by Subscribe once the service started closing its streams for shutdown.
+var ErrShuttingDown = errors.New("realtime service is shutting down")
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTicketInvalid is returned when a stream ticket is unknown, expired, or was already redeemed.
+var ErrTicketInvalid = errors.New("realtime stream ticket is invalid, expired or already used")
+
+// StreamTicket is a single-use credential that opens one realtime stream for UserID, for clients
+// such as a browser EventSource that cannot send an Authorization header.
+// Token is the secret handed to the client; ID names the ticket in audit records without revealing it.
+type StreamTicket struct {
+	ID           string
+	Token        string
+	UserID       uint64
+	IssuedAtUTC  time.Time
+	ExpiresAtUTC time.Time
+}
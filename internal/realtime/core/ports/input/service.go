@@ -8,7 +8,24 @@ import (
 )
 
 // Service defines the realtime publish and subscribe operations.
+// Publish assigns the event its id and fans it out, through the fan-out broker when one is
+// configured; Deliver hands an event received from that broker to the subscribers of this
+// instance, enqueueing it only on streams whose filter it passes. Subscribe fails with
+// domain.ErrUserStreamLimit or domain.ErrStreamLimit when a stream cap is reached, and its
+// channel ends with a domain.EventTypeResyncRequired event when the service drops a stream that
+// could not keep up. Replay returns the events a user missed after lastEventID that pass the same
+// filter; callers subscribe first and skip live events whose id is not above the last replayed one.
+// Shutdown ends every channel with a domain.EventTypeServerShutdown event and refuses new streams.
 type Service interface {
+	Publisher
+	Deliver(ctx context.Context, event domain.Event)
+	Subscribe(ctx context.Context, userID uint64, filter domain.Filter) (<-chan domain.Event, func(), error)
+	Replay(ctx context.Context, userID, lastEventID uint64, filter domain.Filter) (domain.Replay, error)
+	Shutdown(ctx context.Context)
+}
+
+// Publisher is the part of Service that producers in other bounded contexts use to announce a change
+// to the user's streams once it is committed.
+type Publisher interface {
 	Publish(ctx context.Context, event domain.Event)
-	Subscribe(ctx context.Context, userID uint64) (<-chan domain.Event, func())
 }
@@ -0,0 +1,18 @@
+package input
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// TicketService issues and redeems single-use stream tickets.
+// Issue creates a short-lived ticket for an authenticated user; Lookup returns a ticket without
+// consuming it, so a request can be checked before the ticket is spent; Redeem consumes a ticket and
+// returns it. Lookup and Redeem fail with domain.ErrTicketInvalid for unknown, expired, or already
+// used tokens.
+type TicketService interface {
+	Issue(ctx context.Context, userID uint64) (domain.StreamTicket, error)
+	Lookup(ctx context.Context, token string) (domain.StreamTicket, error)
+	Redeem(ctx context.Context, token string) (domain.StreamTicket, error)
+}
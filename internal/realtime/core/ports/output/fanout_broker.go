@@ -0,0 +1,19 @@
+package output
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// FanoutBroker carries realtime events between API instances.
+// Publish hands an event to every instance that joined its user; Join and Leave track the users
+// this instance has open streams for; Receive blocks for the next event of a joined user until
+// ctx ends; Close releases the underlying connection.
+type FanoutBroker interface {
+	Publish(ctx context.Context, event domain.Event) error
+	Join(ctx context.Context, userID uint64) error
+	Leave(ctx context.Context, userID uint64) error
+	Receive(ctx context.Context) (domain.Event, error)
+	Close() error
+}
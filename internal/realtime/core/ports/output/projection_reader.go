@@ -0,0 +1,15 @@
+// Package output declares the realtime secondary ports.
+package output
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// ProjectionEventReader yields projection-ready events for realtime fan-out.
+// Read blocks until an event arrives or ctx ends; Close releases the underlying source.
+type ProjectionEventReader interface {
+	Read(ctx context.Context) (domain.Event, error)
+	Close() error
+}
@@ -0,0 +1,16 @@
+package output
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// ReplayStore keeps the most recent realtime events of each user under monotonically increasing ids.
+// Append assigns the next id of the event's user, retains the event, and returns it with ID set.
+// Since returns the retained events with an id above lastEventID, oldest first, with the window of ids
+// still held for the user.
+type ReplayStore interface {
+	Append(ctx context.Context, event domain.Event) (domain.Event, error)
+	Since(ctx context.Context, userID, lastEventID uint64) ([]domain.Event, domain.ReplayWindow, error)
+}
@@ -0,0 +1,19 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+// TicketStore keeps issued stream tickets until they expire or are redeemed.
+// Save stores the ticket under its token for ttl. Lookup returns the ticket for token and leaves it
+// stored. Redeem removes and returns the ticket for token in
+// one step, so concurrent redemptions of the same token succeed at most once. Lookup and Redeem
+// return domain.ErrTicketInvalid when no ticket is stored under token.
+type TicketStore interface {
+	Save(ctx context.Context, ticket domain.StreamTicket, ttl time.Duration) error
+	Lookup(ctx context.Context, token string) (domain.StreamTicket, error)
+	Redeem(ctx context.Context, token string) (domain.StreamTicket, error)
+}
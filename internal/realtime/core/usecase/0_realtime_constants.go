@@ -1,6 +1,97 @@
 // Package usecase implements the in-memory realtime pub/sub service.
 package usecase
 
+import "time"
+
+const (
+	// TracerName is the tracer name used by the realtime use case.
+	TracerName = "aion-api.realtime.usecase"
+	// MeterName is the meter name used by the realtime use case.
+	MeterName = "aion-api.realtime.usecase"
+
+	// SpanPublish is the span name for fanning one event out to subscribers.
+	SpanPublish = "realtime.publish"
+
+	// SpanReplay is the span name for reading missed events after a Last-Event-ID.
+	SpanReplay = "realtime.replay"
+
+	// SpanDeliver is the span name for handing one fanned-out event to the subscribers of this instance.
+	SpanDeliver = "realtime.fanout.deliver"
+
+	// SpanShutdown is the span name for closing every stream before the instance stops.
+	SpanShutdown = "realtime.shutdown"
+
+	// SpanTicketIssue is the span name for issuing a stream ticket.
+	SpanTicketIssue = "realtime.ticket.issue"
+
+	// SpanTicketLookup is the span name for checking a stream ticket without redeeming it.
+	SpanTicketLookup = "realtime.ticket.lookup"
+
+	// SpanTicketRedeem is the span name for redeeming a stream ticket.
+	SpanTicketRedeem = "realtime.ticket.redeem"
+)
+
+const (
+	// MetricSubscribers observes the realtime streams open on this instance.
+	MetricSubscribers = "aion.realtime.subscribers"
+	// MetricSubscribedUsers observes the distinct users with a stream open on this instance.
+	MetricSubscribedUsers = "aion.realtime.subscribed_users"
+	// MetricEventsDropped counts events a stream lost because its buffer was full, by policy.
+	MetricEventsDropped = "aion.realtime.events.dropped"
+	// MetricStreamsRejected counts streams refused by the per-user or per-instance cap, by scope.
+	MetricStreamsRejected = "aion.realtime.streams.rejected"
+
+	// MetricAttrPolicy is the slow-consumer policy that dropped an event.
+	MetricAttrPolicy = "policy"
+	// MetricAttrScope is the cap that refused a stream: MetricScopeUser or MetricScopeInstance.
+	MetricAttrScope = "scope"
+
+	MetricScopeUser     = "user"
+	MetricScopeInstance = "instance"
+)
+
+const (
+	logRealtimeEventDropped  = "realtime subscriber channel full, dropping event"
+	logRealtimeStreamOverrun = "realtime subscriber channel full, closing stream with resync_required"
+	logRealtimeStreamLimit   = "realtime stream limit reached"
+	logRealtimeAppendFailed  = "realtime replay append failed, delivering event without id"
+	logRealtimeResyncRequest = "realtime replay gap, client must resync"
+	logRealtimeShutdown      = "realtime streams closed for shutdown"
+	logRealtimeShuttingDown  = "realtime stream refused during shutdown"
+
+	logRealtimeFanoutPublishFailed = "realtime fan-out publish failed"
+	logRealtimeFanoutJoinFailed    = "realtime fan-out join failed"
+	logRealtimeFanoutLeaveFailed   = "realtime fan-out leave failed"
+	logRealtimeMetricsUnavailable  = "realtime subscriber metrics unavailable"
+)
+
 const (
-	logRealtimeEventDropped = "realtime subscriber channel full, dropping event"
+	// defaultTicketTTL applies when the configured ticket lifetime is below one second.
+	defaultTicketTTL = 30 * time.Second
+
+	// ticketTokenBytes is the entropy of a ticket token before base64url encoding.
+	ticketTokenBytes = 32
+
+	attrTicketID          = "ticket_id"
+	attrTicketFingerprint = "ticket_fingerprint"
+
+	statusTicketIssued   = "realtime stream ticket issued"
+	statusTicketRedeemed = "realtime stream ticket redeemed"
+
+	errTicketUserRequired = "realtime stream ticket requires a user id"
+
+	logRealtimeTicketIssued      = "realtime stream ticket issued"
+	logRealtimeTicketRedeemed    = "realtime stream ticket redeemed"
+	logRealtimeTicketRejected    = "realtime stream ticket rejected"
+	logRealtimeTicketExpired     = "realtime stream ticket redeemed after expiry"
+	logRealtimeTicketSaveFailed  = "realtime stream ticket save failed"
+	logRealtimeTicketAuditFailed = "realtime stream ticket audit write failed"
+
+	auditUIActionStreamTicket = "realtime_stream_ticket"
+	auditEntityStreamTicket   = "realtime_stream_ticket"
+	auditActionTicketIssued   = "realtime_stream_ticket_issued"
+	auditActionTicketRedeemed = "realtime_stream_ticket_redeemed"
+	auditOperationIssue       = "issue"
+	auditOperationRedeem      = "redeem"
+	auditStatusSuccess        = "success"
 )
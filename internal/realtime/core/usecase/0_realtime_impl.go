@@ -6,29 +6,106 @@ import (
 
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
 	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/realtime/core/ports/output"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // Service provides fan-out of realtime events to per-user subscribers.
 type Service struct {
 	logger           logger.ContextLogger
 	subscriberBuffer int
+	replay           output.ReplayStore
+	fanout           output.FanoutBroker
+	policy           domain.SlowConsumerPolicy
+	maxUserStreams   int
+	maxStreams       int
+	metrics          *serviceMetrics
 
 	mu          sync.RWMutex
 	nextID      uint64
-	subscribers map[uint64]map[uint64]chan domain.Event
+	streams     int
+	closing     bool
+	subscribers map[uint64]map[uint64]subscriber
+
+	// membership serializes fan-out Join and Leave calls; joined counts the streams of each user
+	// that this instance joined the broker for.
+	membership sync.Mutex
+	joined     map[uint64]int
+}
+
+// subscriber is one open stream: its channel and the filter events must pass to be enqueued on it.
+type subscriber struct {
+	ch     chan domain.Event
+	filter domain.Filter
 }
 
-// NewService creates a realtime service with the configured subscriber buffer.
+// NewService creates a realtime service with the configured subscriber buffer. Events that find a
+// stream's buffer full are dropped, and streams are not capped, until configured otherwise.
+// Metrics are registered on the global OpenTelemetry meter provider.
 func NewService(log logger.ContextLogger, subscriberBuffer int) *Service {
 	if subscriberBuffer <= 0 {
 		subscriberBuffer = 1
 	}
 
-	return &Service{
+	s := &Service{
 		logger:           log,
 		subscriberBuffer: subscriberBuffer,
-		subscribers:      make(map[uint64]map[uint64]chan domain.Event),
+		policy:           domain.SlowConsumerDropNewest,
+		subscribers:      make(map[uint64]map[uint64]subscriber),
+		joined:           make(map[uint64]int),
+	}
+	return s.WithMeterProvider(otel.GetMeterProvider())
+}
+
+// WithReplayStore enables event ids and Last-Event-ID resumption through the given store.
+// Without one, events carry no id and every resumption asks the client to resync.
+func (s *Service) WithReplayStore(store output.ReplayStore) *Service {
+	s.replay = store
+	return s
+}
+
+// WithFanout routes published events through a broker shared by every API instance, so an
+// instance only receives events of users it has streams for. Without one, Publish delivers to
+// the subscribers of this instance directly.
+func (s *Service) WithFanout(broker output.FanoutBroker) *Service {
+	s.fanout = broker
+	return s
+}
+
+// WithSlowConsumerPolicy sets what happens to events that arrive while a stream's buffer is full.
+// Unknown policies keep domain.SlowConsumerDropNewest.
+func (s *Service) WithSlowConsumerPolicy(policy domain.SlowConsumerPolicy) *Service {
+	switch policy {
+	case domain.SlowConsumerDropNewest, domain.SlowConsumerDropOldest, domain.SlowConsumerDisconnect:
+		s.policy = policy
+	}
+	return s
+}
+
+// WithStreamLimits caps the streams one user and this instance may have open; Subscribe refuses
+// streams beyond them. A limit of zero or less leaves that scope uncapped.
+func (s *Service) WithStreamLimits(perUser, total int) *Service {
+	s.maxUserStreams = perUser
+	s.maxStreams = total
+	return s
+}
+
+// WithMeterProvider moves the realtime metrics to the given provider.
+func (s *Service) WithMeterProvider(provider metric.MeterProvider) *Service {
+	if s.metrics != nil {
+		_ = s.metrics.registration.Unregister()
+		s.metrics = nil
+	}
+
+	metrics, err := newServiceMetrics(provider, s)
+	if err != nil {
+		s.logger.Warnw(logRealtimeMetricsUnavailable, commonkeys.Error, err.Error())
+		return s
 	}
+	s.metrics = metrics
+	return s
 }
 
 func (s *Service) nextSubscriberID() uint64 {
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"time"
+
+	auditinput "github.com/lechitz/aion-api/internal/audit/core/ports/input"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/realtime/core/ports/output"
+)
+
+// TicketService issues single-use stream tickets and records every issue and redemption in the
+// audit log when an audit service is configured.
+type TicketService struct {
+	store  output.TicketStore
+	ttl    time.Duration
+	audit  auditinput.Service
+	logger logger.ContextLogger
+	now    func() time.Time
+}
+
+// NewTicketService creates a ticket service whose tickets expire after ttl. A ttl below one second
+// falls back to defaultTicketTTL.
+func NewTicketService(store output.TicketStore, ttl time.Duration, log logger.ContextLogger) *TicketService {
+	if ttl < time.Second {
+		ttl = defaultTicketTTL
+	}
+	return &TicketService{
+		store:  store,
+		ttl:    ttl,
+		logger: log,
+		now:    time.Now,
+	}
+}
+
+// WithAudit writes an audit event for each issued and redeemed ticket. Audit failures are logged
+// and never fail the ticket operation.
+func (s *TicketService) WithAudit(audit auditinput.Service) *TicketService {
+	s.audit = audit
+	return s
+}
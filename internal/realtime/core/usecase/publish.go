@@ -2,34 +2,205 @@ package usecase
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
 	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// Publish fans out a realtime event to subscribers of the same user.
+// Publish fans out a realtime event to subscribers of the same user, after the replay store, when
+// configured, has assigned its id. With a fan-out broker the event goes to the broker and reaches
+// subscribers through Deliver on every instance that joined the user, this one included.
+// The publish span continues the trace carried by the event, and subscribers receive the
+// publish span as the event's trace context so their delivery spans nest under it.
 func (s *Service) Publish(ctx context.Context, event domain.Event) {
-	s.mu.RLock()
-	subscribers := s.subscribers[event.UserID]
-	if len(subscribers) == 0 {
-		s.mu.RUnlock()
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(
+		tracecontext.Extract(ctx, event.TraceParent, event.TraceState),
+		SpanPublish,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("user_id", strconv.FormatUint(event.UserID, 10)),
+			attribute.String("record_id", strconv.FormatUint(event.RecordID, 10)),
+			attribute.String("source_event_id", event.SourceEventID),
+		),
+	)
+	defer span.End()
+
+	if traceParent, traceState := tracecontext.Inject(ctx); traceParent != "" {
+		event.TraceParent, event.TraceState = traceParent, traceState
+	}
+
+	// Events are retained even without subscribers so a disconnected client can catch up.
+	if s.replay != nil {
+		stored, err := s.replay.Append(ctx, event)
+		if err != nil {
+			span.RecordError(err)
+			s.logger.WarnwCtx(ctx, logRealtimeAppendFailed,
+				"user_id", event.UserID,
+				"record_id", event.RecordID,
+				commonkeys.Error, err.Error(),
+			)
+		} else {
+			event = stored
+			span.SetAttributes(attribute.String("event_id", strconv.FormatUint(event.ID, 10)))
+		}
+	}
+
+	if s.fanout != nil {
+		if err := s.fanout.Publish(ctx, event); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			s.logger.ErrorwCtx(ctx, logRealtimeFanoutPublishFailed,
+				"user_id", event.UserID,
+				"record_id", event.RecordID,
+				commonkeys.Error, err.Error(),
+			)
+		}
 		return
 	}
+	s.deliverLocal(ctx, span, event)
+}
+
+// Deliver hands an event received from the fan-out broker to the subscribers of this instance.
+// The event already carries its id; the delivery span continues the publishing instance's trace.
+func (s *Service) Deliver(ctx context.Context, event domain.Event) {
+	ctx, span := otel.Tracer(TracerName).Start(
+		tracecontext.Extract(ctx, event.TraceParent, event.TraceState),
+		SpanDeliver,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("user_id", strconv.FormatUint(event.UserID, 10)),
+			attribute.String("record_id", strconv.FormatUint(event.RecordID, 10)),
+			attribute.String("event_id", strconv.FormatUint(event.ID, 10)),
+		),
+	)
+	defer span.End()
+
+	if traceParent, traceState := tracecontext.Inject(ctx); traceParent != "" {
+		event.TraceParent, event.TraceState = traceParent, traceState
+	}
+	s.deliverLocal(ctx, span, event)
+}
 
-	channels := make([]chan domain.Event, 0, len(subscribers))
-	for _, ch := range subscribers {
-		channels = append(channels, ch)
+// deliverLocal enqueues event on every stream of its user whose filter it passes. Filtered-out
+// streams are not counted as receivers and never see the event, so they spend no buffer on it.
+// A full stream is handled by the slow-consumer policy; sends happen under the read lock so a
+// stream cannot be closed while an event is handed to it.
+func (s *Service) deliverLocal(ctx context.Context, span trace.Span, event domain.Event) {
+	s.mu.RLock()
+	subscribers := s.subscribers[event.UserID]
+	matched, dropped := 0, 0
+	var overrun []uint64
+	for subscriberID, sub := range subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+		matched++
+		if s.enqueue(sub.ch, event) {
+			continue
+		}
+		if s.policy == domain.SlowConsumerDisconnect {
+			overrun = append(overrun, subscriberID)
+			continue
+		}
+		dropped++
 	}
 	s.mu.RUnlock()
+	span.SetAttributes(
+		attribute.Int("subscriber_count", len(subscribers)),
+		attribute.Int("matched_subscriber_count", matched),
+	)
+
+	if dropped > 0 {
+		span.AddEvent(logRealtimeEventDropped)
+		s.metrics.recordDropped(ctx, s.policy, dropped)
+		s.logger.WarnwCtx(ctx, logRealtimeEventDropped,
+			"user_id", event.UserID,
+			"record_id", event.RecordID,
+			"event_type", event.Type,
+			MetricAttrPolicy, string(s.policy),
+			"dropped_count", dropped,
+		)
+	}
+	if len(overrun) > 0 {
+		s.disconnect(ctx, span, event, overrun)
+	}
+}
+
+// enqueue hands event to ch without blocking and reports whether it did so without losing an
+// event. Under drop-oldest a full buffer gives up its oldest event for the new one, which counts
+// as a loss; the other policies leave a full buffer untouched.
+func (s *Service) enqueue(ch chan domain.Event, event domain.Event) bool {
+	select {
+	case ch <- event:
+		return true
+	default:
+	}
+	if s.policy != domain.SlowConsumerDropOldest {
+		return false
+	}
+
+	select {
+	case <-ch:
+	default:
+	}
+	select {
+	case ch <- event:
+	default:
+	}
+	return false
+}
+
+// disconnect closes the overrun streams of event's user: the buffered events are discarded and
+// replaced by one resync_required event carrying the id of the event that did not fit, the id the
+// client resumes after once it has refetched its state.
+func (s *Service) disconnect(ctx context.Context, span trace.Span, event domain.Event, subscriberIDs []uint64) {
+	lost := 0
+	s.mu.Lock()
+	subscribers := s.subscribers[event.UserID]
+	for _, subscriberID := range subscriberIDs {
+		sub, ok := subscribers[subscriberID]
+		if !ok {
+			continue
+		}
+		delete(subscribers, subscriberID)
+		s.streams--
+		lost += drain(sub.ch) + 1
+		// Every send happens under the read lock, so the drained buffer has room for this one.
+		sub.ch <- domain.Event{ID: event.ID, Type: domain.EventTypeResyncRequired, UserID: event.UserID}
+		close(sub.ch)
+	}
+	if len(subscribers) == 0 {
+		delete(s.subscribers, event.UserID)
+	}
+	s.mu.Unlock()
+
+	span.AddEvent(logRealtimeStreamOverrun)
+	s.metrics.recordDropped(ctx, s.policy, lost)
+	s.logger.WarnwCtx(ctx, logRealtimeStreamOverrun,
+		"user_id", event.UserID,
+		"event_id", event.ID,
+		"stream_count", len(subscriberIDs),
+		"dropped_count", lost,
+	)
+}
 
-	for _, ch := range channels {
+// drain empties ch without blocking, since its reader may take events concurrently, and returns
+// how many events it discarded.
+func drain(ch chan domain.Event) int {
+	discarded := 0
+	for {
 		select {
-		case ch <- event:
+		case <-ch:
+			discarded++
 		default:
-			s.logger.WarnwCtx(ctx, logRealtimeEventDropped,
-				"user_id", event.UserID,
-				"record_id", event.RecordID,
-				"event_type", event.Type,
-			)
+			return discarded
 		}
 	}
 }
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Replay returns the events of userID after lastEventID that pass filter. When the store no longer
+// holds all of them, or no store is configured, the result asks the client to resync instead of
+// silently resuming with a gap. LatestID is the user's latest id whether or not that event matched.
+func (s *Service) Replay(ctx context.Context, userID, lastEventID uint64, filter domain.Filter) (domain.Replay, error) {
+	ctx, span := otel.Tracer(TracerName).Start(ctx, SpanReplay)
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("user_id", strconv.FormatUint(userID, 10)),
+		attribute.String("last_event_id", strconv.FormatUint(lastEventID, 10)),
+	)
+
+	if s.replay == nil {
+		return domain.Replay{ResyncRequired: true}, nil
+	}
+
+	events, window, err := s.replay.Since(ctx, userID, lastEventID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.Replay{}, err
+	}
+	matched := make([]domain.Event, 0, len(events))
+	for _, event := range events {
+		if filter.Matches(event) {
+			matched = append(matched, event)
+		}
+	}
+	span.SetAttributes(
+		attribute.Int("replayed_count", len(matched)),
+		attribute.String("latest_event_id", strconv.FormatUint(window.Latest, 10)),
+	)
+
+	if !window.Covers(lastEventID) {
+		span.AddEvent(logRealtimeResyncRequest)
+		s.logger.InfowCtx(ctx, logRealtimeResyncRequest,
+			"user_id", userID,
+			"last_event_id", lastEventID,
+			"oldest_event_id", window.Oldest,
+			"latest_event_id", window.Latest,
+		)
+		return domain.Replay{LatestID: window.Latest, ResyncRequired: true}, nil
+	}
+	return domain.Replay{Events: matched, LatestID: window.Latest}, nil
+}
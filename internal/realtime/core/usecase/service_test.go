@@ -3,19 +3,35 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/lechitz/aion-api/internal/realtime/adapter/secondary/replay/memory"
 	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
+func subscribe(ctx context.Context, t *testing.T, svc *Service, userID uint64, filter domain.Filter) (<-chan domain.Event, func()) {
+	t.Helper()
+	stream, cleanup, err := svc.Subscribe(ctx, userID, filter)
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	return stream, cleanup
+}
+
 func TestServicePublishAndSubscribe(t *testing.T) {
 	svc := NewService(noopRealtimeLogger{}, 2)
 
 	ctx, cancel := context.WithCancel(t.Context())
 	defer cancel()
 
-	stream, cleanup := svc.Subscribe(ctx, 14)
+	stream, cleanup := subscribe(ctx, t, svc, 14, domain.Filter{})
 	defer cleanup()
 
 	expected := domain.Event{
@@ -39,7 +55,7 @@ func TestServicePublishAndSubscribe(t *testing.T) {
 
 func TestServiceSubscribeFiltersByUser(t *testing.T) {
 	svc := NewService(noopRealtimeLogger{}, 1)
-	stream, cleanup := svc.Subscribe(t.Context(), 14)
+	stream, cleanup := subscribe(t.Context(), t, svc, 14, domain.Filter{})
 	defer cleanup()
 
 	svc.Publish(t.Context(), domain.Event{Type: "record_projection_changed", UserID: 99, RecordID: 1})
@@ -51,6 +67,434 @@ func TestServiceSubscribeFiltersByUser(t *testing.T) {
 	}
 }
 
+func TestServicePublishEnqueuesOnlyMatchingEvents(t *testing.T) {
+	svc := NewService(noopRealtimeLogger{}, 1)
+	stream, cleanup := subscribe(t.Context(), t, svc, 14, domain.Filter{TagIDs: []uint64{7}, Actions: []string{domain.ActionCreated}})
+	defer cleanup()
+
+	// With a buffer of one, a non-matching event enqueued first would push the matching one out.
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 1, TagID: 8, Action: domain.ActionCreated})
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 2, TagID: 7, Action: domain.ActionDeleted})
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 3, TagID: 7, Action: domain.ActionCreated})
+
+	select {
+	case got := <-stream:
+		if got.RecordID != 3 {
+			t.Fatalf("expected only the matching record 3, got %#v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for realtime event")
+	}
+}
+
+func TestServiceReplayAppliesFilter(t *testing.T) {
+	svc := NewService(noopRealtimeLogger{}, 1).WithReplayStore(memory.NewReplayStore(4))
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 1, CategoryID: 3})
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 2, CategoryID: 4})
+
+	replay, err := svc.Replay(t.Context(), 14, 0, domain.Filter{CategoryIDs: []uint64{3}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if replay.ResyncRequired || len(replay.Events) != 1 || replay.Events[0].RecordID != 1 || replay.LatestID != 2 {
+		t.Fatalf("expected record 1 replayed with latest id 2, got %#v", replay)
+	}
+}
+
+func TestServicePublishCarriesTraceContextToSubscribers(t *testing.T) {
+	svc := NewService(noopRealtimeLogger{}, 1)
+	stream, cleanup := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanup()
+
+	svc.Publish(t.Context(), domain.Event{
+		Type:        "record_projection_changed",
+		UserID:      14,
+		RecordID:    42,
+		TraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	})
+
+	select {
+	case got := <-stream:
+		if !strings.Contains(got.TraceParent, "4bf92f3577b34da6a3ce929d0e0e4736") {
+			t.Fatalf("expected subscriber event to continue the upstream trace, got %q", got.TraceParent)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for realtime event")
+	}
+}
+
+func TestServicePublishAssignsReplayIDs(t *testing.T) {
+	svc := NewService(noopRealtimeLogger{}, 4).WithReplayStore(memory.NewReplayStore(2))
+
+	// Published before anyone subscribes: retained for replay, not delivered.
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 1})
+
+	stream, cleanup := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanup()
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 2})
+
+	select {
+	case got := <-stream:
+		if got.ID != 2 {
+			t.Fatalf("expected the second event of user 14 to carry id 2, got %d", got.ID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for realtime event")
+	}
+
+	replay, err := svc.Replay(t.Context(), 14, 0, domain.Filter{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if replay.ResyncRequired || len(replay.Events) != 2 || replay.Events[0].RecordID != 1 || replay.LatestID != 2 {
+		t.Fatalf("unexpected replay: %#v", replay)
+	}
+}
+
+func TestServiceReplayRequiresResyncAfterEviction(t *testing.T) {
+	svc := NewService(noopRealtimeLogger{}, 1).WithReplayStore(memory.NewReplayStore(2))
+	for recordID := uint64(1); recordID <= 4; recordID++ {
+		svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: recordID})
+	}
+
+	replay, err := svc.Replay(t.Context(), 14, 1, domain.Filter{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !replay.ResyncRequired || replay.LatestID != 4 || len(replay.Events) != 0 {
+		t.Fatalf("expected a resync at id 4, got %#v", replay)
+	}
+
+	replay, err = svc.Replay(t.Context(), 14, 2, domain.Filter{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if replay.ResyncRequired || len(replay.Events) != 2 {
+		t.Fatalf("expected ids 3 and 4 to be replayed, got %#v", replay)
+	}
+}
+
+func TestServiceReplayWithoutStoreRequiresResync(t *testing.T) {
+	replay, err := NewService(noopRealtimeLogger{}, 1).Replay(t.Context(), 14, 3, domain.Filter{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !replay.ResyncRequired {
+		t.Fatal("expected a resync without a replay store")
+	}
+}
+
+type recordingFanout struct {
+	mu        sync.Mutex
+	published []domain.Event
+	joins     []uint64
+	leaves    []uint64
+}
+
+func (f *recordingFanout) Publish(_ context.Context, event domain.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.published = append(f.published, event)
+	return nil
+}
+
+func (f *recordingFanout) Join(_ context.Context, userID uint64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.joins = append(f.joins, userID)
+	return nil
+}
+
+func (f *recordingFanout) Leave(_ context.Context, userID uint64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.leaves = append(f.leaves, userID)
+	return nil
+}
+
+func (*recordingFanout) Receive(ctx context.Context) (domain.Event, error) {
+	<-ctx.Done()
+	return domain.Event{}, ctx.Err()
+}
+
+func (*recordingFanout) Close() error { return nil }
+
+func TestServiceFanoutJoinsFirstAndLeavesLastStreamOfUser(t *testing.T) {
+	fanout := &recordingFanout{}
+	svc := NewService(noopRealtimeLogger{}, 1).WithFanout(fanout)
+
+	_, cleanupFirst := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	_, cleanupSecond := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	cleanupFirst()
+	if len(fanout.joins) != 1 || len(fanout.leaves) != 0 {
+		t.Fatalf("expected one join and no leave while a stream is open, got joins=%v leaves=%v", fanout.joins, fanout.leaves)
+	}
+
+	cleanupSecond()
+	if len(fanout.leaves) != 1 || fanout.leaves[0] != 14 {
+		t.Fatalf("expected a leave after the last stream closed, got %v", fanout.leaves)
+	}
+}
+
+func TestServicePublishGoesThroughFanoutAndDeliverReachesSubscribers(t *testing.T) {
+	fanout := &recordingFanout{}
+	svc := NewService(noopRealtimeLogger{}, 1).WithFanout(fanout).WithReplayStore(memory.NewReplayStore(4))
+	stream, cleanup := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanup()
+
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 42})
+	select {
+	case got := <-stream:
+		t.Fatalf("expected Publish to leave local delivery to the broker, got %#v", got)
+	default:
+	}
+	if len(fanout.published) != 1 || fanout.published[0].ID != 1 {
+		t.Fatalf("expected the event to reach the broker with its id, got %#v", fanout.published)
+	}
+
+	svc.Deliver(t.Context(), fanout.published[0])
+	select {
+	case got := <-stream:
+		if got.ID != 1 || got.RecordID != 42 {
+			t.Fatalf("unexpected event: %#v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for realtime event")
+	}
+}
+
+func TestServiceExportsSubscriberGauges(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = provider.Shutdown(t.Context()) })
+
+	svc := NewService(noopRealtimeLogger{}, 1).WithMeterProvider(provider)
+	_, cleanupA := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanupA()
+	_, cleanupB := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanupB()
+	_, cleanupC := subscribe(t.Context(), t, svc, 99, domain.Filter{})
+	defer cleanupC()
+
+	var collected metricdata.ResourceMetrics
+	if err := reader.Collect(t.Context(), &collected); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+	values := map[string]int64{}
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if gauge, ok := m.Data.(metricdata.Gauge[int64]); ok && len(gauge.DataPoints) == 1 {
+				values[m.Name] = gauge.DataPoints[0].Value
+			}
+		}
+	}
+	if values[MetricSubscribers] != 3 || values[MetricSubscribedUsers] != 2 {
+		t.Fatalf("expected 3 streams across 2 users, got %v", values)
+	}
+}
+
+func TestServiceDropOldestKeepsNewestEvents(t *testing.T) {
+	svc := NewService(noopRealtimeLogger{}, 2).WithSlowConsumerPolicy(domain.SlowConsumerDropOldest)
+	stream, cleanup := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanup()
+
+	for recordID := uint64(1); recordID <= 3; recordID++ {
+		svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: recordID})
+	}
+
+	first, second := <-stream, <-stream
+	if first.RecordID != 2 || second.RecordID != 3 {
+		t.Fatalf("expected records 2 and 3 to survive, got %d and %d", first.RecordID, second.RecordID)
+	}
+}
+
+func TestServiceDisconnectPolicySendsResyncAndClosesStream(t *testing.T) {
+	svc := NewService(noopRealtimeLogger{}, 2).
+		WithReplayStore(memory.NewReplayStore(16)).
+		WithSlowConsumerPolicy(domain.SlowConsumerDisconnect)
+	stream, cleanup := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanup()
+	other, cleanupOther := subscribe(t.Context(), t, svc, 99, domain.Filter{})
+	defer cleanupOther()
+
+	for recordID := uint64(1); recordID <= 3; recordID++ {
+		svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: recordID})
+	}
+	svc.Publish(t.Context(), domain.Event{UserID: 99, RecordID: 7})
+
+	resync, ok := <-stream
+	if !ok || resync.Type != domain.EventTypeResyncRequired || resync.ID != 3 {
+		t.Fatalf("expected resync_required for event 3, got %#v (open=%v)", resync, ok)
+	}
+	if _, ok := <-stream; ok {
+		t.Fatal("expected the overrun stream to be closed")
+	}
+	if got := <-other; got.RecordID != 7 {
+		t.Fatalf("expected other users to keep their stream, got %#v", got)
+	}
+	if streams, _ := svc.subscriberCounts(); streams != 1 {
+		t.Fatalf("expected the overrun stream to be released, got %d open", streams)
+	}
+}
+
+func TestServiceSubscribeEnforcesStreamLimits(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = provider.Shutdown(t.Context()) })
+
+	svc := NewService(noopRealtimeLogger{}, 1).WithStreamLimits(2, 3).WithMeterProvider(provider)
+	_, cleanupA := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanupA()
+	_, cleanupB := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanupB()
+
+	if _, _, err := svc.Subscribe(t.Context(), 14, domain.Filter{}); !errors.Is(err, domain.ErrUserStreamLimit) {
+		t.Fatalf("expected the per-user cap, got %v", err)
+	}
+
+	_, cleanupC := subscribe(t.Context(), t, svc, 99, domain.Filter{})
+	if _, _, err := svc.Subscribe(t.Context(), 100, domain.Filter{}); !errors.Is(err, domain.ErrStreamLimit) {
+		t.Fatalf("expected the instance cap, got %v", err)
+	}
+
+	cleanupC()
+	_, cleanupD := subscribe(t.Context(), t, svc, 100, domain.Filter{})
+	defer cleanupD()
+
+	var collected metricdata.ResourceMetrics
+	if err := reader.Collect(t.Context(), &collected); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+	rejected := map[string]int64{}
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if !ok || m.Name != MetricStreamsRejected {
+				continue
+			}
+			for _, point := range sum.DataPoints {
+				value, _ := point.Attributes.Value(MetricAttrScope)
+				rejected[value.AsString()] = point.Value
+			}
+		}
+	}
+	if rejected[MetricScopeUser] != 1 || rejected[MetricScopeInstance] != 1 {
+		t.Fatalf("expected one rejection per scope, got %v", rejected)
+	}
+}
+
+func TestServiceCountsDroppedEventsByPolicy(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = provider.Shutdown(t.Context()) })
+
+	svc := NewService(noopRealtimeLogger{}, 1).WithMeterProvider(provider)
+	_, cleanup := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanup()
+
+	for recordID := uint64(1); recordID <= 3; recordID++ {
+		svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: recordID})
+	}
+
+	var collected metricdata.ResourceMetrics
+	if err := reader.Collect(t.Context(), &collected); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+	var dropped int64
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok && m.Name == MetricEventsDropped && len(sum.DataPoints) == 1 {
+				policy, _ := sum.DataPoints[0].Attributes.Value(MetricAttrPolicy)
+				if policy.AsString() != string(domain.SlowConsumerDropNewest) {
+					t.Fatalf("unexpected policy attribute %q", policy.AsString())
+				}
+				dropped = sum.DataPoints[0].Value
+			}
+		}
+	}
+	if dropped != 2 {
+		t.Fatalf("expected 2 dropped events, got %d", dropped)
+	}
+}
+
+func TestServiceShutdownEndsStreamsAfterBufferedEvents(t *testing.T) {
+	svc := NewService(noopRealtimeLogger{}, 2)
+	stream, cleanup := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	full, cleanupFull := subscribe(t.Context(), t, svc, 99, domain.Filter{})
+
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 1})
+	svc.Publish(t.Context(), domain.Event{UserID: 99, RecordID: 2})
+	svc.Publish(t.Context(), domain.Event{UserID: 99, RecordID: 3})
+	svc.Shutdown(t.Context())
+
+	var got []string
+	for event := range stream {
+		got = append(got, event.Type+":"+strconv.FormatUint(event.RecordID, 10))
+	}
+	if strings.Join(got, ",") != ":1,server_shutdown:0" {
+		t.Fatalf("expected the buffered event before server_shutdown, got %v", got)
+	}
+	got = got[:0]
+	for event := range full {
+		got = append(got, event.Type+":"+strconv.FormatUint(event.RecordID, 10))
+	}
+	if strings.Join(got, ",") != ":2,:3" {
+		t.Fatalf("expected a full buffer to keep every event and close without server_shutdown, got %v", got)
+	}
+
+	// Cleanup after shutdown must not close the channels again.
+	cleanup()
+	cleanupFull()
+	if _, _, err := svc.Subscribe(t.Context(), 14, domain.Filter{}); !errors.Is(err, domain.ErrShuttingDown) {
+		t.Fatalf("expected new streams to be refused, got %v", err)
+	}
+	if streams, users := svc.subscriberCounts(); streams != 0 || users != 0 {
+		t.Fatalf("expected no streams after shutdown, got %d across %d users", streams, users)
+	}
+}
+
+func TestServiceShutdownWithFullBufferSkipsNoEventOnReconnect(t *testing.T) {
+	svc := NewService(noopRealtimeLogger{}, 2).WithReplayStore(memory.NewReplayStore(8))
+	stream, cleanup := subscribe(t.Context(), t, svc, 14, domain.Filter{})
+	defer cleanup()
+
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 1})
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 2})
+	svc.Shutdown(t.Context())
+	// Published after the stream closed: only the replay store has it.
+	svc.Publish(t.Context(), domain.Event{UserID: 14, RecordID: 3})
+
+	var ids []uint64
+	var lastID uint64
+	for event := range stream {
+		if event.Type == domain.EventTypeServerShutdown {
+			continue
+		}
+		ids = append(ids, event.ID)
+		lastID = event.ID
+	}
+
+	replay, err := svc.Replay(t.Context(), 14, lastID, domain.Filter{})
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if replay.ResyncRequired {
+		t.Fatalf("expected replay from %d to be possible, got %#v", lastID, replay)
+	}
+	for _, event := range replay.Events {
+		ids = append(ids, event.ID)
+	}
+
+	if len(ids) != 3 {
+		t.Fatalf("expected 3 events across shutdown and reconnect, got ids %v", ids)
+	}
+	for i, id := range ids {
+		if id != uint64(i+1) {
+			t.Fatalf("expected contiguous ids 1..3 across shutdown and reconnect, got %v", ids)
+		}
+	}
+}
+
 type noopRealtimeLogger struct{}
 
 func (noopRealtimeLogger) Infof(string, ...any)                      {}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Shutdown ends every open stream with a server_shutdown event and closes its channel, then refuses
+// new streams with domain.ErrShuttingDown. Events already buffered stay ahead of the shutdown event.
+// A stream whose buffer is full is closed without it rather than losing a buffered event, so the last
+// id the client receives is still the one to resume from.
+// It returns once every stream was signalled; waiting for the readers to finish is up to the caller.
+func (s *Service) Shutdown(ctx context.Context) {
+	ctx, span := otel.Tracer(TracerName).Start(ctx, SpanShutdown)
+	defer span.End()
+
+	s.mu.Lock()
+	s.closing = true
+	streams, users := s.streams, len(s.subscribers)
+	for userID, subscribers := range s.subscribers {
+		for _, sub := range subscribers {
+			// Sends only happen under the read lock, so with room left this send cannot block.
+			if len(sub.ch) < cap(sub.ch) {
+				sub.ch <- domain.Event{Type: domain.EventTypeServerShutdown, UserID: userID}
+			}
+			close(sub.ch)
+		}
+	}
+	s.subscribers = make(map[uint64]map[uint64]subscriber)
+	s.streams = 0
+	s.mu.Unlock()
+
+	span.SetAttributes(attribute.Int("stream_count", streams), attribute.Int("user_count", users))
+	s.logger.InfowCtx(ctx, logRealtimeShutdown, "stream_count", streams, "user_count", users)
+}
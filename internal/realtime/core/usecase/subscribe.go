@@ -2,39 +2,70 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 )
 
-// Subscribe registers a per-user subscriber stream and returns a cleanup function.
-func (s *Service) Subscribe(ctx context.Context, userID uint64) (<-chan domain.Event, func()) {
+// Subscribe registers a per-user subscriber stream that only receives events passing filter and
+// returns a cleanup function. The filter is expected to be validated by the caller.
+// It fails with domain.ErrUserStreamLimit or domain.ErrStreamLimit when a stream cap is reached,
+// and with domain.ErrShuttingDown after Shutdown. The channel is closed by cleanup, or by the
+// service after a domain.EventTypeResyncRequired event under the disconnect slow-consumer policy
+// or a domain.EventTypeServerShutdown event on shutdown.
+// With a fan-out broker, the first stream of a user on this instance joins the user's channel
+// and the last one to close leaves it.
+func (s *Service) Subscribe(ctx context.Context, userID uint64, filter domain.Filter) (<-chan domain.Event, func(), error) {
 	subscriberID := s.nextSubscriberID()
 	ch := make(chan domain.Event, s.subscriberBuffer)
 
 	s.mu.Lock()
+	if s.closing {
+		s.mu.Unlock()
+		s.logger.InfowCtx(ctx, logRealtimeShuttingDown, "user_id", userID)
+		return nil, nil, domain.ErrShuttingDown
+	}
+	if err := s.checkStreamLimits(userID); err != nil {
+		s.mu.Unlock()
+		scope := MetricScopeInstance
+		if errors.Is(err, domain.ErrUserStreamLimit) {
+			scope = MetricScopeUser
+		}
+		s.metrics.recordRejected(ctx, scope)
+		s.logger.WarnwCtx(ctx, logRealtimeStreamLimit, "user_id", userID, MetricAttrScope, scope)
+		return nil, nil, err
+	}
 	if _, ok := s.subscribers[userID]; !ok {
-		s.subscribers[userID] = make(map[uint64]chan domain.Event)
+		s.subscribers[userID] = make(map[uint64]subscriber)
 	}
-	s.subscribers[userID][subscriberID] = ch
+	s.subscribers[userID][subscriberID] = subscriber{ch: ch, filter: filter}
+	s.streams++
 	s.mu.Unlock()
 
+	// Joining after the channel is registered means nothing the broker sends is missed locally.
+	joined := s.join(ctx, userID)
+
 	var once sync.Once
 	cleanup := func() {
 		once.Do(func() {
 			s.mu.Lock()
-			defer s.mu.Unlock()
-
 			subscribers := s.subscribers[userID]
-			if subscribers == nil {
-				return
-			}
-			if existing, ok := subscribers[subscriberID]; ok {
-				delete(subscribers, subscriberID)
-				close(existing)
+			if subscribers != nil {
+				if existing, ok := subscribers[subscriberID]; ok {
+					delete(subscribers, subscriberID)
+					s.streams--
+					close(existing.ch)
+				}
+				if len(subscribers) == 0 {
+					delete(s.subscribers, userID)
+				}
 			}
-			if len(subscribers) == 0 {
-				delete(s.subscribers, userID)
+			s.mu.Unlock()
+
+			if joined {
+				s.leave(userID)
 			}
 		})
 	}
@@ -44,5 +75,52 @@ func (s *Service) Subscribe(ctx context.Context, userID uint64) (<-chan domain.E
 		cleanup()
 	}()
 
-	return ch, cleanup
+	return ch, cleanup, nil
+}
+
+// checkStreamLimits reports whether one more stream of userID would exceed a cap. It runs under s.mu.
+func (s *Service) checkStreamLimits(userID uint64) error {
+	if s.maxUserStreams > 0 && len(s.subscribers[userID]) >= s.maxUserStreams {
+		return domain.ErrUserStreamLimit
+	}
+	if s.maxStreams > 0 && s.streams >= s.maxStreams {
+		return domain.ErrStreamLimit
+	}
+	return nil
+}
+
+// join counts one more stream of userID and joins the broker for the first one. A failed join
+// is not counted, so the next stream of the user tries again.
+func (s *Service) join(ctx context.Context, userID uint64) bool {
+	if s.fanout == nil {
+		return false
+	}
+
+	s.membership.Lock()
+	defer s.membership.Unlock()
+
+	if s.joined[userID] == 0 {
+		if err := s.fanout.Join(ctx, userID); err != nil {
+			s.logger.WarnwCtx(ctx, logRealtimeFanoutJoinFailed, "user_id", userID, commonkeys.Error, err.Error())
+			return false
+		}
+	}
+	s.joined[userID]++
+	return true
+}
+
+// leave releases one stream of userID and leaves the broker after the last one. It runs after the
+// stream context ended, so the broker call gets a fresh context.
+func (s *Service) leave(userID uint64) {
+	s.membership.Lock()
+	defer s.membership.Unlock()
+
+	s.joined[userID]--
+	if s.joined[userID] > 0 {
+		return
+	}
+	delete(s.joined, userID)
+	if err := s.fanout.Leave(context.Background(), userID); err != nil {
+		s.logger.Warnw(logRealtimeFanoutLeaveFailed, "user_id", userID, commonkeys.Error, err.Error())
+	}
 }
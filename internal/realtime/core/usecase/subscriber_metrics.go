@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// serviceMetrics holds the realtime instruments. The stream and user gauges are read from the
+// subscriber map on each collection, so an idle instance costs nothing per event; drops and
+// refused streams are counted as they happen.
+type serviceMetrics struct {
+	registration metric.Registration
+	dropped      metric.Int64Counter
+	rejected     metric.Int64Counter
+}
+
+func newServiceMetrics(provider metric.MeterProvider, s *Service) (*serviceMetrics, error) {
+	meter := provider.Meter(MeterName)
+
+	streams, err := meter.Int64ObservableGauge(MetricSubscribers,
+		metric.WithDescription("Realtime streams open on this instance."),
+		metric.WithUnit("{stream}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := meter.Int64ObservableGauge(MetricSubscribedUsers,
+		metric.WithDescription("Distinct users with at least one realtime stream open on this instance."),
+		metric.WithUnit("{user}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	dropped, err := meter.Int64Counter(MetricEventsDropped,
+		metric.WithDescription("Realtime events a stream lost because its buffer was full."),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rejected, err := meter.Int64Counter(MetricStreamsRejected,
+		metric.WithDescription("Realtime streams refused by the per-user or per-instance cap."),
+		metric.WithUnit("{stream}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	registration, err := meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		streamCount, userCount := s.subscriberCounts()
+		observer.ObserveInt64(streams, int64(streamCount))
+		observer.ObserveInt64(users, int64(userCount))
+		return nil
+	}, streams, users)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceMetrics{registration: registration, dropped: dropped, rejected: rejected}, nil
+}
+
+// recordDropped and recordRejected tolerate a nil receiver so a service without metrics still delivers.
+func (m *serviceMetrics) recordDropped(ctx context.Context, policy domain.SlowConsumerPolicy, count int) {
+	if m == nil || count == 0 {
+		return
+	}
+	m.dropped.Add(ctx, int64(count), metric.WithAttributes(attribute.String(MetricAttrPolicy, string(policy))))
+}
+
+func (m *serviceMetrics) recordRejected(ctx context.Context, scope string) {
+	if m == nil {
+		return
+	}
+	m.rejected.Add(ctx, 1, metric.WithAttributes(attribute.String(MetricAttrScope, scope)))
+}
+
+func (s *Service) subscriberCounts() (streams int, users int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.streams, len(s.subscribers)
+}
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	auditdomain "github.com/lechitz/aion-api/internal/audit/core/domain"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// Issue creates a ticket for userID that opens one stream within the configured ttl.
+func (s *TicketService) Issue(ctx context.Context, userID uint64) (domain.StreamTicket, error) {
+	ctx, span := otel.Tracer(TracerName).Start(ctx, SpanTicketIssue)
+	defer span.End()
+	span.SetAttributes(attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)))
+
+	if userID == 0 {
+		err := errors.New(errTicketUserRequired)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.StreamTicket{}, err
+	}
+
+	token, err := newTicketToken()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.StreamTicket{}, err
+	}
+
+	issuedAt := s.now().UTC()
+	ticket := domain.StreamTicket{
+		ID:           uuid.NewString(),
+		Token:        token,
+		UserID:       userID,
+		IssuedAtUTC:  issuedAt,
+		ExpiresAtUTC: issuedAt.Add(s.ttl),
+	}
+	if err := s.store.Save(ctx, ticket, s.ttl); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.ErrorwCtx(ctx, logRealtimeTicketSaveFailed, commonkeys.UserID, userID, commonkeys.Error, err.Error())
+		return domain.StreamTicket{}, err
+	}
+
+	span.SetAttributes(attribute.String(attrTicketID, ticket.ID))
+	span.SetStatus(codes.Ok, statusTicketIssued)
+	s.logger.InfowCtx(ctx, logRealtimeTicketIssued, commonkeys.UserID, userID, attrTicketID, ticket.ID)
+	s.writeAudit(ctx, ticket, auditActionTicketIssued, auditOperationIssue)
+	return ticket, nil
+}
+
+// Lookup returns the ticket for token without consuming it, so the request it came with can be
+// checked before the ticket is spent. Rejections are left to the caller to log.
+func (s *TicketService) Lookup(ctx context.Context, token string) (domain.StreamTicket, error) {
+	ctx, span := otel.Tracer(TracerName).Start(ctx, SpanTicketLookup)
+	defer span.End()
+
+	if token == "" {
+		span.SetStatus(codes.Error, domain.ErrTicketInvalid.Error())
+		return domain.StreamTicket{}, domain.ErrTicketInvalid
+	}
+
+	ticket, err := s.store.Lookup(ctx, token)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return domain.StreamTicket{}, err
+	}
+	if !s.now().Before(ticket.ExpiresAtUTC) {
+		span.SetStatus(codes.Error, domain.ErrTicketInvalid.Error())
+		return domain.StreamTicket{}, domain.ErrTicketInvalid
+	}
+
+	span.SetAttributes(
+		attribute.String(commonkeys.UserID, strconv.FormatUint(ticket.UserID, 10)),
+		attribute.String(attrTicketID, ticket.ID),
+	)
+	span.SetStatus(codes.Ok, "")
+	return ticket, nil
+}
+
+// Redeem consumes the ticket for token. A token can be redeemed once; replaying it, even
+// concurrently, returns domain.ErrTicketInvalid.
+func (s *TicketService) Redeem(ctx context.Context, token string) (domain.StreamTicket, error) {
+	ctx, span := otel.Tracer(TracerName).Start(ctx, SpanTicketRedeem)
+	defer span.End()
+
+	if token == "" {
+		span.SetStatus(codes.Error, domain.ErrTicketInvalid.Error())
+		return domain.StreamTicket{}, domain.ErrTicketInvalid
+	}
+
+	ticket, err := s.store.Redeem(ctx, token)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		// The token is a credential; only a fingerprint of it reaches the logs.
+		s.logger.WarnwCtx(ctx, logRealtimeTicketRejected, attrTicketFingerprint, ticketFingerprint(token), commonkeys.Error, err.Error())
+		return domain.StreamTicket{}, err
+	}
+	if !s.now().Before(ticket.ExpiresAtUTC) {
+		span.SetStatus(codes.Error, domain.ErrTicketInvalid.Error())
+		s.logger.WarnwCtx(ctx, logRealtimeTicketExpired, commonkeys.UserID, ticket.UserID, attrTicketID, ticket.ID)
+		return domain.StreamTicket{}, domain.ErrTicketInvalid
+	}
+
+	span.SetAttributes(
+		attribute.String(commonkeys.UserID, strconv.FormatUint(ticket.UserID, 10)),
+		attribute.String(attrTicketID, ticket.ID),
+	)
+	span.SetStatus(codes.Ok, statusTicketRedeemed)
+	s.logger.InfowCtx(ctx, logRealtimeTicketRedeemed, commonkeys.UserID, ticket.UserID, attrTicketID, ticket.ID)
+	s.writeAudit(ctx, ticket, auditActionTicketRedeemed, auditOperationRedeem)
+	return ticket, nil
+}
+
+// writeAudit records one ticket operation. The audit schema requires a draft id; the ticket id
+// fills it so issue and redemption of the same ticket can be correlated.
+func (s *TicketService) writeAudit(ctx context.Context, ticket domain.StreamTicket, action, operation string) {
+	if s.audit == nil {
+		return
+	}
+
+	traceID, _ := ctx.Value(ctxkeys.TraceID).(string)
+	requestID, _ := ctx.Value(ctxkeys.RequestID).(string)
+	event := auditdomain.AuditActionEvent{
+		TimestampUTC: s.now().UTC(),
+		UserID:       ticket.UserID,
+		TraceID:      traceID,
+		RequestID:    requestID,
+		UIActionType: auditUIActionStreamTicket,
+		DraftID:      ticket.ID,
+		Action:       action,
+		Entity:       auditEntityStreamTicket,
+		Operation:    operation,
+		Status:       auditStatusSuccess,
+		EntityID:     ticket.ID,
+		PayloadRedacted: map[string]interface{}{
+			"ticket_id":      ticket.ID,
+			"expires_at_utc": ticket.ExpiresAtUTC,
+		},
+	}
+	if err := s.audit.WriteEvent(ctx, event); err != nil {
+		s.logger.ErrorwCtx(ctx, logRealtimeTicketAuditFailed, commonkeys.UserID, ticket.UserID, attrTicketID, ticket.ID, commonkeys.Error, err.Error())
+	}
+}
+
+func newTicketToken() (string, error) {
+	raw := make([]byte, ticketTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generate realtime ticket: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func ticketFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:4])
+}
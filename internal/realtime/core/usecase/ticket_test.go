@@ -0,0 +1,182 @@
+//nolint:testpackage // Tests pin the ticket clock through the unexported now field.
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	auditdomain "github.com/lechitz/aion-api/internal/audit/core/domain"
+	"github.com/lechitz/aion-api/internal/realtime/core/domain"
+)
+
+type ticketStoreStub struct {
+	mu      sync.Mutex
+	tickets map[string]domain.StreamTicket
+	ttls    []time.Duration
+}
+
+func newTicketStoreStub() *ticketStoreStub {
+	return &ticketStoreStub{tickets: make(map[string]domain.StreamTicket)}
+}
+
+func (s *ticketStoreStub) Save(_ context.Context, ticket domain.StreamTicket, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tickets[ticket.Token] = ticket
+	s.ttls = append(s.ttls, ttl)
+	return nil
+}
+
+func (s *ticketStoreStub) Lookup(_ context.Context, token string) (domain.StreamTicket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ticket, ok := s.tickets[token]
+	if !ok {
+		return domain.StreamTicket{}, domain.ErrTicketInvalid
+	}
+	return ticket, nil
+}
+
+func (s *ticketStoreStub) Redeem(_ context.Context, token string) (domain.StreamTicket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ticket, ok := s.tickets[token]
+	if !ok {
+		return domain.StreamTicket{}, domain.ErrTicketInvalid
+	}
+	delete(s.tickets, token)
+	return ticket, nil
+}
+
+type auditServiceStub struct {
+	events []auditdomain.AuditActionEvent
+	err    error
+}
+
+func (s *auditServiceStub) WriteEvent(_ context.Context, event auditdomain.AuditActionEvent) error {
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func (s *auditServiceStub) ListEvents(context.Context, auditdomain.AuditActionEventFilter) ([]auditdomain.AuditActionEvent, error) {
+	return nil, nil
+}
+
+func TestTicketServiceRedeemsTicketOnce(t *testing.T) {
+	store := newTicketStoreStub()
+	audit := &auditServiceStub{}
+	svc := NewTicketService(store, 20*time.Second, noopRealtimeLogger{}).WithAudit(audit)
+
+	ticket, err := svc.Issue(t.Context(), 14)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	if ticket.Token == "" || ticket.ID == "" || ticket.UserID != 14 {
+		t.Fatalf("unexpected ticket %#v", ticket)
+	}
+	if got := ticket.ExpiresAtUTC.Sub(ticket.IssuedAtUTC); got != 20*time.Second || store.ttls[0] != 20*time.Second {
+		t.Fatalf("expected a 20s ticket, got lifetime %v and store ttl %v", got, store.ttls[0])
+	}
+
+	redeemed, err := svc.Redeem(t.Context(), ticket.Token)
+	if err != nil {
+		t.Fatalf("redeem: %v", err)
+	}
+	if redeemed.UserID != 14 || redeemed.ID != ticket.ID {
+		t.Fatalf("expected the issued ticket back, got %#v", redeemed)
+	}
+
+	if _, err := svc.Redeem(t.Context(), ticket.Token); !errors.Is(err, domain.ErrTicketInvalid) {
+		t.Fatalf("expected a replayed ticket to be rejected, got %v", err)
+	}
+
+	if len(audit.events) != 2 {
+		t.Fatalf("expected issue and redeem audit events, got %#v", audit.events)
+	}
+	for i, action := range []string{auditActionTicketIssued, auditActionTicketRedeemed} {
+		event := audit.events[i]
+		if event.Action != action || event.UserID != 14 || event.EntityID != ticket.ID || event.DraftID != ticket.ID {
+			t.Fatalf("unexpected audit event %d: %#v", i, event)
+		}
+	}
+}
+
+func TestTicketServiceRejectsExpiredTicket(t *testing.T) {
+	store := newTicketStoreStub()
+	svc := NewTicketService(store, time.Second, noopRealtimeLogger{})
+	now := time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	ticket, err := svc.Issue(t.Context(), 14)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+
+	now = now.Add(time.Second)
+	if _, err := svc.Redeem(t.Context(), ticket.Token); !errors.Is(err, domain.ErrTicketInvalid) {
+		t.Fatalf("expected an expired ticket to be rejected, got %v", err)
+	}
+}
+
+func TestTicketServiceLookupLeavesTicketRedeemable(t *testing.T) {
+	store := newTicketStoreStub()
+	audit := &auditServiceStub{}
+	svc := NewTicketService(store, time.Second, noopRealtimeLogger{}).WithAudit(audit)
+	now := time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	ticket, err := svc.Issue(t.Context(), 14)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+
+	for range 2 {
+		looked, err := svc.Lookup(t.Context(), ticket.Token)
+		if err != nil {
+			t.Fatalf("lookup: %v", err)
+		}
+		if looked.UserID != 14 || looked.ID != ticket.ID {
+			t.Fatalf("expected the issued ticket back, got %#v", looked)
+		}
+	}
+	if len(audit.events) != 1 {
+		t.Fatalf("expected lookups to leave the audit log alone, got %#v", audit.events)
+	}
+	if _, err := svc.Redeem(t.Context(), ticket.Token); err != nil {
+		t.Fatalf("expected the looked-up ticket to stay redeemable, got %v", err)
+	}
+	if _, err := svc.Lookup(t.Context(), ticket.Token); !errors.Is(err, domain.ErrTicketInvalid) {
+		t.Fatalf("expected a redeemed ticket to fail lookup, got %v", err)
+	}
+
+	expiring, err := svc.Issue(t.Context(), 14)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	now = now.Add(time.Second)
+	if _, err := svc.Lookup(t.Context(), expiring.Token); !errors.Is(err, domain.ErrTicketInvalid) {
+		t.Fatalf("expected an expired ticket to fail lookup, got %v", err)
+	}
+}
+
+func TestTicketServiceIgnoresAuditFailures(t *testing.T) {
+	svc := NewTicketService(newTicketStoreStub(), 0, noopRealtimeLogger{}).WithAudit(&auditServiceStub{err: errors.New("audit down")})
+
+	ticket, err := svc.Issue(t.Context(), 14)
+	if err != nil {
+		t.Fatalf("expected issue to succeed despite the audit failure, got %v", err)
+	}
+	if got := ticket.ExpiresAtUTC.Sub(ticket.IssuedAtUTC); got != defaultTicketTTL {
+		t.Fatalf("expected the default ttl, got %v", got)
+	}
+	if _, err := svc.Redeem(t.Context(), ticket.Token); err != nil {
+		t.Fatalf("expected redeem to succeed despite the audit failure, got %v", err)
+	}
+
+	if _, err := svc.Issue(t.Context(), 0); err == nil {
+		t.Fatal("expected a ticket without user to be rejected")
+	}
+}
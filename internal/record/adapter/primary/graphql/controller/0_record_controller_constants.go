@@ -20,6 +20,9 @@ const (
 	// SpanCreate is the span name for creating a record.
 	SpanCreate = "record.controller.create"
 
+	// SpanCreateMany is the span name for creating a batch of records.
+	SpanCreateMany = "record.controller.create_many"
+
 	// SpanGetByID is the span name for retrieving a record by ID.
 	SpanGetByID = "record.controller.get_by_id"
 
@@ -77,6 +80,18 @@ const (
 	// SpanSoftDeleteAll is the span name for soft-deleting all records.
 	SpanSoftDeleteAll = "record.controller.soft_delete_all"
 
+	// SpanListDeleted is the span name for listing soft-deleted records.
+	SpanListDeleted = "record.controller.list_deleted"
+
+	// SpanRestore is the span name for restoring a soft-deleted record.
+	SpanRestore = "record.controller.restore"
+
+	// SpanRestoreMany is the span name for restoring several soft-deleted records.
+	SpanRestoreMany = "record.controller.restore_many"
+
+	// SpanHistory is the span name for listing a record's revisions.
+	SpanHistory = "record.controller.history"
+
 	// SpanInsightFeed is the span name for insight feed queries.
 	SpanInsightFeed = "record.controller.insight_feed"
 
@@ -110,6 +125,9 @@ const (
 	// StatusDeletedAll is the human-readable status for soft deletion of all records.
 	StatusDeletedAll = "deleted_all"
 
+	// StatusRestored is the human-readable status for restoring soft-deleted records.
+	StatusRestored = "restored"
+
 	// StatusUpdated is the human-readable status for record update.
 	StatusUpdated = "updated"
 )
@@ -126,6 +144,9 @@ const (
 	// MsgCreateError is the log message for when a create operation fails.
 	MsgCreateError = "error creating record"
 
+	// MsgCreateManyError is the log message for when a batch create fails as a whole.
+	MsgCreateManyError = "error creating record batch"
+
 	// MsgSearchError is the log message for when a search operation fails.
 	MsgSearchError = "error searching records"
 
@@ -180,6 +201,15 @@ const (
 	// MsgSoftDeleteAllError is the log message for soft delete all operation failure.
 	MsgSoftDeleteAllError = "error soft deleting all records"
 
+	// MsgListDeletedError is the log message for listing soft-deleted records failures.
+	MsgListDeletedError = "error listing deleted records"
+
+	// MsgRestoreError is the log message for restore operation failure.
+	MsgRestoreError = "error restoring records"
+
+	// MsgHistoryError is the log message for record history failures.
+	MsgHistoryError = "error listing record history"
+
 	// MsgUpdateError is the log message for update operation failure.
 	MsgUpdateError = "error updating record"
 
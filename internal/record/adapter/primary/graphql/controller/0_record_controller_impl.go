@@ -11,6 +11,7 @@ import (
 // RecordController is the contract used by GraphQL resolvers.
 type RecordController interface {
 	Create(ctx context.Context, in model.CreateRecordInput, userID uint64) (*model.Record, error)
+	CreateMany(ctx context.Context, in []*model.CreateRecordInput, mode model.CreateRecordsMode, userID uint64) (*model.CreateRecordsPayload, error)
 	GetByID(ctx context.Context, recordID, userID uint64) (*model.Record, error)
 	GetProjectedByID(ctx context.Context, recordID, userID uint64) (*model.RecordProjection, error)
 	ListByUser(ctx context.Context, userID uint64, limit int, afterEventTime *string, afterID *int64) ([]*model.Record, error)
@@ -47,6 +48,10 @@ type RecordController interface {
 	Update(ctx context.Context, in model.UpdateRecordInput, userID uint64) (*model.Record, error)
 	SoftDelete(ctx context.Context, recordID, userID uint64) error
 	SoftDeleteAll(ctx context.Context, userID uint64) error
+	ListDeleted(ctx context.Context, userID uint64, limit int, afterDeletedAt *string, afterID *int64) ([]*model.Record, error)
+	Restore(ctx context.Context, recordID, userID uint64) (*model.Record, error)
+	RestoreMany(ctx context.Context, recordIDs []uint64, userID uint64) ([]*model.Record, error)
+	History(ctx context.Context, recordID, userID uint64, limit int) ([]*model.RecordRevision, error)
 	SearchRecords(ctx context.Context, filters model.SearchFilters, userID uint64) ([]*model.Record, error)
 	RecordStats(ctx context.Context, filters *model.RecordStatsFilters, userID uint64) (*model.RecordStats, error)
 	UpsertMetricDefinition(ctx context.Context, userID uint64, in model.UpsertMetricDefinitionInput) (*model.MetricDefinition, error)
@@ -44,6 +44,11 @@ func toModelOut(t domain.Record) *gmodel.Record {
 	if t.Status != nil {
 		out.Status = t.Status
 	}
+	if t.DeletedAt != nil {
+		// Full precision, so deletedAt can be passed back as the deletedRecords cursor.
+		d := t.DeletedAt.UTC().Format(time.RFC3339Nano)
+		out.DeletedAt = &d
+	}
 	return out
 }
 
@@ -56,6 +61,33 @@ func toModelOutSlice(records []domain.Record) []*gmodel.Record {
 	return result
 }
 
+// toRevisionModelOutSlice converts record revisions to GraphQL models.
+func toRevisionModelOutSlice(revisions []domain.RecordRevision) []*gmodel.RecordRevision {
+	result := make([]*gmodel.RecordRevision, len(revisions))
+	for i, rev := range revisions {
+		changes := make([]*gmodel.RecordFieldChange, len(rev.Changes))
+		for j, change := range rev.Changes {
+			changes[j] = &gmodel.RecordFieldChange{Field: change.Field, OldValue: change.OldValue, NewValue: change.NewValue}
+		}
+		out := &gmodel.RecordRevision{
+			ID:           strconv.FormatUint(rev.ID, 10),
+			RecordID:     strconv.FormatUint(rev.RecordID, 10),
+			Operation:    rev.Operation,
+			Changes:      changes,
+			ActorUserID:  strconv.FormatUint(rev.ActorUserID, 10),
+			ActorService: rev.ActorService,
+			Source:       rev.Source,
+			CreatedAt:    rev.CreatedAt.UTC().Format(time.RFC3339Nano),
+		}
+		if rev.RequestID != "" {
+			requestID := rev.RequestID
+			out.RequestID = &requestID
+		}
+		result[i] = out
+	}
+	return result
+}
+
 func toProjectedModelOut(t domain.RecordProjection) *gmodel.RecordProjection {
 	out := &gmodel.RecordProjection{
 		RecordID:           strconv.FormatUint(t.RecordID, 10),
@@ -113,6 +145,24 @@ func toProjectedModelOutSlice(items []domain.RecordProjection) []*gmodel.RecordP
 	return result
 }
 
+// toCreateRecordsPayload converts batch create results to the GraphQL payload.
+func toCreateRecordsPayload(results []input.CreateRecordResult) *gmodel.CreateRecordsPayload {
+	out := &gmodel.CreateRecordsPayload{Results: make([]*gmodel.CreateRecordResult, len(results))}
+	for i, result := range results {
+		item := &gmodel.CreateRecordResult{Index: safeRecordIntToInt32(result.Index)}
+		if result.Err != nil {
+			msg := result.Err.Error()
+			item.Error = &msg
+			out.FailedCount++
+		} else {
+			item.Record = toModelOut(result.Record)
+			out.CreatedCount++
+		}
+		out.Results[i] = item
+	}
+	return out
+}
+
 // toCreateCommand converts a GraphQL CreateRecordInput into an input.CreateRecordCommand.
 func toCreateCommand(in gmodel.CreateRecordInput, userID uint64) input.CreateRecordCommand {
 	uid := userID
@@ -148,16 +198,17 @@ func toCreateCommand(in gmodel.CreateRecordInput, userID uint64) input.CreateRec
 	}
 
 	return input.CreateRecordCommand{
-		UserID:       uid,
-		Description:  in.Description,
-		TagID:        tagID,
-		EventTime:    eventTime,
-		RecordedAt:   recordedAt,
-		DurationSecs: duration,
-		Value:        in.Value,
-		Source:       in.Source,
-		Timezone:     in.Timezone,
-		Status:       in.Status,
+		UserID:         uid,
+		Description:    in.Description,
+		TagID:          tagID,
+		EventTime:      eventTime,
+		RecordedAt:     recordedAt,
+		DurationSecs:   duration,
+		Value:          in.Value,
+		Source:         in.Source,
+		Timezone:       in.Timezone,
+		Status:         in.Status,
+		IdempotencyKey: in.IdempotencyKey,
 	}
 }
 
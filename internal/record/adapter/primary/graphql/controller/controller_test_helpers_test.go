@@ -15,6 +15,7 @@ import (
 
 type recordServiceStub struct {
 	createFn                func(context.Context, input.CreateRecordCommand) (domain.Record, error)
+	createManyFn            func(context.Context, input.CreateRecordsCommand) ([]input.CreateRecordResult, error)
 	getByIDFn               func(context.Context, uint64, uint64) (domain.Record, error)
 	getProjectedByIDFn      func(context.Context, uint64, uint64) (domain.RecordProjection, error)
 	listByUserFn            func(context.Context, uint64, int, *string, *int64) ([]domain.Record, error)
@@ -29,6 +30,10 @@ type recordServiceStub struct {
 	updateFn                func(context.Context, uint64, uint64, input.UpdateRecordCommand) (domain.Record, error)
 	deleteFn                func(context.Context, uint64, uint64) error
 	deleteAllFn             func(context.Context, uint64) error
+	listDeletedFn           func(context.Context, uint64, int, *string, *int64) ([]domain.Record, error)
+	restoreFn               func(context.Context, uint64, uint64) (domain.Record, error)
+	restoreManyFn           func(context.Context, []uint64, uint64) ([]domain.Record, error)
+	historyFn               func(context.Context, uint64, uint64, int) ([]domain.RecordRevision, error)
 	searchFn                func(context.Context, uint64, domain.SearchFilters) ([]domain.Record, error)
 	dashboardFn             func(context.Context, uint64, input.DashboardSnapshotQuery) (domain.DashboardSnapshot, error)
 	insightFeedFn           func(context.Context, uint64, input.InsightFeedQuery) ([]domain.InsightCard, error)
@@ -63,6 +68,13 @@ func (s *recordServiceStub) Create(ctx context.Context, cmd input.CreateRecordCo
 	return s.createFn(ctx, cmd)
 }
 
+func (s *recordServiceStub) CreateMany(ctx context.Context, cmd input.CreateRecordsCommand) ([]input.CreateRecordResult, error) {
+	if s.createManyFn == nil {
+		panic("unexpected CreateMany call")
+	}
+	return s.createManyFn(ctx, cmd)
+}
+
 func (s *recordServiceStub) GetByID(ctx context.Context, recordID uint64, userID uint64) (domain.Record, error) {
 	if s.getByIDFn == nil {
 		panic("unexpected GetByID call")
@@ -161,6 +173,34 @@ func (s *recordServiceStub) DeleteAll(ctx context.Context, userID uint64) error
 	return s.deleteAllFn(ctx, userID)
 }
 
+func (s *recordServiceStub) ListDeleted(ctx context.Context, userID uint64, limit int, afterDeletedAt *string, afterID *int64) ([]domain.Record, error) {
+	if s.listDeletedFn == nil {
+		panic("unexpected ListDeleted call")
+	}
+	return s.listDeletedFn(ctx, userID, limit, afterDeletedAt, afterID)
+}
+
+func (s *recordServiceStub) Restore(ctx context.Context, recordID uint64, userID uint64) (domain.Record, error) {
+	if s.restoreFn == nil {
+		panic("unexpected Restore call")
+	}
+	return s.restoreFn(ctx, recordID, userID)
+}
+
+func (s *recordServiceStub) RestoreMany(ctx context.Context, recordIDs []uint64, userID uint64) ([]domain.Record, error) {
+	if s.restoreManyFn == nil {
+		panic("unexpected RestoreMany call")
+	}
+	return s.restoreManyFn(ctx, recordIDs, userID)
+}
+
+func (s *recordServiceStub) History(ctx context.Context, recordID uint64, userID uint64, limit int) ([]domain.RecordRevision, error) {
+	if s.historyFn == nil {
+		panic("unexpected History call")
+	}
+	return s.historyFn(ctx, recordID, userID, limit)
+}
+
 func (s *recordServiceStub) SearchRecords(ctx context.Context, userID uint64, filters domain.SearchFilters) ([]domain.Record, error) {
 	if s.searchFn == nil {
 		panic("unexpected SearchRecords call")
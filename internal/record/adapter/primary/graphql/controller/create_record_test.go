@@ -64,6 +64,7 @@ func TestCreate_Success(t *testing.T) {
 	source := "mobile"
 	tz := "UTC"
 	status := "done"
+	key := "offline-42"
 
 	svc := &recordServiceStub{
 		createFn: func(_ context.Context, cmd input.CreateRecordCommand) (domain.Record, error) {
@@ -84,6 +85,8 @@ func TestCreate_Success(t *testing.T) {
 			require.Equal(t, tz, *cmd.Timezone)
 			require.NotNil(t, cmd.Status)
 			require.Equal(t, status, *cmd.Status)
+			require.NotNil(t, cmd.IdempotencyKey)
+			require.Equal(t, key, *cmd.IdempotencyKey)
 
 			return domain.Record{
 				ID:           99,
@@ -117,6 +120,7 @@ func TestCreate_Success(t *testing.T) {
 		Source:          &source,
 		Timezone:        &tz,
 		Status:          &status,
+		IdempotencyKey:  &key,
 	}
 
 	out, err := h.Create(t.Context(), in, 10)
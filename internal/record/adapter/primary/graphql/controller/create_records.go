@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	gmodel "github.com/lechitz/aion-api/internal/adapter/primary/graphql/model"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// CreateMany creates a batch of records in one transaction and reports each item's outcome.
+func (h *controller) CreateMany(ctx context.Context, in []*gmodel.CreateRecordInput, mode gmodel.CreateRecordsMode, userID uint64) (*gmodel.CreateRecordsPayload, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanCreateMany)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanCreateMany),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+		attribute.Int(AttrRecordsCount, len(in)),
+	)
+
+	if userID == 0 {
+		span.SetStatus(codes.Error, ErrUserIDNotFound.Error())
+		h.Logger.ErrorwCtx(ctx, ErrUserIDNotFound.Error(), commonkeys.UserID, userID)
+		return nil, ErrUserIDNotFound
+	}
+
+	items := make([]input.CreateRecordCommand, len(in))
+	for i, item := range in {
+		if item != nil {
+			items[i] = toCreateCommand(*item, userID)
+		}
+	}
+
+	results, err := h.RecordService.CreateMany(ctx, input.CreateRecordsCommand{Items: items, Mode: string(mode)})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, MsgCreateManyError)
+		h.Logger.ErrorwCtx(ctx, MsgCreateManyError, commonkeys.Error, err.Error(), commonkeys.UserID, userID)
+		return nil, err
+	}
+
+	out := toCreateRecordsPayload(results)
+	span.SetAttributes(attribute.Int(AttrCount, int(out.CreatedCount)))
+	span.SetStatus(codes.Ok, StatusCreated)
+	return out, nil
+}
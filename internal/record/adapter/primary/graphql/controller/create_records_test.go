@@ -0,0 +1,62 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gmodel "github.com/lechitz/aion-api/internal/adapter/primary/graphql/model"
+	"github.com/lechitz/aion-api/internal/record/adapter/primary/graphql/controller"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMany_InvalidUser(t *testing.T) {
+	h, ctrl := newRecordController(t, &recordServiceStub{})
+	defer ctrl.Finish()
+
+	_, err := h.CreateMany(t.Context(), []*gmodel.CreateRecordInput{{TagID: "1"}}, gmodel.CreateRecordsModeBestEffort, 0)
+	require.ErrorIs(t, err, controller.ErrUserIDNotFound)
+}
+
+func TestCreateMany_ServiceError(t *testing.T) {
+	expected := errors.New("batch failed")
+	svc := &recordServiceStub{
+		createManyFn: func(context.Context, input.CreateRecordsCommand) ([]input.CreateRecordResult, error) {
+			return nil, expected
+		},
+	}
+	h, ctrl := newRecordController(t, svc)
+	defer ctrl.Finish()
+
+	_, err := h.CreateMany(t.Context(), []*gmodel.CreateRecordInput{{TagID: "1"}}, gmodel.CreateRecordsModeAllOrNothing, 2)
+	require.ErrorIs(t, err, expected)
+}
+
+func TestCreateMany_ReportsEveryItem(t *testing.T) {
+	svc := &recordServiceStub{
+		createManyFn: func(_ context.Context, cmd input.CreateRecordsCommand) ([]input.CreateRecordResult, error) {
+			require.Equal(t, input.CreateRecordsModeBestEffort, cmd.Mode)
+			require.Len(t, cmd.Items, 2)
+			require.Equal(t, uint64(3), cmd.Items[0].TagID)
+			require.Equal(t, uint64(2), cmd.Items[0].UserID)
+			return []input.CreateRecordResult{
+				{Index: 0, Record: domain.Record{ID: 10, UserID: 2, TagID: 3}},
+				{Index: 1, Err: errors.New("tag not found")},
+			}, nil
+		},
+	}
+	h, ctrl := newRecordController(t, svc)
+	defer ctrl.Finish()
+
+	out, err := h.CreateMany(t.Context(), []*gmodel.CreateRecordInput{{TagID: "3"}, {TagID: "99"}}, gmodel.CreateRecordsModeBestEffort, 2)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), out.CreatedCount)
+	require.Equal(t, int32(1), out.FailedCount)
+	require.Equal(t, "10", out.Results[0].Record.ID)
+	require.Nil(t, out.Results[0].Error)
+	require.Equal(t, int32(1), out.Results[1].Index)
+	require.Nil(t, out.Results[1].Record)
+	require.Equal(t, "tag not found", *out.Results[1].Error)
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	gmodel "github.com/lechitz/aion-api/internal/adapter/primary/graphql/model"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// History fetches the change log of one of the user's records, newest first.
+func (h *controller) History(ctx context.Context, recordID, userID uint64, limit int) ([]*gmodel.RecordRevision, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanHistory)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanHistory),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+		attribute.String(commonkeys.RecordID, strconv.FormatUint(recordID, 10)),
+		attribute.Int(AttrLimit, limit),
+	)
+
+	if userID == 0 {
+		span.SetStatus(codes.Error, ErrUserIDNotFound.Error())
+		h.Logger.ErrorwCtx(ctx, ErrUserIDNotFound.Error(), commonkeys.UserID, userID)
+		return nil, ErrUserIDNotFound
+	}
+
+	revisions, err := h.RecordService.History(ctx, recordID, userID, limit)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, MsgHistoryError)
+		h.Logger.ErrorwCtx(ctx, MsgHistoryError, commonkeys.Error, err.Error(), commonkeys.RecordID, recordID)
+		return nil, err
+	}
+
+	out := toRevisionModelOutSlice(revisions)
+	span.SetAttributes(attribute.Int(AttrCount, len(out)))
+	span.SetStatus(codes.Ok, StatusFetched)
+	return out, nil
+}
@@ -0,0 +1,74 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/primary/graphql/controller"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory_InvalidUser(t *testing.T) {
+	h, ctrl := newRecordController(t, &recordServiceStub{})
+	defer ctrl.Finish()
+
+	_, err := h.History(t.Context(), 1, 0, 10)
+	require.ErrorIs(t, err, controller.ErrUserIDNotFound)
+}
+
+func TestHistory_ServiceError(t *testing.T) {
+	expected := errors.New("history failed")
+	svc := &recordServiceStub{
+		historyFn: func(context.Context, uint64, uint64, int) ([]domain.RecordRevision, error) {
+			return nil, expected
+		},
+	}
+	h, ctrl := newRecordController(t, svc)
+	defer ctrl.Finish()
+
+	_, err := h.History(t.Context(), 1, 2, 10)
+	require.ErrorIs(t, err, expected)
+}
+
+func TestHistory_Success(t *testing.T) {
+	oldValue, newValue := "1.5", "2"
+	service := "aion-chat"
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 123456000, time.UTC)
+	svc := &recordServiceStub{
+		historyFn: func(_ context.Context, recordID, userID uint64, limit int) ([]domain.RecordRevision, error) {
+			require.Equal(t, uint64(7), recordID)
+			require.Equal(t, uint64(2), userID)
+			require.Equal(t, 10, limit)
+			return []domain.RecordRevision{{
+				ID:           3,
+				RecordID:     recordID,
+				UserID:       userID,
+				Operation:    domain.RevisionOperationUpdated,
+				Changes:      []domain.RecordFieldChange{{Field: "value", OldValue: &oldValue, NewValue: &newValue}},
+				ActorUserID:  userID,
+				ActorService: &service,
+				Source:       domain.RevisionSourceChat,
+				CreatedAt:    createdAt,
+			}}, nil
+		},
+	}
+	h, ctrl := newRecordController(t, svc)
+	defer ctrl.Finish()
+
+	out, err := h.History(t.Context(), 7, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	require.Equal(t, "3", out[0].ID)
+	require.Equal(t, "7", out[0].RecordID)
+	require.Equal(t, domain.RevisionSourceChat, out[0].Source)
+	require.Equal(t, "aion-chat", *out[0].ActorService)
+	require.Nil(t, out[0].RequestID)
+	require.Equal(t, "2026-03-01T12:00:00.123456Z", out[0].CreatedAt)
+	require.Len(t, out[0].Changes, 1)
+	require.Equal(t, "value", out[0].Changes[0].Field)
+	require.Equal(t, "1.5", *out[0].Changes[0].OldValue)
+	require.Equal(t, "2", *out[0].Changes[0].NewValue)
+}
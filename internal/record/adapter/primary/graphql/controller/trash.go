@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	gmodel "github.com/lechitz/aion-api/internal/adapter/primary/graphql/model"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// ListDeleted fetches the authenticated user's soft-deleted records, most recently deleted first.
+func (h *controller) ListDeleted(ctx context.Context, userID uint64, limit int, afterDeletedAt *string, afterID *int64) ([]*gmodel.Record, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanListDeleted)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanListDeleted),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+		attribute.Int(AttrLimit, limit),
+	)
+
+	if userID == 0 {
+		span.SetStatus(codes.Error, ErrUserIDNotFound.Error())
+		h.Logger.ErrorwCtx(ctx, ErrUserIDNotFound.Error(), commonkeys.UserID, userID)
+		return nil, ErrUserIDNotFound
+	}
+
+	records, err := h.RecordService.ListDeleted(ctx, userID, limit, afterDeletedAt, afterID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, MsgListDeletedError)
+		h.Logger.ErrorwCtx(ctx, MsgListDeletedError, commonkeys.Error, err.Error(), commonkeys.UserID, userID)
+		return nil, err
+	}
+
+	out := toModelOutSlice(records)
+	span.SetAttributes(attribute.Int(AttrCount, len(out)))
+	span.SetStatus(codes.Ok, StatusFetched)
+	return out, nil
+}
+
+// Restore brings one soft-deleted record of the user back.
+func (h *controller) Restore(ctx context.Context, recordID, userID uint64) (*gmodel.Record, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanRestore)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+		attribute.String(commonkeys.RecordID, strconv.FormatUint(recordID, 10)),
+	)
+
+	record, err := h.RecordService.Restore(ctx, recordID, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, MsgRestoreError)
+		return nil, err
+	}
+	span.SetStatus(codes.Ok, StatusRestored)
+	return toModelOut(record), nil
+}
+
+// RestoreMany brings soft-deleted records of the user back; IDs not in the user's trash are skipped.
+func (h *controller) RestoreMany(ctx context.Context, recordIDs []uint64, userID uint64) ([]*gmodel.Record, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanRestoreMany)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+		attribute.Int(AttrRecordsCount, len(recordIDs)),
+	)
+
+	records, err := h.RecordService.RestoreMany(ctx, recordIDs, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, MsgRestoreError)
+		return nil, err
+	}
+
+	out := toModelOutSlice(records)
+	span.SetAttributes(attribute.Int(AttrCount, len(out)))
+	span.SetStatus(codes.Ok, StatusRestored)
+	return out, nil
+}
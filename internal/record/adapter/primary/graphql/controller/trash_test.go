@@ -0,0 +1,84 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/primary/graphql/controller"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDeleted_InvalidUser(t *testing.T) {
+	h, ctrl := newRecordController(t, &recordServiceStub{})
+	defer ctrl.Finish()
+
+	_, err := h.ListDeleted(t.Context(), 0, 10, nil, nil)
+	require.ErrorIs(t, err, controller.ErrUserIDNotFound)
+}
+
+func TestListDeleted_Success(t *testing.T) {
+	deletedAt := time.Date(2026, 3, 1, 12, 0, 0, 123456000, time.UTC)
+	svc := &recordServiceStub{
+		listDeletedFn: func(_ context.Context, userID uint64, limit int, _ *string, _ *int64) ([]domain.Record, error) {
+			require.Equal(t, uint64(2), userID)
+			require.Equal(t, 10, limit)
+			return []domain.Record{{ID: 1, UserID: 2, DeletedAt: &deletedAt}}, nil
+		},
+	}
+	h, ctrl := newRecordController(t, svc)
+	defer ctrl.Finish()
+
+	out, err := h.ListDeleted(t.Context(), 2, 10, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	require.Equal(t, "2026-03-01T12:00:00.123456Z", *out[0].DeletedAt)
+}
+
+func TestRestore(t *testing.T) {
+	t.Run("service error", func(t *testing.T) {
+		expected := errors.New("restore failed")
+		svc := &recordServiceStub{
+			restoreFn: func(_ context.Context, _, _ uint64) (domain.Record, error) {
+				return domain.Record{}, expected
+			},
+		}
+		h, ctrl := newRecordController(t, svc)
+		defer ctrl.Finish()
+
+		_, err := h.Restore(t.Context(), 1, 2)
+		require.ErrorIs(t, err, expected)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		svc := &recordServiceStub{
+			restoreFn: func(_ context.Context, recordID, userID uint64) (domain.Record, error) {
+				return domain.Record{ID: recordID, UserID: userID}, nil
+			},
+		}
+		h, ctrl := newRecordController(t, svc)
+		defer ctrl.Finish()
+
+		out, err := h.Restore(t.Context(), 1, 2)
+		require.NoError(t, err)
+		require.Equal(t, "1", out.ID)
+		require.Nil(t, out.DeletedAt)
+	})
+}
+
+func TestRestoreMany_Success(t *testing.T) {
+	svc := &recordServiceStub{
+		restoreManyFn: func(_ context.Context, recordIDs []uint64, userID uint64) ([]domain.Record, error) {
+			require.Equal(t, []uint64{1, 3}, recordIDs)
+			return []domain.Record{{ID: 1, UserID: userID}}, nil
+		},
+	}
+	h, ctrl := newRecordController(t, svc)
+	defer ctrl.Finish()
+
+	out, err := h.RestoreMany(t.Context(), []uint64{1, 3}, 2)
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+}
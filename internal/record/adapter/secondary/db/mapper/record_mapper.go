@@ -9,40 +9,44 @@ import (
 // RecordFromDB maps a model.Record object to a domain.Record object.
 func RecordFromDB(record model.Record) domain.Record {
 	return domain.Record{
-		ID:           record.ID,
-		UserID:       record.UserID,
-		Description:  record.Description,
-		TagID:        record.TagID,
-		EventTime:    record.EventTime,
-		RecordedAt:   record.RecordedAt,
-		DurationSecs: record.DurationSecs,
-		Value:        record.Value,
-		Source:       record.Source,
-		Timezone:     record.Timezone,
-		Status:       record.Status,
-		CreatedAt:    record.CreatedAt,
-		UpdatedAt:    record.UpdatedAt,
-		DeletedAt:    record.DeletedAt,
+		ID:                     record.ID,
+		UserID:                 record.UserID,
+		Description:            record.Description,
+		TagID:                  record.TagID,
+		EventTime:              record.EventTime,
+		RecordedAt:             record.RecordedAt,
+		DurationSecs:           record.DurationSecs,
+		Value:                  record.Value,
+		Source:                 record.Source,
+		Timezone:               record.Timezone,
+		Status:                 record.Status,
+		IdempotencyKey:         record.IdempotencyKey,
+		IdempotencyFingerprint: record.IdempotencyFingerprint,
+		CreatedAt:              record.CreatedAt,
+		UpdatedAt:              record.UpdatedAt,
+		DeletedAt:              record.DeletedAt,
 	}
 }
 
 // RecordToDB maps a domain.Record object to a model.Record object for database operations.
 func RecordToDB(record domain.Record) model.Record {
 	return model.Record{
-		ID:           record.ID,
-		UserID:       record.UserID,
-		Description:  record.Description,
-		TagID:        record.TagID,
-		EventTime:    record.EventTime,
-		RecordedAt:   record.RecordedAt,
-		DurationSecs: record.DurationSecs,
-		Value:        record.Value,
-		Source:       record.Source,
-		Timezone:     record.Timezone,
-		Status:       record.Status,
-		CreatedAt:    record.CreatedAt,
-		UpdatedAt:    record.UpdatedAt,
-		DeletedAt:    record.DeletedAt,
+		ID:                     record.ID,
+		UserID:                 record.UserID,
+		Description:            record.Description,
+		TagID:                  record.TagID,
+		EventTime:              record.EventTime,
+		RecordedAt:             record.RecordedAt,
+		DurationSecs:           record.DurationSecs,
+		Value:                  record.Value,
+		Source:                 record.Source,
+		Timezone:               record.Timezone,
+		Status:                 record.Status,
+		IdempotencyKey:         record.IdempotencyKey,
+		IdempotencyFingerprint: record.IdempotencyFingerprint,
+		CreatedAt:              record.CreatedAt,
+		UpdatedAt:              record.UpdatedAt,
+		DeletedAt:              record.DeletedAt,
 	}
 }
 
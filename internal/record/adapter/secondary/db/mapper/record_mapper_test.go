@@ -19,30 +19,37 @@ func TestRecordMapperRoundtripAndSlice(t *testing.T) {
 	status := "active"
 	desc := "desc"
 	deletedAt := now.Add(-time.Hour)
+	key := "offline-1"
+	fingerprint := "abc123"
 
 	dbRecord := model.Record{
-		ID:           1,
-		UserID:       2,
-		Description:  &desc,
-		TagID:        3,
-		EventTime:    now,
-		RecordedAt:   &recordedAt,
-		DurationSecs: &duration,
-		Value:        &value,
-		Source:       &source,
-		Timezone:     &tz,
-		Status:       &status,
-		CreatedAt:    now,
-		UpdatedAt:    now,
-		DeletedAt:    &deletedAt,
+		ID:                     1,
+		UserID:                 2,
+		Description:            &desc,
+		TagID:                  3,
+		EventTime:              now,
+		RecordedAt:             &recordedAt,
+		DurationSecs:           &duration,
+		Value:                  &value,
+		Source:                 &source,
+		Timezone:               &tz,
+		Status:                 &status,
+		IdempotencyKey:         &key,
+		IdempotencyFingerprint: &fingerprint,
+		CreatedAt:              now,
+		UpdatedAt:              now,
+		DeletedAt:              &deletedAt,
 	}
 
 	domainRecord := mapper.RecordFromDB(dbRecord)
 	require.Equal(t, dbRecord.ID, domainRecord.ID)
 	require.NotNil(t, domainRecord.Description)
 
+	require.Equal(t, key, *domainRecord.IdempotencyKey)
+
 	backToDB := mapper.RecordToDB(domainRecord)
 	require.Equal(t, dbRecord.TagID, backToDB.TagID)
+	require.Equal(t, fingerprint, *backToDB.IdempotencyFingerprint)
 
 	list := mapper.RecordsFromDB([]model.Record{dbRecord})
 	require.Len(t, list, 1)
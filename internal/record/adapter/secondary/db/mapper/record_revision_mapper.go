@@ -0,0 +1,92 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// RecordRevisionToDB maps a domain.RecordRevision to its row, splitting the changes into the old
+// and new value objects.
+func RecordRevisionToDB(revision domain.RecordRevision) (model.RecordRevision, error) {
+	oldValues := make(map[string]*string, len(revision.Changes))
+	newValues := make(map[string]*string, len(revision.Changes))
+	for _, change := range revision.Changes {
+		oldValues[change.Field] = change.OldValue
+		newValues[change.Field] = change.NewValue
+	}
+
+	oldJSON, err := json.Marshal(oldValues)
+	if err != nil {
+		return model.RecordRevision{}, fmt.Errorf("marshal old values: %w", err)
+	}
+	newJSON, err := json.Marshal(newValues)
+	if err != nil {
+		return model.RecordRevision{}, fmt.Errorf("marshal new values: %w", err)
+	}
+
+	var requestID *string
+	if revision.RequestID != "" {
+		requestID = &revision.RequestID
+	}
+
+	return model.RecordRevision{
+		ID:           revision.ID,
+		RecordID:     revision.RecordID,
+		UserID:       revision.UserID,
+		Operation:    revision.Operation,
+		OldValues:    oldJSON,
+		NewValues:    newJSON,
+		ActorUserID:  revision.ActorUserID,
+		ActorService: revision.ActorService,
+		Source:       revision.Source,
+		RequestID:    requestID,
+		CreatedAt:    revision.CreatedAt,
+	}, nil
+}
+
+// RecordRevisionFromDB maps a row back to a domain.RecordRevision with its changes ordered by field name.
+func RecordRevisionFromDB(row model.RecordRevision) (domain.RecordRevision, error) {
+	var oldValues, newValues map[string]*string
+	if err := json.Unmarshal(row.OldValues, &oldValues); err != nil {
+		return domain.RecordRevision{}, fmt.Errorf("unmarshal old values: %w", err)
+	}
+	if err := json.Unmarshal(row.NewValues, &newValues); err != nil {
+		return domain.RecordRevision{}, fmt.Errorf("unmarshal new values: %w", err)
+	}
+
+	fields := make([]string, 0, len(newValues))
+	for field := range newValues {
+		fields = append(fields, field)
+	}
+	for field := range oldValues {
+		if _, ok := newValues[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := make([]domain.RecordFieldChange, len(fields))
+	for i, field := range fields {
+		changes[i] = domain.RecordFieldChange{Field: field, OldValue: oldValues[field], NewValue: newValues[field]}
+	}
+
+	revision := domain.RecordRevision{
+		ID:           row.ID,
+		RecordID:     row.RecordID,
+		UserID:       row.UserID,
+		Operation:    row.Operation,
+		Changes:      changes,
+		ActorUserID:  row.ActorUserID,
+		ActorService: row.ActorService,
+		Source:       row.Source,
+		CreatedAt:    row.CreatedAt,
+	}
+	if row.RequestID != nil {
+		revision.RequestID = *row.RequestID
+	}
+	return revision, nil
+}
@@ -0,0 +1,48 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/mapper"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordRevisionMapperRoundtrip(t *testing.T) {
+	oldValue, newValue, service := "1.5", "2", "aion-chat"
+	revision := domain.RecordRevision{
+		ID:        4,
+		RecordID:  5,
+		UserID:    6,
+		Operation: domain.RevisionOperationUpdated,
+		Changes: []domain.RecordFieldChange{
+			{Field: "value", OldValue: &oldValue, NewValue: &newValue},
+			{Field: "description", NewValue: &newValue},
+		},
+		ActorUserID:  6,
+		ActorService: &service,
+		Source:       domain.RevisionSourceChat,
+		CreatedAt:    time.Now().UTC(),
+	}
+
+	row, err := mapper.RecordRevisionToDB(revision)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"description":null,"value":"1.5"}`, string(row.OldValues))
+	require.JSONEq(t, `{"description":"2","value":"2"}`, string(row.NewValues))
+	require.Nil(t, row.RequestID)
+
+	back, err := mapper.RecordRevisionFromDB(row)
+	require.NoError(t, err)
+	require.Equal(t, []domain.RecordFieldChange{
+		{Field: "description", NewValue: &newValue},
+		{Field: "value", OldValue: &oldValue, NewValue: &newValue},
+	}, back.Changes)
+	require.Equal(t, revision.Source, back.Source)
+	require.Equal(t, revision.ActorService, back.ActorService)
+	require.Empty(t, back.RequestID)
+
+	row.OldValues = []byte("not json")
+	_, err = mapper.RecordRevisionFromDB(row)
+	require.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// RecordRevision represents the database model for aion_api.record_revisions. OldValues and
+// NewValues hold JSON objects keyed by the changed field.
+type RecordRevision struct {
+	ID           uint64    `gorm:"column:id;primaryKey;autoIncrement"`
+	RecordID     uint64    `gorm:"column:record_id;not null"`
+	UserID       uint64    `gorm:"column:user_id;not null"`
+	Operation    string    `gorm:"column:operation;type:varchar(16);not null"`
+	OldValues    []byte    `gorm:"column:old_values;type:jsonb;not null"`
+	NewValues    []byte    `gorm:"column:new_values;type:jsonb;not null"`
+	ActorUserID  uint64    `gorm:"column:actor_user_id;not null"`
+	ActorService *string   `gorm:"column:actor_service;type:varchar(64)"`
+	Source       string    `gorm:"column:source;type:varchar(32);not null"`
+	RequestID    *string   `gorm:"column:request_id;type:varchar(128)"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for GORM.
+func (RecordRevision) TableName() string {
+	return "aion_api.record_revisions"
+}
@@ -4,6 +4,7 @@ package repository
 import (
 	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/record/core/ports/output"
 )
 
 // RecordRepository implements persistence using database operations.
@@ -19,7 +20,7 @@ func New(database db.DB, logger logger.ContextLogger) *RecordRepository {
 }
 
 // WithDB clones the repository with a transaction-bound database handle.
-func (r *RecordRepository) WithDB(database db.DB) *RecordRepository {
+func (r *RecordRepository) WithDB(database db.DB) output.RecordRepository {
 	if r == nil {
 		return nil
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/mapper"
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
 	"github.com/lechitz/aion-api/internal/record/core/domain"
 )
 
@@ -17,3 +18,30 @@ func (r *RecordRepository) Create(ctx context.Context, rec domain.Record) (domai
 
 	return mapper.RecordFromDB(recordDB), nil
 }
+
+// CreateMany inserts records in one statement and returns them with IDs populated, in input order.
+func (r *RecordRepository) CreateMany(ctx context.Context, recs []domain.Record) ([]domain.Record, error) {
+	recordsDB := make([]model.Record, len(recs))
+	for i := range recs {
+		recordsDB[i] = mapper.RecordToDB(recs[i])
+	}
+
+	if err := r.db.WithContext(ctx).Create(&recordsDB).Error(); err != nil {
+		return nil, err
+	}
+
+	return mapper.RecordsFromDB(recordsDB), nil
+}
+
+// ListByIdempotencyKeys returns the user's records created under any of keys, including records in
+// the trash, since a key stays bound to its record until the record is purged.
+func (r *RecordRepository) ListByIdempotencyKeys(ctx context.Context, userID uint64, keys []string) ([]domain.Record, error) {
+	var recordsDB []model.Record
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND idempotency_key IN ?", userID, keys).
+		Find(&recordsDB).Error(); err != nil {
+		return nil, err
+	}
+
+	return mapper.RecordsFromDB(recordsDB), nil
+}
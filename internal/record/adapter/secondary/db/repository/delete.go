@@ -7,10 +7,10 @@ import (
 	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
 )
 
-// Delete performs a soft delete for the given record belonging to the user.
-func (r *RecordRepository) Delete(ctx context.Context, recordID uint64, userID uint64) error {
+// Delete performs a soft delete for the given record belonging to the user, stamping it with deletedAt.
+func (r *RecordRepository) Delete(ctx context.Context, recordID uint64, userID uint64, deletedAt time.Time) error {
 	return r.db.WithContext(ctx).
 		Model(&model.Record{}).
 		Where("id = ? AND user_id = ?", recordID, userID).
-		Update("deleted_at", time.Now()).Error()
+		Update("deleted_at", deletedAt).Error()
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
+)
+
+// DeleteMany soft-deletes the user's live records among ids, stamping them with deletedAt.
+func (r *RecordRepository) DeleteMany(ctx context.Context, userID uint64, ids []uint64, deletedAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&model.Record{}).
+		Where("user_id = ? AND id IN ? AND deleted_at IS NULL", userID, ids).
+		Update("deleted_at", deletedAt).Error()
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/mapper"
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/output"
+)
+
+// ProjectionReconcileRepository is the RecordRepository as the reconciler sees it. Its WithDB
+// rebinds to the reconcile port, so a rebuild can clear projections and enqueue its events in one
+// transaction.
+type ProjectionReconcileRepository struct {
+	*RecordRepository
+}
+
+// NewProjectionReconcile creates the repository the projection reconciler works on.
+func NewProjectionReconcile(database db.DB, logger logger.ContextLogger) *ProjectionReconcileRepository {
+	return &ProjectionReconcileRepository{RecordRepository: New(database, logger)}
+}
+
+// WithDB clones the repository with a transaction-bound database handle.
+func (r *ProjectionReconcileRepository) WithDB(database db.DB) output.RecordProjectionReconcileRepository {
+	if r == nil || r.RecordRepository == nil {
+		return nil
+	}
+	return NewProjectionReconcile(database, r.logger)
+}
+
+// ListProjectionUserIDs returns every user with a live record or a projection row, so a drift
+// check also visits users whose only rows are orphaned projections.
+func (r *RecordRepository) ListProjectionUserIDs(ctx context.Context) ([]uint64, error) {
+	var userIDs []uint64
+	if err := r.db.WithContext(ctx).
+		Raw(`
+			SELECT user_id FROM aion_api.records WHERE deleted_at IS NULL
+			UNION
+			SELECT user_id FROM aion_derived.record_projection_v1
+			ORDER BY user_id
+		`).
+		Scan(&userIDs).Error(); err != nil {
+		return nil, fmt.Errorf("list projection users: %w", err)
+	}
+	return userIDs, nil
+}
+
+// ListCanonicalRecords returns every live record of the user ordered by id.
+func (r *RecordRepository) ListCanonicalRecords(ctx context.Context, userID uint64) ([]domain.Record, error) {
+	var recordsDB []model.Record
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND deleted_at IS NULL", userID).
+		Order("id ASC").
+		Find(&recordsDB).Error(); err != nil {
+		return nil, fmt.Errorf("list canonical records: %w", err)
+	}
+	return mapper.RecordsFromDB(recordsDB), nil
+}
+
+// ListUserProjections returns every derived projection of the user ordered by record id.
+func (r *RecordRepository) ListUserProjections(ctx context.Context, userID uint64) ([]domain.RecordProjection, error) {
+	var rows []recordProjectionRow
+	if err := r.db.WithContext(ctx).
+		Raw(`
+			SELECT
+				record_id,
+				user_id,
+				tag_id,
+				description,
+				event_time_utc,
+				recorded_at_utc,
+				status,
+				timezone,
+				duration_seconds,
+				value,
+				source,
+				last_event_id,
+				last_event_type,
+				last_event_version,
+				last_trace_id,
+				last_request_id,
+				last_kafka_topic,
+				last_kafka_partition,
+				last_kafka_offset,
+				last_consumed_at_utc,
+				payload_json,
+				created_at_utc,
+				updated_at_utc
+			FROM aion_derived.record_projection_v1
+			WHERE user_id = ?
+			ORDER BY record_id ASC
+		`, userID).
+		Scan(&rows).Error(); err != nil {
+		return nil, fmt.Errorf("list user projections: %w", err)
+	}
+
+	out := make([]domain.RecordProjection, len(rows))
+	for i := range rows {
+		out[i] = toRecordProjection(rows[i])
+	}
+	return out, nil
+}
+
+// DeleteUserProjections removes every derived projection of the user and reports how many rows went.
+func (r *RecordRepository) DeleteUserProjections(ctx context.Context, userID uint64) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Exec(`DELETE FROM aion_derived.record_projection_v1 WHERE user_id = ?`, userID)
+	if err := result.Error(); err != nil {
+		return 0, fmt.Errorf("delete user projections: %w", err)
+	}
+	return result.RowsAffected(), nil
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
 	"github.com/lechitz/aion-api/tests/mocks"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -94,3 +96,149 @@ func TestRecordProjectionQueries(t *testing.T) {
 		require.Equal(t, uint64(5177), got[0].RecordID)
 	})
 }
+
+func TestRecordProjectionWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dbMock := mocks.NewMockDB(ctrl)
+	logger := mocks.NewMockContextLogger(ctrl)
+	repo := New(dbMock, logger)
+	now := time.Date(2026, time.March, 13, 15, 0, 0, 0, time.UTC)
+	projection := domain.RecordProjection{
+		RecordID:           5177,
+		UserID:             7,
+		TagID:              32,
+		EventTimeUTC:       now,
+		LastEventID:        "evt-2",
+		LastEventType:      "record.updated",
+		LastEventVersion:   "v1",
+		LastKafkaTopic:     "aion.record.events.v1",
+		LastKafkaPartition: 1,
+		LastKafkaOffset:    42,
+		LastConsumedAtUTC:  now,
+		PayloadJSON:        []byte(`{"record_id":5177}`),
+	}
+
+	t.Run("upsert applied", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(query string, values ...any) db.DB {
+			require.Contains(t, query, "ON CONFLICT (record_id) DO UPDATE")
+			require.Contains(t, query, "record_projection_v1.last_kafka_offset < EXCLUDED.last_kafka_offset")
+			require.Len(t, values, 23)
+			require.Equal(t, uint64(5177), values[0])
+			require.Equal(t, int64(42), values[18])
+			require.JSONEq(t, `{"record_id":5177}`, values[20].(string))
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+		dbMock.EXPECT().RowsAffected().Return(int64(1))
+
+		applied, err := repo.UpsertProjection(t.Context(), projection)
+		require.NoError(t, err)
+		require.True(t, applied)
+	})
+
+	t.Run("upsert skipped for a stale or repeated event", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec(gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(nil)
+		dbMock.EXPECT().RowsAffected().Return(int64(0))
+
+		applied, err := repo.UpsertProjection(t.Context(), projection)
+		require.NoError(t, err)
+		require.False(t, applied)
+	})
+
+	t.Run("upsert error", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec(gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("write fail"))
+
+		applied, err := repo.UpsertProjection(t.Context(), projection)
+		require.ErrorContains(t, err, "upsert projected record")
+		require.False(t, applied)
+	})
+
+	t.Run("delete applied", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(query string, values ...any) db.DB {
+			require.Contains(t, query, "DELETE FROM aion_derived.record_projection_v1")
+			require.Equal(t, []any{uint64(5177), "aion.record.events.v1", 1, int64(42)}, values)
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+		dbMock.EXPECT().RowsAffected().Return(int64(1))
+
+		applied, err := repo.DeleteProjection(t.Context(), projection)
+		require.NoError(t, err)
+		require.True(t, applied)
+	})
+}
+
+func TestRecordProjectionReconcileQueries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dbMock := mocks.NewMockDB(ctrl)
+	logger := mocks.NewMockContextLogger(ctrl)
+	repo := New(dbMock, logger)
+
+	t.Run("list projection users", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Raw(gomock.Any()).DoAndReturn(func(query string, _ ...any) db.DB {
+			require.Contains(t, query, "UNION")
+			return dbMock
+		})
+		dbMock.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest any) db.DB {
+			ids, ok := dest.(*[]uint64)
+			require.True(t, ok)
+			*ids = []uint64{7, 9}
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		got, err := repo.ListProjectionUserIDs(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, []uint64{7, 9}, got)
+	})
+
+	t.Run("list canonical records", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where(gomock.Any(), uint64(7)).Return(dbMock)
+		dbMock.EXPECT().Order("id ASC").Return(dbMock)
+		dbMock.EXPECT().Find(gomock.Any()).DoAndReturn(func(dest any, _ ...any) db.DB {
+			rows, ok := dest.(*[]model.Record)
+			require.True(t, ok)
+			*rows = []model.Record{{ID: 5177, UserID: 7}}
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		got, err := repo.ListCanonicalRecords(t.Context(), 7)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Equal(t, uint64(5177), got[0].ID)
+	})
+
+	t.Run("list user projections error", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Raw(gomock.Any(), uint64(7)).Return(dbMock)
+		dbMock.EXPECT().Scan(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("query fail"))
+
+		_, err := repo.ListUserProjections(t.Context(), 7)
+		require.ErrorContains(t, err, "list user projections")
+	})
+
+	t.Run("delete user projections", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec(gomock.Any(), uint64(7)).Return(dbMock)
+		dbMock.EXPECT().Error().Return(nil)
+		dbMock.EXPECT().RowsAffected().Return(int64(3))
+
+		deleted, err := repo.DeleteUserProjections(t.Context(), 7)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), deleted)
+	})
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// UpsertProjection inserts or replaces one derived record projection. An existing row is only
+// replaced by a different event read later from the same partition, or from another topic or
+// partition, so redeliveries and replays of older offsets leave it untouched and report false.
+func (r *RecordRepository) UpsertProjection(ctx context.Context, projection domain.RecordProjection) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Exec(`
+			INSERT INTO aion_derived.record_projection_v1 (
+				record_id,
+				user_id,
+				tag_id,
+				description,
+				event_time_utc,
+				recorded_at_utc,
+				status,
+				timezone,
+				duration_seconds,
+				value,
+				source,
+				last_event_id,
+				last_event_type,
+				last_event_version,
+				last_trace_id,
+				last_request_id,
+				last_kafka_topic,
+				last_kafka_partition,
+				last_kafka_offset,
+				last_consumed_at_utc,
+				payload_json,
+				created_at_utc,
+				updated_at_utc
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?::jsonb, ?, ?)
+			ON CONFLICT (record_id) DO UPDATE SET
+				user_id = EXCLUDED.user_id,
+				tag_id = EXCLUDED.tag_id,
+				description = EXCLUDED.description,
+				event_time_utc = EXCLUDED.event_time_utc,
+				recorded_at_utc = EXCLUDED.recorded_at_utc,
+				status = EXCLUDED.status,
+				timezone = EXCLUDED.timezone,
+				duration_seconds = EXCLUDED.duration_seconds,
+				value = EXCLUDED.value,
+				source = EXCLUDED.source,
+				last_event_id = EXCLUDED.last_event_id,
+				last_event_type = EXCLUDED.last_event_type,
+				last_event_version = EXCLUDED.last_event_version,
+				last_trace_id = EXCLUDED.last_trace_id,
+				last_request_id = EXCLUDED.last_request_id,
+				last_kafka_topic = EXCLUDED.last_kafka_topic,
+				last_kafka_partition = EXCLUDED.last_kafka_partition,
+				last_kafka_offset = EXCLUDED.last_kafka_offset,
+				last_consumed_at_utc = EXCLUDED.last_consumed_at_utc,
+				payload_json = EXCLUDED.payload_json,
+				updated_at_utc = EXCLUDED.updated_at_utc
+			WHERE record_projection_v1.last_event_id <> EXCLUDED.last_event_id
+				AND (
+					record_projection_v1.last_kafka_topic <> EXCLUDED.last_kafka_topic
+					OR record_projection_v1.last_kafka_partition <> EXCLUDED.last_kafka_partition
+					OR record_projection_v1.last_kafka_offset < EXCLUDED.last_kafka_offset
+				)
+		`,
+			projection.RecordID,
+			projection.UserID,
+			projection.TagID,
+			projection.Description,
+			projection.EventTimeUTC,
+			projection.RecordedAtUTC,
+			projection.Status,
+			projection.Timezone,
+			projection.DurationSeconds,
+			projection.Value,
+			projection.Source,
+			projection.LastEventID,
+			projection.LastEventType,
+			projection.LastEventVersion,
+			projection.LastTraceID,
+			projection.LastRequestID,
+			projection.LastKafkaTopic,
+			projection.LastKafkaPartition,
+			projection.LastKafkaOffset,
+			projection.LastConsumedAtUTC,
+			string(projection.PayloadJSON),
+			projection.LastConsumedAtUTC,
+			projection.LastConsumedAtUTC,
+		)
+	if err := result.Error(); err != nil {
+		return false, fmt.Errorf("upsert projected record: %w", err)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// DeleteProjection removes one derived record projection unless the row already reflects the
+// same or a later position of the same partition, so a redelivered delete reports false.
+func (r *RecordRepository) DeleteProjection(ctx context.Context, projection domain.RecordProjection) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Exec(`
+			DELETE FROM aion_derived.record_projection_v1
+			WHERE record_id = ?
+				AND NOT (
+					last_kafka_topic = ?
+					AND last_kafka_partition = ?
+					AND last_kafka_offset >= ?
+				)
+		`,
+			projection.RecordID,
+			projection.LastKafkaTopic,
+			projection.LastKafkaPartition,
+			projection.LastKafkaOffset,
+		)
+	if err := result.Error(); err != nil {
+		return false, fmt.Errorf("delete projected record: %w", err)
+	}
+	return result.RowsAffected() > 0, nil
+}
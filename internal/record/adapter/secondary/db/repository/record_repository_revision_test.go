@@ -0,0 +1,77 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecordRevisionQueries(t *testing.T) {
+	repo, dbMock := newRecordRepo(t)
+	newValue := "2026-03-01T08:00:00Z"
+	revision := domain.RecordRevision{
+		RecordID:    1,
+		UserID:      10,
+		Operation:   domain.RevisionOperationDeleted,
+		Changes:     []domain.RecordFieldChange{{Field: "deleted_at", NewValue: &newValue}},
+		ActorUserID: 10,
+		Source:      domain.RevisionSourceWeb,
+		RequestID:   "req-1",
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	t.Run("create inserts every revision", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Create(gomock.Any()).DoAndReturn(func(v any) db.DB {
+			rows, ok := v.(*[]model.RecordRevision)
+			require.True(t, ok)
+			require.Len(t, *rows, 2)
+			require.Equal(t, "req-1", *(*rows)[0].RequestID)
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		require.NoError(t, repo.CreateRevisions(t.Context(), []domain.RecordRevision{revision, revision}))
+	})
+
+	t.Run("create without revisions is a no-op", func(t *testing.T) {
+		require.NoError(t, repo.CreateRevisions(t.Context(), nil))
+	})
+
+	t.Run("list newest first", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where("user_id = ? AND record_id = ?", uint64(10), uint64(1)).Return(dbMock)
+		dbMock.EXPECT().Order("created_at DESC, id DESC").Return(dbMock)
+		dbMock.EXPECT().Limit(50).Return(dbMock)
+		dbMock.EXPECT().Find(gomock.Any()).DoAndReturn(func(dest any, _ ...any) db.DB {
+			rows, ok := dest.(*[]model.RecordRevision)
+			require.True(t, ok)
+			*rows = []model.RecordRevision{{ID: 9, RecordID: 1, UserID: 10, OldValues: []byte(`{"value":"1"}`), NewValues: []byte(`{"value":"2"}`)}}
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		got, err := repo.ListRevisions(t.Context(), 10, 1, 50)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Equal(t, "value", got[0].Changes[0].Field)
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where(gomock.Any(), uint64(10), uint64(1)).Return(dbMock)
+		dbMock.EXPECT().Order(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Limit(50).Return(dbMock)
+		dbMock.EXPECT().Find(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("query fail"))
+
+		_, err := repo.ListRevisions(t.Context(), 10, 1, 50)
+		require.Error(t, err)
+	})
+}
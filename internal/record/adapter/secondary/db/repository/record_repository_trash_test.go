@@ -0,0 +1,89 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecordTrashQueries(t *testing.T) {
+	repo, dbMock := newRecordRepo(t)
+	rec := sampleRecord()
+	deletedAt := time.Now().UTC()
+
+	t.Run("list deleted with cursor", func(t *testing.T) {
+		after := deletedAt.Format(time.RFC3339Nano)
+		afterID := int64(10)
+
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where("user_id = ? AND deleted_at IS NOT NULL", rec.UserID).Return(dbMock)
+		dbMock.EXPECT().Order("deleted_at DESC, id DESC").Return(dbMock)
+		dbMock.EXPECT().Limit(20).Return(dbMock)
+		dbMock.EXPECT().Where(gomock.Any(), after, after, afterID).Return(dbMock)
+		dbMock.EXPECT().Find(gomock.Any()).DoAndReturn(func(dest any, _ ...any) db.DB {
+			rows, ok := dest.(*[]model.Record)
+			require.True(t, ok)
+			*rows = []model.Record{{ID: rec.ID, UserID: rec.UserID, DeletedAt: &deletedAt}}
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		got, err := repo.ListDeleted(t.Context(), rec.UserID, 20, &after, &afterID)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.NotNil(t, got[0].DeletedAt)
+	})
+
+	t.Run("list deleted by ids", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where(gomock.Any(), rec.UserID, []uint64{1, 2}).Return(dbMock)
+		dbMock.EXPECT().Order("id ASC").Return(dbMock)
+		dbMock.EXPECT().Find(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("query fail"))
+
+		_, err := repo.ListDeletedByIDs(t.Context(), rec.UserID, []uint64{1, 2})
+		require.Error(t, err)
+	})
+
+	t.Run("restore clears deleted_at", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Model(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where(gomock.Any(), rec.UserID, []uint64{1}).Return(dbMock)
+		dbMock.EXPECT().Updates(gomock.Any()).DoAndReturn(func(values any) db.DB {
+			fields, ok := values.(map[string]any)
+			require.True(t, ok)
+			require.Contains(t, fields, "deleted_at")
+			require.Nil(t, fields["deleted_at"])
+			require.Equal(t, deletedAt, fields["updated_at"])
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		require.NoError(t, repo.Restore(t.Context(), rec.UserID, []uint64{1}, deletedAt))
+	})
+
+	t.Run("purge deleted reports removed rows", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec(gomock.Any(), deletedAt, 500).Return(dbMock)
+		dbMock.EXPECT().Error().Return(nil)
+		dbMock.EXPECT().RowsAffected().Return(int64(3))
+
+		removed, err := repo.PurgeDeleted(t.Context(), deletedAt, 500)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), removed)
+	})
+
+	t.Run("purge deleted error", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Exec(gomock.Any(), deletedAt, 500).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("exec fail"))
+
+		_, err := repo.PurgeDeleted(t.Context(), deletedAt, 500)
+		require.Error(t, err)
+	})
+}
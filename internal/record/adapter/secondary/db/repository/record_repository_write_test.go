@@ -3,9 +3,11 @@ package repository_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -37,6 +39,59 @@ func TestRecordCreateUpdateDelete(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("create many success", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Create(gomock.Any()).DoAndReturn(func(v any) db.DB {
+			rows, ok := v.(*[]model.Record)
+			require.True(t, ok)
+			require.Len(t, *rows, 2)
+			(*rows)[0].ID = 100
+			(*rows)[1].ID = 101
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		got, err := repo.CreateMany(t.Context(), []domain.Record{rec, rec})
+		require.NoError(t, err)
+		require.Equal(t, uint64(100), got[0].ID)
+		require.Equal(t, uint64(101), got[1].ID)
+	})
+
+	t.Run("create many error", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Create(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("create fail"))
+		_, err := repo.CreateMany(t.Context(), []domain.Record{rec})
+		require.Error(t, err)
+	})
+
+	t.Run("list by idempotency keys", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where("user_id = ? AND idempotency_key IN ?", uint64(2), []string{"k1"}).Return(dbMock)
+		dbMock.EXPECT().Find(gomock.Any()).DoAndReturn(func(v any, _ ...any) db.DB {
+			rows, ok := v.(*[]model.Record)
+			require.True(t, ok)
+			key := "k1"
+			*rows = []model.Record{{ID: 5, UserID: 2, IdempotencyKey: &key}}
+			return dbMock
+		})
+		dbMock.EXPECT().Error().Return(nil)
+
+		got, err := repo.ListByIdempotencyKeys(t.Context(), 2, []string{"k1"})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Equal(t, "k1", *got[0].IdempotencyKey)
+	})
+
+	t.Run("list by idempotency keys error", func(t *testing.T) {
+		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where(gomock.Any(), gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Find(gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Error().Return(errors.New("find fail"))
+		_, err := repo.ListByIdempotencyKeys(t.Context(), 2, []string{"k1"})
+		require.Error(t, err)
+	})
+
 	t.Run("update error", func(t *testing.T) {
 		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
 		dbMock.EXPECT().Model(gomock.Any()).Return(dbMock)
@@ -77,15 +132,16 @@ func TestRecordCreateUpdateDelete(t *testing.T) {
 		dbMock.EXPECT().Where(gomock.Any(), gomock.Any(), gomock.Any()).Return(dbMock)
 		dbMock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(dbMock)
 		dbMock.EXPECT().Error().Return(errors.New("delete fail"))
-		require.Error(t, repo.Delete(t.Context(), rec.ID, rec.UserID))
+		require.Error(t, repo.Delete(t.Context(), rec.ID, rec.UserID, time.Now().UTC()))
 	})
 
-	t.Run("delete all by user success", func(t *testing.T) {
+	t.Run("delete many success", func(t *testing.T) {
+		deletedAt := time.Now().UTC()
 		dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
 		dbMock.EXPECT().Model(gomock.Any()).Return(dbMock)
-		dbMock.EXPECT().Where(gomock.Any(), gomock.Any()).Return(dbMock)
-		dbMock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(dbMock)
+		dbMock.EXPECT().Where(gomock.Any(), rec.UserID, []uint64{rec.ID}).Return(dbMock)
+		dbMock.EXPECT().Update("deleted_at", deletedAt).Return(dbMock)
 		dbMock.EXPECT().Error().Return(nil)
-		require.NoError(t, repo.DeleteAllByUser(t.Context(), rec.UserID))
+		require.NoError(t, repo.DeleteMany(t.Context(), rec.UserID, []uint64{rec.ID}, deletedAt))
 	})
 }
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/mapper"
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// CreateRevisions appends record revisions in one insert. Callers run it in the transaction of
+// the change it describes.
+func (r *RecordRepository) CreateRevisions(ctx context.Context, revisions []domain.RecordRevision) error {
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	rows := make([]model.RecordRevision, len(revisions))
+	for i := range revisions {
+		row, err := mapper.RecordRevisionToDB(revisions[i])
+		if err != nil {
+			return fmt.Errorf("create record revisions: %w", err)
+		}
+		rows[i] = row
+	}
+
+	if err := r.db.WithContext(ctx).Create(&rows).Error(); err != nil {
+		return fmt.Errorf("create record revisions: %w", err)
+	}
+	return nil
+}
+
+// ListRevisions returns up to limit revisions of the user's record, newest first. Revisions of
+// soft-deleted records are kept, so the history of a trashed record stays readable.
+func (r *RecordRepository) ListRevisions(ctx context.Context, userID uint64, recordID uint64, limit int) ([]domain.RecordRevision, error) {
+	var rows []model.RecordRevision
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND record_id = ?", userID, recordID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&rows).Error(); err != nil {
+		return nil, fmt.Errorf("list record revisions: %w", err)
+	}
+
+	out := make([]domain.RecordRevision, len(rows))
+	for i := range rows {
+		revision, err := mapper.RecordRevisionFromDB(rows[i])
+		if err != nil {
+			return nil, fmt.Errorf("list record revisions: %w", err)
+		}
+		out[i] = revision
+	}
+	return out, nil
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/mapper"
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/db/model"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// ListDeleted returns the user's soft-deleted records ordered by deleted_at desc.
+func (r *RecordRepository) ListDeleted(ctx context.Context, userID uint64, limit int, afterDeletedAt *string, afterID *int64) ([]domain.Record, error) {
+	var recordsDB []model.Record
+	q := r.db.WithContext(ctx).
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID).
+		Order("deleted_at DESC, id DESC").
+		Limit(limit)
+
+	if afterDeletedAt != nil && afterID != nil {
+		q = q.Where("deleted_at < ? OR (deleted_at = ? AND id < ?)", *afterDeletedAt, *afterDeletedAt, *afterID)
+	}
+
+	if err := q.Find(&recordsDB).Error(); err != nil {
+		return nil, err
+	}
+
+	return mapper.RecordsFromDB(recordsDB), nil
+}
+
+// ListDeletedByIDs returns the user's soft-deleted records among ids ordered by id; ids that are
+// live, missing, or owned by someone else are left out.
+func (r *RecordRepository) ListDeletedByIDs(ctx context.Context, userID uint64, ids []uint64) ([]domain.Record, error) {
+	var recordsDB []model.Record
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND id IN ? AND deleted_at IS NOT NULL", userID, ids).
+		Order("id ASC").
+		Find(&recordsDB).Error(); err != nil {
+		return nil, err
+	}
+
+	return mapper.RecordsFromDB(recordsDB), nil
+}
+
+// Restore clears deleted_at for the user's soft-deleted records among ids.
+func (r *RecordRepository) Restore(ctx context.Context, userID uint64, ids []uint64, restoredAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&model.Record{}).
+		Where("user_id = ? AND id IN ? AND deleted_at IS NOT NULL", userID, ids).
+		Updates(map[string]any{"deleted_at": nil, "updated_at": restoredAt}).Error()
+}
+
+// PurgeDeleted hard-deletes up to limit records soft-deleted before the cutoff, oldest first,
+// and reports how many rows went.
+func (r *RecordRepository) PurgeDeleted(ctx context.Context, before time.Time, limit int) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Exec(`
+			DELETE FROM aion_api.records
+			WHERE id IN (
+				SELECT id FROM aion_api.records
+				WHERE deleted_at IS NOT NULL AND deleted_at < ?
+				ORDER BY deleted_at ASC, id ASC
+				LIMIT ?
+			)
+		`, before, limit)
+	if err := result.Error(); err != nil {
+		return 0, fmt.Errorf("purge deleted records: %w", err)
+	}
+	return result.RowsAffected(), nil
+}
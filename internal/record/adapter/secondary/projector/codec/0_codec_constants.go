@@ -0,0 +1,38 @@
+// Package codec decodes record outbox events and encodes projection-ready events for the built-in
+// record projector, independently of the transport that carries them.
+package codec
+
+import "errors"
+
+// Record events arrive in any format the outbox publisher writes: the legacy envelope with routing
+// headers, or CloudEvents 1.0 in binary mode (ce_* headers, payload as the body) or structured mode
+// (one JSON document with the payload under data).
+const (
+	// HeaderEventID carries the outbox event id of a legacy envelope.
+	HeaderEventID = "event_id"
+	// HeaderEventType carries the event type of a legacy envelope or a projection-ready event.
+	HeaderEventType = "event_type"
+	// HeaderAggregateSequence carries the per-aggregate outbox sequence of a legacy envelope.
+	HeaderAggregateSequence = "aggregate_sequence"
+	// HeaderContentType carries the media type of the message body.
+	HeaderContentType = "content-type"
+	// ContentTypeJSON is the media type of projection-ready events.
+	ContentTypeJSON = "application/json"
+	// ContentTypeCloudEventsJSON marks a structured-mode CloudEvents body.
+	ContentTypeCloudEventsJSON = "application/cloudevents+json"
+
+	headerCloudEventsPrefix = "ce_"
+	headerSpecVersion       = headerCloudEventsPrefix + "specversion"
+	headerID                = headerCloudEventsPrefix + "id"
+	headerType              = headerCloudEventsPrefix + "type"
+	headerEventVersion      = headerCloudEventsPrefix + "eventversion"
+	headerAggregateSequence = headerCloudEventsPrefix + "aggregatesequence"
+	headerTraceID           = headerCloudEventsPrefix + "traceid"
+	headerRequestID         = headerCloudEventsPrefix + "requestid"
+
+	// projectedAtLayout keeps microsecond precision like the outbox timestamps.
+	projectedAtLayout = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// ErrIncompleteRecordEvent is returned when a decoded record event lacks its id, type, record or user.
+var ErrIncompleteRecordEvent = errors.New("record event is missing event id, event type, record id or user id")
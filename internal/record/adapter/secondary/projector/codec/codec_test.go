@@ -0,0 +1,131 @@
+package codec_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/projector/codec"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+const recordPayload = `{"record_id":42,"user_id":14,"tag_id":7,"event_time_utc":"2026-03-13T13:00:00.000000Z","status":"published"}`
+
+func TestDecodeRecordEventFormats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		headers map[string]string
+	}{
+		{
+			name: "legacy envelope",
+			value: `{"event_id":"evt-1","event_type":"record.updated","event_version":"v1","trace_id":"trace-1",` +
+				`"request_id":"req-1","payload":` + recordPayload + `}`,
+			headers: map[string]string{"aggregate_sequence": "3", "traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		},
+		{
+			name:  "cloudevents binary",
+			value: recordPayload,
+			headers: map[string]string{
+				"ce_specversion":       "1.0",
+				"ce_id":                "evt-1",
+				"ce_type":              "record.updated",
+				"ce_eventversion":      "v1",
+				"ce_aggregatesequence": "3",
+				"ce_traceid":           "trace-1",
+				"ce_requestid":         "req-1",
+				"traceparent":          "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			},
+		},
+		{
+			name: "cloudevents structured",
+			value: `{"specversion":"1.0","id":"evt-1","type":"record.updated","eventversion":"v1","traceid":"trace-1",` +
+				`"requestid":"req-1","aggregatesequence":3,"data":` + recordPayload + `}`,
+			headers: map[string]string{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			event, err := codec.DecodeRecordEvent([]byte(tt.value), tt.headers)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if event.EventID != "evt-1" || event.EventType != "record.updated" || event.EventVersion != "v1" {
+				t.Fatalf("unexpected metadata: %#v", event)
+			}
+			if event.TraceID != "trace-1" || event.RequestID != "req-1" || event.AggregateSequence != 3 {
+				t.Fatalf("unexpected correlation: %#v", event)
+			}
+			if event.TraceParent != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+				t.Fatalf("expected trace context from headers, got %q", event.TraceParent)
+			}
+			if event.Payload.RecordID != 42 || event.Payload.UserID != 14 || event.Payload.TagID != 7 {
+				t.Fatalf("unexpected payload: %#v", event.Payload)
+			}
+			if string(event.PayloadJSON) != recordPayload {
+				t.Fatalf("expected the raw payload to be kept, got %s", event.PayloadJSON)
+			}
+		})
+	}
+}
+
+func TestDecodeRecordEventRejectsIncompleteEvents(t *testing.T) {
+	t.Parallel()
+
+	_, err := codec.DecodeRecordEvent([]byte(`{"event_id":"evt-1","event_type":"record.created","payload":{"record_id":42}}`), nil)
+	if !errors.Is(err, codec.ErrIncompleteRecordEvent) {
+		t.Fatalf("expected incomplete event error, got %v", err)
+	}
+
+	if _, err := codec.DecodeRecordEvent([]byte(`not json`), nil); err == nil {
+		t.Fatal("expected a decode error")
+	}
+
+	_, err = codec.DecodeRecordEvent([]byte(recordPayload), map[string]string{"ce_specversion": "1.0", "ce_aggregatesequence": "x"})
+	if err == nil {
+		t.Fatal("expected an aggregate sequence error")
+	}
+}
+
+func TestEncodeProjectionReady(t *testing.T) {
+	t.Parallel()
+
+	message, err := codec.EncodeProjectionReady(domain.ProjectionReady{
+		EventType:       "record.projection.created",
+		EventVersion:    "v1",
+		UserID:          14,
+		RecordID:        42,
+		TagID:           7,
+		CategoryID:      3,
+		SourceEventID:   "evt-1",
+		SourceEventType: "record.created",
+		ProjectedAtUTC:  time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC),
+		TraceParent:     "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if message.Key != "42" {
+		t.Fatalf("expected the record id as key, got %q", message.Key)
+	}
+	if message.Headers["event_type"] != "record.projection.created" || message.Headers["traceparent"] == "" {
+		t.Fatalf("unexpected headers: %#v", message.Headers)
+	}
+	if _, ok := message.Headers["tracestate"]; ok {
+		t.Fatal("expected no tracestate header without trace state")
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(message.Value, &body); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if body["projected_at_utc"] != "2026-03-13T13:00:00.000000Z" || body["category_id"] != float64(3) || body["source_event_id"] != "evt-1" {
+		t.Fatalf("unexpected body: %s", message.Value)
+	}
+}
@@ -0,0 +1,70 @@
+package codec
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// projectionReadyEnvelope is the legacy flat JSON layout aion-streams publishes and the realtime
+// readers decode.
+type projectionReadyEnvelope struct {
+	EventType       string `json:"event_type"`
+	EventVersion    string `json:"event_version"`
+	SourceEventID   string `json:"source_event_id"`
+	SourceEventType string `json:"source_event_type"`
+	ProjectedAtUTC  string `json:"projected_at_utc"`
+	TraceID         string `json:"trace_id,omitempty"`
+	RequestID       string `json:"request_id,omitempty"`
+	UserID          uint64 `json:"user_id"`
+	RecordID        uint64 `json:"record_id"`
+	TagID           uint64 `json:"tag_id"`
+	CategoryID      uint64 `json:"category_id"`
+}
+
+// Message is one encoded projection-ready event. Key is the record id, so every change of one
+// record lands on the same partition.
+type Message struct {
+	Key     string
+	Value   []byte
+	Headers map[string]string
+}
+
+// EncodeProjectionReady lays one projection-ready event out as the legacy flat JSON value with the
+// event type, content type and W3C trace context as headers.
+func EncodeProjectionReady(event domain.ProjectionReady) (Message, error) {
+	value, err := json.Marshal(projectionReadyEnvelope{
+		EventType:       event.EventType,
+		EventVersion:    event.EventVersion,
+		SourceEventID:   event.SourceEventID,
+		SourceEventType: event.SourceEventType,
+		ProjectedAtUTC:  event.ProjectedAtUTC.UTC().Format(projectedAtLayout),
+		TraceID:         event.TraceID,
+		RequestID:       event.RequestID,
+		UserID:          event.UserID,
+		RecordID:        event.RecordID,
+		TagID:           event.TagID,
+		CategoryID:      event.CategoryID,
+	})
+	if err != nil {
+		return Message{}, err
+	}
+
+	headers := map[string]string{
+		HeaderEventType:   event.EventType,
+		HeaderContentType: ContentTypeJSON,
+	}
+	if event.TraceParent != "" {
+		headers[tracecontext.HeaderTraceParent] = event.TraceParent
+	}
+	if event.TraceState != "" {
+		headers[tracecontext.HeaderTraceState] = event.TraceState
+	}
+	return Message{
+		Key:     strconv.FormatUint(event.RecordID, 10),
+		Value:   value,
+		Headers: headers,
+	}, nil
+}
@@ -0,0 +1,131 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// legacyEnvelope is the aion-api outbox envelope; the aggregate sequence travels as a header.
+type legacyEnvelope struct {
+	EventID      string          `json:"event_id"`
+	EventType    string          `json:"event_type"`
+	EventVersion string          `json:"event_version"`
+	TraceID      string          `json:"trace_id"`
+	RequestID    string          `json:"request_id"`
+	Payload      json.RawMessage `json:"payload"`
+}
+
+// cloudEvent holds the CloudEvents attributes the projector needs, whether they came from ce_*
+// headers or from a structured document.
+type cloudEvent struct {
+	SpecVersion       string          `json:"specversion"`
+	ID                string          `json:"id"`
+	Type              string          `json:"type"`
+	EventVersion      string          `json:"eventversion"`
+	TraceID           string          `json:"traceid"`
+	RequestID         string          `json:"requestid"`
+	Data              json.RawMessage `json:"data"`
+	AggregateSequence int64           `json:"aggregatesequence"`
+}
+
+// DecodeRecordEvent maps one record outbox message to a record event. It accepts the legacy
+// envelope as well as CloudEvents in binary mode (detected by the ce_specversion header) and
+// structured mode (detected by the content type or a specversion attribute in the body). The
+// transport position is left to the reader.
+func DecodeRecordEvent(value []byte, headers map[string]string) (domain.RecordEvent, error) {
+	event, err := decode(value, headers)
+	if err != nil {
+		return domain.RecordEvent{}, err
+	}
+	if err := json.Unmarshal(event.PayloadJSON, &event.Payload); err != nil {
+		return domain.RecordEvent{}, fmt.Errorf("decode record event payload: %w", err)
+	}
+	if event.EventID == "" || event.EventType == "" || event.Payload.RecordID == 0 || event.Payload.UserID == 0 {
+		return domain.RecordEvent{}, ErrIncompleteRecordEvent
+	}
+	event.TraceParent = headers[tracecontext.HeaderTraceParent]
+	event.TraceState = headers[tracecontext.HeaderTraceState]
+	return event, nil
+}
+
+func decode(value []byte, headers map[string]string) (domain.RecordEvent, error) {
+	if headers[headerSpecVersion] != "" {
+		sequence, err := parseSequence(headers[headerAggregateSequence])
+		if err != nil {
+			return domain.RecordEvent{}, err
+		}
+		return fromCloudEvent(cloudEvent{
+			ID:                headers[headerID],
+			Type:              headers[headerType],
+			EventVersion:      headers[headerEventVersion],
+			TraceID:           headers[headerTraceID],
+			RequestID:         headers[headerRequestID],
+			Data:              value,
+			AggregateSequence: sequence,
+		}), nil
+	}
+
+	var structured cloudEvent
+	if err := json.Unmarshal(value, &structured); err != nil {
+		return domain.RecordEvent{}, fmt.Errorf("decode record event: %w", err)
+	}
+	if structured.SpecVersion != "" || strings.HasPrefix(headers[HeaderContentType], ContentTypeCloudEventsJSON) {
+		return fromCloudEvent(structured), nil
+	}
+
+	var envelope legacyEnvelope
+	if err := json.Unmarshal(value, &envelope); err != nil {
+		return domain.RecordEvent{}, fmt.Errorf("decode record event: %w", err)
+	}
+	sequence, err := parseSequence(headers[HeaderAggregateSequence])
+	if err != nil {
+		return domain.RecordEvent{}, err
+	}
+	return domain.RecordEvent{
+		EventID:           firstNonEmpty(envelope.EventID, headers[HeaderEventID]),
+		EventType:         firstNonEmpty(envelope.EventType, headers[HeaderEventType]),
+		EventVersion:      envelope.EventVersion,
+		TraceID:           envelope.TraceID,
+		RequestID:         envelope.RequestID,
+		PayloadJSON:       envelope.Payload,
+		AggregateSequence: sequence,
+	}, nil
+}
+
+func fromCloudEvent(event cloudEvent) domain.RecordEvent {
+	return domain.RecordEvent{
+		EventID:           event.ID,
+		EventType:         event.Type,
+		EventVersion:      event.EventVersion,
+		TraceID:           event.TraceID,
+		RequestID:         event.RequestID,
+		PayloadJSON:       event.Data,
+		AggregateSequence: event.AggregateSequence,
+	}
+}
+
+// parseSequence reads an aggregate sequence header; events published before sequences existed have none.
+func parseSequence(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	sequence, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse aggregate sequence: %w", err)
+	}
+	return sequence, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
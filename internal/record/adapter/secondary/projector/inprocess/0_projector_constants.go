@@ -0,0 +1,12 @@
+// Package inprocess carries the built-in record projector over the in-process event bus: record
+// events in from the in-process outbox publisher, projection-ready events out to the realtime reader.
+package inprocess
+
+import "errors"
+
+// Partition is the partition reported for bus messages. The bus has no offsets, so the aggregate
+// sequence of each record event stands in for one: it grows with every event of a record.
+const Partition = -1
+
+// ErrReaderClosed is returned by Read after Close.
+var ErrReaderClosed = errors.New("record projector in-process reader closed")
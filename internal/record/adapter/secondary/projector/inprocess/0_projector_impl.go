@@ -0,0 +1,36 @@
+package inprocess
+
+import (
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+)
+
+// RecordEventReader reads record outbox events published on the in-process bus.
+type RecordEventReader struct {
+	messages <-chan eventbus.Message
+	cancel   func()
+}
+
+// NewRecordEventReader subscribes to topic right away, so no event published after construction
+// is missed while the projector loop starts.
+func NewRecordEventReader(bus *eventbus.Bus, topic string, buffer int) *RecordEventReader {
+	messages, cancel := bus.Subscribe(topic, buffer)
+	return &RecordEventReader{messages: messages, cancel: cancel}
+}
+
+// Close unsubscribes from the bus.
+func (r *RecordEventReader) Close() error {
+	r.cancel()
+	return nil
+}
+
+// ProjectionReadyPublisher publishes projection-ready events on the in-process bus.
+type ProjectionReadyPublisher struct {
+	bus   *eventbus.Bus
+	topic string
+}
+
+// NewProjectionReadyPublisher publishes to topic, usually the record projection topic the
+// in-process realtime reader subscribes to.
+func NewProjectionReadyPublisher(bus *eventbus.Bus, topic string) *ProjectionReadyPublisher {
+	return &ProjectionReadyPublisher{bus: bus, topic: topic}
+}
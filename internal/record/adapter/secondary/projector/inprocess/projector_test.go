@@ -0,0 +1,82 @@
+package inprocess_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/projector/inprocess"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+const (
+	recordTopic     = "aion.record.events.v1"
+	projectionTopic = "aion.record_projection.events.v1"
+)
+
+func TestReadDecodesRecordEventWithSequenceAsOffset(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	reader := inprocess.NewRecordEventReader(bus, recordTopic, 1)
+	defer reader.Close()
+
+	err := bus.Publish(context.Background(), eventbus.Message{
+		Topic:   recordTopic,
+		Key:     "42",
+		Headers: map[string]string{"aggregate_sequence": "5"},
+		Value: []byte(`{"event_id":"evt-5","event_type":"record.updated","event_version":"v1",` +
+			`"payload":{"record_id":42,"user_id":14,"tag_id":7,"event_time_utc":"2026-03-13T13:00:00Z"}}`),
+	})
+	if err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	event, err := reader.Read(context.Background())
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if event.Topic != recordTopic || event.Partition != inprocess.Partition || event.Offset != 5 {
+		t.Fatalf("unexpected position: %s/%d/%d", event.Topic, event.Partition, event.Offset)
+	}
+	if event.EventID != "evt-5" || event.Payload.RecordID != 42 {
+		t.Fatalf("unexpected event: %#v", event)
+	}
+	if err := reader.Commit(context.Background(), event); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	if err := reader.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if _, err := reader.Read(context.Background()); !errors.Is(err, inprocess.ErrReaderClosed) {
+		t.Fatalf("expected closed reader error, got %v", err)
+	}
+}
+
+func TestPublishProjectionReadyReachesTopicSubscribers(t *testing.T) {
+	t.Parallel()
+
+	bus := eventbus.New()
+	messages, cancel := bus.Subscribe(projectionTopic, 1)
+	defer cancel()
+
+	publisher := inprocess.NewProjectionReadyPublisher(bus, projectionTopic)
+	err := publisher.PublishProjectionReady(context.Background(), domain.ProjectionReady{
+		EventType:      "record.projection.deleted",
+		UserID:         14,
+		RecordID:       42,
+		SourceEventID:  "evt-6",
+		ProjectedAtUTC: time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	message := <-messages
+	if message.Key != "42" || message.Headers["event_type"] != "record.projection.deleted" {
+		t.Fatalf("unexpected message: %#v", message)
+	}
+}
@@ -0,0 +1,23 @@
+package inprocess
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/platform/eventbus"
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/projector/codec"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// PublishProjectionReady hands one projection-ready event to the bus subscribers of the topic.
+func (p *ProjectionReadyPublisher) PublishProjectionReady(ctx context.Context, event domain.ProjectionReady) error {
+	message, err := codec.EncodeProjectionReady(event)
+	if err != nil {
+		return err
+	}
+	return p.bus.Publish(ctx, eventbus.Message{
+		Topic:   p.topic,
+		Key:     message.Key,
+		Value:   message.Value,
+		Headers: message.Headers,
+	})
+}
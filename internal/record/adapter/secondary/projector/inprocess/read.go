@@ -0,0 +1,33 @@
+package inprocess
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/projector/codec"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// Read blocks for the next record event on the bus and decodes it.
+func (r *RecordEventReader) Read(ctx context.Context) (domain.RecordEvent, error) {
+	select {
+	case <-ctx.Done():
+		return domain.RecordEvent{}, ctx.Err()
+	case message, ok := <-r.messages:
+		if !ok {
+			return domain.RecordEvent{}, ErrReaderClosed
+		}
+		event, err := codec.DecodeRecordEvent(message.Value, message.Headers)
+		if err != nil {
+			return domain.RecordEvent{}, err
+		}
+		event.Topic = message.Topic
+		event.Partition = Partition
+		event.Offset = event.AggregateSequence
+		return event, nil
+	}
+}
+
+// Commit does nothing: the bus keeps no consumer position.
+func (r *RecordEventReader) Commit(context.Context, domain.RecordEvent) error {
+	return nil
+}
@@ -0,0 +1,11 @@
+// Package kafka carries the built-in record projector over Kafka: record events in from the record
+// events topic, projection-ready events out to the record projection topic.
+package kafka
+
+import "time"
+
+const (
+	// writerBatchTimeout bounds how long the writer waits to fill a batch; projection-ready events
+	// are written one at a time, so the kafka-go default of one second would only add latency.
+	writerBatchTimeout = 10 * time.Millisecond
+)
@@ -0,0 +1,68 @@
+package kafka
+
+import (
+	"strings"
+
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+// RecordEventReader reads record outbox events from Kafka within a consumer group and commits
+// offsets explicitly, once an event has been projected.
+type RecordEventReader struct {
+	reader *kafkago.Reader
+}
+
+// NewRecordEventReader creates a reader bound to the record events topic.
+func NewRecordEventReader(brokers string, groupID string, topic string) *RecordEventReader {
+	return &RecordEventReader{
+		reader: kafkago.NewReader(kafkago.ReaderConfig{
+			Brokers:  splitBrokers(brokers),
+			GroupID:  groupID,
+			Topic:    topic,
+			MinBytes: 1,
+			MaxBytes: 10e6,
+		}),
+	}
+}
+
+// Close releases the underlying Kafka reader resources.
+func (r *RecordEventReader) Close() error {
+	return r.reader.Close()
+}
+
+// ProjectionReadyPublisher writes projection-ready events to the record projection topic.
+type ProjectionReadyPublisher struct {
+	writer *kafkago.Writer
+}
+
+// NewProjectionReadyPublisher creates a writer for topic. Messages are keyed by record id and
+// hashed to partitions, so the changes of one record keep their order.
+func NewProjectionReadyPublisher(brokers string, topic string) *ProjectionReadyPublisher {
+	return &ProjectionReadyPublisher{
+		writer: &kafkago.Writer{
+			Addr:         kafkago.TCP(splitBrokers(brokers)...),
+			Topic:        topic,
+			Balancer:     &kafkago.Hash{},
+			RequiredAcks: kafkago.RequireAll,
+			BatchTimeout: writerBatchTimeout,
+		},
+	}
+}
+
+// Close flushes and releases the underlying Kafka writer.
+func (p *ProjectionReadyPublisher) Close() error {
+	return p.writer.Close()
+}
+
+func splitBrokers(value string) []string {
+	parts := strings.Split(value, ",")
+	out := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		out = append(out, part)
+	}
+	return out
+}
@@ -0,0 +1,34 @@
+//nolint:testpackage // Tests validate package-private header helpers directly.
+package kafka
+
+import (
+	"testing"
+
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+func TestHeaderConversion(t *testing.T) {
+	headers := kafkaHeaders(map[string]string{
+		"traceparent":  "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"event_type":   "record.projection.created",
+		"content-type": "application/json",
+	})
+	if len(headers) != 3 || headers[0].Key != "content-type" || headers[2].Key != "traceparent" {
+		t.Fatalf("expected headers sorted by key, got %#v", headers)
+	}
+
+	back := headerMap(append(headers, kafkago.Header{Key: "event_type", Value: []byte("record.projection.updated")}))
+	if back["event_type"] != "record.projection.updated" {
+		t.Fatalf("expected the last repeated header to win, got %q", back["event_type"])
+	}
+	if back["traceparent"] != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Fatalf("unexpected traceparent %q", back["traceparent"])
+	}
+}
+
+func TestSplitBrokers(t *testing.T) {
+	got := splitBrokers(" kafka-1:9092, ,kafka-2:9092 ")
+	if len(got) != 2 || got[0] != "kafka-1:9092" || got[1] != "kafka-2:9092" {
+		t.Fatalf("unexpected brokers %#v", got)
+	}
+}
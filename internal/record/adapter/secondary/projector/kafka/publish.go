@@ -0,0 +1,33 @@
+package kafka
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/projector/codec"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+// PublishProjectionReady writes one projection-ready event and waits for every in-sync replica.
+func (p *ProjectionReadyPublisher) PublishProjectionReady(ctx context.Context, event domain.ProjectionReady) error {
+	message, err := codec.EncodeProjectionReady(event)
+	if err != nil {
+		return err
+	}
+	return p.writer.WriteMessages(ctx, kafkago.Message{
+		Key:     []byte(message.Key),
+		Value:   message.Value,
+		Headers: kafkaHeaders(message.Headers),
+	})
+}
+
+// kafkaHeaders turns the encoded header map into Kafka headers in a stable order.
+func kafkaHeaders(headers map[string]string) []kafkago.Header {
+	out := make([]kafkago.Header, 0, len(headers))
+	for _, key := range slices.Sorted(maps.Keys(headers)) {
+		out = append(out, kafkago.Header{Key: key, Value: []byte(headers[key])})
+	}
+	return out
+}
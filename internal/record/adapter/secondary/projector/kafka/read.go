@@ -0,0 +1,42 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/record/adapter/secondary/projector/codec"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+// Read fetches the next record event without committing it and decodes it, keeping the topic,
+// partition and offset it was read from. A message that cannot be decoded still comes back with
+// its position next to the error, so the caller can commit past it.
+func (r *RecordEventReader) Read(ctx context.Context) (domain.RecordEvent, error) {
+	message, err := r.reader.FetchMessage(ctx)
+	if err != nil {
+		return domain.RecordEvent{}, err
+	}
+	event, err := codec.DecodeRecordEvent(message.Value, headerMap(message.Headers))
+	event.Topic = message.Topic
+	event.Partition = message.Partition
+	event.Offset = message.Offset
+	return event, err
+}
+
+// Commit stores the offset of event for the consumer group.
+func (r *RecordEventReader) Commit(ctx context.Context, event domain.RecordEvent) error {
+	return r.reader.CommitMessages(ctx, kafkago.Message{
+		Topic:     event.Topic,
+		Partition: event.Partition,
+		Offset:    event.Offset,
+	})
+}
+
+// headerMap indexes Kafka headers by key; a repeated key keeps its last value.
+func headerMap(headers []kafkago.Header) map[string]string {
+	out := make(map[string]string, len(headers))
+	for _, header := range headers {
+		out[header.Key] = string(header.Value)
+	}
+	return out
+}
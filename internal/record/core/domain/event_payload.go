@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// RecordEventPayloadV1 is the outbox payload of record.created, record.updated, record.deleted, and record.restored at v1.
+// Its shape is pinned by the record.v1 JSON Schema in the event schema registry.
+type RecordEventPayloadV1 struct {
+	RecordedAtUTC   *time.Time `json:"recorded_at_utc"`
+	Status          *string    `json:"status"`
+	Timezone        *string    `json:"timezone"`
+	DurationSeconds *int       `json:"duration_seconds"`
+	Value           *float64   `json:"value"`
+	Source          *string    `json:"source"`
+	Description     *string    `json:"description"`
+	EventTimeUTC    string     `json:"event_time_utc"`
+	RecordID        uint64     `json:"record_id"`
+	UserID          uint64     `json:"user_id"`
+	TagID           uint64     `json:"tag_id"`
+}
+
+// MetricDefinitionEventPayloadV1 is the outbox payload of metric_definition.created and metric_definition.updated at v1.
+// Its shape is pinned by the metric_definition.v1 JSON Schema in the event schema registry.
+type MetricDefinitionEventPayloadV1 struct {
+	CategoryID         *uint64  `json:"category_id"`
+	GoalDefault        *float64 `json:"goal_default"`
+	MetricKey          string   `json:"metric_key"`
+	DisplayName        string   `json:"display_name"`
+	ValueSource        string   `json:"value_source"`
+	Aggregation        string   `json:"aggregation"`
+	Unit               string   `json:"unit"`
+	UpdatedAtUTC       string   `json:"updated_at_utc"`
+	TagIDs             []uint64 `json:"tag_ids"`
+	MetricDefinitionID uint64   `json:"metric_definition_id"`
+	UserID             uint64   `json:"user_id"`
+	TagID              uint64   `json:"tag_id"`
+	IsActive           bool     `json:"is_active"`
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// Projection drift kinds reported when aion_derived.record_projection_v1 disagrees with aion_api.records.
+const (
+	// DriftMissing marks a live record without a projection row.
+	DriftMissing = "missing"
+	// DriftStale marks a projection last written before the record's latest update.
+	DriftStale = "stale"
+	// DriftMismatch marks a projection whose projected fields differ from the record.
+	DriftMismatch = "mismatch"
+	// DriftOrphaned marks a projection whose record was deleted or never existed.
+	DriftOrphaned = "orphaned"
+)
+
+// DriftKinds lists every drift kind in report order.
+func DriftKinds() []string {
+	return []string{DriftMissing, DriftStale, DriftMismatch, DriftOrphaned}
+}
+
+// ProjectionDrift is one record whose projection disagrees with the canonical row. Record is
+// empty for orphaned projections and Projection is empty for missing ones; Fields names the
+// differing columns of a mismatch.
+type ProjectionDrift struct {
+	Kind       string
+	RecordID   uint64
+	UserID     uint64
+	Fields     []string
+	Record     Record
+	Projection RecordProjection
+}
+
+// ProjectionDriftReport is the outcome of comparing one user's records with their projections.
+type ProjectionDriftReport struct {
+	UserID       uint64
+	CheckedAtUTC time.Time
+	Records      int
+	Projections  int
+	Drifts       []ProjectionDrift
+	Repaired     int
+}
+
+// Counts returns the number of drifts per kind, with every kind present.
+func (r ProjectionDriftReport) Counts() map[string]int {
+	counts := make(map[string]int, len(DriftKinds()))
+	for _, kind := range DriftKinds() {
+		counts[kind] = 0
+	}
+	for _, drift := range r.Drifts {
+		counts[drift.Kind]++
+	}
+	return counts
+}
@@ -20,6 +20,11 @@ type Record struct {
 	Timezone     *string  `json:"timezone,omitempty"        db:"timezone"`
 	Status       *string  `json:"status,omitempty"          db:"status"`
 
+	// IdempotencyKey is the client key the record was created under; IdempotencyFingerprint
+	// identifies the create payload so a retry under the same key can be told from a reuse.
+	IdempotencyKey         *string `json:"idempotencyKey,omitempty"         db:"idempotency_key"`
+	IdempotencyFingerprint *string `json:"idempotencyFingerprint,omitempty" db:"idempotency_fingerprint"`
+
 	CreatedAt time.Time  `json:"createdAt"           db:"created_at"`
 	UpdatedAt time.Time  `json:"updatedAt"           db:"updated_at"`
 	DeletedAt *time.Time `json:"deletedAt,omitempty" db:"deleted_at"`
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// RecordEvent is one record outbox event read back from the event transport by the built-in
+// projector, together with the position it was read from. Topic, Partition and Offset end up in
+// the LastKafka* columns of the projection and order redeliveries of the same record.
+type RecordEvent struct {
+	EventID           string
+	EventType         string
+	EventVersion      string
+	TraceID           string
+	RequestID         string
+	TraceParent       string
+	TraceState        string
+	Topic             string
+	PayloadJSON       []byte
+	Payload           RecordEventPayloadV1
+	AggregateSequence int64
+	Offset            int64
+	Partition         int
+}
+
+// ProjectionReady announces one applied change of the record projection to realtime consumers.
+// EventType is record.projection.created, record.projection.updated or record.projection.deleted.
+type ProjectionReady struct {
+	ProjectedAtUTC  time.Time
+	EventType       string
+	EventVersion    string
+	SourceEventID   string
+	SourceEventType string
+	TraceID         string
+	RequestID       string
+	TraceParent     string
+	TraceState      string
+	UserID          uint64
+	RecordID        uint64
+	TagID           uint64
+	CategoryID      uint64
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// Revision operations name the change a record revision captures.
+const (
+	// RevisionOperationUpdated marks an in-place edit of one or more record fields.
+	RevisionOperationUpdated = "updated"
+	// RevisionOperationDeleted marks a soft delete.
+	RevisionOperationDeleted = "deleted"
+	// RevisionOperationRestored marks a restore out of the trash.
+	RevisionOperationRestored = "restored"
+)
+
+// Revision sources name the surface a change came through.
+const (
+	// RevisionSourceWeb marks a change made with a user session.
+	RevisionSourceWeb = "web"
+	// RevisionSourceChat marks a change made by aion-chat on the user's behalf.
+	RevisionSourceChat = "chat"
+	// RevisionSourceServiceToken marks a change made by any other service-key caller.
+	RevisionSourceServiceToken = "service_token"
+)
+
+// RecordFieldChange is one field of a revision. Values are rendered as strings (times in RFC 3339,
+// numbers in their shortest form); nil means the field was unset.
+type RecordFieldChange struct {
+	Field    string
+	OldValue *string
+	NewValue *string
+}
+
+// RecordRevision is one immutable entry of a record's change log. ActorUserID is the user the change
+// was made for; ActorService names the calling service when the change came through a service key.
+type RecordRevision struct {
+	ID           uint64
+	RecordID     uint64
+	UserID       uint64
+	Operation    string
+	Changes      []RecordFieldChange
+	ActorUserID  uint64
+	ActorService *string
+	Source       string
+	RequestID    string
+	CreatedAt    time.Time
+}
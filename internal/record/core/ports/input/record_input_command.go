@@ -1,7 +1,11 @@
 // Package input defines input DTOs and commands for the record use cases.
 package input
 
-import "time"
+import (
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
 
 // CreateRecordCommand represents input for creating a record via usecase.
 // Note: category is obtained via Tag relationship (Record → Tag → Category).
@@ -16,6 +20,32 @@ type CreateRecordCommand struct {
 	Source       *string    `json:"source,omitempty"`
 	Timezone     *string    `json:"timezone,omitempty"`
 	Status       *string    `json:"status,omitempty"`
+	// IdempotencyKey is a client-generated key, unique per user. A retry with the same key and
+	// payload returns the original record; the same key with another payload is a conflict.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// Batch create modes decide what happens to valid items when another item fails validation.
+const (
+	// CreateRecordsModeAllOrNothing writes nothing unless every item is valid.
+	CreateRecordsModeAllOrNothing = "ALL_OR_NOTHING"
+	// CreateRecordsModeBestEffort writes the valid items and reports the others.
+	CreateRecordsModeBestEffort = "BEST_EFFORT"
+)
+
+// CreateRecordsCommand represents a batch of records created in one transaction. An empty Mode
+// means CreateRecordsModeAllOrNothing.
+type CreateRecordsCommand struct {
+	Items []CreateRecordCommand
+	Mode  string
+}
+
+// CreateRecordResult is the outcome of one batch item, at the item's Index in the command.
+// Err is nil when Record was created.
+type CreateRecordResult struct {
+	Index  int
+	Record domain.Record
+	Err    error
 }
 
 // UpdateRecordCommand represents fields allowed to be updated.
@@ -7,9 +7,10 @@ import (
 	"github.com/lechitz/aion-api/internal/record/core/domain"
 )
 
-// RecordCreator interface for creating a new record.
+// RecordCreator interface for creating new records.
 type RecordCreator interface {
 	Create(ctx context.Context, cmd CreateRecordCommand) (domain.Record, error)
+	CreateMany(ctx context.Context, cmd CreateRecordsCommand) ([]CreateRecordResult, error)
 }
 
 // RecordRetriever defines methods for retrieving record details.
@@ -35,6 +36,18 @@ type RecordDeleter interface {
 	DeleteAll(ctx context.Context, userID uint64) error
 }
 
+// RecordRestorer defines trash operations on soft-deleted records.
+type RecordRestorer interface {
+	ListDeleted(ctx context.Context, userID uint64, limit int, afterDeletedAt *string, afterID *int64) ([]domain.Record, error)
+	Restore(ctx context.Context, recordID uint64, userID uint64) (domain.Record, error)
+	RestoreMany(ctx context.Context, recordIDs []uint64, userID uint64) ([]domain.Record, error)
+}
+
+// RecordHistoryReader defines read access to a record's change log.
+type RecordHistoryReader interface {
+	History(ctx context.Context, recordID uint64, userID uint64, limit int) ([]domain.RecordRevision, error)
+}
+
 // RecordService defines the input port used by controllers/handlers to interact with record use cases.
 type RecordService interface {
 	RecordCreator
@@ -42,6 +55,8 @@ type RecordService interface {
 	RecordProjectionRetriever
 	RecordUpdater
 	RecordDeleter
+	RecordRestorer
+	RecordHistoryReader
 
 	// SearchRecords performs full-text search with filters
 	SearchRecords(ctx context.Context, userID uint64, filters domain.SearchFilters) ([]domain.Record, error)
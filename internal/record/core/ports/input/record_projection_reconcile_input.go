@@ -0,0 +1,23 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// ReconcileOptions selects the users a drift check covers and whether drift is repaired. A zero
+// UserID checks every user; records and projections written within Grace are skipped as in flight.
+type ReconcileOptions struct {
+	UserID uint64
+	Repair bool
+	Grace  time.Duration
+}
+
+// RecordProjectionReconciler compares the derived record projection with canonical records and
+// repairs it by re-enqueuing record outbox events.
+type RecordProjectionReconciler interface {
+	Reconcile(ctx context.Context, opts ReconcileOptions) ([]domain.ProjectionDriftReport, error)
+	Rebuild(ctx context.Context, userID uint64) (int, error)
+}
@@ -0,0 +1,12 @@
+package input
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// RecordProjector applies record events to the derived projection when aion-streams is not deployed.
+type RecordProjector interface {
+	Project(ctx context.Context, event domain.RecordEvent) error
+}
@@ -0,0 +1,8 @@
+package input
+
+import "context"
+
+// RecordTrashPurger hard-deletes soft-deleted records once they outlive the trash retention.
+type RecordTrashPurger interface {
+	PurgeDeleted(ctx context.Context) (int64, error)
+}
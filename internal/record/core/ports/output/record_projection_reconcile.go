@@ -0,0 +1,16 @@
+package output
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// RecordProjectionReconcileRepository reads both sides of the record projection for drift checks
+// and clears a user's projections before a rebuild.
+type RecordProjectionReconcileRepository interface {
+	ListProjectionUserIDs(ctx context.Context) ([]uint64, error)
+	ListCanonicalRecords(ctx context.Context, userID uint64) ([]domain.Record, error)
+	ListUserProjections(ctx context.Context, userID uint64) ([]domain.RecordProjection, error)
+	DeleteUserProjections(ctx context.Context, userID uint64) (int64, error)
+}
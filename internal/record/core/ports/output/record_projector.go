@@ -0,0 +1,28 @@
+package output
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+)
+
+// RecordProjectionWriter materializes record events into the derived projection. Both writes are
+// idempotent: they report applied=false when the row already reflects the event or a later one,
+// which the projector uses to suppress duplicate projection-ready events.
+type RecordProjectionWriter interface {
+	UpsertProjection(ctx context.Context, projection domain.RecordProjection) (applied bool, err error)
+	DeleteProjection(ctx context.Context, projection domain.RecordProjection) (applied bool, err error)
+}
+
+// RecordEventReader reads record outbox events for the built-in projector. Commit acknowledges an
+// event once it has been projected, so a restart resumes after it.
+type RecordEventReader interface {
+	Read(ctx context.Context) (domain.RecordEvent, error)
+	Commit(ctx context.Context, event domain.RecordEvent) error
+	Close() error
+}
+
+// ProjectionReadyPublisher emits projection-ready events to the transport realtime reads from.
+type ProjectionReadyPublisher interface {
+	PublishProjectionReady(ctx context.Context, event domain.ProjectionReady) error
+}
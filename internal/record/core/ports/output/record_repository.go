@@ -11,6 +11,8 @@ import (
 // RecordRepository defines persistence operations for records.
 type RecordRepository interface {
 	Create(ctx context.Context, r domain.Record) (domain.Record, error)
+	CreateMany(ctx context.Context, records []domain.Record) ([]domain.Record, error)
+	ListByIdempotencyKeys(ctx context.Context, userID uint64, keys []string) ([]domain.Record, error)
 	Update(ctx context.Context, r domain.Record) (domain.Record, error)
 	GetByID(ctx context.Context, recordID uint64, userID uint64) (domain.Record, error)
 	GetByUserCategoryDate(ctx context.Context, userID uint64, categoryID uint64, date time.Time) (domain.Record, error)
@@ -23,8 +25,18 @@ type RecordRepository interface {
 	ListAllUntil(ctx context.Context, userID uint64, until time.Time, limit int) ([]domain.Record, error)
 	ListAllBetween(ctx context.Context, userID uint64, startDate time.Time, endDate time.Time, limit int) ([]domain.Record, error)
 
-	Delete(ctx context.Context, id uint64, userID uint64) error
-	DeleteAllByUser(ctx context.Context, userID uint64) error
+	Delete(ctx context.Context, id uint64, userID uint64, deletedAt time.Time) error
+	DeleteMany(ctx context.Context, userID uint64, ids []uint64, deletedAt time.Time) error
+
+	// Trash: soft-deleted records can be listed, restored, or hard-purged after retention
+	ListDeleted(ctx context.Context, userID uint64, limit int, afterDeletedAt *string, afterID *int64) ([]domain.Record, error)
+	ListDeletedByIDs(ctx context.Context, userID uint64, ids []uint64) ([]domain.Record, error)
+	Restore(ctx context.Context, userID uint64, ids []uint64, restoredAt time.Time) error
+	PurgeDeleted(ctx context.Context, before time.Time, limit int) (int64, error)
+
+	// Revisions: an append-only change log written in the transaction of each update, delete and restore
+	CreateRevisions(ctx context.Context, revisions []domain.RecordRevision) error
+	ListRevisions(ctx context.Context, userID uint64, recordID uint64, limit int) ([]domain.RecordRevision, error)
 
 	// SearchRecords performs text search with filters
 	SearchRecords(ctx context.Context, userID uint64, filters domain.SearchFilters) ([]domain.Record, error)
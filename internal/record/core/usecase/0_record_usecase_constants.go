@@ -1,6 +1,9 @@
 package usecase
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // =============================================================================
 // TRACING - OpenTelemetry Instrumentation
@@ -10,6 +13,9 @@ import "errors"
 // Format: aion-api.<domain>.<layer> .
 const TracerName = "aion-api.record.usecase"
 
+// MeterName identifies the OpenTelemetry meter for the Record usecase package.
+const MeterName = "aion-api.record.usecase"
+
 // -----------------------------------------------------------------------------
 // Span Names
 // Format: <domain>.<operation>
@@ -19,6 +25,9 @@ const (
 	// SpanCreate is the span name for creating a record.
 	SpanCreate = "record.create"
 
+	// SpanCreateMany is the span name for creating a batch of records.
+	SpanCreateMany = "record.create_many"
+
 	// SpanGetByID is the span name for getting a record by ID.
 	SpanGetByID = "record.get_by_id"
 
@@ -61,11 +70,38 @@ const (
 	// SpanSoftDelete is the span name for soft-deleting a record.
 	SpanSoftDelete = "record.soft_delete"
 
+	// SpanDeleteAll is the span name for soft-deleting every record of a user.
+	SpanDeleteAll = "record.delete_all"
+
+	// SpanListDeleted is the span name for listing soft-deleted records.
+	SpanListDeleted = "record.list_deleted"
+
+	// SpanRestore is the span name for restoring soft-deleted records.
+	SpanRestore = "record.restore"
+
+	// SpanPurgeDeleted is the span name for hard-purging records past the trash retention.
+	SpanPurgeDeleted = "record.trash.purge"
+
+	// SpanHistory is the span name for listing a record's revisions.
+	SpanHistory = "record.history"
+
 	// SpanInsightFeed is the span name for computing canonical insights.
 	SpanInsightFeed = "record.insight_feed"
 
 	// SpanAnalyticsSeries is the span name for computing dashboard analytics series.
 	SpanAnalyticsSeries = "record.analytics_series"
+
+	// SpanProject is the span name for applying one record event to the derived projection.
+	SpanProject = "record.project"
+
+	// SpanReconcileProjection is the span name for checking one user's projection drift.
+	SpanReconcileProjection = "record.projection.reconcile"
+
+	// SpanRebuildProjection is the span name for rebuilding one user's projections.
+	SpanRebuildProjection = "record.projection.rebuild"
+
+	// SpanPublishRealtime is the span name for announcing committed record changes to the dashboard streams.
+	SpanPublishRealtime = "record.realtime.dashboard"
 )
 
 // -----------------------------------------------------------------------------
@@ -92,6 +128,18 @@ const (
 	// EventRepositoryDelete marks the repository delete call.
 	EventRepositoryDelete = "record.repository.delete"
 
+	// EventRepositoryRestore marks the repository restore call.
+	EventRepositoryRestore = "record.repository.restore"
+
+	// EventRepositoryPurge marks one repository purge batch.
+	EventRepositoryPurge = "record.repository.purge"
+
+	// EventRepositoryRevision marks writing the change's record revisions.
+	EventRepositoryRevision = "record.repository.revision"
+
+	// EventIdempotentReplay marks a create answered with the record stored under its idempotency key.
+	EventIdempotentReplay = "record.idempotent_replay"
+
 	// EventCheckCache marks checking cache for records.
 	EventCheckCache = "record.cache.check"
 
@@ -118,6 +166,21 @@ const (
 
 	// EventRepositoryTags marks tag lookups used for scoped insights.
 	EventRepositoryTags = "record.repository.tags"
+
+	// EventProjectionWrite marks the idempotent projection upsert or delete.
+	EventProjectionWrite = "record.projection.write"
+
+	// EventProjectionReadyPublish marks emitting the projection-ready event.
+	EventProjectionReadyPublish = "record.projection.ready.publish"
+
+	// EventProjectionCompare marks comparing canonical records with their projections.
+	EventProjectionCompare = "record.projection.compare"
+
+	// EventProjectionClear marks deleting a user's projections before a rebuild.
+	EventProjectionClear = "record.projection.clear"
+
+	// EventPublishRealtime marks announcing dashboard invalidations and goal status changes to the user's realtime streams.
+	EventPublishRealtime = "record.realtime.publish"
 )
 
 // -----------------------------------------------------------------------------
@@ -137,6 +200,12 @@ const (
 	// StatusDeleted indicates a record was deleted.
 	StatusDeleted = "deleted"
 
+	// StatusRestored indicates soft-deleted records were restored.
+	StatusRestored = "restored"
+
+	// StatusPurged indicates records past the trash retention were hard-deleted.
+	StatusPurged = "purged"
+
 	// StatusListedAll indicates all records were listed.
 	StatusListedAll = "listed_all"
 
@@ -145,6 +214,18 @@ const (
 
 	// StatusStatsComputed indicates analytics computation completed.
 	StatusStatsComputed = "stats_computed"
+
+	// StatusProjected indicates a record event changed the derived projection.
+	StatusProjected = "projected"
+
+	// StatusProjectionSkipped indicates the projection already reflected the record event.
+	StatusProjectionSkipped = "projection_skipped"
+
+	// StatusProjectionReconciled indicates a drift check, and any repair, completed.
+	StatusProjectionReconciled = "projection_reconciled"
+
+	// StatusProjectionRebuilt indicates a user's projections were cleared and their records re-enqueued.
+	StatusProjectionRebuilt = "projection_rebuilt"
 )
 
 // =============================================================================
@@ -177,6 +258,30 @@ const (
 	// FailedToDeleteRecord indicates failure to delete a record.
 	FailedToDeleteRecord = "failed to delete record"
 
+	// FailedToRestoreRecords indicates failure to restore soft-deleted records.
+	FailedToRestoreRecords = "failed to restore records"
+
+	// FailedToListRecordHistory indicates failure to list a record's revisions.
+	FailedToListRecordHistory = "failed to list record history"
+
+	// FailedToPurgeDeletedRecords indicates failure to hard-purge records past the trash retention.
+	FailedToPurgeDeletedRecords = "failed to purge deleted records"
+
+	// FailedToProjectRecordEvent indicates failure to apply a record event to the derived projection.
+	FailedToProjectRecordEvent = "failed to project record event"
+
+	// InvalidRecordEventTime indicates a record event carries an unparsable event_time_utc.
+	InvalidRecordEventTime = "invalid record event time"
+
+	// FailedToReconcileProjection indicates failure to compare records with their projections.
+	FailedToReconcileProjection = "failed to reconcile record projection"
+
+	// FailedToRebuildProjection indicates failure to rebuild a user's projections.
+	FailedToRebuildProjection = "failed to rebuild record projection"
+
+	// OutboxUnavailable indicates a repair was requested without an outbox service.
+	OutboxUnavailable = "record outbox service unavailable"
+
 	// RecordNotFound indicates the record was not found.
 	RecordNotFound = "record not found"
 
@@ -188,7 +293,15 @@ const (
 const (
 	LogRecordUpdatedSuccessfully = "record updated successfully"
 	LogRecordCreatedSuccessfully = "record created successfully"
+	LogRecordsCreated            = "record batch created"
 	LogRecordSoftDeletedSuccess  = "record soft-deleted successfully"
+	LogRecordsRestored           = "records restored successfully"
+	LogAllRecordsSoftDeleted     = "all records soft-deleted successfully"
+	LogDeletedRecordsListed      = "deleted records listed successfully"
+	LogDeletedRecordsPurged      = "deleted records purged"
+	LogTrashMetricsUnavailable   = "record trash metrics unavailable"
+	LogRecordHistoryListed       = "record history listed successfully"
+	LogRecordCreateReplayed      = "record create replayed from idempotency key"
 
 	LogFailedSaveRecordToCacheAfterCreation = "failed to save record to cache after creation"
 	LogFailedInvalidateRecordCache          = "failed to invalidate record cache"
@@ -212,6 +325,13 @@ const (
 	AttrWindow       = "window"
 	AttrTagIDsCount  = "tag_ids_count"
 	AttrEventType    = "event_type"
+
+	AttrRequestedCount = "requested_count"
+	AttrCreatedCount   = "created_count"
+	AttrFailedCount    = "failed_count"
+	AttrMode           = "mode"
+	AttrCutoff         = "cutoff"
+	AttrRealtimeDays   = "realtime_day_count"
 )
 
 // Outbox event constants.
@@ -241,6 +361,30 @@ const (
 	// StartDateMustBeBeforeEndDate indicates start date validation error.
 	StartDateMustBeBeforeEndDate = "startDate must be before or equal to endDate"
 
+	// RecordIDsAreRequired indicates a restore was requested without record IDs.
+	RecordIDsAreRequired = "at least one record ID is required"
+
+	// RestoreBatchTooLarge indicates a restore asked for more records than one call may restore.
+	RestoreBatchTooLarge = "too many records to restore at once"
+
+	// RecordsAreRequired indicates a batch create was requested without items.
+	RecordsAreRequired = "at least one record is required"
+
+	// CreateBatchTooLarge indicates a batch create asked for more records than one call may create.
+	CreateBatchTooLarge = "too many records to create at once"
+
+	// InvalidCreateRecordsMode indicates a batch create mode other than ALL_OR_NOTHING or BEST_EFFORT.
+	InvalidCreateRecordsMode = "invalid create records mode"
+
+	// BatchItemAborted indicates a valid batch item was not written because another item failed.
+	BatchItemAborted = "not created: another item in the batch failed"
+
+	// IdempotencyKeyTooLong indicates an idempotency key longer than MaxIdempotencyKeyLength.
+	IdempotencyKeyTooLong = "idempotency key is too long"
+
+	// IdempotencyKeyConflict indicates an idempotency key reused with a different create payload.
+	IdempotencyKeyConflict = "idempotency key was already used with a different payload"
+
 	// InvalidRecordIDOrUserID indicates invalid record or user ID.
 	InvalidRecordIDOrUserID = "invalid recordID or userID"
 
@@ -272,6 +416,9 @@ const (
 	DefaultTimezone = "America/Sao_Paulo"
 	// DefaultDashboardLimit caps records loaded for dashboard snapshot calculations.
 	DefaultDashboardLimit = 50000
+	// MaxRealtimeDashboardDays caps the days a record write announces one by one; a write touching
+	// more days publishes a single dateless dashboard invalidation instead.
+	MaxRealtimeDashboardDays = 7
 	// DefaultDashboardViewName is the default dashboard view title.
 	DefaultDashboardViewName = "Meu Dashboard"
 	// FallbackDashboardViewName is the fallback view title when bootstraping.
@@ -288,6 +435,30 @@ const (
 	DefaultInsightFeedLimit = 8
 	// MaxInsightFeedLimit prevents oversized insight payloads.
 	MaxInsightFeedLimit = 20
+	// DefaultDeletedListLimit is the trash page size when none or an oversized one is requested.
+	DefaultDeletedListLimit = 50
+	// MaxDeletedListLimit caps one trash page.
+	MaxDeletedListLimit = 100
+	// MaxRestoreBatchSize caps the records one restore call may bring back.
+	MaxRestoreBatchSize = 100
+	// DeleteAllPageSize is how many live records delete-all loads, deletes and logs per step.
+	DeleteAllPageSize = 200
+	// MaxCreateBatchSize caps the records one batch create may write.
+	MaxCreateBatchSize = 100
+	// MaxIdempotencyKeyLength matches the idempotency_key column.
+	MaxIdempotencyKeyLength = 128
+	// DefaultTrashRetention keeps soft-deleted records restorable for 30 days.
+	DefaultTrashRetention = 30 * 24 * time.Hour
+	// DefaultTrashPurgeBatchSize bounds the rows one purge statement deletes.
+	DefaultTrashPurgeBatchSize = 500
+	// DefaultTrashPurgeMaxBatches bounds the batches one purge run deletes.
+	DefaultTrashPurgeMaxBatches = 20
+	// DefaultHistoryLimit is the revision page size when none or an oversized one is requested.
+	DefaultHistoryLimit = 50
+	// MaxHistoryLimit caps one revision page.
+	MaxHistoryLimit = 200
+	// ChatServiceName is the caller name the service-token middleware gives aion-chat's key, marking its changes as chat revisions.
+	ChatServiceName = "aion-chat"
 )
 
 const (
@@ -301,13 +472,87 @@ const (
 	RecordEventTypeUpdatedV1 = "record.updated"
 	// RecordEventTypeDeletedV1 is emitted after record soft deletion succeeds.
 	RecordEventTypeDeletedV1 = "record.deleted"
+	// RecordEventTypeRestoredV1 is emitted after a soft-deleted record is restored.
+	RecordEventTypeRestoredV1 = "record.restored"
 )
 
 const (
-	// LogFailedToEnqueueRecordEvent indicates best-effort outbox enqueue failure after a successful write.
-	LogFailedToEnqueueRecordEvent = "failed to enqueue record outbox event"
-	// LogFailedToMarshalRecordEventPayload indicates payload serialization failed before enqueue.
-	LogFailedToMarshalRecordEventPayload = "failed to marshal record outbox payload"
+	// ProjectionEventVersionV1 identifies the projection-ready event contract version.
+	ProjectionEventVersionV1 = "v1"
+	// ProjectionEventTypeCreated is emitted after the projector inserts a record projection.
+	ProjectionEventTypeCreated = "record.projection.created"
+	// ProjectionEventTypeUpdated is emitted after the projector changes a record projection.
+	ProjectionEventTypeUpdated = "record.projection.updated"
+	// ProjectionEventTypeDeleted is emitted after the projector removes a record projection.
+	ProjectionEventTypeDeleted = "record.projection.deleted"
+)
+
+const (
+	// LogRecordEventProjected indicates one record event changed the derived projection.
+	LogRecordEventProjected = "record event projected"
+	// LogRecordEventIgnored indicates the projector received an event type it does not project.
+	LogRecordEventIgnored = "record event type not projected"
+	// LogFailedLookupProjectionCategory indicates the tag category could not be resolved for a projection-ready event.
+	LogFailedLookupProjectionCategory = "failed to resolve tag category for projection-ready event"
+	// LogFailedPublishProjectionReady indicates the projection changed but its projection-ready event was lost.
+	LogFailedPublishProjectionReady = "failed to publish projection-ready event"
+	// LogFailedLoadDashboardForRealtime indicates a committed record write was not announced to the dashboard streams.
+	LogFailedLoadDashboardForRealtime = "failed to load dashboard state for realtime events"
+	// LogSkippedGoalStatusForRealtime indicates a day held too many records to evaluate its goals for realtime events.
+	LogSkippedGoalStatusForRealtime = "skipped realtime goal statuses of a day over the dashboard limit"
+	// LogProjectionDriftFound indicates a drift check found records whose projection disagrees.
+	LogProjectionDriftFound = "record projection drift found"
+	// LogProjectionRebuilt indicates a user's projections were cleared and their records re-enqueued.
+	LogProjectionRebuilt = "record projection rebuilt"
+	// LogReconcileMetricsUnavailable indicates the drift instruments could not be created.
+	LogReconcileMetricsUnavailable = "record projection reconcile metrics unavailable"
+)
+
+// Record fields name the canonical columns a drift mismatch or a revision reports.
+const (
+	RecordFieldTagID           = "tag_id"
+	RecordFieldDescription     = "description"
+	RecordFieldEventTime       = "event_time"
+	RecordFieldRecordedAt      = "recorded_at"
+	RecordFieldStatus          = "status"
+	RecordFieldTimezone        = "timezone"
+	RecordFieldDurationSeconds = "duration_seconds"
+	RecordFieldValue           = "value"
+	RecordFieldSource          = "source"
+	RecordFieldDeletedAt       = "deleted_at"
+)
+
+const (
+	// MetricProjectionDrift observes the drifted records found by the last all-users check, by kind.
+	MetricProjectionDrift = "aion.record.projection.drift"
+	// MetricProjectionRepaired counts record events re-enqueued to repair or rebuild projections.
+	MetricProjectionRepaired = "aion.record.projection.repaired"
+	// MetricTrashPurged counts soft-deleted records hard-purged after the trash retention.
+	MetricTrashPurged = "aion.record.trash.purged"
+	// MetricAttrDriftKind is the drift kind of an observed count.
+	MetricAttrDriftKind = "kind"
+)
+
+const (
+	// MetricDefinitionAggregateType identifies dashboard metric definitions in canonical outbox events.
+	MetricDefinitionAggregateType = "metric_definition"
+	// MetricDefinitionEventVersionV1 identifies the first canonical metric definition event contract version.
+	MetricDefinitionEventVersionV1 = "v1"
+	// MetricDefinitionEventTypeCreatedV1 is emitted after a metric definition is created.
+	MetricDefinitionEventTypeCreatedV1 = "metric_definition.created"
+	// MetricDefinitionEventTypeUpdatedV1 is emitted after an existing metric definition is changed, including deactivation.
+	MetricDefinitionEventTypeUpdatedV1 = "metric_definition.updated"
+)
+
+const (
+	// FailedToEnqueueRecordEvent indicates the outbox rejected the event, which rolls the record write back.
+	FailedToEnqueueRecordEvent = "failed to enqueue record outbox event"
+	// FailedToMarshalRecordEventPayload indicates record payload serialization failed before enqueue.
+	FailedToMarshalRecordEventPayload = "failed to marshal record outbox payload"
+	// FailedToEnqueueMetricDefinitionEvent indicates the outbox rejected the event, which rolls the metric definition write back.
+	FailedToEnqueueMetricDefinitionEvent = "failed to enqueue metric definition outbox event"
+	// FailedToMarshalMetricDefinitionEventPayload indicates metric definition payload serialization failed before enqueue.
+	FailedToMarshalMetricDefinitionEventPayload = "failed to marshal metric definition outbox payload"
 )
 
 // Dashboard validation and domain messages.
@@ -376,6 +621,39 @@ var (
 	// ErrDeleteRecord is a sentinel error for record deletion failures.
 	ErrDeleteRecord = errors.New(FailedToDeleteRecord)
 
+	// ErrRestoreRecords is a sentinel error for restore failures.
+	ErrRestoreRecords = errors.New(FailedToRestoreRecords)
+
+	// ErrListRecordHistory is a sentinel error for record history failures.
+	ErrListRecordHistory = errors.New(FailedToListRecordHistory)
+
+	// ErrPurgeDeletedRecords is a sentinel error for trash purge failures.
+	ErrPurgeDeletedRecords = errors.New(FailedToPurgeDeletedRecords)
+
+	// ErrRecordIDsAreRequired is a sentinel error when a restore names no records.
+	ErrRecordIDsAreRequired = errors.New(RecordIDsAreRequired)
+
+	// ErrRestoreBatchTooLarge is a sentinel error when a restore names more than MaxRestoreBatchSize records.
+	ErrRestoreBatchTooLarge = errors.New(RestoreBatchTooLarge)
+
+	// ErrRecordsAreRequired is a sentinel error when a batch create has no items.
+	ErrRecordsAreRequired = errors.New(RecordsAreRequired)
+
+	// ErrCreateBatchTooLarge is a sentinel error when a batch create has more than MaxCreateBatchSize items.
+	ErrCreateBatchTooLarge = errors.New(CreateBatchTooLarge)
+
+	// ErrInvalidCreateRecordsMode is a sentinel error for an unknown batch create mode.
+	ErrInvalidCreateRecordsMode = errors.New(InvalidCreateRecordsMode)
+
+	// ErrBatchItemAborted is reported for valid items of an ALL_OR_NOTHING batch another item failed.
+	ErrBatchItemAborted = errors.New(BatchItemAborted)
+
+	// ErrIdempotencyKeyTooLong is a sentinel error for an idempotency key over MaxIdempotencyKeyLength.
+	ErrIdempotencyKeyTooLong = errors.New(IdempotencyKeyTooLong)
+
+	// ErrIdempotencyKeyConflict is a sentinel error when an idempotency key is reused with another payload.
+	ErrIdempotencyKeyConflict = errors.New(IdempotencyKeyConflict)
+
 	// ErrRecordNotFound is a sentinel error when record is not found.
 	ErrRecordNotFound = errors.New(RecordNotFound)
 
@@ -406,6 +684,21 @@ var (
 	// ErrProjectionRepositoryUnavailable is a sentinel error when derived projections are not configured.
 	ErrProjectionRepositoryUnavailable = errors.New(ProjectionRepositoryUnavailable)
 
+	// ErrProjectRecordEvent is a sentinel error for projection write failures.
+	ErrProjectRecordEvent = errors.New(FailedToProjectRecordEvent)
+
+	// ErrInvalidRecordEventTime is a sentinel error for record events whose event time cannot be parsed.
+	ErrInvalidRecordEventTime = errors.New(InvalidRecordEventTime)
+
+	// ErrReconcileProjection is a sentinel error for drift checks that could not read either side.
+	ErrReconcileProjection = errors.New(FailedToReconcileProjection)
+
+	// ErrRebuildProjection is a sentinel error for rebuilds that could not clear or re-enqueue projections.
+	ErrRebuildProjection = errors.New(FailedToRebuildProjection)
+
+	// ErrOutboxUnavailable is a sentinel error when a repair or rebuild has no outbox to enqueue to.
+	ErrOutboxUnavailable = errors.New(OutboxUnavailable)
+
 	// ErrUserIDNegative is a sentinel error when user ID is negative.
 	ErrUserIDNegative = errors.New(UserIDNegative)
 
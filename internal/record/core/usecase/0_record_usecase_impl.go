@@ -9,6 +9,7 @@ import (
 	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	realtimeinput "github.com/lechitz/aion-api/internal/realtime/core/ports/input"
 	"github.com/lechitz/aion-api/internal/record/core/ports/output"
 	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
 	tagoutput "github.com/lechitz/aion-api/internal/tag/core/ports/output"
@@ -22,6 +23,7 @@ type Service struct {
 	TagRepository              tagoutput.TagRepository
 	OutboxService              eventoutboxinput.Service
 	TransactionManager         dbport.DB
+	Realtime                   realtimeinput.Publisher
 	Logger                     logger.ContextLogger
 }
 
@@ -53,6 +55,13 @@ func (s *Service) WithProjectionReader(projectionRepo output.RecordProjectionRep
 	return s
 }
 
+// WithRealtime attaches an optional realtime publisher that announces dashboard invalidations and
+// goal status changes caused by committed record writes.
+func (s *Service) WithRealtime(publisher realtimeinput.Publisher) *Service {
+	s.Realtime = publisher
+	return s
+}
+
 // getUserIDFromContext extracts a numeric user ID from context, supporting common types.
 func getUserIDFromContext(ctx context.Context) (uint64, error) {
 	v := ctx.Value(ctxkeys.UserID)
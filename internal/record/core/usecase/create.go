@@ -37,15 +37,29 @@ func (s *Service) Create(ctx context.Context, cmd input.CreateRecordCommand) (do
 		return domain.Record{}, err
 	}
 
-	eventTime := resolveEventTime(cmd)
-
-	if err := validateRecordedAt(cmd.RecordedAt); err != nil {
+	cmd, err = withIdempotencyKey(cmd)
+	if err == nil {
+		err = validateRecordedAt(cmd.RecordedAt)
+	}
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, ErrToValidateRecord)
 		s.Logger.ErrorwCtx(ctx, ErrToValidateRecord, commonkeys.Error, err.Error())
 		return domain.Record{}, err
 	}
 
+	// A retried create returns the record stored under its key before any tag check or write.
+	if stored, found, err := s.findIdempotentRecord(ctx, recordFromCommand(cmd, userID, cmd.TagID)); err != nil || found {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, FailedToCreateRecord)
+			s.Logger.ErrorwCtx(ctx, FailedToCreateRecord, commonkeys.Error, err.Error())
+			return domain.Record{}, err
+		}
+		s.logIdempotentReplay(ctx, span, stored)
+		return stored, nil
+	}
+
 	finalTagID, err := s.resolveTagID(ctx, cmd.TagID, userID)
 	if err != nil {
 		span.RecordError(err)
@@ -54,23 +68,7 @@ func (s *Service) Create(ctx context.Context, cmd input.CreateRecordCommand) (do
 		return domain.Record{}, fmt.Errorf("%w: %w", ErrCreateRecord, err)
 	}
 
-	// Apply default values for optional fields
-	recordedAt := resolveRecordedAt(cmd.RecordedAt)
-	status := resolveStatus(cmd.Status)
-	timezone := resolveTimezone(cmd.Timezone)
-
-	rec := domain.Record{
-		UserID:       userID,
-		Description:  cmd.Description,
-		TagID:        finalTagID,
-		EventTime:    eventTime,
-		RecordedAt:   recordedAt,
-		DurationSecs: cmd.DurationSecs,
-		Value:        cmd.Value,
-		Source:       cmd.Source,
-		Timezone:     timezone,
-		Status:       status,
-	}
+	rec := recordFromCommand(cmd, userID, finalTagID)
 
 	var created domain.Record
 	if err := s.runWithinRecordOutboxTransaction(ctx, func(recordRepo output.RecordRepository, outboxService eventoutboxinput.Service) error {
@@ -81,18 +79,26 @@ func (s *Service) Create(ctx context.Context, cmd input.CreateRecordCommand) (do
 			return createErr
 		}
 
-		if outboxService != nil {
-			s.enqueueRecordOutboxEventWithService(ctx, outboxService, RecordEventTypeCreatedV1, created)
-		}
-		return nil
+		return s.enqueueRecordOutboxEventWithService(ctx, outboxService, RecordEventTypeCreatedV1, created)
 	}); err != nil {
+		// A concurrent create under the same key may have won the unique index.
+		stored, found, replayErr := s.findIdempotentRecord(ctx, rec)
+		if found {
+			s.logIdempotentReplay(ctx, span, stored)
+			return stored, nil
+		}
+		err = fmt.Errorf("%w: %w", ErrCreateRecord, err)
+		if errors.Is(replayErr, ErrIdempotencyKeyConflict) {
+			err = replayErr
+		}
 		span.RecordError(err)
 		span.SetStatus(codes.Error, FailedToCreateRecord)
 		s.Logger.ErrorwCtx(ctx, FailedToCreateRecord, commonkeys.Error, err)
-		return domain.Record{}, fmt.Errorf("%w: %w", ErrCreateRecord, err)
+		return domain.Record{}, err
 	}
 
 	s.saveToCacheAndInvalidate(ctx, span, created)
+	s.publishDashboardChanges(ctx, span, userID, createdChanges([]domain.Record{created}))
 
 	span.AddEvent(EventSuccess)
 	span.SetStatus(codes.Ok, StatusCreated)
@@ -104,6 +110,29 @@ func (s *Service) Create(ctx context.Context, cmd input.CreateRecordCommand) (do
 	return created, nil
 }
 
+// recordFromCommand builds the record to insert, applying defaults for optional fields. A record
+// with an idempotency key also carries the fingerprint of the command's payload.
+func recordFromCommand(cmd input.CreateRecordCommand, userID uint64, tagID uint64) domain.Record {
+	rec := domain.Record{
+		UserID:       userID,
+		Description:  cmd.Description,
+		TagID:        tagID,
+		EventTime:    resolveEventTime(cmd),
+		RecordedAt:   resolveRecordedAt(cmd.RecordedAt),
+		DurationSecs: cmd.DurationSecs,
+		Value:        cmd.Value,
+		Source:       cmd.Source,
+		Timezone:     resolveTimezone(cmd.Timezone),
+		Status:       resolveStatus(cmd.Status),
+	}
+	if cmd.IdempotencyKey != nil {
+		fingerprint := idempotencyFingerprint(cmd)
+		rec.IdempotencyKey = cmd.IdempotencyKey
+		rec.IdempotencyFingerprint = &fingerprint
+	}
+	return rec
+}
+
 // resolveEventTime determines the event time from the command.
 func resolveEventTime(cmd input.CreateRecordCommand) time.Time {
 	if !cmd.EventTime.IsZero() {
@@ -0,0 +1,304 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/ports/output"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CreateMany validates every item, then writes the valid ones in one transaction with one
+// record.created event each. Item errors are reported per result; in ALL_OR_NOTHING mode any
+// invalid item keeps the whole batch out and marks the valid items ErrBatchItemAborted. Items
+// whose idempotency key already holds a record return it without a write. The returned error is
+// reserved for problems with the batch itself or the write.
+func (s *Service) CreateMany(ctx context.Context, cmd input.CreateRecordsCommand) ([]input.CreateRecordResult, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanCreateMany)
+	defer span.End()
+
+	mode := cmd.Mode
+	if mode == "" {
+		mode = input.CreateRecordsModeAllOrNothing
+	}
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanCreateMany),
+		attribute.String(AttrMode, mode),
+		attribute.Int(AttrRequestedCount, len(cmd.Items)),
+	)
+
+	span.AddEvent(EventValidateInput)
+	userID, err := getUserIDFromContext(ctx)
+	if err == nil {
+		err = validateCreateManyInput(cmd.Items, mode)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, ErrToValidateRecord)
+		s.Logger.ErrorwCtx(ctx, ErrToValidateRecord, commonkeys.Error, err.Error())
+		return nil, err
+	}
+
+	stored, err := s.findIdempotentRecords(ctx, userID, cmd.Items)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, FailedToCreateRecord)
+		s.Logger.ErrorwCtx(ctx, FailedToCreateRecord, commonkeys.UserID, userID, commonkeys.Error, err)
+		return nil, fmt.Errorf("%w: %w", ErrCreateRecord, err)
+	}
+
+	batch := s.planCreateBatch(ctx, userID, cmd.Items, stored)
+	if batch.failed > 0 && mode == input.CreateRecordsModeAllOrNothing {
+		batch.abort()
+	}
+
+	created, err := s.writeCreateBatch(ctx, span, userID, mode, batch)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, FailedToCreateRecord)
+		s.Logger.ErrorwCtx(ctx, FailedToCreateRecord, commonkeys.UserID, userID, commonkeys.Error, err)
+		return nil, fmt.Errorf("%w: %w", ErrCreateRecord, err)
+	}
+
+	for j, record := range created {
+		batch.results[batch.indexes[j]].Record = record
+		s.saveToCacheAndInvalidate(ctx, span, record)
+	}
+	for i, j := range batch.repeats {
+		batch.results[i].Record = created[j]
+	}
+	s.publishDashboardChanges(ctx, span, userID, createdChanges(created))
+
+	span.SetAttributes(
+		attribute.Int(AttrCreatedCount, len(created)),
+		attribute.Int(AttrFailedCount, batch.failed),
+	)
+	span.AddEvent(EventSuccess)
+	span.SetStatus(codes.Ok, StatusCreated)
+	s.Logger.InfowCtx(ctx, LogRecordsCreated,
+		commonkeys.UserID, userID,
+		AttrMode, mode,
+		AttrCreatedCount, len(created),
+		AttrFailedCount, batch.failed,
+	)
+
+	return batch.results, nil
+}
+
+// writeCreateBatch inserts the pending records in one transaction with one record.created event each.
+// When the write fails because a concurrent create won the unique index for some idempotency keys,
+// those items are settled against the stored records, as replays or conflicts, and the rest is
+// retried. Every retry settles at least one item, so the loop ends.
+func (s *Service) writeCreateBatch(ctx context.Context, span trace.Span, userID uint64, mode string, batch *createBatch) ([]domain.Record, error) {
+	for len(batch.pending) > 0 {
+		var created []domain.Record
+		err := s.runWithinRecordOutboxTransaction(ctx, func(recordRepo output.RecordRepository, outboxService eventoutboxinput.Service) error {
+			span.AddEvent(EventRepositoryCreate)
+			var createErr error
+			created, createErr = recordRepo.CreateMany(ctx, batch.pending)
+			if createErr != nil {
+				return createErr
+			}
+
+			for _, record := range created {
+				if enqueueErr := s.enqueueRecordOutboxEventWithService(ctx, outboxService, RecordEventTypeCreatedV1, record); enqueueErr != nil {
+					return enqueueErr
+				}
+			}
+			return nil
+		})
+		if err == nil {
+			return created, nil
+		}
+
+		settled, lookupErr := s.settleRacedKeys(ctx, userID, batch)
+		if lookupErr != nil || settled == 0 {
+			return nil, err
+		}
+		span.AddEvent(EventIdempotentReplay)
+		if batch.failed > 0 && mode == input.CreateRecordsModeAllOrNothing {
+			batch.abort()
+		}
+	}
+	return nil, nil
+}
+
+// settleRacedKeys looks the idempotency keys of the pending records up again and settles every
+// pending record, and its in-batch repeats, whose key now holds a stored record. It returns how
+// many pending records it settled.
+func (s *Service) settleRacedKeys(ctx context.Context, userID uint64, batch *createBatch) (int, error) {
+	keys := make([]string, 0, len(batch.pending))
+	for _, rec := range batch.pending {
+		if rec.IdempotencyKey != nil {
+			keys = append(keys, *rec.IdempotencyKey)
+		}
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	stored, err := s.RecordRepository.ListByIdempotencyKeys(ctx, userID, keys)
+	if err != nil || len(stored) == 0 {
+		return 0, err
+	}
+	byKey := make(map[string]domain.Record, len(stored))
+	for _, record := range stored {
+		if record.IdempotencyKey != nil {
+			byKey[*record.IdempotencyKey] = record
+		}
+	}
+
+	settle := func(i int, rec domain.Record, original domain.Record) {
+		if err := matchIdempotentRecord(original, rec); err != nil {
+			batch.fail(i, err)
+			return
+		}
+		batch.results[i].Record = original
+	}
+
+	kept := make(map[int]int, len(batch.pending))
+	pending := batch.pending[:0:0]
+	indexes := batch.indexes[:0:0]
+	for j, rec := range batch.pending {
+		var original domain.Record
+		raced := false
+		if rec.IdempotencyKey != nil {
+			original, raced = byKey[*rec.IdempotencyKey]
+		}
+		if !raced {
+			kept[j] = len(pending)
+			pending = append(pending, rec)
+			indexes = append(indexes, batch.indexes[j])
+			continue
+		}
+		settle(batch.indexes[j], rec, original)
+		for i, target := range batch.repeats {
+			if target == j {
+				settle(i, rec, original)
+				delete(batch.repeats, i)
+			}
+		}
+	}
+	for i, target := range batch.repeats {
+		batch.repeats[i] = kept[target]
+	}
+
+	settled := len(batch.pending) - len(pending)
+	batch.pending, batch.indexes = pending, indexes
+	return settled, nil
+}
+
+// validateCreateManyInput rejects an empty or oversized batch and an unknown mode.
+func validateCreateManyInput(items []input.CreateRecordCommand, mode string) error {
+	switch {
+	case len(items) == 0:
+		return ErrRecordsAreRequired
+	case len(items) > MaxCreateBatchSize:
+		return fmt.Errorf("%w: %d > %d", ErrCreateBatchTooLarge, len(items), MaxCreateBatchSize)
+	case mode != input.CreateRecordsModeAllOrNothing && mode != input.CreateRecordsModeBestEffort:
+		return fmt.Errorf("%w: %q", ErrInvalidCreateRecordsMode, mode)
+	}
+	return nil
+}
+
+// createBatch tracks how each item of a batch create resolves. pending holds the records to
+// insert, indexes the result of each, and repeats maps a result to the pending record an earlier
+// item of the batch holds under the same idempotency key.
+type createBatch struct {
+	results []input.CreateRecordResult
+	pending []domain.Record
+	indexes []int
+	repeats map[int]int
+	failed  int
+}
+
+// planCreateBatch validates every item and sorts it into a failure, a replay of a stored record,
+// a repeat of an earlier item, or a pending insert.
+func (s *Service) planCreateBatch(ctx context.Context, userID uint64, items []input.CreateRecordCommand, stored map[string]domain.Record) *createBatch {
+	batch := &createBatch{
+		results: make([]input.CreateRecordResult, len(items)),
+		pending: make([]domain.Record, 0, len(items)),
+		indexes: make([]int, 0, len(items)),
+		repeats: make(map[int]int),
+	}
+	pendingByKey := make(map[string]int)
+	tagErrs := make(map[uint64]error)
+
+	for i, item := range items {
+		batch.results[i].Index = i
+
+		item, err := withIdempotencyKey(item)
+		if err == nil {
+			err = validateRecordedAt(item.RecordedAt)
+		}
+		if err != nil {
+			batch.fail(i, err)
+			continue
+		}
+
+		rec := recordFromCommand(item, userID, item.TagID)
+		if rec.IdempotencyKey != nil {
+			key := *rec.IdempotencyKey
+			if original, ok := stored[key]; ok {
+				if err := matchIdempotentRecord(original, rec); err != nil {
+					batch.fail(i, err)
+				} else {
+					batch.results[i].Record = original
+				}
+				continue
+			}
+			if j, ok := pendingByKey[key]; ok {
+				if err := matchIdempotentRecord(batch.pending[j], rec); err != nil {
+					batch.fail(i, err)
+				} else {
+					batch.repeats[i] = j
+				}
+				continue
+			}
+		}
+
+		tagErr, checked := tagErrs[item.TagID]
+		if !checked {
+			_, tagErr = s.resolveTagID(ctx, item.TagID, userID)
+			tagErrs[item.TagID] = tagErr
+		}
+		if tagErr != nil {
+			batch.fail(i, fmt.Errorf("%w: %w", ErrCreateRecord, tagErr))
+			continue
+		}
+
+		if rec.IdempotencyKey != nil {
+			pendingByKey[*rec.IdempotencyKey] = len(batch.pending)
+		}
+		batch.pending = append(batch.pending, rec)
+		batch.indexes = append(batch.indexes, i)
+	}
+
+	return batch
+}
+
+func (b *createBatch) fail(i int, err error) {
+	b.results[i].Err = err
+	b.failed++
+}
+
+// abort keeps every pending record out of the write; replays of stored records keep their record.
+func (b *createBatch) abort() {
+	for _, i := range b.indexes {
+		b.results[i].Err = ErrBatchItemAborted
+	}
+	for i := range b.repeats {
+		b.results[i].Err = ErrBatchItemAborted
+	}
+	b.failed += len(b.indexes) + len(b.repeats)
+	b.pending, b.indexes, b.repeats = nil, nil, nil
+}
@@ -0,0 +1,220 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/usecase"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	tagdomain "github.com/lechitz/aion-api/internal/tag/core/domain"
+	"github.com/lechitz/aion-api/tests/setup"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func expectCreateManyCacheWrites(suite *setup.RecordServiceTestSuite, userID uint64) {
+	suite.RecordCache.EXPECT().SaveRecord(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	suite.RecordCache.EXPECT().DeleteRecordsByDay(gomock.Any(), userID, gomock.Any()).Return(nil).AnyTimes()
+	suite.RecordCache.EXPECT().DeleteRecordsByCategory(gomock.Any(), gomock.Any(), userID).Return(nil).AnyTimes()
+	suite.RecordCache.EXPECT().DeleteRecordsByTag(gomock.Any(), gomock.Any(), userID).Return(nil).AnyTimes()
+}
+
+func assignRecordIDs(_ context.Context, records []domain.Record) ([]domain.Record, error) {
+	out := make([]domain.Record, len(records))
+	for i, rec := range records {
+		rec.ID = uint64(100 + i)
+		out[i] = rec
+	}
+	return out, nil
+}
+
+func TestService_CreateMany_WritesEveryItemInOneCall(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	outbox := &captureOutboxService{}
+	suite.RecordService.WithOutbox(outbox)
+
+	userID := uint64(7)
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, userID)
+	eventTime := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), userID).Return(tagdomain.Tag{ID: 10, CategoryID: 1}, nil).MinTimes(1)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(11), userID).Return(tagdomain.Tag{ID: 11, CategoryID: 1}, nil).MinTimes(1)
+	suite.RecordRepository.EXPECT().
+		CreateMany(gomock.Any(), gomock.Len(3)).
+		DoAndReturn(func(ctx context.Context, records []domain.Record) ([]domain.Record, error) {
+			for _, rec := range records {
+				require.Equal(t, userID, rec.UserID)
+				require.Equal(t, usecase.DefaultRecordStatus, *rec.Status)
+			}
+			return assignRecordIDs(ctx, records)
+		})
+	expectCreateManyCacheWrites(suite, userID)
+
+	results, err := suite.RecordService.CreateMany(ctx, input.CreateRecordsCommand{
+		Items: []input.CreateRecordCommand{
+			{TagID: 10, EventTime: eventTime},
+			{TagID: 11, EventTime: eventTime},
+			{TagID: 10, EventTime: eventTime.Add(time.Hour)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	for i, result := range results {
+		require.Equal(t, i, result.Index)
+		require.NoError(t, result.Err)
+		require.Equal(t, uint64(100+i), result.Record.ID)
+	}
+	require.Len(t, outbox.events, 3)
+	for _, event := range outbox.events {
+		require.Equal(t, usecase.RecordEventTypeCreatedV1, event.EventType)
+	}
+}
+
+func TestService_CreateMany_FailsWhenOutboxRejectsEvent(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	rejected := errors.New("outbox unavailable")
+	suite.RecordService.WithOutbox(&captureOutboxService{err: rejected})
+
+	userID := uint64(7)
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, userID)
+	eventTime := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), userID).Return(tagdomain.Tag{ID: 10, CategoryID: 1}, nil).MinTimes(1)
+	suite.RecordRepository.EXPECT().CreateMany(gomock.Any(), gomock.Len(2)).DoAndReturn(assignRecordIDs)
+
+	// The batch rolls back, so no cache is touched and nothing is reported as created.
+	results, err := suite.RecordService.CreateMany(ctx, input.CreateRecordsCommand{
+		Items: []input.CreateRecordCommand{
+			{TagID: 10, EventTime: eventTime},
+			{TagID: 10, EventTime: eventTime.Add(time.Hour)},
+		},
+	})
+	require.ErrorIs(t, err, rejected)
+	require.Empty(t, results)
+}
+
+func TestService_CreateMany_AllOrNothingAbortsOnInvalidItem(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	userID := uint64(7)
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, userID)
+
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), userID).Return(tagdomain.Tag{ID: 10}, nil)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(99), userID).Return(tagdomain.Tag{}, errors.New("tag not found"))
+
+	results, err := suite.RecordService.CreateMany(ctx, input.CreateRecordsCommand{
+		Mode: input.CreateRecordsModeAllOrNothing,
+		Items: []input.CreateRecordCommand{
+			{TagID: 10, EventTime: time.Now().UTC()},
+			{TagID: 99, EventTime: time.Now().UTC()},
+			{TagID: 10, EventTime: time.Now().UTC(), RecordedAt: timePtr(time.Now().UTC().Add(24 * time.Hour))},
+		},
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, results[0].Err, usecase.ErrBatchItemAborted)
+	require.ErrorIs(t, results[1].Err, usecase.ErrCreateRecord)
+	require.ErrorIs(t, results[2].Err, usecase.ErrRecordedAtFuture)
+	for _, result := range results {
+		require.Zero(t, result.Record.ID)
+	}
+}
+
+func TestService_CreateMany_BestEffortWritesValidItems(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	userID := uint64(7)
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, userID)
+
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), userID).Return(tagdomain.Tag{ID: 10, CategoryID: 1}, nil).MinTimes(1)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(99), userID).Return(tagdomain.Tag{}, errors.New("tag not found"))
+	suite.RecordRepository.EXPECT().CreateMany(gomock.Any(), gomock.Len(1)).DoAndReturn(assignRecordIDs)
+	expectCreateManyCacheWrites(suite, userID)
+
+	results, err := suite.RecordService.CreateMany(ctx, input.CreateRecordsCommand{
+		Mode: input.CreateRecordsModeBestEffort,
+		Items: []input.CreateRecordCommand{
+			{TagID: 99, EventTime: time.Now().UTC()},
+			{TagID: 10, EventTime: time.Now().UTC()},
+		},
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, results[0].Err, usecase.ErrCreateRecord)
+	require.NoError(t, results[1].Err)
+	require.Equal(t, uint64(100), results[1].Record.ID)
+}
+
+func TestService_CreateMany_ErrorCases(t *testing.T) {
+	tooMany := make([]input.CreateRecordCommand, usecase.MaxCreateBatchSize+1)
+	withUser := func(t *testing.T) context.Context {
+		return context.WithValue(t.Context(), ctxkeys.UserID, uint64(7))
+	}
+
+	tests := []struct {
+		name      string
+		setupCtx  func(t *testing.T) context.Context
+		cmd       input.CreateRecordsCommand
+		setupMock func(*setup.RecordServiceTestSuite)
+		wantErr   error
+	}{
+		{
+			name:      "user not authenticated",
+			setupCtx:  func(t *testing.T) context.Context { return t.Context() },
+			cmd:       input.CreateRecordsCommand{Items: []input.CreateRecordCommand{{TagID: 10}}},
+			setupMock: func(*setup.RecordServiceTestSuite) {},
+			wantErr:   usecase.ErrUserNotAuthenticated,
+		},
+		{
+			name:      "empty batch",
+			setupCtx:  withUser,
+			setupMock: func(*setup.RecordServiceTestSuite) {},
+			wantErr:   usecase.ErrRecordsAreRequired,
+		},
+		{
+			name:      "batch too large",
+			setupCtx:  withUser,
+			cmd:       input.CreateRecordsCommand{Items: tooMany},
+			setupMock: func(*setup.RecordServiceTestSuite) {},
+			wantErr:   usecase.ErrCreateBatchTooLarge,
+		},
+		{
+			name:      "unknown mode",
+			setupCtx:  withUser,
+			cmd:       input.CreateRecordsCommand{Mode: "SOMETIMES", Items: []input.CreateRecordCommand{{TagID: 10}}},
+			setupMock: func(*setup.RecordServiceTestSuite) {},
+			wantErr:   usecase.ErrInvalidCreateRecordsMode,
+		},
+		{
+			name:     "repository failure",
+			setupCtx: withUser,
+			cmd:      input.CreateRecordsCommand{Items: []input.CreateRecordCommand{{TagID: 10, EventTime: time.Now().UTC()}}},
+			setupMock: func(s *setup.RecordServiceTestSuite) {
+				s.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), uint64(7)).Return(tagdomain.Tag{ID: 10}, nil)
+				s.RecordRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			wantErr: usecase.ErrCreateRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := setup.RecordServiceTest(t)
+			defer suite.Ctrl.Finish()
+			tt.setupMock(suite)
+
+			results, err := suite.RecordService.CreateMany(tt.setupCtx(t), tt.cmd)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.wantErr.Error())
+			require.Nil(t, results)
+		})
+	}
+}
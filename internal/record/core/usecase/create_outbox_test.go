@@ -19,9 +19,13 @@ import (
 
 type captureOutboxService struct {
 	events []eventoutboxdomain.Event
+	err    error
 }
 
 func (c *captureOutboxService) Enqueue(_ context.Context, event eventoutboxdomain.Event) error {
+	if c.err != nil {
+		return c.err
+	}
 	c.events = append(c.events, event)
 	return nil
 }
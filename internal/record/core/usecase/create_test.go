@@ -3,10 +3,12 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	eventoutboxdomain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	eventoutboxusecase "github.com/lechitz/aion-api/internal/eventoutbox/core/usecase"
 	"github.com/lechitz/aion-api/internal/record/core/domain"
 	"github.com/lechitz/aion-api/internal/record/core/ports/input"
 	"github.com/lechitz/aion-api/internal/record/core/usecase"
@@ -176,7 +178,7 @@ func TestService_Create_ErrorCases(t *testing.T) {
 	}
 }
 
-func TestService_Create_EnqueuesOutboxBestEffort(t *testing.T) {
+func TestService_Create_FailsWhenOutboxRejectsEvent(t *testing.T) {
 	suite := setup.RecordServiceTest(t)
 	defer suite.Ctrl.Finish()
 
@@ -203,12 +205,7 @@ func TestService_Create_EnqueuesOutboxBestEffort(t *testing.T) {
 			return rec, nil
 		})
 
-	suite.RecordCache.EXPECT().SaveRecord(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	suite.RecordCache.EXPECT().DeleteRecordsByDay(gomock.Any(), userID, gomock.Any()).Return(nil).AnyTimes()
-	suite.TagRepository.EXPECT().GetByID(gomock.Any(), tagID, userID).Return(tagdomain.Tag{ID: tagID, CategoryID: 1}, nil).AnyTimes()
-	suite.RecordCache.EXPECT().DeleteRecordsByCategory(gomock.Any(), gomock.Any(), userID).Return(nil).AnyTimes()
-	suite.RecordCache.EXPECT().DeleteRecordsByTag(gomock.Any(), tagID, userID).Return(nil).AnyTimes()
-
+	// The write rolls back, so no cache is touched.
 	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event eventoutboxdomain.Event) error {
 		assert.Equal(t, usecase.RecordAggregateType, event.AggregateType)
 		assert.Equal(t, "77", event.AggregateID)
@@ -217,7 +214,7 @@ func TestService_Create_EnqueuesOutboxBestEffort(t *testing.T) {
 		assert.Equal(t, "trace-123", event.TraceID)
 		assert.Equal(t, "req-123", event.RequestID)
 		assert.NotEmpty(t, event.PayloadJSON)
-		return errors.New("outbox unavailable")
+		return fmt.Errorf("%w: missing property user_id", eventoutboxusecase.ErrPayloadSchemaViolation)
 	})
 
 	result, err := suite.RecordService.Create(ctx, input.CreateRecordCommand{
@@ -225,8 +222,8 @@ func TestService_Create_EnqueuesOutboxBestEffort(t *testing.T) {
 		EventTime: eventTime,
 	})
 
-	require.NoError(t, err)
-	assert.Equal(t, uint64(77), result.ID)
+	require.ErrorIs(t, err, eventoutboxusecase.ErrPayloadSchemaViolation)
+	assert.Equal(t, domain.Record{}, result)
 }
 
 // Helper functions.
@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordChange is one committed record write as the dashboard sees it. Before is nil for a create or
+// a restore and After is nil for a delete.
+type recordChange struct {
+	Before *domain.Record
+	After  *domain.Record
+}
+
+// dashboardDay is a local day whose dashboard snapshot a set of record changes touched.
+type dashboardDay struct {
+	Date       string
+	Timezone   string
+	StartUTC   time.Time
+	EndUTC     time.Time
+	MetricKeys []string
+	Changes    []recordChange
+}
+
+// createdChanges describes records that were created or restored.
+func createdChanges(records []domain.Record) []recordChange {
+	changes := make([]recordChange, len(records))
+	for i := range records {
+		changes[i] = recordChange{After: &records[i]}
+	}
+	return changes
+}
+
+// deletedChanges describes records that were deleted, as they were before the delete.
+func deletedChanges(records []domain.Record) []recordChange {
+	changes := make([]recordChange, len(records))
+	for i := range records {
+		changes[i] = recordChange{Before: &records[i]}
+	}
+	return changes
+}
+
+// publishDashboardChanges announces committed record changes to the user's realtime streams without
+// holding up the request: the dashboard state is read and the events are published in the background.
+func (s *Service) publishDashboardChanges(ctx context.Context, span trace.Span, userID uint64, changes []recordChange) {
+	if s.Realtime == nil || len(changes) == 0 {
+		return
+	}
+
+	// The caller owns the records behind the changes and may reuse them once this returns.
+	owned := make([]recordChange, len(changes))
+	for i, change := range changes {
+		if change.Before != nil {
+			before := *change.Before
+			owned[i].Before = &before
+		}
+		if change.After != nil {
+			after := *change.After
+			owned[i].After = &after
+		}
+	}
+
+	span.AddEvent(EventPublishRealtime)
+	go s.announceDashboardChanges(context.WithoutCancel(ctx), userID, owned)
+}
+
+// announceDashboardChanges publishes one dashboard_snapshot_invalidated event per local day whose
+// metrics the changes feed, and one goal_status_changed event per daily goal of that day whose status
+// the changes moved. The days follow each record's own timezone. Changes spanning more than
+// MaxRealtimeDashboardDays days publish a single invalidation without a date instead, and no goal
+// statuses. Failing to read the dashboard state only skips the events, since the write is already
+// committed.
+func (s *Service) announceDashboardChanges(ctx context.Context, userID uint64, changes []recordChange) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanPublishRealtime)
+	defer span.End()
+
+	defs, err := s.RecordRepository.ListMetricDefinitions(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		s.Logger.WarnwCtx(ctx, LogFailedLoadDashboardForRealtime, commonkeys.UserID, userID, commonkeys.Error, err)
+		return
+	}
+	days := groupChangesByDashboardDay(changes, defs)
+	span.SetAttributes(attribute.Int(AttrRealtimeDays, len(days)))
+	if len(days) == 0 {
+		return
+	}
+
+	now := time.Now().UTC()
+	if len(days) > MaxRealtimeDashboardDays {
+		var keys []string
+		for _, day := range days {
+			for _, key := range day.MetricKeys {
+				if !slices.Contains(keys, key) {
+					keys = append(keys, key)
+				}
+			}
+		}
+		s.Realtime.Publish(ctx, realtimedomain.NewDashboardSnapshotInvalidatedEvent(userID, realtimedomain.DashboardSnapshotInvalidated{
+			MetricKeys:       keys,
+			InvalidatedAtUTC: now,
+		}))
+		return
+	}
+
+	goals, err := s.RecordRepository.ListGoalTemplates(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		s.Logger.WarnwCtx(ctx, LogFailedLoadDashboardForRealtime, commonkeys.UserID, userID, commonkeys.Error, err)
+		goals = nil
+	}
+
+	for _, day := range days {
+		s.Realtime.Publish(ctx, realtimedomain.NewDashboardSnapshotInvalidatedEvent(userID, realtimedomain.DashboardSnapshotInvalidated{
+			Date:             day.Date,
+			Timezone:         day.Timezone,
+			MetricKeys:       day.MetricKeys,
+			InvalidatedAtUTC: now,
+		}))
+		s.publishGoalStatusChanges(ctx, userID, day, defs, goals, now)
+	}
+}
+
+// publishGoalStatusChanges evaluates the goals on the metrics of day against the records before and
+// after the changes, and publishes the goals whose status moved. A day holding more records than
+// DefaultDashboardLimit is skipped, since statuses computed from a truncated read could be wrong.
+func (s *Service) publishGoalStatusChanges(
+	ctx context.Context,
+	userID uint64,
+	day dashboardDay,
+	defs []domain.MetricDefinition,
+	goals []domain.GoalTemplate,
+	now time.Time,
+) {
+	defByKey := make(map[string]domain.MetricDefinition, len(day.MetricKeys))
+	for _, def := range defs {
+		for _, key := range day.MetricKeys {
+			if def.MetricKey == key {
+				defByKey[key] = def
+			}
+		}
+	}
+
+	var after, before []domain.Record
+	loaded := false
+	for _, goal := range goals {
+		def, ok := defByKey[goal.MetricKey]
+		if !ok {
+			continue
+		}
+		if !loaded {
+			var err error
+			after, err = s.RecordRepository.ListAllBetween(ctx, userID, day.StartUTC, day.EndUTC, DefaultDashboardLimit)
+			if err != nil {
+				s.Logger.WarnwCtx(ctx, LogFailedLoadDashboardForRealtime, commonkeys.UserID, userID, commonkeys.Error, err)
+				return
+			}
+			if len(after) >= DefaultDashboardLimit {
+				s.Logger.WarnwCtx(ctx, LogSkippedGoalStatusForRealtime, commonkeys.UserID, userID, commonkeys.Date, day.Date)
+				return
+			}
+			before = recordsBeforeChanges(after, day)
+			loaded = true
+		}
+
+		previous, _ := evaluateGoal(computeMetricValue(before, def), goal.TargetValue, goal.Comparison)
+		current := computeMetricValue(after, def)
+		status, _ := evaluateGoal(current, goal.TargetValue, goal.Comparison)
+		if status == previous {
+			continue
+		}
+
+		s.Realtime.Publish(ctx, realtimedomain.NewGoalStatusChangedEvent(userID, realtimedomain.GoalStatusChanged{
+			GoalID:         goal.ID,
+			MetricKey:      goal.MetricKey,
+			Date:           day.Date,
+			PreviousStatus: previous,
+			Status:         status,
+			Current:        current,
+			Target:         goal.TargetValue,
+			ChangedAtUTC:   now,
+		}))
+	}
+}
+
+// groupChangesByDashboardDay collects, per local day in order of first appearance, the changes that
+// touch it and the keys of the metrics whose tags they carry. Days without such metrics are dropped.
+func groupChangesByDashboardDay(changes []recordChange, defs []domain.MetricDefinition) []dashboardDay {
+	var days []dashboardDay
+	index := make(map[string]int)
+	for _, change := range changes {
+		for _, rec := range []*domain.Record{change.Before, change.After} {
+			if rec == nil {
+				continue
+			}
+			day := dashboardDayOf(*rec)
+			key := day.Date + "|" + day.Timezone
+			i, seen := index[key]
+			if !seen {
+				i = len(days)
+				index[key] = i
+				days = append(days, day)
+			}
+			if n := len(days[i].Changes); n == 0 || days[i].Changes[n-1] != change {
+				days[i].Changes = append(days[i].Changes, change)
+			}
+			days[i].MetricKeys = appendMetricKeys(days[i].MetricKeys, defs, rec.TagID)
+		}
+	}
+
+	kept := days[:0]
+	for _, day := range days {
+		if len(day.MetricKeys) > 0 {
+			kept = append(kept, day)
+		}
+	}
+	return kept
+}
+
+// dashboardDayOf returns the local day of rec's event time in rec's timezone.
+func dashboardDayOf(rec domain.Record) dashboardDay {
+	tzName := DefaultTimezone
+	if rec.Timezone != nil && *rec.Timezone != "" {
+		tzName = *rec.Timezone
+	}
+	loc, err := time.LoadLocation(tzName)
+	if err != nil {
+		loc = time.UTC
+		tzName = "UTC"
+	}
+
+	local := rec.EventTime.In(loc)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	return dashboardDay{
+		Date:     start.Format(DateFormatISO8601Date),
+		Timezone: tzName,
+		StartUTC: start.UTC(),
+		EndUTC:   start.Add(24*time.Hour - time.Nanosecond).UTC(),
+	}
+}
+
+// appendMetricKeys adds the keys of the metrics fed by tagID that keys does not hold yet.
+func appendMetricKeys(keys []string, defs []domain.MetricDefinition, tagID uint64) []string {
+	for _, def := range defs {
+		if _, ok := buildMetricTagSet(def)[tagID]; !ok {
+			continue
+		}
+		present := false
+		for _, key := range keys {
+			present = present || key == def.MetricKey
+		}
+		if !present {
+			keys = append(keys, def.MetricKey)
+		}
+	}
+	return keys
+}
+
+// recordsBeforeChanges rebuilds the records of day as they were before its changes from the records
+// after them: changed records are taken out and their earlier state on that day is put back.
+func recordsBeforeChanges(after []domain.Record, day dashboardDay) []domain.Record {
+	changed := make(map[uint64]struct{}, len(day.Changes))
+	for _, change := range day.Changes {
+		for _, rec := range []*domain.Record{change.Before, change.After} {
+			if rec != nil {
+				changed[rec.ID] = struct{}{}
+			}
+		}
+	}
+
+	before := make([]domain.Record, 0, len(after))
+	for _, rec := range after {
+		if _, ok := changed[rec.ID]; !ok {
+			before = append(before, rec)
+		}
+	}
+	for _, change := range day.Changes {
+		if change.Before == nil {
+			continue
+		}
+		if other := dashboardDayOf(*change.Before); other.Date == day.Date && other.Timezone == day.Timezone {
+			before = append(before, *change.Before)
+		}
+	}
+	return before
+}
@@ -0,0 +1,250 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/usecase"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	tagdomain "github.com/lechitz/aion-api/internal/tag/core/domain"
+	"github.com/lechitz/aion-api/tests/setup"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func sessionsMetric() domain.MetricDefinition {
+	return domain.MetricDefinition{
+		MetricKey:   "sessions",
+		TagID:       10,
+		ValueSource: usecase.DashboardValueSourceCount,
+		Aggregation: usecase.DashboardAggregationCount,
+	}
+}
+
+func TestService_Create_PublishesDashboardInvalidationAndGoalStatus(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.RecordService.WithRealtime(publisher)
+
+	userID := uint64(7)
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, userID)
+	eventTime := time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)
+	earlier := domain.Record{ID: 50, UserID: userID, TagID: 10, EventTime: eventTime.Add(-time.Hour)}
+
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), userID).Return(tagdomain.Tag{ID: 10, CategoryID: 1}, nil).MinTimes(1)
+	suite.RecordRepository.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rec domain.Record) (domain.Record, error) {
+			rec.ID = 51
+			return rec, nil
+		})
+	expectCreateManyCacheWrites(suite, userID)
+
+	suite.RecordRepository.EXPECT().ListMetricDefinitions(gomock.Any(), userID).Return([]domain.MetricDefinition{sessionsMetric()}, nil)
+	suite.RecordRepository.EXPECT().ListGoalTemplates(gomock.Any(), userID).Return([]domain.GoalTemplate{
+		{ID: 3, MetricKey: "sessions", TargetValue: 2, Comparison: usecase.DashboardGoalComparisonGTE},
+	}, nil)
+	suite.RecordRepository.EXPECT().
+		ListAllBetween(gomock.Any(), userID, gomock.Any(), gomock.Any(), usecase.DefaultDashboardLimit).
+		DoAndReturn(func(_ context.Context, _ uint64, start, end time.Time, _ int) ([]domain.Record, error) {
+			loc, _ := time.LoadLocation(usecase.DefaultTimezone)
+			require.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, loc).UTC(), start)
+			require.True(t, end.After(eventTime))
+			return []domain.Record{earlier, {ID: 51, UserID: userID, TagID: 10, EventTime: eventTime}}, nil
+		})
+
+	_, err := suite.RecordService.Create(ctx, input.CreateRecordCommand{TagID: 10, EventTime: eventTime})
+	require.NoError(t, err)
+
+	events := publisher.AwaitEvents(t, 2)
+	require.Len(t, events, 2)
+
+	require.Equal(t, realtimedomain.EventTypeDashboardSnapshotInvalidated, events[0].Type)
+	require.Equal(t, userID, events[0].UserID)
+	require.Equal(t, "2026-03-01", events[0].Dashboard.Date)
+	require.Equal(t, usecase.DefaultTimezone, events[0].Dashboard.Timezone)
+	require.Equal(t, []string{"sessions"}, events[0].Dashboard.MetricKeys)
+
+	require.Equal(t, realtimedomain.EventTypeGoalStatusChanged, events[1].Type)
+	require.Equal(t, realtimedomain.GoalStatusChanged{
+		GoalID:         3,
+		MetricKey:      "sessions",
+		Date:           "2026-03-01",
+		PreviousStatus: usecase.DashboardMetricStatusPending,
+		Status:         usecase.DashboardMetricStatusCompleted,
+		Current:        2,
+		Target:         2,
+		ChangedAtUTC:   events[1].Goal.ChangedAtUTC,
+	}, *events[1].Goal)
+}
+
+func TestService_Update_MovingARecordAcrossDaysReevaluatesBothDays(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.RecordService.WithRealtime(publisher)
+
+	userID := uint64(7)
+	utc := "UTC"
+	existing := domain.Record{ID: 5, UserID: userID, TagID: 10, Timezone: &utc, EventTime: time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)}
+	moved := time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC)
+
+	suite.RecordRepository.EXPECT().GetByID(gomock.Any(), existing.ID, userID).Return(existing, nil)
+	suite.RecordRepository.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rec domain.Record) (domain.Record, error) { return rec, nil })
+	suite.RecordRepository.EXPECT().CreateRevisions(gomock.Any(), gomock.Any()).Return(nil)
+	suite.RecordCache.EXPECT().DeleteRecord(gomock.Any(), existing.ID, userID).Return(nil)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), userID).Return(tagdomain.Tag{ID: 10, CategoryID: 1}, nil)
+	expectCreateManyCacheWrites(suite, userID)
+
+	suite.RecordRepository.EXPECT().ListMetricDefinitions(gomock.Any(), userID).Return([]domain.MetricDefinition{sessionsMetric()}, nil)
+	suite.RecordRepository.EXPECT().ListGoalTemplates(gomock.Any(), userID).Return([]domain.GoalTemplate{
+		{ID: 3, MetricKey: "sessions", TargetValue: 1, Comparison: usecase.DashboardGoalComparisonGTE},
+	}, nil)
+	suite.RecordRepository.EXPECT().
+		ListAllBetween(gomock.Any(), userID, gomock.Any(), gomock.Any(), usecase.DefaultDashboardLimit).
+		DoAndReturn(func(_ context.Context, _ uint64, start, _ time.Time, _ int) ([]domain.Record, error) {
+			if start.Day() == moved.Day() {
+				after := existing
+				after.EventTime = moved
+				return []domain.Record{after}, nil
+			}
+			return nil, nil
+		}).
+		Times(2)
+
+	_, err := suite.RecordService.Update(suite.Ctx, existing.ID, userID, input.UpdateRecordCommand{EventTime: &moved})
+	require.NoError(t, err)
+
+	events := publisher.AwaitEvents(t, 4)
+	require.Len(t, events, 4)
+	require.Equal(t, "2026-03-01", events[0].Dashboard.Date)
+	require.Equal(t, "UTC", events[0].Dashboard.Timezone)
+	require.Equal(t, "2026-03-01", events[1].Goal.Date)
+	require.Equal(t, usecase.DashboardMetricStatusCompleted, events[1].Goal.PreviousStatus)
+	require.Equal(t, usecase.DashboardMetricStatusPending, events[1].Goal.Status)
+	require.Equal(t, "2026-03-02", events[2].Dashboard.Date)
+	require.Equal(t, "2026-03-02", events[3].Goal.Date)
+	require.Equal(t, usecase.DashboardMetricStatusPending, events[3].Goal.PreviousStatus)
+	require.Equal(t, usecase.DashboardMetricStatusCompleted, events[3].Goal.Status)
+}
+
+func TestService_Delete_RecordOutsideEveryMetricPublishesNothing(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.RecordService.WithRealtime(publisher)
+
+	userID := uint64(7)
+	existing := domain.Record{ID: 5, UserID: userID, TagID: 99, EventTime: time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)}
+
+	suite.RecordRepository.EXPECT().GetByID(gomock.Any(), existing.ID, userID).Return(existing, nil)
+	suite.RecordRepository.EXPECT().Delete(gomock.Any(), existing.ID, userID, gomock.Any()).Return(nil)
+	suite.RecordRepository.EXPECT().CreateRevisions(gomock.Any(), gomock.Any()).Return(nil)
+	suite.RecordCache.EXPECT().DeleteRecord(gomock.Any(), existing.ID, userID).Return(nil)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(99), userID).Return(tagdomain.Tag{ID: 99, CategoryID: 1}, nil)
+	expectCreateManyCacheWrites(suite, userID)
+
+	// No metric counts tag 99, so neither goals nor the day's records are read.
+	read := make(chan struct{})
+	suite.RecordRepository.EXPECT().
+		ListMetricDefinitions(gomock.Any(), userID).
+		DoAndReturn(func(context.Context, uint64) ([]domain.MetricDefinition, error) {
+			defer close(read)
+			return []domain.MetricDefinition{sessionsMetric()}, nil
+		})
+
+	require.NoError(t, suite.RecordService.Delete(suite.Ctx, existing.ID, userID))
+	<-read
+	require.Empty(t, publisher.Events())
+}
+
+func TestService_CreateMany_SpanningManyDaysPublishesOneDatelessInvalidation(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.RecordService.WithRealtime(publisher)
+
+	userID := uint64(7)
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, userID)
+	first := time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)
+
+	items := make([]input.CreateRecordCommand, usecase.MaxRealtimeDashboardDays+1)
+	for i := range items {
+		items[i] = input.CreateRecordCommand{TagID: 10, EventTime: first.AddDate(0, 0, i)}
+	}
+
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), userID).Return(tagdomain.Tag{ID: 10, CategoryID: 1}, nil).MinTimes(1)
+	suite.RecordRepository.EXPECT().
+		CreateMany(gomock.Any(), gomock.Len(len(items))).
+		DoAndReturn(func(ctx context.Context, records []domain.Record) ([]domain.Record, error) {
+			return assignRecordIDs(ctx, records)
+		})
+	expectCreateManyCacheWrites(suite, userID)
+
+	// Too many days to evaluate one by one: neither goals nor any day's records are read.
+	suite.RecordRepository.EXPECT().ListMetricDefinitions(gomock.Any(), userID).Return([]domain.MetricDefinition{sessionsMetric()}, nil)
+
+	_, err := suite.RecordService.CreateMany(ctx, input.CreateRecordsCommand{Items: items})
+	require.NoError(t, err)
+
+	events := publisher.AwaitEvents(t, 1)
+	require.Len(t, events, 1)
+	require.Equal(t, realtimedomain.EventTypeDashboardSnapshotInvalidated, events[0].Type)
+	require.Empty(t, events[0].Dashboard.Date)
+	require.Equal(t, []string{"sessions"}, events[0].Dashboard.MetricKeys)
+}
+
+func TestService_Create_DayOverTheDashboardLimitSkipsGoalStatus(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.RecordService.WithRealtime(publisher)
+
+	userID := uint64(7)
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, userID)
+	eventTime := time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)
+
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), userID).Return(tagdomain.Tag{ID: 10, CategoryID: 1}, nil).MinTimes(1)
+	suite.RecordRepository.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rec domain.Record) (domain.Record, error) {
+			rec.ID = 51
+			return rec, nil
+		})
+	expectCreateManyCacheWrites(suite, userID)
+
+	suite.RecordRepository.EXPECT().ListMetricDefinitions(gomock.Any(), userID).Return([]domain.MetricDefinition{sessionsMetric()}, nil)
+	suite.RecordRepository.EXPECT().ListGoalTemplates(gomock.Any(), userID).Return([]domain.GoalTemplate{
+		{ID: 3, MetricKey: "sessions", TargetValue: 1, Comparison: usecase.DashboardGoalComparisonGTE},
+	}, nil)
+	// A read that hits the limit may be truncated, so the goal's status is not trusted.
+	read := make(chan struct{})
+	suite.RecordRepository.EXPECT().
+		ListAllBetween(gomock.Any(), userID, gomock.Any(), gomock.Any(), usecase.DefaultDashboardLimit).
+		DoAndReturn(func(context.Context, uint64, time.Time, time.Time, int) ([]domain.Record, error) {
+			defer close(read)
+			records := make([]domain.Record, usecase.DefaultDashboardLimit)
+			records[0] = domain.Record{ID: 51, UserID: userID, TagID: 10, EventTime: eventTime}
+			return records, nil
+		})
+
+	_, err := suite.RecordService.Create(ctx, input.CreateRecordCommand{TagID: 10, EventTime: eventTime})
+	require.NoError(t, err)
+
+	<-read
+	events := publisher.AwaitEvents(t, 1)
+	require.Equal(t, realtimedomain.EventTypeDashboardSnapshotInvalidated, events[0].Type)
+	require.Never(t, func() bool { return len(publisher.Events()) > 1 }, 50*time.Millisecond, 5*time.Millisecond)
+}
@@ -7,8 +7,10 @@ import (
 	"strings"
 	"time"
 
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	"github.com/lechitz/aion-api/internal/record/core/domain"
 	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/ports/output"
 )
 
 // ListMetricDefinitions returns active dashboard metric definitions for the user.
@@ -147,11 +149,26 @@ func (s *Service) UpsertMetricDefinition(ctx context.Context, userID uint64, cmd
 		GoalDefault: cmd.GoalDefault,
 		IsActive:    active,
 	}
+	eventType := MetricDefinitionEventTypeCreatedV1
 	if cmd.ID != nil {
 		def.ID = *cmd.ID
+		eventType = MetricDefinitionEventTypeUpdatedV1
 	}
 
-	return s.RecordRepository.UpsertMetricDefinition(ctx, def)
+	var saved domain.MetricDefinition
+	if err := s.runWithinRecordOutboxTransaction(ctx, func(recordRepo output.RecordRepository, outboxService eventoutboxinput.Service) error {
+		var upsertErr error
+		saved, upsertErr = recordRepo.UpsertMetricDefinition(ctx, def)
+		if upsertErr != nil {
+			return upsertErr
+		}
+
+		return s.enqueueMetricDefinitionOutboxEventWithService(ctx, outboxService, eventType, saved)
+	}); err != nil {
+		return domain.MetricDefinition{}, err
+	}
+
+	return saved, nil
 }
 
 // UpsertGoalTemplate creates/updates a goal template.
@@ -1,12 +1,16 @@
 package usecase_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
+	eventoutboxdomain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
 	"github.com/lechitz/aion-api/internal/record/core/domain"
 	"github.com/lechitz/aion-api/internal/record/core/ports/input"
 	"github.com/lechitz/aion-api/internal/record/core/usecase"
+	"github.com/lechitz/aion-api/tests/mocks"
 	"github.com/lechitz/aion-api/tests/setup"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -216,3 +220,62 @@ func TestService_DashboardSnapshot_RespectsTimezoneBoundaryForChecklist(t *testi
 	require.NotNil(t, out.Metrics[0].Checklist)
 	assert.Equal(t, 1, out.Metrics[0].Checklist.CompletedCount)
 }
+
+func TestService_UpsertMetricDefinition_EnqueuesLifecycleEvent(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	outbox := mocks.NewMockOutboxService(suite.Ctrl)
+	suite.RecordService.WithOutbox(outbox)
+
+	existingID := uint64(8)
+	cases := []struct {
+		name      string
+		id        *uint64
+		eventType string
+	}{
+		{name: "create", id: nil, eventType: usecase.MetricDefinitionEventTypeCreatedV1},
+		{name: "update", id: &existingID, eventType: usecase.MetricDefinitionEventTypeUpdatedV1},
+	}
+	for _, tc := range cases {
+		suite.RecordRepository.EXPECT().
+			UpsertMetricDefinition(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, def domain.MetricDefinition) (domain.MetricDefinition, error) {
+				def.ID = existingID
+				return def, nil
+			})
+		outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event eventoutboxdomain.Event) error {
+			assert.Equal(t, usecase.MetricDefinitionAggregateType, event.AggregateType, tc.name)
+			assert.Equal(t, "8", event.AggregateID, tc.name)
+			assert.Equal(t, tc.eventType, event.EventType, tc.name)
+			return nil
+		})
+
+		_, err := suite.RecordService.UpsertMetricDefinition(t.Context(), 999, input.UpsertMetricDefinitionCommand{
+			ID:          tc.id,
+			MetricKey:   "intentions",
+			DisplayName: "Intencoes",
+			TagID:       8,
+		})
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestUpsertMetricDefinition_FailedEnqueueFailsTheWrite(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	outbox := mocks.NewMockOutboxService(suite.Ctrl)
+	suite.RecordService.WithOutbox(outbox)
+	enqueueErr := errors.New("payload rejected by schema")
+
+	suite.RecordRepository.EXPECT().UpsertMetricDefinition(gomock.Any(), gomock.Any()).Return(domain.MetricDefinition{ID: 8, UserID: 999}, nil)
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(enqueueErr)
+
+	_, err := suite.RecordService.UpsertMetricDefinition(t.Context(), 999, input.UpsertMetricDefinitionCommand{
+		MetricKey:   "intentions",
+		DisplayName: "Intencoes",
+		TagID:       8,
+	})
+	require.ErrorIs(t, err, enqueueErr)
+}
@@ -1,8 +1,113 @@
 package usecase
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
 
-// DeleteAll performs a soft delete on all records for the given user.
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/output"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// DeleteAll soft-deletes every live record of the user in one transaction. Like a single delete,
+// each record gets a deleted revision and a record.deleted event, so history and projections
+// see the bulk delete record by record.
 func (s *Service) DeleteAll(ctx context.Context, userID uint64) error {
-	return s.RecordRepository.DeleteAllByUser(ctx, userID)
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanDeleteAll)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanDeleteAll),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+	)
+
+	deletedAt := time.Now().UTC()
+	var deleted []domain.Record
+	if err := s.runWithinRecordOutboxTransaction(ctx, func(recordRepo output.RecordRepository, outboxService eventoutboxinput.Service) error {
+		// Each page is deleted before the next is listed, so listing from the start again reaches the next page.
+		for {
+			span.AddEvent(EventRepositoryList)
+			live, listErr := recordRepo.ListByUser(ctx, userID, DeleteAllPageSize, nil, nil)
+			if listErr != nil || len(live) == 0 {
+				return listErr
+			}
+
+			page, deleteErr := s.deleteRecordPage(ctx, recordRepo, outboxService, userID, live, deletedAt)
+			if deleteErr != nil {
+				return deleteErr
+			}
+			deleted = append(deleted, page...)
+		}
+	}); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, FailedToDeleteRecord)
+		s.Logger.ErrorwCtx(ctx, FailedToDeleteRecord,
+			commonkeys.UserID, userID,
+			commonkeys.Error, err,
+		)
+		return fmt.Errorf("%w: %w", ErrDeleteRecord, err)
+	}
+
+	for _, record := range deleted {
+		s.invalidateRecordCaches(ctx, span, record)
+	}
+	s.publishDashboardChanges(ctx, span, userID, deletedChanges(deleted))
+
+	span.SetAttributes(attribute.Int(AttrResultsCount, len(deleted)))
+	span.AddEvent(EventSuccess)
+	span.SetStatus(codes.Ok, StatusDeleted)
+	s.Logger.InfowCtx(ctx, LogAllRecordsSoftDeleted,
+		commonkeys.UserID, userID,
+		AttrResultsCount, len(deleted),
+	)
+
+	return nil
+}
+
+// deleteRecordPage soft-deletes live, writes one deleted revision per record and enqueues their
+// record.deleted events. It returns the records as they are after the delete.
+func (s *Service) deleteRecordPage(
+	ctx context.Context,
+	recordRepo output.RecordRepository,
+	outboxService eventoutboxinput.Service,
+	userID uint64,
+	live []domain.Record,
+	deletedAt time.Time,
+) ([]domain.Record, error) {
+	ids := make([]uint64, len(live))
+	for i := range live {
+		ids[i] = live[i].ID
+	}
+
+	if err := recordRepo.DeleteMany(ctx, userID, ids, deletedAt); err != nil {
+		return nil, err
+	}
+
+	deleted := make([]domain.Record, len(live))
+	revisions := make([]domain.RecordRevision, 0, len(live))
+	for i := range live {
+		deleted[i] = live[i]
+		deleted[i].DeletedAt = &deletedAt
+		if revision, changed := newRecordRevision(ctx, domain.RevisionOperationDeleted, live[i], deleted[i], deletedAt); changed {
+			revisions = append(revisions, revision)
+		}
+	}
+
+	if err := recordRepo.CreateRevisions(ctx, revisions); err != nil {
+		return nil, err
+	}
+
+	for i := range live {
+		if err := s.enqueueRecordOutboxEventWithService(ctx, outboxService, RecordEventTypeDeletedV1, live[i]); err != nil {
+			return nil, err
+		}
+	}
+	return deleted, nil
 }
@@ -1,22 +1,100 @@
 package usecase_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/usecase"
+	tagdomain "github.com/lechitz/aion-api/internal/tag/core/domain"
 	"github.com/lechitz/aion-api/tests/setup"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
-func TestService_DeleteAll(t *testing.T) {
+func TestService_DeleteAll_WritesRevisionAndEventPerRecord(t *testing.T) {
 	suite := setup.RecordServiceTest(t)
 	defer suite.Ctrl.Finish()
 
+	outbox := &captureOutboxService{}
+	suite.RecordService.WithOutbox(outbox)
+
 	userID := uint64(1)
+	live := []domain.Record{
+		{ID: 2, UserID: userID, TagID: 5, EventTime: time.Now().UTC()},
+		{ID: 4, UserID: userID, TagID: 5, EventTime: time.Now().UTC()},
+	}
+
+	gomock.InOrder(
+		suite.RecordRepository.EXPECT().
+			ListByUser(gomock.Any(), userID, usecase.DeleteAllPageSize, nil, nil).
+			Return(live, nil),
+		suite.RecordRepository.EXPECT().
+			ListByUser(gomock.Any(), userID, usecase.DeleteAllPageSize, nil, nil).
+			Return(nil, nil),
+	)
+
+	var stampedAt time.Time
+	suite.RecordRepository.EXPECT().
+		DeleteMany(gomock.Any(), userID, []uint64{2, 4}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint64, _ []uint64, deletedAt time.Time) error {
+			stampedAt = deletedAt
+			return nil
+		})
+	suite.RecordRepository.EXPECT().
+		CreateRevisions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, revisions []domain.RecordRevision) error {
+			require.Len(t, revisions, 2)
+			for i, revision := range revisions {
+				require.Equal(t, live[i].ID, revision.RecordID)
+				require.Equal(t, domain.RevisionOperationDeleted, revision.Operation)
+				require.Equal(t, usecase.RecordFieldDeletedAt, revision.Changes[0].Field)
+				require.Nil(t, revision.Changes[0].OldValue)
+				require.Equal(t, stampedAt, revision.CreatedAt)
+			}
+			return nil
+		})
+
+	suite.RecordCache.EXPECT().DeleteRecord(gomock.Any(), gomock.Any(), userID).Return(nil).Times(2)
+	suite.RecordCache.EXPECT().DeleteRecordsByDay(gomock.Any(), userID, gomock.Any()).Return(nil).Times(2)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(5), userID).Return(tagdomain.Tag{ID: 5, CategoryID: 8}, nil).Times(2)
+	suite.RecordCache.EXPECT().DeleteRecordsByCategory(gomock.Any(), uint64(8), userID).Return(nil).Times(2)
+	suite.RecordCache.EXPECT().DeleteRecordsByTag(gomock.Any(), uint64(5), userID).Return(nil).Times(2)
+
+	require.NoError(t, suite.RecordService.DeleteAll(suite.Ctx, userID))
+
+	require.Len(t, outbox.events, 2)
+	for _, event := range outbox.events {
+		require.Equal(t, usecase.RecordEventTypeDeletedV1, event.EventType)
+	}
+	require.Equal(t, "2", outbox.events[0].AggregateID)
+	require.Equal(t, "4", outbox.events[1].AggregateID)
+}
+
+func TestService_DeleteAll_NoLiveRecords(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	suite.RecordRepository.EXPECT().
+		ListByUser(gomock.Any(), uint64(1), usecase.DeleteAllPageSize, nil, nil).
+		Return(nil, nil)
+
+	require.NoError(t, suite.RecordService.DeleteAll(suite.Ctx, 1))
+}
+
+func TestService_DeleteAll_RevisionErrorFailsTheDelete(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	live := []domain.Record{{ID: 2, UserID: 1, TagID: 5, EventTime: time.Now().UTC()}}
 	suite.RecordRepository.EXPECT().
-		DeleteAllByUser(gomock.Any(), userID).
-		Return(nil)
+		ListByUser(gomock.Any(), uint64(1), usecase.DeleteAllPageSize, nil, nil).
+		Return(live, nil)
+	suite.RecordRepository.EXPECT().DeleteMany(gomock.Any(), uint64(1), []uint64{2}, gomock.Any()).Return(nil)
+	suite.RecordRepository.EXPECT().CreateRevisions(gomock.Any(), gomock.Any()).Return(errors.New("revisions table locked"))
 
-	err := suite.RecordService.DeleteAll(suite.Ctx, userID)
-	require.NoError(t, err)
+	err := suite.RecordService.DeleteAll(suite.Ctx, 1)
+	require.ErrorIs(t, err, usecase.ErrDeleteRecord)
 }
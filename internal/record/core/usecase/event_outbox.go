@@ -2,62 +2,75 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
-	"strconv"
+	"fmt"
 
 	eventoutboxdomain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/outboxtx"
 	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	"github.com/lechitz/aion-api/internal/record/core/domain"
-	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
-	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
 )
 
-func (s *Service) enqueueRecordOutboxEventWithService(ctx context.Context, outboxService eventoutboxinput.Service, eventType string, record domain.Record) {
+// enqueueRecordOutboxEventWithService enqueues the record event on the write's transaction. An error
+// must roll the write back, so a committed record always has its event.
+func (s *Service) enqueueRecordOutboxEventWithService(ctx context.Context, outboxService eventoutboxinput.Service, eventType string, record domain.Record) error {
 	if outboxService == nil {
-		return
+		return nil
 	}
 
-	traceID, _ := ctx.Value(ctxkeys.TraceID).(string)
-	requestID, _ := ctx.Value(ctxkeys.RequestID).(string)
-	payloadJSON, err := json.Marshal(map[string]any{
-		"record_id":        record.ID,
-		"user_id":          record.UserID,
-		"tag_id":           record.TagID,
-		"event_time_utc":   record.EventTime.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
-		"recorded_at_utc":  record.RecordedAt,
-		"status":           record.Status,
-		"timezone":         record.Timezone,
-		"duration_seconds": record.DurationSecs,
-		"value":            record.Value,
-		"source":           record.Source,
-		"description":      record.Description,
-	})
+	event, err := newRecordOutboxEvent(ctx, eventType, record)
 	if err != nil {
-		s.Logger.WarnwCtx(ctx, LogFailedToMarshalRecordEventPayload,
-			commonkeys.Error, err,
-			commonkeys.RecordID, record.ID,
-			commonkeys.UserID, record.UserID,
-			"event_type", eventType,
-		)
-		return
+		return fmt.Errorf("%s: %w", FailedToMarshalRecordEventPayload, err)
 	}
 
-	event := eventoutboxdomain.Event{
-		AggregateType: RecordAggregateType,
-		AggregateID:   strconv.FormatUint(record.ID, 10),
-		EventType:     eventType,
-		EventVersion:  RecordEventVersionV1,
-		TraceID:       traceID,
-		RequestID:     requestID,
-		PayloadJSON:   payloadJSON,
+	if err := outboxService.Enqueue(ctx, event); err != nil {
+		return fmt.Errorf("%s: %w", FailedToEnqueueRecordEvent, err)
+	}
+	return nil
+}
+
+// newRecordOutboxEvent builds the canonical record event carrying the full record state.
+func newRecordOutboxEvent(ctx context.Context, eventType string, record domain.Record) (eventoutboxdomain.Event, error) {
+	return outboxtx.NewEvent(ctx, RecordAggregateType, record.ID, eventType, RecordEventVersionV1, domain.RecordEventPayloadV1{
+		RecordID:        record.ID,
+		UserID:          record.UserID,
+		TagID:           record.TagID,
+		EventTimeUTC:    record.EventTime.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		RecordedAtUTC:   record.RecordedAt,
+		Status:          record.Status,
+		Timezone:        record.Timezone,
+		DurationSeconds: record.DurationSecs,
+		Value:           record.Value,
+		Source:          record.Source,
+		Description:     record.Description,
+	})
+}
+
+func (s *Service) enqueueMetricDefinitionOutboxEventWithService(ctx context.Context, outboxService eventoutboxinput.Service, eventType string, def domain.MetricDefinition) error {
+	if outboxService == nil {
+		return nil
+	}
+
+	event, err := outboxtx.NewEvent(ctx, MetricDefinitionAggregateType, def.ID, eventType, MetricDefinitionEventVersionV1, domain.MetricDefinitionEventPayloadV1{
+		MetricDefinitionID: def.ID,
+		UserID:             def.UserID,
+		MetricKey:          def.MetricKey,
+		DisplayName:        def.DisplayName,
+		CategoryID:         def.CategoryID,
+		TagID:              def.TagID,
+		TagIDs:             def.TagIDs,
+		ValueSource:        def.ValueSource,
+		Aggregation:        def.Aggregation,
+		Unit:               def.Unit,
+		GoalDefault:        def.GoalDefault,
+		IsActive:           def.IsActive,
+		UpdatedAtUTC:       outboxtx.FormatTime(def.UpdatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", FailedToMarshalMetricDefinitionEventPayload, err)
 	}
 
 	if err := outboxService.Enqueue(ctx, event); err != nil {
-		s.Logger.WarnwCtx(ctx, LogFailedToEnqueueRecordEvent,
-			commonkeys.Error, err,
-			commonkeys.RecordID, record.ID,
-			commonkeys.UserID, record.UserID,
-			"event_type", eventType,
-		)
+		return fmt.Errorf("%s: %w", FailedToEnqueueMetricDefinitionEvent, err)
 	}
+	return nil
 }
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// History returns the revisions of the user's record, newest first. A record that was never
+// changed, or is not the user's, has an empty history.
+func (s *Service) History(ctx context.Context, recordID uint64, userID uint64, limit int) ([]domain.RecordRevision, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanHistory)
+	defer span.End()
+
+	if limit <= 0 || limit > MaxHistoryLimit {
+		limit = DefaultHistoryLimit
+	}
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanHistory),
+		attribute.String(commonkeys.RecordID, strconv.FormatUint(recordID, 10)),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+		attribute.Int(AttrLimit, limit),
+	)
+
+	span.AddEvent(EventValidateInput)
+	if recordID == 0 || userID == 0 {
+		span.RecordError(ErrInvalidRecordIDOrUserID)
+		span.SetStatus(codes.Error, ErrToValidateRecord)
+		return nil, ErrInvalidRecordIDOrUserID
+	}
+
+	span.AddEvent(EventRepositoryList)
+	revisions, err := s.RecordRepository.ListRevisions(ctx, userID, recordID, limit)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, FailedToListRecordHistory)
+		s.Logger.ErrorwCtx(ctx, FailedToListRecordHistory,
+			commonkeys.RecordID, recordID,
+			commonkeys.UserID, userID,
+			commonkeys.Error, err,
+		)
+		return nil, fmt.Errorf("%w: %w", ErrListRecordHistory, err)
+	}
+
+	span.SetAttributes(attribute.Int(AttrResultsCount, len(revisions)))
+	span.AddEvent(EventSuccess)
+	span.SetStatus(codes.Ok, StatusRetrieved)
+	s.Logger.InfowCtx(ctx, LogRecordHistoryListed,
+		commonkeys.RecordID, recordID,
+		commonkeys.UserID, userID,
+		AttrResultsCount, len(revisions),
+	)
+
+	return revisions, nil
+}
@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/usecase"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	tagdomain "github.com/lechitz/aion-api/internal/tag/core/domain"
+	"github.com/lechitz/aion-api/tests/setup"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestService_History(t *testing.T) {
+	t.Run("clamps the limit", func(t *testing.T) {
+		suite := setup.RecordServiceTest(t)
+		defer suite.Ctrl.Finish()
+
+		revisions := []domain.RecordRevision{{ID: 2, RecordID: 5, UserID: 1}, {ID: 1, RecordID: 5, UserID: 1}}
+		suite.RecordRepository.EXPECT().
+			ListRevisions(gomock.Any(), uint64(1), uint64(5), usecase.DefaultHistoryLimit).
+			Return(revisions, nil)
+
+		got, err := suite.RecordService.History(suite.Ctx, 5, 1, usecase.MaxHistoryLimit+1)
+		require.NoError(t, err)
+		require.Equal(t, revisions, got)
+	})
+
+	t.Run("invalid ids", func(t *testing.T) {
+		suite := setup.RecordServiceTest(t)
+		defer suite.Ctrl.Finish()
+
+		_, err := suite.RecordService.History(suite.Ctx, 0, 1, 10)
+		require.ErrorIs(t, err, usecase.ErrInvalidRecordIDOrUserID)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		suite := setup.RecordServiceTest(t)
+		defer suite.Ctrl.Finish()
+
+		suite.RecordRepository.EXPECT().
+			ListRevisions(gomock.Any(), uint64(1), uint64(5), 10).
+			Return(nil, errors.New("db down"))
+
+		_, err := suite.RecordService.History(suite.Ctx, 5, 1, 10)
+		require.ErrorIs(t, err, usecase.ErrListRecordHistory)
+	})
+}
+
+func TestService_Update_RevisionSourceAndFields(t *testing.T) {
+	chat := context.WithValue(context.WithValue(context.Background(), ctxkeys.ServiceAccount, true), ctxkeys.ServiceName, usecase.ChatServiceName)
+	worker := context.WithValue(context.WithValue(context.Background(), ctxkeys.ServiceAccount, true), ctxkeys.ServiceName, "importer")
+	anonymous := context.WithValue(context.Background(), ctxkeys.ServiceAccount, true)
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		wantSource  string
+		wantService *string
+	}{
+		{name: "chat", ctx: chat, wantSource: domain.RevisionSourceChat, wantService: stringPtr(usecase.ChatServiceName)},
+		{name: "named service", ctx: worker, wantSource: domain.RevisionSourceServiceToken, wantService: stringPtr("importer")},
+		{name: "unnamed service", ctx: anonymous, wantSource: domain.RevisionSourceServiceToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := setup.RecordServiceTest(t)
+			defer suite.Ctrl.Finish()
+
+			oldValue, newValue := 1.5, 2.0
+			oldTime := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+			newTime := oldTime.Add(30 * time.Minute)
+			existing := domain.Record{ID: 2, UserID: 1, TagID: 3, EventTime: oldTime, Value: &oldValue}
+			updated := existing
+			updated.EventTime = newTime
+			updated.Value = &newValue
+
+			suite.RecordRepository.EXPECT().GetByID(gomock.Any(), uint64(2), uint64(1)).Return(existing, nil)
+			suite.RecordRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(updated, nil)
+			suite.RecordRepository.EXPECT().
+				CreateRevisions(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, revisions []domain.RecordRevision) error {
+					require.Len(t, revisions, 1)
+					revision := revisions[0]
+					require.Equal(t, tt.wantSource, revision.Source)
+					require.Equal(t, tt.wantService, revision.ActorService)
+					require.Equal(t, uint64(1), revision.ActorUserID)
+					require.Equal(t, []domain.RecordFieldChange{
+						{Field: usecase.RecordFieldEventTime, OldValue: stringPtr("2026-03-01T08:00:00Z"), NewValue: stringPtr("2026-03-01T08:30:00Z")},
+						{Field: usecase.RecordFieldValue, OldValue: stringPtr("1.5"), NewValue: stringPtr("2")},
+					}, revision.Changes)
+					return nil
+				})
+			expectRecordCacheInvalidation(suite, updated)
+
+			_, err := suite.RecordService.Update(tt.ctx, 2, 1, input.UpdateRecordCommand{EventTime: &newTime, Value: &newValue})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_Update_NoChangeWritesNoRevision(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	existing := domain.Record{ID: 2, UserID: 1, TagID: 3, EventTime: time.Now().UTC()}
+	suite.RecordRepository.EXPECT().GetByID(gomock.Any(), uint64(2), uint64(1)).Return(existing, nil)
+	suite.RecordRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(existing, nil)
+	expectRecordCacheInvalidation(suite, existing)
+
+	_, err := suite.RecordService.Update(suite.Ctx, 2, 1, input.UpdateRecordCommand{})
+	require.NoError(t, err)
+}
+
+func TestService_Update_RevisionWriteFailureFailsUpdate(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	existing := domain.Record{ID: 2, UserID: 1, TagID: 3, EventTime: time.Now().UTC()}
+	updated := existing
+	updated.Description = stringPtr("changed")
+
+	suite.RecordRepository.EXPECT().GetByID(gomock.Any(), uint64(2), uint64(1)).Return(existing, nil)
+	suite.RecordRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(updated, nil)
+	suite.RecordRepository.EXPECT().CreateRevisions(gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+
+	_, err := suite.RecordService.Update(suite.Ctx, 2, 1, input.UpdateRecordCommand{Description: stringPtr("changed")})
+	require.ErrorIs(t, err, usecase.ErrUpdateRecord)
+}
+
+func expectRecordCacheInvalidation(suite *setup.RecordServiceTestSuite, record domain.Record) {
+	suite.RecordCache.EXPECT().DeleteRecord(gomock.Any(), record.ID, record.UserID).Return(nil)
+	suite.RecordCache.EXPECT().DeleteRecordsByDay(gomock.Any(), record.UserID, gomock.Any()).Return(nil)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), record.TagID, record.UserID).Return(tagdomain.Tag{ID: record.TagID, CategoryID: 10}, nil)
+	suite.RecordCache.EXPECT().DeleteRecordsByCategory(gomock.Any(), uint64(10), record.UserID).Return(nil)
+	suite.RecordCache.EXPECT().DeleteRecordsByTag(gomock.Any(), record.TagID, record.UserID).Return(nil)
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// idempotencyPayload is the part of a create command its fingerprint covers. Times stay as the
+// client sent them, before clock-based defaults, so a retry fingerprints the same.
+type idempotencyPayload struct {
+	TagID        uint64     `json:"tag_id"`
+	Description  *string    `json:"description"`
+	EventTime    *time.Time `json:"event_time"`
+	RecordedAt   *time.Time `json:"recorded_at"`
+	DurationSecs *int       `json:"duration_seconds"`
+	Value        *float64   `json:"value"`
+	Source       *string    `json:"source"`
+	Timezone     *string    `json:"timezone"`
+	Status       *string    `json:"status"`
+}
+
+// normalizeIdempotencyKey trims the key; an absent or blank key returns "".
+func normalizeIdempotencyKey(key *string) (string, error) {
+	if key == nil {
+		return "", nil
+	}
+	trimmed := strings.TrimSpace(*key)
+	if len(trimmed) > MaxIdempotencyKeyLength {
+		return "", fmt.Errorf("%w: %d > %d", ErrIdempotencyKeyTooLong, len(trimmed), MaxIdempotencyKeyLength)
+	}
+	return trimmed, nil
+}
+
+// withIdempotencyKey returns cmd carrying its normalized key, or no key when it was blank.
+func withIdempotencyKey(cmd input.CreateRecordCommand) (input.CreateRecordCommand, error) {
+	key, err := normalizeIdempotencyKey(cmd.IdempotencyKey)
+	if err != nil {
+		return cmd, err
+	}
+	cmd.IdempotencyKey = nil
+	if key != "" {
+		cmd.IdempotencyKey = &key
+	}
+	return cmd, nil
+}
+
+// idempotencyFingerprint hashes the create payload of cmd.
+func idempotencyFingerprint(cmd input.CreateRecordCommand) string {
+	payload := idempotencyPayload{
+		TagID:        cmd.TagID,
+		Description:  cmd.Description,
+		RecordedAt:   utcTimePtr(cmd.RecordedAt),
+		DurationSecs: cmd.DurationSecs,
+		Value:        cmd.Value,
+		Source:       cmd.Source,
+		Timezone:     resolveTimezone(cmd.Timezone),
+		Status:       resolveStatus(cmd.Status),
+	}
+	if !cmd.EventTime.IsZero() {
+		payload.EventTime = utcTimePtr(&cmd.EventTime)
+	}
+
+	raw, _ := json.Marshal(payload)
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func utcTimePtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+// matchIdempotentRecord accepts stored as the answer to rec when both carry the same fingerprint.
+func matchIdempotentRecord(stored domain.Record, rec domain.Record) error {
+	if stored.IdempotencyFingerprint == nil || rec.IdempotencyFingerprint == nil ||
+		*stored.IdempotencyFingerprint != *rec.IdempotencyFingerprint {
+		return fmt.Errorf("%w: %s", ErrIdempotencyKeyConflict, *rec.IdempotencyKey)
+	}
+	return nil
+}
+
+// findIdempotentRecord returns the record an earlier create stored under rec's idempotency key.
+// It reports false when rec has no key or the key is unused.
+func (s *Service) findIdempotentRecord(ctx context.Context, rec domain.Record) (domain.Record, bool, error) {
+	if rec.IdempotencyKey == nil {
+		return domain.Record{}, false, nil
+	}
+
+	stored, err := s.RecordRepository.ListByIdempotencyKeys(ctx, rec.UserID, []string{*rec.IdempotencyKey})
+	if err != nil {
+		return domain.Record{}, false, fmt.Errorf("%w: %w", ErrCreateRecord, err)
+	}
+	if len(stored) == 0 {
+		return domain.Record{}, false, nil
+	}
+	if err := matchIdempotentRecord(stored[0], rec); err != nil {
+		return domain.Record{}, false, err
+	}
+	return stored[0], true, nil
+}
+
+// findIdempotentRecords returns the user's records stored under the keys of items, by key.
+// Items whose key fails validation are skipped here and reported by the batch.
+func (s *Service) findIdempotentRecords(ctx context.Context, userID uint64, items []input.CreateRecordCommand) (map[string]domain.Record, error) {
+	keys := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		key, err := normalizeIdempotencyKey(item.IdempotencyKey)
+		if _, dup := seen[key]; err != nil || key == "" || dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return map[string]domain.Record{}, nil
+	}
+
+	stored, err := s.RecordRepository.ListByIdempotencyKeys(ctx, userID, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]domain.Record, len(stored))
+	for _, record := range stored {
+		if record.IdempotencyKey != nil {
+			byKey[*record.IdempotencyKey] = record
+		}
+	}
+	return byKey, nil
+}
+
+// logIdempotentReplay closes a create answered with an earlier record.
+func (s *Service) logIdempotentReplay(ctx context.Context, span trace.Span, record domain.Record) {
+	span.AddEvent(EventIdempotentReplay)
+	span.SetStatus(codes.Ok, StatusRetrieved)
+	s.Logger.InfowCtx(ctx, LogRecordCreateReplayed,
+		commonkeys.RecordID, record.ID,
+		commonkeys.UserID, record.UserID,
+	)
+}
@@ -0,0 +1,250 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/server/http/middleware/servicetoken"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/usecase"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+	tagdomain "github.com/lechitz/aion-api/internal/tag/core/domain"
+	"github.com/lechitz/aion-api/tests/setup"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// storeKeyedRecord creates cmd once and returns the record as the repository stored it, with the
+// idempotency key and payload fingerprint the usecase assigned.
+func storeKeyedRecord(t *testing.T, cmd input.CreateRecordCommand) domain.Record {
+	t.Helper()
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, uint64(7))
+	suite.RecordRepository.EXPECT().ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{*cmd.IdempotencyKey}).Return(nil, nil)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), cmd.TagID, uint64(7)).Return(tagdomain.Tag{ID: cmd.TagID, CategoryID: 1}, nil).MinTimes(1)
+
+	var stored domain.Record
+	suite.RecordRepository.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rec domain.Record) (domain.Record, error) {
+			rec.ID = 41
+			stored = rec
+			return rec, nil
+		})
+	expectCreateManyCacheWrites(suite, 7)
+
+	_, err := suite.RecordService.Create(ctx, cmd)
+	require.NoError(t, err)
+	require.Equal(t, *cmd.IdempotencyKey, *stored.IdempotencyKey)
+	require.Len(t, *stored.IdempotencyFingerprint, 64)
+	return stored
+}
+
+func keyedCommand(key string) input.CreateRecordCommand {
+	description := "Morning run"
+	return input.CreateRecordCommand{
+		TagID:          10,
+		Description:    &description,
+		EventTime:      time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC),
+		IdempotencyKey: &key,
+	}
+}
+
+func TestService_Create_IdempotencyKey(t *testing.T) {
+	stored := storeKeyedRecord(t, keyedCommand("offline-1"))
+
+	t.Run("retry with the same payload returns the original record", func(t *testing.T) {
+		suite := setup.RecordServiceTest(t)
+		defer suite.Ctrl.Finish()
+		ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, uint64(7))
+
+		suite.RecordRepository.EXPECT().ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{"offline-1"}).Return([]domain.Record{stored}, nil)
+
+		cmd := keyedCommand(" offline-1 ")
+		cmd.EventTime = cmd.EventTime.In(time.FixedZone("BRT", -3*60*60))
+		got, err := suite.RecordService.Create(ctx, cmd)
+		require.NoError(t, err)
+		require.Equal(t, stored.ID, got.ID)
+	})
+
+	t.Run("same key with another payload is a conflict", func(t *testing.T) {
+		suite := setup.RecordServiceTest(t)
+		defer suite.Ctrl.Finish()
+		ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, uint64(7))
+
+		suite.RecordRepository.EXPECT().ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{"offline-1"}).Return([]domain.Record{stored}, nil)
+
+		cmd := keyedCommand("offline-1")
+		other := "Evening run"
+		cmd.Description = &other
+		_, err := suite.RecordService.Create(ctx, cmd)
+		require.ErrorIs(t, err, usecase.ErrIdempotencyKeyConflict)
+	})
+
+	t.Run("a concurrent create under the same key is replayed", func(t *testing.T) {
+		suite := setup.RecordServiceTest(t)
+		defer suite.Ctrl.Finish()
+		ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, uint64(7))
+
+		gomock.InOrder(
+			suite.RecordRepository.EXPECT().ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{"offline-1"}).Return(nil, nil),
+			suite.RecordRepository.EXPECT().ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{"offline-1"}).Return([]domain.Record{stored}, nil),
+		)
+		suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), uint64(7)).Return(tagdomain.Tag{ID: 10}, nil)
+		suite.RecordRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(domain.Record{}, errors.New("duplicate key value"))
+
+		got, err := suite.RecordService.Create(ctx, keyedCommand("offline-1"))
+		require.NoError(t, err)
+		require.Equal(t, stored.ID, got.ID)
+	})
+
+	t.Run("oversized key is rejected", func(t *testing.T) {
+		suite := setup.RecordServiceTest(t)
+		defer suite.Ctrl.Finish()
+		ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, uint64(7))
+
+		_, err := suite.RecordService.Create(ctx, keyedCommand(strings.Repeat("k", usecase.MaxIdempotencyKeyLength+1)))
+		require.ErrorIs(t, err, usecase.ErrIdempotencyKeyTooLong)
+	})
+}
+
+func TestService_CreateMany_IdempotencyKeys(t *testing.T) {
+	stored := storeKeyedRecord(t, keyedCommand("offline-1"))
+
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, uint64(7))
+
+	changed := keyedCommand("offline-1")
+	other := "Evening run"
+	changed.Description = &other
+
+	suite.RecordRepository.EXPECT().
+		ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{"offline-1", "offline-2"}).
+		Return([]domain.Record{stored}, nil)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), uint64(7)).Return(tagdomain.Tag{ID: 10, CategoryID: 1}, nil).MinTimes(1)
+	suite.RecordRepository.EXPECT().CreateMany(gomock.Any(), gomock.Len(1)).DoAndReturn(assignRecordIDs)
+	expectCreateManyCacheWrites(suite, 7)
+
+	results, err := suite.RecordService.CreateMany(ctx, input.CreateRecordsCommand{
+		Mode: input.CreateRecordsModeBestEffort,
+		Items: []input.CreateRecordCommand{
+			keyedCommand("offline-1"),
+			changed,
+			keyedCommand("offline-2"),
+			keyedCommand("offline-2"),
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, results[0].Err)
+	require.Equal(t, stored.ID, results[0].Record.ID, "a stored key replays its record")
+	require.ErrorIs(t, results[1].Err, usecase.ErrIdempotencyKeyConflict)
+	require.NoError(t, results[2].Err)
+	require.Equal(t, uint64(100), results[2].Record.ID)
+	require.NoError(t, results[3].Err)
+	require.Equal(t, uint64(100), results[3].Record.ID, "a repeated key within the batch shares one insert")
+}
+
+func TestService_CreateMany_SettlesKeysWonByConcurrentCreate(t *testing.T) {
+	won := storeKeyedRecord(t, keyedCommand("race-1"))
+	changed := keyedCommand("race-2")
+	other := "Evening run"
+	changed.Description = &other
+	conflicting := storeKeyedRecord(t, changed)
+
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, uint64(7))
+
+	unkeyed := keyedCommand("")
+	unkeyed.IdempotencyKey = nil
+
+	gomock.InOrder(
+		suite.RecordRepository.EXPECT().
+			ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{"race-1", "race-2"}).
+			Return(nil, nil),
+		suite.RecordRepository.EXPECT().
+			CreateMany(gomock.Any(), gomock.Len(3)).
+			Return(nil, errors.New("duplicate key value violates unique constraint")),
+		suite.RecordRepository.EXPECT().
+			ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{"race-1", "race-2"}).
+			Return([]domain.Record{won, conflicting}, nil),
+		suite.RecordRepository.EXPECT().
+			CreateMany(gomock.Any(), gomock.Len(1)).
+			DoAndReturn(assignRecordIDs),
+	)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), uint64(7)).Return(tagdomain.Tag{ID: 10, CategoryID: 1}, nil).MinTimes(1)
+	expectCreateManyCacheWrites(suite, 7)
+
+	results, err := suite.RecordService.CreateMany(ctx, input.CreateRecordsCommand{
+		Mode:  input.CreateRecordsModeBestEffort,
+		Items: []input.CreateRecordCommand{keyedCommand("race-1"), keyedCommand("race-2"), unkeyed},
+	})
+	require.NoError(t, err)
+	require.NoError(t, results[0].Err)
+	require.Equal(t, won.ID, results[0].Record.ID, "a key won by a concurrent create replays its record")
+	require.ErrorIs(t, results[1].Err, usecase.ErrIdempotencyKeyConflict)
+	require.NoError(t, results[2].Err)
+	require.Equal(t, uint64(100), results[2].Record.ID, "the rest of the batch is retried")
+}
+
+func TestService_CreateMany_AllOrNothingAbortsOnRacedConflict(t *testing.T) {
+	changed := keyedCommand("race-1")
+	other := "Evening run"
+	changed.Description = &other
+	conflicting := storeKeyedRecord(t, changed)
+
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, uint64(7))
+
+	unkeyed := keyedCommand("")
+	unkeyed.IdempotencyKey = nil
+
+	gomock.InOrder(
+		suite.RecordRepository.EXPECT().
+			ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{"race-1"}).
+			Return(nil, nil),
+		suite.RecordRepository.EXPECT().
+			CreateMany(gomock.Any(), gomock.Len(2)).
+			Return(nil, errors.New("duplicate key value violates unique constraint")),
+		suite.RecordRepository.EXPECT().
+			ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{"race-1"}).
+			Return([]domain.Record{conflicting}, nil),
+	)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(10), uint64(7)).Return(tagdomain.Tag{ID: 10, CategoryID: 1}, nil).MinTimes(1)
+
+	results, err := suite.RecordService.CreateMany(ctx, input.CreateRecordsCommand{
+		Mode:  input.CreateRecordsModeAllOrNothing,
+		Items: []input.CreateRecordCommand{keyedCommand("race-1"), unkeyed},
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, results[0].Err, usecase.ErrIdempotencyKeyConflict)
+	require.ErrorIs(t, results[1].Err, usecase.ErrBatchItemAborted)
+}
+
+// Chat quick-add reaches createRecord through aion-chat's service key with the idempotency key the
+// client sent in ui_action.quick_add; a retried quick-add must replay the first record.
+func TestService_Create_ChatQuickAddRetryReturnsTheOriginalRecord(t *testing.T) {
+	require.Equal(t, servicetoken.AionChatServiceName, usecase.ChatServiceName, "the service-key caller name is what marks chat changes")
+
+	stored := storeKeyedRecord(t, keyedCommand("qa-1"))
+
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+	ctx := context.WithValue(suite.Ctx, ctxkeys.UserID, uint64(7))
+	ctx = context.WithValue(ctx, ctxkeys.ServiceAccount, true)
+	ctx = context.WithValue(ctx, ctxkeys.ServiceName, servicetoken.AionChatServiceName)
+
+	suite.RecordRepository.EXPECT().ListByIdempotencyKeys(gomock.Any(), uint64(7), []string{"qa-1"}).Return([]domain.Record{stored}, nil)
+
+	got, err := suite.RecordService.Create(ctx, keyedCommand("qa-1"))
+	require.NoError(t, err)
+	require.Equal(t, stored.ID, got.ID)
+}
@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/observability/tracecontext"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/output"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	tagoutput "github.com/lechitz/aion-api/internal/tag/core/ports/output"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Projector is the built-in replacement for the aion-streams record projection: it applies record
+// outbox events to aion_derived.record_projection_v1 and announces every applied change with a
+// projection-ready event, so one aion-api deployment serves the whole derived read path.
+type Projector struct {
+	Writer        output.RecordProjectionWriter
+	TagRepository tagoutput.TagRepository
+	Publisher     output.ProjectionReadyPublisher
+	Logger        logger.ContextLogger
+}
+
+// NewProjector wires the projection writer, the tag lookup used to resolve categories, and the
+// projection-ready publisher.
+func NewProjector(
+	writer output.RecordProjectionWriter,
+	tagRepo tagoutput.TagRepository,
+	publisher output.ProjectionReadyPublisher,
+	logger logger.ContextLogger,
+) *Projector {
+	return &Projector{
+		Writer:        writer,
+		TagRepository: tagRepo,
+		Publisher:     publisher,
+		Logger:        logger,
+	}
+}
+
+// Project applies one record event. Redelivered or out-of-date events leave the projection
+// untouched and emit nothing. A failed write is returned so the caller can retry; a lost
+// projection-ready event is only logged, since realtime clients recover through resync.
+func (p *Projector) Project(ctx context.Context, event domain.RecordEvent) error {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(
+		tracecontext.Extract(ctx, event.TraceParent, event.TraceState),
+		SpanProject,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+	)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanProject),
+		attribute.String(commonkeys.RecordID, strconv.FormatUint(event.Payload.RecordID, 10)),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(event.Payload.UserID, 10)),
+		attribute.String(AttrEventType, event.EventType),
+	)
+
+	readyType := projectionEventType(event.EventType)
+	if readyType == "" {
+		span.SetStatus(codes.Ok, StatusProjectionSkipped)
+		p.Logger.DebugwCtx(ctx, LogRecordEventIgnored, AttrEventType, event.EventType)
+		return nil
+	}
+
+	projection, err := toRecordProjection(event)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, FailedToProjectRecordEvent)
+		return err
+	}
+
+	span.AddEvent(EventProjectionWrite)
+	var applied bool
+	if readyType == ProjectionEventTypeDeleted {
+		applied, err = p.Writer.DeleteProjection(ctx, projection)
+	} else {
+		applied, err = p.Writer.UpsertProjection(ctx, projection)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, FailedToProjectRecordEvent)
+		p.Logger.ErrorwCtx(ctx, FailedToProjectRecordEvent,
+			commonkeys.RecordID, projection.RecordID,
+			commonkeys.UserID, projection.UserID,
+			commonkeys.Error, err,
+		)
+		return fmt.Errorf("%w: %w", ErrProjectRecordEvent, err)
+	}
+	if !applied {
+		span.SetStatus(codes.Ok, StatusProjectionSkipped)
+		return nil
+	}
+
+	span.AddEvent(EventProjectionReadyPublish)
+	ready := p.projectionReady(ctx, event, readyType, projection)
+	if err := p.Publisher.PublishProjectionReady(ctx, ready); err != nil {
+		span.RecordError(err)
+		p.Logger.WarnwCtx(ctx, LogFailedPublishProjectionReady,
+			commonkeys.RecordID, projection.RecordID,
+			commonkeys.UserID, projection.UserID,
+			commonkeys.Error, err,
+		)
+	}
+
+	span.SetStatus(codes.Ok, StatusProjected)
+	p.Logger.DebugwCtx(ctx, LogRecordEventProjected,
+		commonkeys.RecordID, projection.RecordID,
+		commonkeys.UserID, projection.UserID,
+		AttrEventType, event.EventType,
+	)
+	return nil
+}
+
+// projectionReady builds the event announcing an applied change. The category comes from the
+// record's tag; when the tag cannot be read the event goes out without one.
+func (p *Projector) projectionReady(
+	ctx context.Context,
+	event domain.RecordEvent,
+	readyType string,
+	projection domain.RecordProjection,
+) domain.ProjectionReady {
+	ready := domain.ProjectionReady{
+		EventType:       readyType,
+		EventVersion:    ProjectionEventVersionV1,
+		UserID:          projection.UserID,
+		RecordID:        projection.RecordID,
+		TagID:           projection.TagID,
+		SourceEventID:   event.EventID,
+		SourceEventType: event.EventType,
+		ProjectedAtUTC:  projection.LastConsumedAtUTC,
+		TraceID:         event.TraceID,
+		RequestID:       event.RequestID,
+	}
+	ready.TraceParent, ready.TraceState = tracecontext.Inject(ctx)
+
+	if p.TagRepository != nil && projection.TagID != 0 {
+		tag, err := p.TagRepository.GetByID(ctx, projection.TagID, projection.UserID)
+		if err != nil {
+			p.Logger.WarnwCtx(ctx, LogFailedLookupProjectionCategory,
+				commonkeys.TagID, projection.TagID,
+				commonkeys.UserID, projection.UserID,
+				commonkeys.Error, err,
+			)
+		} else {
+			ready.CategoryID = tag.CategoryID
+		}
+	}
+	return ready
+}
+
+// toRecordProjection maps a record event to the projection row it stands for, stamped with the
+// transport position used by the writer's idempotency check.
+func toRecordProjection(event domain.RecordEvent) (domain.RecordProjection, error) {
+	eventTime, err := time.Parse(time.RFC3339Nano, event.Payload.EventTimeUTC)
+	if err != nil {
+		return domain.RecordProjection{}, fmt.Errorf("%w: %w", ErrInvalidRecordEventTime, err)
+	}
+
+	projection := domain.RecordProjection{
+		RecordID:           event.Payload.RecordID,
+		UserID:             event.Payload.UserID,
+		TagID:              event.Payload.TagID,
+		Description:        event.Payload.Description,
+		EventTimeUTC:       eventTime.UTC(),
+		RecordedAtUTC:      event.Payload.RecordedAtUTC,
+		Status:             event.Payload.Status,
+		Timezone:           event.Payload.Timezone,
+		DurationSeconds:    event.Payload.DurationSeconds,
+		Value:              event.Payload.Value,
+		Source:             event.Payload.Source,
+		LastEventID:        event.EventID,
+		LastEventType:      event.EventType,
+		LastEventVersion:   event.EventVersion,
+		LastKafkaTopic:     event.Topic,
+		LastKafkaPartition: event.Partition,
+		LastKafkaOffset:    event.Offset,
+		LastConsumedAtUTC:  time.Now().UTC(),
+		PayloadJSON:        event.PayloadJSON,
+	}
+	if event.TraceID != "" {
+		projection.LastTraceID = &event.TraceID
+	}
+	if event.RequestID != "" {
+		projection.LastRequestID = &event.RequestID
+	}
+	return projection, nil
+}
+
+func projectionEventType(eventType string) string {
+	switch eventType {
+	case RecordEventTypeCreatedV1, RecordEventTypeRestoredV1:
+		return ProjectionEventTypeCreated
+	case RecordEventTypeUpdatedV1:
+		return ProjectionEventTypeUpdated
+	case RecordEventTypeDeletedV1:
+		return ProjectionEventTypeDeleted
+	default:
+		return ""
+	}
+}
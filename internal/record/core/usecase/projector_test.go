@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/usecase"
+	tagdomain "github.com/lechitz/aion-api/internal/tag/core/domain"
+	"github.com/lechitz/aion-api/tests/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type projectorSuite struct {
+	writer    *mocks.MockRecordProjectionWriter
+	tags      *mocks.MockTagRepository
+	publisher *mocks.MockProjectionReadyPublisher
+	projector *usecase.Projector
+}
+
+func newProjectorSuite(t *testing.T) projectorSuite {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	log := mocks.NewMockContextLogger(ctrl)
+	log.EXPECT().DebugwCtx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().WarnwCtx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().ErrorwCtx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	suite := projectorSuite{
+		writer:    mocks.NewMockRecordProjectionWriter(ctrl),
+		tags:      mocks.NewMockTagRepository(ctrl),
+		publisher: mocks.NewMockProjectionReadyPublisher(ctrl),
+	}
+	suite.projector = usecase.NewProjector(suite.writer, suite.tags, suite.publisher, log)
+	return suite
+}
+
+func recordEvent(eventType string) domain.RecordEvent {
+	return domain.RecordEvent{
+		EventID:      "evt-1",
+		EventType:    eventType,
+		EventVersion: "v1",
+		TraceID:      "trace-1",
+		Topic:        "aion.record.events.v1",
+		Partition:    2,
+		Offset:       40,
+		PayloadJSON:  []byte(`{"record_id":42}`),
+		Payload: domain.RecordEventPayloadV1{
+			RecordID:     42,
+			UserID:       14,
+			TagID:        7,
+			EventTimeUTC: "2026-03-13T13:00:00.000000Z",
+		},
+	}
+}
+
+func TestProjector_UpsertsAndPublishesProjectionReady(t *testing.T) {
+	suite := newProjectorSuite(t)
+
+	suite.writer.EXPECT().UpsertProjection(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, projection domain.RecordProjection) (bool, error) {
+			require.Equal(t, uint64(42), projection.RecordID)
+			require.Equal(t, "evt-1", projection.LastEventID)
+			require.Equal(t, "aion.record.events.v1", projection.LastKafkaTopic)
+			require.Equal(t, 2, projection.LastKafkaPartition)
+			require.Equal(t, int64(40), projection.LastKafkaOffset)
+			require.Equal(t, "trace-1", *projection.LastTraceID)
+			require.Nil(t, projection.LastRequestID)
+			require.Equal(t, 13, projection.EventTimeUTC.Hour())
+			require.False(t, projection.LastConsumedAtUTC.IsZero())
+			return true, nil
+		})
+	suite.tags.EXPECT().GetByID(gomock.Any(), uint64(7), uint64(14)).Return(tagdomain.Tag{ID: 7, CategoryID: 3}, nil)
+	suite.publisher.EXPECT().PublishProjectionReady(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, ready domain.ProjectionReady) error {
+			require.Equal(t, usecase.ProjectionEventTypeCreated, ready.EventType)
+			require.Equal(t, "v1", ready.EventVersion)
+			require.Equal(t, uint64(3), ready.CategoryID)
+			require.Equal(t, "evt-1", ready.SourceEventID)
+			require.Equal(t, "record.created", ready.SourceEventType)
+			require.False(t, ready.ProjectedAtUTC.IsZero())
+			return nil
+		})
+
+	require.NoError(t, suite.projector.Project(t.Context(), recordEvent(usecase.RecordEventTypeCreatedV1)))
+}
+
+func TestProjector_DeletesProjection(t *testing.T) {
+	suite := newProjectorSuite(t)
+
+	suite.writer.EXPECT().DeleteProjection(gomock.Any(), gomock.Any()).Return(true, nil)
+	suite.tags.EXPECT().GetByID(gomock.Any(), uint64(7), uint64(14)).Return(tagdomain.Tag{}, errors.New("tag gone"))
+	suite.publisher.EXPECT().PublishProjectionReady(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, ready domain.ProjectionReady) error {
+			require.Equal(t, usecase.ProjectionEventTypeDeleted, ready.EventType)
+			require.Zero(t, ready.CategoryID)
+			return nil
+		})
+
+	require.NoError(t, suite.projector.Project(t.Context(), recordEvent(usecase.RecordEventTypeDeletedV1)))
+}
+
+func TestProjector_SkipsDuplicatesAndUnknownTypes(t *testing.T) {
+	suite := newProjectorSuite(t)
+
+	suite.writer.EXPECT().UpsertProjection(gomock.Any(), gomock.Any()).Return(false, nil)
+	require.NoError(t, suite.projector.Project(t.Context(), recordEvent(usecase.RecordEventTypeUpdatedV1)))
+
+	require.NoError(t, suite.projector.Project(t.Context(), recordEvent("record.archived")))
+}
+
+func TestProjector_ReturnsWriteErrors(t *testing.T) {
+	suite := newProjectorSuite(t)
+
+	suite.writer.EXPECT().UpsertProjection(gomock.Any(), gomock.Any()).Return(false, errors.New("db down"))
+	err := suite.projector.Project(t.Context(), recordEvent(usecase.RecordEventTypeUpdatedV1))
+	require.ErrorIs(t, err, usecase.ErrProjectRecordEvent)
+
+	event := recordEvent(usecase.RecordEventTypeUpdatedV1)
+	event.Payload.EventTimeUTC = "yesterday"
+	require.ErrorIs(t, suite.projector.Project(t.Context(), event), usecase.ErrInvalidRecordEventTime)
+}
+
+func TestProjector_PublishFailureDoesNotFailProjection(t *testing.T) {
+	suite := newProjectorSuite(t)
+
+	suite.writer.EXPECT().UpsertProjection(gomock.Any(), gomock.Any()).Return(true, nil)
+	suite.tags.EXPECT().GetByID(gomock.Any(), uint64(7), uint64(14)).Return(tagdomain.Tag{ID: 7, CategoryID: 3}, nil)
+	suite.publisher.EXPECT().PublishProjectionReady(gomock.Any(), gomock.Any()).Return(errors.New("broker down"))
+
+	require.NoError(t, suite.projector.Project(t.Context(), recordEvent(usecase.RecordEventTypeUpdatedV1)))
+}
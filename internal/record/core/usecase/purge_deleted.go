@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/ports/output"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// TrashPurgeConfig controls how long soft-deleted records stay restorable and how they are purged.
+// Zero values fall back to package defaults; a nil MeterProvider uses the global OpenTelemetry provider.
+type TrashPurgeConfig struct {
+	MeterProvider metric.MeterProvider
+	Retention     time.Duration
+	BatchSize     int
+	MaxBatches    int
+}
+
+// TrashPurger hard-deletes records soft-deleted longer ago than the trash retention. Their
+// projections already went with the record.deleted event, so purging emits no events.
+type TrashPurger struct {
+	repository output.RecordRepository
+	logger     logger.ContextLogger
+	purged     metric.Int64Counter
+	now        func() time.Time
+	retention  time.Duration
+	batchSize  int
+	maxBatches int
+}
+
+// NewTrashPurger creates the trash retention job use case.
+func NewTrashPurger(repository output.RecordRepository, log logger.ContextLogger, cfg TrashPurgeConfig) input.RecordTrashPurger {
+	if cfg.Retention <= 0 {
+		cfg.Retention = DefaultTrashRetention
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultTrashPurgeBatchSize
+	}
+	if cfg.MaxBatches <= 0 {
+		cfg.MaxBatches = DefaultTrashPurgeMaxBatches
+	}
+	if cfg.MeterProvider == nil {
+		cfg.MeterProvider = otel.GetMeterProvider()
+	}
+
+	purged, err := cfg.MeterProvider.Meter(MeterName).Int64Counter(MetricTrashPurged,
+		metric.WithDescription("Soft-deleted records hard-purged after the trash retention."),
+		metric.WithUnit("{record}"),
+	)
+	if err != nil {
+		log.Warnw(LogTrashMetricsUnavailable, commonkeys.Error, err.Error())
+	}
+
+	return &TrashPurger{
+		repository: repository,
+		logger:     log,
+		purged:     purged,
+		now: func() time.Time {
+			return time.Now().UTC()
+		},
+		retention:  cfg.Retention,
+		batchSize:  cfg.BatchSize,
+		maxBatches: cfg.MaxBatches,
+	}
+}
+
+// PurgeDeleted deletes records soft-deleted before the retention cutoff in batches until none are
+// left, the batch budget of one run is spent, or ctx ends. It returns how many records were removed.
+func (p *TrashPurger) PurgeDeleted(ctx context.Context) (int64, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanPurgeDeleted)
+	defer span.End()
+
+	cutoff := p.now().Add(-p.retention)
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanPurgeDeleted),
+		attribute.String(AttrCutoff, cutoff.Format(time.RFC3339)),
+		attribute.Int(AttrLimit, p.batchSize),
+	)
+
+	var total int64
+	for range p.maxBatches {
+		span.AddEvent(EventRepositoryPurge)
+		removed, err := p.repository.PurgeDeleted(ctx, cutoff, p.batchSize)
+		p.recordPurged(ctx, removed)
+		total += removed
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, FailedToPurgeDeletedRecords)
+			p.logger.ErrorwCtx(ctx, FailedToPurgeDeletedRecords,
+				commonkeys.Error, err.Error(),
+				AttrCutoff, cutoff,
+				AttrResultsCount, total,
+			)
+			return total, fmt.Errorf("%w: %w", ErrPurgeDeletedRecords, err)
+		}
+		if removed < int64(p.batchSize) || ctx.Err() != nil {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int64(AttrResultsCount, total))
+	span.SetStatus(codes.Ok, StatusPurged)
+	if total > 0 {
+		p.logger.InfowCtx(ctx, LogDeletedRecordsPurged,
+			AttrCutoff, cutoff,
+			AttrResultsCount, total,
+		)
+	}
+	return total, nil
+}
+
+func (p *TrashPurger) recordPurged(ctx context.Context, removed int64) {
+	if p.purged == nil || removed == 0 {
+		return
+	}
+	p.purged.Add(ctx, removed)
+}
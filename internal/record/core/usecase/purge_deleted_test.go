@@ -0,0 +1,93 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/core/usecase"
+	"github.com/lechitz/aion-api/tests/mocks"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/mock/gomock"
+)
+
+func newTrashPurger(t *testing.T, cfg usecase.TrashPurgeConfig) (*mocks.MockRecordRepository, *sdkmetric.ManualReader, func() (int64, error)) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	log := mocks.NewMockContextLogger(ctrl)
+	log.EXPECT().InfowCtx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().ErrorwCtx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo := mocks.NewMockRecordRepository(ctrl)
+	reader := sdkmetric.NewManualReader()
+	cfg.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	purger := usecase.NewTrashPurger(repo, log, cfg)
+	return repo, reader, func() (int64, error) { return purger.PurgeDeleted(t.Context()) }
+}
+
+func purgedSum(t *testing.T, reader *sdkmetric.ManualReader) int64 {
+	t.Helper()
+	var collected metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &collected))
+
+	var total int64
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != usecase.MetricTrashPurged {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				total += point.Value
+			}
+		}
+	}
+	return total
+}
+
+func TestTrashPurger_PurgesInBatchesPastTheRetention(t *testing.T) {
+	repo, reader, purge := newTrashPurger(t, usecase.TrashPurgeConfig{Retention: 48 * time.Hour, BatchSize: 2})
+
+	var cutoffs []time.Time
+	record := func(removed int64) func(any, time.Time, int) (int64, error) {
+		return func(_ any, before time.Time, limit int) (int64, error) {
+			require.Equal(t, 2, limit)
+			cutoffs = append(cutoffs, before)
+			return removed, nil
+		}
+	}
+	gomock.InOrder(
+		repo.EXPECT().PurgeDeleted(gomock.Any(), gomock.Any(), 2).DoAndReturn(record(2)),
+		repo.EXPECT().PurgeDeleted(gomock.Any(), gomock.Any(), 2).DoAndReturn(record(1)),
+	)
+
+	removed, err := purge()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), removed)
+	require.Equal(t, int64(3), purgedSum(t, reader))
+
+	require.Equal(t, cutoffs[0], cutoffs[1], "one run uses a single cutoff")
+	require.WithinDuration(t, time.Now().UTC().Add(-48*time.Hour), cutoffs[0], time.Minute)
+}
+
+func TestTrashPurger_StopsAtTheBatchBudget(t *testing.T) {
+	repo, _, purge := newTrashPurger(t, usecase.TrashPurgeConfig{BatchSize: 1, MaxBatches: 3})
+	repo.EXPECT().PurgeDeleted(gomock.Any(), gomock.Any(), 1).Return(int64(1), nil).Times(3)
+
+	removed, err := purge()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), removed)
+}
+
+func TestTrashPurger_ReturnsRepositoryErrors(t *testing.T) {
+	repo, _, purge := newTrashPurger(t, usecase.TrashPurgeConfig{BatchSize: 2})
+	gomock.InOrder(
+		repo.EXPECT().PurgeDeleted(gomock.Any(), gomock.Any(), 2).Return(int64(2), nil),
+		repo.EXPECT().PurgeDeleted(gomock.Any(), gomock.Any(), 2).Return(int64(0), errors.New("db down")),
+	)
+
+	removed, err := purge()
+	require.ErrorIs(t, err, usecase.ErrPurgeDeletedRecords)
+	require.Equal(t, int64(2), removed)
+}
@@ -0,0 +1,375 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/outboxtx"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/ports/output"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Reconciler checks aion_derived.record_projection_v1 against aion_api.records. Drift is repaired
+// by re-enqueuing record outbox events, so the projector rewrites each row through its usual
+// idempotent path instead of the reconciler writing projections itself.
+type Reconciler struct {
+	Repository         output.RecordProjectionReconcileRepository
+	OutboxService      eventoutboxinput.Service
+	TransactionManager dbport.DB
+	Logger             logger.ContextLogger
+	metrics            *reconcileMetrics
+	now                func() time.Time
+}
+
+// NewReconciler wires the reconcile repository and the outbox used for repairs. A nil provider
+// uses the global OpenTelemetry meter provider.
+func NewReconciler(
+	repository output.RecordProjectionReconcileRepository,
+	outboxService eventoutboxinput.Service,
+	log logger.ContextLogger,
+	provider metric.MeterProvider,
+) *Reconciler {
+	if provider == nil {
+		provider = otel.GetMeterProvider()
+	}
+	metrics, err := newReconcileMetrics(provider)
+	if err != nil {
+		log.Warnw(LogReconcileMetricsUnavailable, commonkeys.Error, err.Error())
+	}
+
+	return &Reconciler{
+		Repository:    repository,
+		OutboxService: outboxService,
+		Logger:        log,
+		metrics:       metrics,
+		now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+// WithTransactionManager attaches the transaction manager a rebuild clears and re-enqueues in.
+func (r *Reconciler) WithTransactionManager(database dbport.DB) *Reconciler {
+	r.TransactionManager = database
+	return r
+}
+
+// Reconcile reports the drift of one user, or of every user with records or projections when
+// opts.UserID is zero, and re-enqueues an event per drifted record when opts.Repair is set. The
+// drift gauge is only set by all-users checks and reflects drift found before any repair.
+func (r *Reconciler) Reconcile(ctx context.Context, opts input.ReconcileOptions) ([]domain.ProjectionDriftReport, error) {
+	if opts.Repair && r.OutboxService == nil {
+		return nil, ErrOutboxUnavailable
+	}
+
+	userIDs := []uint64{opts.UserID}
+	if opts.UserID == 0 {
+		ids, err := r.Repository.ListProjectionUserIDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrReconcileProjection, err)
+		}
+		userIDs = ids
+	}
+
+	reports := make([]domain.ProjectionDriftReport, 0, len(userIDs))
+	for _, userID := range userIDs {
+		report, err := r.reconcileUser(ctx, userID, opts)
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, report)
+	}
+
+	if opts.UserID == 0 {
+		r.metrics.recordDrift(ctx, reports)
+	}
+	return reports, nil
+}
+
+func (r *Reconciler) reconcileUser(ctx context.Context, userID uint64, opts input.ReconcileOptions) (domain.ProjectionDriftReport, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanReconcileProjection)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanReconcileProjection),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+	)
+
+	span.AddEvent(EventRepositoryList)
+	records, err := r.Repository.ListCanonicalRecords(ctx, userID)
+	if err != nil {
+		return domain.ProjectionDriftReport{}, r.failReconcile(ctx, span, userID, err)
+	}
+	projections, err := r.Repository.ListUserProjections(ctx, userID)
+	if err != nil {
+		return domain.ProjectionDriftReport{}, r.failReconcile(ctx, span, userID, err)
+	}
+
+	span.AddEvent(EventProjectionCompare)
+	now := r.now()
+	report := domain.ProjectionDriftReport{
+		UserID:       userID,
+		CheckedAtUTC: now,
+		Records:      len(records),
+		Projections:  len(projections),
+		Drifts:       compareProjections(records, projections, now.Add(-opts.Grace)),
+	}
+	if len(report.Drifts) > 0 {
+		counts := report.Counts()
+		r.Logger.WarnwCtx(ctx, LogProjectionDriftFound,
+			commonkeys.UserID, userID,
+			domain.DriftMissing, counts[domain.DriftMissing],
+			domain.DriftStale, counts[domain.DriftStale],
+			domain.DriftMismatch, counts[domain.DriftMismatch],
+			domain.DriftOrphaned, counts[domain.DriftOrphaned],
+		)
+	}
+
+	if opts.Repair {
+		span.AddEvent(EventOutboxEnqueue)
+		for _, drift := range report.Drifts {
+			if err := r.repair(ctx, drift); err != nil {
+				r.metrics.recordRepaired(ctx, report.Repaired)
+				return report, r.failReconcile(ctx, span, userID, err)
+			}
+			report.Repaired++
+		}
+		r.metrics.recordRepaired(ctx, report.Repaired)
+	}
+
+	span.SetAttributes(attribute.Int(AttrResultsCount, len(report.Drifts)))
+	span.SetStatus(codes.Ok, StatusProjectionReconciled)
+	return report, nil
+}
+
+// Rebuild deletes every projection of userID and re-enqueues a created event per live record in one
+// transaction, so the projector repopulates the user from scratch. Reads of the derived path see the
+// user's records reappear as the events are projected; a failed rebuild changes nothing and can be
+// rerun.
+func (r *Reconciler) Rebuild(ctx context.Context, userID uint64) (int, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanRebuildProjection)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanRebuildProjection),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+	)
+
+	if userID == 0 {
+		span.SetStatus(codes.Error, UserIDIsRequired)
+		return 0, ErrUserIDIsRequired
+	}
+	if r.OutboxService == nil {
+		span.SetStatus(codes.Error, OutboxUnavailable)
+		return 0, ErrOutboxUnavailable
+	}
+
+	span.AddEvent(EventRepositoryList)
+	records, err := r.Repository.ListCanonicalRecords(ctx, userID)
+	if err != nil {
+		return 0, r.failRebuild(ctx, span, userID, err)
+	}
+
+	// Clearing and re-enqueuing commit together: a failed rebuild leaves the projections as they were
+	// instead of emptied with only some of their events on the way.
+	var cleared int64
+	enqueued := 0
+	err = outboxtx.Run(ctx, r.TransactionManager, r.Repository, r.OutboxService, func(
+		repository output.RecordProjectionReconcileRepository,
+		outboxService eventoutboxinput.Service,
+	) error {
+		span.AddEvent(EventProjectionClear)
+		var clearErr error
+		if cleared, clearErr = repository.DeleteUserProjections(ctx, userID); clearErr != nil {
+			return clearErr
+		}
+
+		span.AddEvent(EventOutboxEnqueue)
+		for _, record := range records {
+			if enqueueErr := enqueueRecordEvent(ctx, outboxService, RecordEventTypeCreatedV1, record); enqueueErr != nil {
+				return enqueueErr
+			}
+			enqueued++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, r.failRebuild(ctx, span, userID, err)
+	}
+	r.metrics.recordRepaired(ctx, enqueued)
+
+	span.SetAttributes(attribute.Int(AttrResultsCount, enqueued))
+	span.SetStatus(codes.Ok, StatusProjectionRebuilt)
+	r.Logger.InfowCtx(ctx, LogProjectionRebuilt,
+		commonkeys.UserID, userID,
+		"cleared", cleared,
+		"enqueued", enqueued,
+	)
+	return enqueued, nil
+}
+
+// repair re-enqueues the event that brings one drifted projection back in line: a created event
+// for a missing row, a deleted event for an orphan and an updated event otherwise.
+func (r *Reconciler) repair(ctx context.Context, drift domain.ProjectionDrift) error {
+	switch drift.Kind {
+	case domain.DriftMissing:
+		return r.enqueue(ctx, RecordEventTypeCreatedV1, drift.Record)
+	case domain.DriftOrphaned:
+		return r.enqueue(ctx, RecordEventTypeDeletedV1, recordFromProjection(drift.Projection))
+	default:
+		return r.enqueue(ctx, RecordEventTypeUpdatedV1, drift.Record)
+	}
+}
+
+func (r *Reconciler) enqueue(ctx context.Context, eventType string, record domain.Record) error {
+	return enqueueRecordEvent(ctx, r.OutboxService, eventType, record)
+}
+
+func enqueueRecordEvent(ctx context.Context, outboxService eventoutboxinput.Service, eventType string, record domain.Record) error {
+	event, err := newRecordOutboxEvent(ctx, eventType, record)
+	if err != nil {
+		return err
+	}
+	return outboxService.Enqueue(ctx, event)
+}
+
+func (r *Reconciler) failReconcile(ctx context.Context, span trace.Span, userID uint64, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, FailedToReconcileProjection)
+	r.Logger.ErrorwCtx(ctx, FailedToReconcileProjection, commonkeys.UserID, userID, commonkeys.Error, err)
+	return fmt.Errorf("%w: %w", ErrReconcileProjection, err)
+}
+
+func (r *Reconciler) failRebuild(ctx context.Context, span trace.Span, userID uint64, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, FailedToRebuildProjection)
+	r.Logger.ErrorwCtx(ctx, FailedToRebuildProjection, commonkeys.UserID, userID, commonkeys.Error, err)
+	return fmt.Errorf("%w: %w", ErrRebuildProjection, err)
+}
+
+// compareProjections pairs records with projections by id. Records and projections written after
+// cutoff are skipped, since their events may still be on the way to the projector.
+func compareProjections(records []domain.Record, projections []domain.RecordProjection, cutoff time.Time) []domain.ProjectionDrift {
+	byID := make(map[uint64]domain.RecordProjection, len(projections))
+	for _, projection := range projections {
+		byID[projection.RecordID] = projection
+	}
+
+	var drifts []domain.ProjectionDrift
+	for _, record := range records {
+		projection, ok := byID[record.ID]
+		delete(byID, record.ID)
+		if record.UpdatedAt.After(cutoff) {
+			continue
+		}
+
+		drift := domain.ProjectionDrift{RecordID: record.ID, UserID: record.UserID, Record: record, Projection: projection}
+		if !ok {
+			drift.Kind = domain.DriftMissing
+		} else if drift.Fields = mismatchedFields(record, projection); len(drift.Fields) > 0 {
+			drift.Kind = domain.DriftMismatch
+		} else if record.UpdatedAt.After(projection.UpdatedAtUTC) {
+			drift.Kind = domain.DriftStale
+		} else {
+			continue
+		}
+		drifts = append(drifts, drift)
+	}
+
+	for _, projection := range byID {
+		if projection.UpdatedAtUTC.After(cutoff) {
+			continue
+		}
+		drifts = append(drifts, domain.ProjectionDrift{
+			Kind:       domain.DriftOrphaned,
+			RecordID:   projection.RecordID,
+			UserID:     projection.UserID,
+			Projection: projection,
+		})
+	}
+
+	slices.SortFunc(drifts, func(a, b domain.ProjectionDrift) int {
+		return cmp.Compare(a.RecordID, b.RecordID)
+	})
+	return drifts
+}
+
+// mismatchedFields lists the projected columns that differ. Times are compared at the microsecond
+// precision record events carry.
+func mismatchedFields(record domain.Record, projection domain.RecordProjection) []string {
+	var fields []string
+	if record.TagID != projection.TagID {
+		fields = append(fields, RecordFieldTagID)
+	}
+	if !equalPtr(record.Description, projection.Description) {
+		fields = append(fields, RecordFieldDescription)
+	}
+	if !equalMicros(record.EventTime, projection.EventTimeUTC) {
+		fields = append(fields, RecordFieldEventTime)
+	}
+	if (record.RecordedAt == nil) != (projection.RecordedAtUTC == nil) ||
+		(record.RecordedAt != nil && !equalMicros(*record.RecordedAt, *projection.RecordedAtUTC)) {
+		fields = append(fields, RecordFieldRecordedAt)
+	}
+	if !equalPtr(record.Status, projection.Status) {
+		fields = append(fields, RecordFieldStatus)
+	}
+	if !equalPtr(record.Timezone, projection.Timezone) {
+		fields = append(fields, RecordFieldTimezone)
+	}
+	if !equalPtr(record.DurationSecs, projection.DurationSeconds) {
+		fields = append(fields, RecordFieldDurationSeconds)
+	}
+	if !equalPtr(record.Value, projection.Value) {
+		fields = append(fields, RecordFieldValue)
+	}
+	if !equalPtr(record.Source, projection.Source) {
+		fields = append(fields, RecordFieldSource)
+	}
+	return fields
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalMicros(a, b time.Time) bool {
+	return a.Truncate(time.Microsecond).Equal(b.Truncate(time.Microsecond))
+}
+
+// recordFromProjection rebuilds the record state an orphaned projection holds, so its delete
+// event carries the same payload a soft delete would.
+func recordFromProjection(projection domain.RecordProjection) domain.Record {
+	return domain.Record{
+		ID:           projection.RecordID,
+		UserID:       projection.UserID,
+		TagID:        projection.TagID,
+		Description:  projection.Description,
+		EventTime:    projection.EventTimeUTC,
+		RecordedAt:   projection.RecordedAtUTC,
+		DurationSecs: projection.DurationSeconds,
+		Value:        projection.Value,
+		Source:       projection.Source,
+		Timezone:     projection.Timezone,
+		Status:       projection.Status,
+	}
+}
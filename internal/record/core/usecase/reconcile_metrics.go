@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// reconcileMetrics holds the drift instruments. The drift gauge is set once per all-users check,
+// so an alert on it compares whole runs; a single-user check would understate the total.
+type reconcileMetrics struct {
+	drift    metric.Int64Gauge
+	repaired metric.Int64Counter
+}
+
+func newReconcileMetrics(provider metric.MeterProvider) (*reconcileMetrics, error) {
+	meter := provider.Meter(MeterName)
+
+	drift, err := meter.Int64Gauge(MetricProjectionDrift,
+		metric.WithDescription("Records whose derived projection disagreed with the canonical row at the last full check."),
+		metric.WithUnit("{record}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	repaired, err := meter.Int64Counter(MetricProjectionRepaired,
+		metric.WithDescription("Record events re-enqueued to repair or rebuild derived projections."),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reconcileMetrics{drift: drift, repaired: repaired}, nil
+}
+
+// recordDrift and recordRepaired tolerate a nil receiver so a reconciler without metrics still runs.
+func (m *reconcileMetrics) recordDrift(ctx context.Context, reports []domain.ProjectionDriftReport) {
+	if m == nil {
+		return
+	}
+	totals := make(map[string]int64, len(domain.DriftKinds()))
+	for _, report := range reports {
+		for kind, count := range report.Counts() {
+			totals[kind] += int64(count)
+		}
+	}
+	for _, kind := range domain.DriftKinds() {
+		m.drift.Record(ctx, totals[kind], metric.WithAttributes(attribute.String(MetricAttrDriftKind, kind)))
+	}
+}
+
+func (m *reconcileMetrics) recordRepaired(ctx context.Context, count int) {
+	if m == nil || count == 0 {
+		return
+	}
+	m.repaired.Add(ctx, int64(count))
+}
@@ -0,0 +1,283 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	eventoutboxdomain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/ports/output"
+	"github.com/lechitz/aion-api/internal/record/core/usecase"
+	"github.com/lechitz/aion-api/tests/mocks"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/mock/gomock"
+)
+
+type reconcilerSuite struct {
+	repo       *mocks.MockRecordProjectionReconcileRepository
+	outbox     *mocks.MockOutboxService
+	reader     *sdkmetric.ManualReader
+	reconciler *usecase.Reconciler
+}
+
+func newReconcilerSuite(t *testing.T) reconcilerSuite {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	log := mocks.NewMockContextLogger(ctrl)
+	log.EXPECT().InfowCtx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().WarnwCtx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().ErrorwCtx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	suite := reconcilerSuite{
+		repo:   mocks.NewMockRecordProjectionReconcileRepository(ctrl),
+		outbox: mocks.NewMockOutboxService(ctrl),
+		reader: sdkmetric.NewManualReader(),
+	}
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(suite.reader))
+	suite.reconciler = usecase.NewReconciler(suite.repo, suite.outbox, log, provider)
+	return suite
+}
+
+func (s reconcilerSuite) driftGauge(t *testing.T) map[string]int64 {
+	t.Helper()
+	var collected metricdata.ResourceMetrics
+	require.NoError(t, s.reader.Collect(t.Context(), &collected))
+
+	out := map[string]int64{}
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != usecase.MetricProjectionDrift {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Gauge[int64]).DataPoints {
+				kind, _ := point.Attributes.Value(usecase.MetricAttrDriftKind)
+				out[kind.AsString()] = point.Value
+			}
+		}
+	}
+	return out
+}
+
+// driftFixture returns one record per drift kind plus a healthy one, all updated an hour ago.
+func driftFixture() ([]domain.Record, []domain.RecordProjection) {
+	updated := time.Now().UTC().Add(-time.Hour)
+	eventTime := time.Date(2026, time.March, 13, 13, 0, 0, 0, time.UTC)
+	desc := "run"
+	other := "walk"
+
+	record := func(id uint64) domain.Record {
+		return domain.Record{ID: id, UserID: 7, TagID: 3, Description: &desc, EventTime: eventTime, UpdatedAt: updated}
+	}
+	projection := func(id uint64) domain.RecordProjection {
+		return domain.RecordProjection{RecordID: id, UserID: 7, TagID: 3, Description: &desc, EventTimeUTC: eventTime, UpdatedAtUTC: updated.Add(time.Second)}
+	}
+
+	mismatch := projection(3)
+	mismatch.Description = &other
+	mismatch.TagID = 4
+	stale := projection(4)
+	stale.UpdatedAtUTC = updated.Add(-time.Minute)
+
+	records := []domain.Record{record(1), record(2), record(3), record(4)}
+	projections := []domain.RecordProjection{projection(1), mismatch, stale, projection(9)}
+	return records, projections
+}
+
+func TestReconciler_ReportsEveryDriftKind(t *testing.T) {
+	suite := newReconcilerSuite(t)
+	records, projections := driftFixture()
+
+	suite.repo.EXPECT().ListCanonicalRecords(gomock.Any(), uint64(7)).Return(records, nil)
+	suite.repo.EXPECT().ListUserProjections(gomock.Any(), uint64(7)).Return(projections, nil)
+
+	reports, err := suite.reconciler.Reconcile(t.Context(), input.ReconcileOptions{UserID: 7})
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+
+	report := reports[0]
+	require.Equal(t, 4, report.Records)
+	require.Equal(t, 4, report.Projections)
+	require.Len(t, report.Drifts, 4)
+	require.Equal(t, domain.DriftMissing, report.Drifts[0].Kind)
+	require.Equal(t, uint64(2), report.Drifts[0].RecordID)
+	require.Equal(t, domain.DriftMismatch, report.Drifts[1].Kind)
+	require.Equal(t, []string{usecase.RecordFieldTagID, usecase.RecordFieldDescription}, report.Drifts[1].Fields)
+	require.Equal(t, domain.DriftStale, report.Drifts[2].Kind)
+	require.Equal(t, domain.DriftOrphaned, report.Drifts[3].Kind)
+	require.Equal(t, uint64(9), report.Drifts[3].RecordID)
+	require.Zero(t, report.Repaired)
+
+	require.Empty(t, suite.driftGauge(t), "single-user checks leave the drift gauge alone")
+}
+
+func TestReconciler_SkipsRowsWithinGrace(t *testing.T) {
+	suite := newReconcilerSuite(t)
+	records, projections := driftFixture()
+	records[1].UpdatedAt = time.Now().UTC()
+	projections[3].UpdatedAtUTC = time.Now().UTC()
+
+	suite.repo.EXPECT().ListCanonicalRecords(gomock.Any(), uint64(7)).Return(records, nil)
+	suite.repo.EXPECT().ListUserProjections(gomock.Any(), uint64(7)).Return(projections, nil)
+
+	reports, err := suite.reconciler.Reconcile(t.Context(), input.ReconcileOptions{UserID: 7, Grace: time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{
+		domain.DriftMissing:  0,
+		domain.DriftStale:    1,
+		domain.DriftMismatch: 1,
+		domain.DriftOrphaned: 0,
+	}, reports[0].Counts())
+}
+
+func TestReconciler_RepairEnqueuesOneEventPerDrift(t *testing.T) {
+	suite := newReconcilerSuite(t)
+	records, projections := driftFixture()
+
+	suite.repo.EXPECT().ListProjectionUserIDs(gomock.Any()).Return([]uint64{7}, nil)
+	suite.repo.EXPECT().ListCanonicalRecords(gomock.Any(), uint64(7)).Return(records, nil)
+	suite.repo.EXPECT().ListUserProjections(gomock.Any(), uint64(7)).Return(projections, nil)
+
+	var enqueued []eventoutboxdomain.Event
+	suite.outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, event eventoutboxdomain.Event) error {
+			enqueued = append(enqueued, event)
+			return nil
+		},
+	).Times(4)
+
+	reports, err := suite.reconciler.Reconcile(t.Context(), input.ReconcileOptions{Repair: true})
+	require.NoError(t, err)
+	require.Equal(t, 4, reports[0].Repaired)
+
+	types := make([]string, len(enqueued))
+	for i, event := range enqueued {
+		types[i] = event.EventType
+		require.Equal(t, usecase.RecordAggregateType, event.AggregateType)
+	}
+	require.Equal(t, []string{
+		usecase.RecordEventTypeCreatedV1,
+		usecase.RecordEventTypeUpdatedV1,
+		usecase.RecordEventTypeUpdatedV1,
+		usecase.RecordEventTypeDeletedV1,
+	}, types)
+
+	var orphan domain.RecordEventPayloadV1
+	require.NoError(t, json.Unmarshal(enqueued[3].PayloadJSON, &orphan))
+	require.Equal(t, uint64(9), orphan.RecordID)
+	require.Equal(t, uint64(7), orphan.UserID)
+
+	require.Equal(t, map[string]int64{
+		domain.DriftMissing:  1,
+		domain.DriftStale:    1,
+		domain.DriftMismatch: 1,
+		domain.DriftOrphaned: 1,
+	}, suite.driftGauge(t))
+}
+
+func TestReconciler_ReadFailure(t *testing.T) {
+	suite := newReconcilerSuite(t)
+	suite.repo.EXPECT().ListCanonicalRecords(gomock.Any(), uint64(7)).Return(nil, errors.New("db down"))
+
+	_, err := suite.reconciler.Reconcile(t.Context(), input.ReconcileOptions{UserID: 7})
+	require.ErrorIs(t, err, usecase.ErrReconcileProjection)
+}
+
+func TestReconciler_Rebuild(t *testing.T) {
+	t.Run("clears projections and re-enqueues every record", func(t *testing.T) {
+		suite := newReconcilerSuite(t)
+		records, _ := driftFixture()
+
+		gomock.InOrder(
+			suite.repo.EXPECT().ListCanonicalRecords(gomock.Any(), uint64(7)).Return(records, nil),
+			suite.repo.EXPECT().DeleteUserProjections(gomock.Any(), uint64(7)).Return(int64(4), nil),
+			suite.outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, event eventoutboxdomain.Event) error {
+					require.Equal(t, usecase.RecordEventTypeCreatedV1, event.EventType)
+					return nil
+				},
+			).Times(len(records)),
+		)
+
+		enqueued, err := suite.reconciler.Rebuild(t.Context(), 7)
+		require.NoError(t, err)
+		require.Equal(t, len(records), enqueued)
+	})
+
+	t.Run("requires a user", func(t *testing.T) {
+		suite := newReconcilerSuite(t)
+		_, err := suite.reconciler.Rebuild(t.Context(), 0)
+		require.ErrorIs(t, err, usecase.ErrUserIDIsRequired)
+	})
+
+	t.Run("stops at the first failed enqueue", func(t *testing.T) {
+		suite := newReconcilerSuite(t)
+		records, _ := driftFixture()
+
+		suite.repo.EXPECT().ListCanonicalRecords(gomock.Any(), uint64(7)).Return(records, nil)
+		suite.repo.EXPECT().DeleteUserProjections(gomock.Any(), uint64(7)).Return(int64(4), nil)
+		suite.outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+		suite.outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(errors.New("outbox down"))
+
+		enqueued, err := suite.reconciler.Rebuild(t.Context(), 7)
+		require.ErrorIs(t, err, usecase.ErrRebuildProjection)
+		require.Zero(t, enqueued)
+	})
+}
+
+type txAwareReconcileRepository struct {
+	output.RecordProjectionReconcileRepository
+	txRepository output.RecordProjectionReconcileRepository
+}
+
+func (r txAwareReconcileRepository) WithDB(_ dbport.DB) output.RecordProjectionReconcileRepository {
+	return r.txRepository
+}
+
+type txAwareOutboxService struct {
+	eventoutboxinput.Service
+	txService eventoutboxinput.Service
+}
+
+func (s txAwareOutboxService) WithDB(_ dbport.DB) eventoutboxinput.Service {
+	return s.txService
+}
+
+func TestReconciler_RebuildClearsAndEnqueuesInOneTransaction(t *testing.T) {
+	suite := newReconcilerSuite(t)
+	ctrl := gomock.NewController(t)
+	records, _ := driftFixture()
+
+	database := mocks.NewMockDB(ctrl)
+	txRepository := mocks.NewMockRecordProjectionReconcileRepository(ctrl)
+	txOutbox := mocks.NewMockOutboxService(ctrl)
+	suite.reconciler.Repository = txAwareReconcileRepository{RecordProjectionReconcileRepository: suite.repo, txRepository: txRepository}
+	suite.reconciler.OutboxService = txAwareOutboxService{Service: suite.outbox, txService: txOutbox}
+	suite.reconciler.WithTransactionManager(database)
+
+	enqueueErr := errors.New("payload rejected by schema")
+	suite.repo.EXPECT().ListCanonicalRecords(gomock.Any(), uint64(7)).Return(records, nil)
+	database.EXPECT().WithContext(gomock.Any()).Return(database)
+	database.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(dbport.DB) error) error {
+		err := fn(mocks.NewMockDB(ctrl))
+		require.ErrorIs(t, err, enqueueErr, "the callback error is what rolls the clear back")
+		return err
+	})
+	// The clear and every enqueue run on the transaction, and the failure comes after the clear.
+	txRepository.EXPECT().DeleteUserProjections(gomock.Any(), uint64(7)).Return(int64(4), nil)
+	gomock.InOrder(
+		txOutbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil).Times(2),
+		txOutbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(enqueueErr),
+	)
+
+	enqueued, err := suite.reconciler.Rebuild(t.Context(), 7)
+	require.ErrorIs(t, err, enqueueErr)
+	require.ErrorIs(t, err, usecase.ErrRebuildProjection)
+	require.Zero(t, enqueued)
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/shared/constants/ctxkeys"
+)
+
+// newRecordRevision describes the change from before to after made in ctx. It returns false when
+// no tracked field changed, so a no-op update leaves no revision behind.
+func newRecordRevision(ctx context.Context, operation string, before, after domain.Record, at time.Time) (domain.RecordRevision, bool) {
+	changes := recordFieldChanges(before, after)
+	if len(changes) == 0 {
+		return domain.RecordRevision{}, false
+	}
+
+	source, service := revisionActor(ctx)
+	requestID, _ := ctx.Value(ctxkeys.RequestID).(string)
+
+	return domain.RecordRevision{
+		RecordID:     after.ID,
+		UserID:       after.UserID,
+		Operation:    operation,
+		Changes:      changes,
+		ActorUserID:  after.UserID,
+		ActorService: service,
+		Source:       source,
+		RequestID:    requestID,
+		CreatedAt:    at,
+	}, true
+}
+
+// revisionActor tells which surface a change came through. Service-key calls are chat when the
+// key they presented belongs to aion-chat and a generic service token otherwise; everything else
+// is a user session.
+func revisionActor(ctx context.Context) (string, *string) {
+	if svc, ok := ctx.Value(ctxkeys.ServiceAccount).(bool); !ok || !svc {
+		return domain.RevisionSourceWeb, nil
+	}
+
+	name, _ := ctx.Value(ctxkeys.ServiceName).(string)
+	if name == "" {
+		return domain.RevisionSourceServiceToken, nil
+	}
+	if name == ChatServiceName {
+		return domain.RevisionSourceChat, &name
+	}
+	return domain.RevisionSourceServiceToken, &name
+}
+
+// recordFieldChanges lists the user-visible fields that differ, in column order.
+func recordFieldChanges(before, after domain.Record) []domain.RecordFieldChange {
+	var changes []domain.RecordFieldChange
+	add := func(field string, oldValue, newValue *string) {
+		if !equalPtr(oldValue, newValue) {
+			changes = append(changes, domain.RecordFieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+
+	add(RecordFieldTagID, formatUintValue(before.TagID), formatUintValue(after.TagID))
+	add(RecordFieldDescription, before.Description, after.Description)
+	add(RecordFieldEventTime, formatTimeValue(&before.EventTime), formatTimeValue(&after.EventTime))
+	add(RecordFieldRecordedAt, formatTimeValue(before.RecordedAt), formatTimeValue(after.RecordedAt))
+	add(RecordFieldStatus, before.Status, after.Status)
+	add(RecordFieldTimezone, before.Timezone, after.Timezone)
+	add(RecordFieldDurationSeconds, formatIntValue(before.DurationSecs), formatIntValue(after.DurationSecs))
+	add(RecordFieldValue, formatFloatValue(before.Value), formatFloatValue(after.Value))
+	add(RecordFieldSource, before.Source, after.Source)
+	add(RecordFieldDeletedAt, formatTimeValue(before.DeletedAt), formatTimeValue(after.DeletedAt))
+	return changes
+}
+
+func formatUintValue(v uint64) *string {
+	s := strconv.FormatUint(v, 10)
+	return &s
+}
+
+func formatIntValue(v *int) *string {
+	if v == nil {
+		return nil
+	}
+	s := strconv.Itoa(*v)
+	return &s
+}
+
+func formatFloatValue(v *float64) *string {
+	if v == nil {
+		return nil
+	}
+	s := strconv.FormatFloat(*v, 'f', -1, 64)
+	return &s
+}
+
+// formatTimeValue renders at the microsecond precision Postgres keeps, so a round-tripped time
+// does not read as a change.
+func formatTimeValue(v *time.Time) *string {
+	if v == nil {
+		return nil
+	}
+	s := v.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+	return &s
+}
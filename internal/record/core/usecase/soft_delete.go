@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	"github.com/lechitz/aion-api/internal/record/core/domain"
@@ -26,6 +27,7 @@ func (s *Service) Delete(ctx context.Context, id uint64, userID uint64) error {
 		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
 	)
 
+	deletedAt := time.Now().UTC()
 	var existing domain.Record
 	if err := s.runWithinRecordOutboxTransaction(ctx, func(recordRepo output.RecordRepository, outboxService eventoutboxinput.Service) error {
 		span.AddEvent(EventRepositoryGet)
@@ -36,14 +38,20 @@ func (s *Service) Delete(ctx context.Context, id uint64, userID uint64) error {
 		}
 
 		span.AddEvent(EventRepositoryDelete)
-		if deleteErr := recordRepo.Delete(ctx, id, userID); deleteErr != nil {
+		if deleteErr := recordRepo.Delete(ctx, id, userID, deletedAt); deleteErr != nil {
 			return deleteErr
 		}
 
-		if outboxService != nil {
-			s.enqueueRecordOutboxEventWithService(ctx, outboxService, RecordEventTypeDeletedV1, existing)
+		deleted := existing
+		deleted.DeletedAt = &deletedAt
+		if revision, changed := newRecordRevision(ctx, domain.RevisionOperationDeleted, existing, deleted, deletedAt); changed {
+			span.AddEvent(EventRepositoryRevision)
+			if revisionErr := recordRepo.CreateRevisions(ctx, []domain.RecordRevision{revision}); revisionErr != nil {
+				return revisionErr
+			}
 		}
-		return nil
+
+		return s.enqueueRecordOutboxEventWithService(ctx, outboxService, RecordEventTypeDeletedV1, existing)
 	}); err != nil {
 		span.RecordError(err)
 		if isGetRecordError(err) {
@@ -105,6 +113,8 @@ func (s *Service) Delete(ctx context.Context, id uint64, userID uint64) error {
 		}
 	}
 
+	s.publishDashboardChanges(ctx, span, userID, deletedChanges([]domain.Record{existing}))
+
 	span.AddEvent(EventSuccess)
 	span.SetStatus(codes.Ok, StatusDeleted)
 	s.Logger.InfowCtx(ctx, LogRecordSoftDeletedSuccess,
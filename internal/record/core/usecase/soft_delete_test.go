@@ -1,6 +1,7 @@
 package usecase_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -47,7 +48,7 @@ func TestService_Delete_Errors(t *testing.T) {
 
 			if tt.getErr == nil {
 				suite.RecordRepository.EXPECT().
-					Delete(gomock.Any(), recordID, userID).
+					Delete(gomock.Any(), recordID, userID, gomock.Any()).
 					Return(tt.delErr)
 			}
 
@@ -70,9 +71,28 @@ func TestService_Delete_Success(t *testing.T) {
 		GetByID(gomock.Any(), recordID, userID).
 		Return(existing, nil)
 
+	var stampedAt time.Time
 	suite.RecordRepository.EXPECT().
-		Delete(gomock.Any(), recordID, userID).
-		Return(nil)
+		Delete(gomock.Any(), recordID, userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ uint64, deletedAt time.Time) error {
+			stampedAt = deletedAt
+			return nil
+		})
+
+	suite.RecordRepository.EXPECT().
+		CreateRevisions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, revisions []domain.RecordRevision) error {
+			require.Len(t, revisions, 1)
+			require.Equal(t, domain.RevisionOperationDeleted, revisions[0].Operation)
+			require.Len(t, revisions[0].Changes, 1)
+			require.Equal(t, usecase.RecordFieldDeletedAt, revisions[0].Changes[0].Field)
+			require.Nil(t, revisions[0].Changes[0].OldValue)
+			require.NotNil(t, revisions[0].Changes[0].NewValue)
+			// The revision carries the exact timestamp the repository stamped on the row.
+			require.Equal(t, stampedAt.Truncate(time.Microsecond).Format(time.RFC3339Nano), *revisions[0].Changes[0].NewValue)
+			require.Equal(t, stampedAt, revisions[0].CreatedAt)
+			return nil
+		})
 
 	suite.TagRepository.EXPECT().
 		GetByID(gomock.Any(), existing.TagID, userID).
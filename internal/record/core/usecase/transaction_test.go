@@ -2,11 +2,13 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	eventoutboxdomain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
 	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
 	"github.com/lechitz/aion-api/internal/record/core/ports/output"
 	"github.com/lechitz/aion-api/tests/mocks"
 	"go.uber.org/mock/gomock"
@@ -76,3 +78,43 @@ func TestService_RunWithinRecordOutboxTransaction_UsesTransactionBoundDependenci
 		t.Fatalf("expected transaction-bound outbox service")
 	}
 }
+
+func TestService_DeleteAll_WritesThroughTheTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dbMock := mocks.NewMockDB(ctrl)
+	txMock := mocks.NewMockDB(ctrl)
+	recordRepo := mocks.NewMockRecordRepository(ctrl)
+	txRecordRepo := mocks.NewMockRecordRepository(ctrl)
+	logger := mocks.NewMockContextLogger(ctrl)
+	logger.EXPECT().ErrorwCtx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	service := &Service{
+		RecordRepository:   txAwareRecordRepository{RecordRepository: recordRepo, txRepository: txRecordRepo},
+		OutboxService:      txAwareOutboxService{Service: noopOutboxServiceWithTx{}, txService: noopOutboxServiceWithTx{}},
+		TransactionManager: dbMock,
+		Logger:             logger,
+	}
+
+	revisionErr := errors.New("revisions table locked")
+	dbMock.EXPECT().WithContext(gomock.Any()).Return(dbMock)
+	dbMock.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(dbport.DB) error) error {
+		err := fn(txMock)
+		if !errors.Is(err, revisionErr) {
+			t.Fatalf("expected the revision error to roll the transaction back, got %v", err)
+		}
+		return err
+	})
+
+	live := []domain.Record{{ID: 2, UserID: 1, TagID: 5}}
+	txRecordRepo.EXPECT().ListByUser(gomock.Any(), uint64(1), DeleteAllPageSize, nil, nil).Return(live, nil)
+	txRecordRepo.EXPECT().DeleteMany(gomock.Any(), uint64(1), []uint64{2}, gomock.Any()).Return(nil)
+	txRecordRepo.EXPECT().CreateRevisions(gomock.Any(), gomock.Any()).Return(revisionErr)
+
+	if err := service.DeleteAll(t.Context(), 1); !errors.Is(err, ErrDeleteRecord) {
+		t.Fatalf("expected ErrDeleteRecord, got %v", err)
+	}
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/ports/output"
+	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// ListDeleted returns the user's soft-deleted records, most recently deleted first, with optional cursor parameters.
+func (s *Service) ListDeleted(ctx context.Context, userID uint64, limit int, afterDeletedAt *string, afterID *int64) ([]domain.Record, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanListDeleted)
+	defer span.End()
+
+	if limit <= 0 || limit > MaxDeletedListLimit {
+		limit = DefaultDeletedListLimit
+	}
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanListDeleted),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+		attribute.Int(AttrLimit, limit),
+	)
+
+	span.AddEvent(EventRepositoryList)
+	records, err := s.RecordRepository.ListDeleted(ctx, userID, limit, afterDeletedAt, afterID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, FailedToListRecords)
+		s.Logger.ErrorwCtx(ctx, FailedToListRecords,
+			commonkeys.UserID, userID,
+			commonkeys.Error, err,
+		)
+		return nil, fmt.Errorf("%w: %w", ErrListRecords, err)
+	}
+
+	span.AddEvent(EventSuccess)
+	span.SetStatus(codes.Ok, StatusListedAll)
+	s.Logger.InfowCtx(ctx, LogDeletedRecordsListed,
+		commonkeys.UserID, userID,
+		AttrResultsCount, len(records),
+	)
+
+	return records, nil
+}
+
+// Restore brings one soft-deleted record of the user back. It fails with ErrRecordNotFound when the
+// record is not in the user's trash.
+func (s *Service) Restore(ctx context.Context, recordID uint64, userID uint64) (domain.Record, error) {
+	restored, err := s.RestoreMany(ctx, []uint64{recordID}, userID)
+	if err != nil {
+		return domain.Record{}, err
+	}
+	if len(restored) == 0 {
+		return domain.Record{}, ErrRecordNotFound
+	}
+	return restored[0], nil
+}
+
+// RestoreMany brings soft-deleted records of the user back in one transaction and enqueues a
+// record.restored event for each, so derived projections return. IDs that are not in the user's
+// trash are skipped; the restored records are returned ordered by id.
+func (s *Service) RestoreMany(ctx context.Context, recordIDs []uint64, userID uint64) ([]domain.Record, error) {
+	tr := otel.Tracer(TracerName)
+	ctx, span := tr.Start(ctx, SpanRestore)
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String(commonkeys.Operation, SpanRestore),
+		attribute.String(commonkeys.UserID, strconv.FormatUint(userID, 10)),
+		attribute.Int(AttrRequestedCount, len(recordIDs)),
+	)
+
+	span.AddEvent(EventValidateInput)
+	ids, err := validateRestoreInput(recordIDs, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, ErrToValidateRecord)
+		return nil, err
+	}
+
+	restoredAt := time.Now().UTC()
+	var restored []domain.Record
+	if err := s.runWithinRecordOutboxTransaction(ctx, func(recordRepo output.RecordRepository, outboxService eventoutboxinput.Service) error {
+		span.AddEvent(EventRepositoryList)
+		found, listErr := recordRepo.ListDeletedByIDs(ctx, userID, ids)
+		if listErr != nil || len(found) == 0 {
+			return listErr
+		}
+
+		foundIDs := make([]uint64, len(found))
+		for i := range found {
+			foundIDs[i] = found[i].ID
+		}
+
+		span.AddEvent(EventRepositoryRestore)
+		if restoreErr := recordRepo.Restore(ctx, userID, foundIDs, restoredAt); restoreErr != nil {
+			return restoreErr
+		}
+
+		revisions := make([]domain.RecordRevision, 0, len(found))
+		for i := range found {
+			before := found[i]
+			found[i].DeletedAt = nil
+			found[i].UpdatedAt = restoredAt
+			if revision, changed := newRecordRevision(ctx, domain.RevisionOperationRestored, before, found[i], restoredAt); changed {
+				revisions = append(revisions, revision)
+			}
+		}
+
+		span.AddEvent(EventRepositoryRevision)
+		if revisionErr := recordRepo.CreateRevisions(ctx, revisions); revisionErr != nil {
+			return revisionErr
+		}
+
+		for i := range found {
+			if enqueueErr := s.enqueueRecordOutboxEventWithService(ctx, outboxService, RecordEventTypeRestoredV1, found[i]); enqueueErr != nil {
+				return enqueueErr
+			}
+		}
+		restored = found
+		return nil
+	}); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, FailedToRestoreRecords)
+		s.Logger.ErrorwCtx(ctx, FailedToRestoreRecords,
+			commonkeys.UserID, userID,
+			commonkeys.Error, err,
+		)
+		return nil, fmt.Errorf("%w: %w", ErrRestoreRecords, err)
+	}
+
+	for _, record := range restored {
+		s.invalidateRecordCaches(ctx, span, record)
+	}
+	s.publishDashboardChanges(ctx, span, userID, createdChanges(restored))
+
+	span.SetAttributes(attribute.Int(AttrResultsCount, len(restored)))
+	span.AddEvent(EventSuccess)
+	span.SetStatus(codes.Ok, StatusRestored)
+	s.Logger.InfowCtx(ctx, LogRecordsRestored,
+		commonkeys.UserID, userID,
+		AttrRequestedCount, len(ids),
+		AttrResultsCount, len(restored),
+	)
+
+	return restored, nil
+}
+
+// validateRestoreInput rejects a missing user or record ID and returns the IDs deduplicated.
+func validateRestoreInput(recordIDs []uint64, userID uint64) ([]uint64, error) {
+	if userID == 0 {
+		return nil, ErrUserIDIsRequired
+	}
+
+	ids := make([]uint64, 0, len(recordIDs))
+	seen := make(map[uint64]struct{}, len(recordIDs))
+	for _, id := range recordIDs {
+		if id == 0 {
+			return nil, ErrRecordIDIsRequired
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	switch {
+	case len(ids) == 0:
+		return nil, ErrRecordIDsAreRequired
+	case len(ids) > MaxRestoreBatchSize:
+		return nil, fmt.Errorf("%w: %d > %d", ErrRestoreBatchTooLarge, len(ids), MaxRestoreBatchSize)
+	}
+	return ids, nil
+}
@@ -0,0 +1,152 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lechitz/aion-api/internal/record/core/domain"
+	"github.com/lechitz/aion-api/internal/record/core/usecase"
+	tagdomain "github.com/lechitz/aion-api/internal/tag/core/domain"
+	"github.com/lechitz/aion-api/tests/setup"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestService_ListDeleted(t *testing.T) {
+	t.Run("clamps the page size", func(t *testing.T) {
+		suite := setup.RecordServiceTest(t)
+		defer suite.Ctrl.Finish()
+
+		suite.RecordRepository.EXPECT().
+			ListDeleted(gomock.Any(), uint64(1), usecase.DefaultDeletedListLimit, nil, nil).
+			Return([]domain.Record{{ID: 3}}, nil)
+
+		records, err := suite.RecordService.ListDeleted(suite.Ctx, 1, usecase.MaxDeletedListLimit+1, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+	})
+
+	t.Run("wraps repository errors", func(t *testing.T) {
+		suite := setup.RecordServiceTest(t)
+		defer suite.Ctrl.Finish()
+
+		suite.RecordRepository.EXPECT().
+			ListDeleted(gomock.Any(), uint64(1), 10, nil, nil).
+			Return(nil, errors.New("db down"))
+
+		_, err := suite.RecordService.ListDeleted(suite.Ctx, 1, 10, nil, nil)
+		require.ErrorIs(t, err, usecase.ErrListRecords)
+	})
+}
+
+func TestService_RestoreMany_RestoresTrashedRecordsAndEnqueuesEvents(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	outbox := &captureOutboxService{}
+	suite.RecordService.WithOutbox(outbox)
+
+	userID := uint64(1)
+	deletedAt := time.Now().UTC().Add(-time.Hour)
+	trashed := []domain.Record{
+		{ID: 2, UserID: userID, TagID: 5, EventTime: time.Now().UTC(), DeletedAt: &deletedAt},
+		{ID: 4, UserID: userID, TagID: 5, EventTime: time.Now().UTC(), DeletedAt: &deletedAt},
+	}
+
+	suite.RecordRepository.EXPECT().
+		ListDeletedByIDs(gomock.Any(), userID, []uint64{4, 2, 9}).
+		Return(trashed, nil)
+	suite.RecordRepository.EXPECT().
+		Restore(gomock.Any(), userID, []uint64{2, 4}, gomock.Any()).
+		Return(nil)
+	suite.RecordRepository.EXPECT().
+		CreateRevisions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, revisions []domain.RecordRevision) error {
+			require.Len(t, revisions, 2)
+			for _, revision := range revisions {
+				require.Equal(t, domain.RevisionOperationRestored, revision.Operation)
+				require.Equal(t, usecase.RecordFieldDeletedAt, revision.Changes[0].Field)
+				require.Nil(t, revision.Changes[0].NewValue)
+			}
+			return nil
+		})
+
+	suite.RecordCache.EXPECT().DeleteRecord(gomock.Any(), gomock.Any(), userID).Return(nil).Times(2)
+	suite.RecordCache.EXPECT().DeleteRecordsByDay(gomock.Any(), userID, gomock.Any()).Return(nil).Times(2)
+	suite.TagRepository.EXPECT().GetByID(gomock.Any(), uint64(5), userID).Return(tagdomain.Tag{ID: 5, CategoryID: 8}, nil).Times(2)
+	suite.RecordCache.EXPECT().DeleteRecordsByCategory(gomock.Any(), uint64(8), userID).Return(nil).Times(2)
+	suite.RecordCache.EXPECT().DeleteRecordsByTag(gomock.Any(), uint64(5), userID).Return(nil).Times(2)
+
+	restored, err := suite.RecordService.RestoreMany(suite.Ctx, []uint64{4, 2, 4, 9}, userID)
+	require.NoError(t, err)
+	require.Len(t, restored, 2)
+	for _, record := range restored {
+		require.Nil(t, record.DeletedAt)
+		require.False(t, record.UpdatedAt.IsZero())
+	}
+
+	require.Len(t, outbox.events, 2)
+	for _, event := range outbox.events {
+		require.Equal(t, usecase.RecordEventTypeRestoredV1, event.EventType)
+	}
+	require.Equal(t, "2", outbox.events[0].AggregateID)
+	require.Equal(t, "4", outbox.events[1].AggregateID)
+}
+
+func TestService_RestoreMany_Validation(t *testing.T) {
+	tooMany := make([]uint64, usecase.MaxRestoreBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = uint64(i + 1)
+	}
+
+	tests := []struct {
+		name    string
+		ids     []uint64
+		userID  uint64
+		wantErr error
+	}{
+		{name: "missing user", ids: []uint64{1}, wantErr: usecase.ErrUserIDIsRequired},
+		{name: "no ids", userID: 1, wantErr: usecase.ErrRecordIDsAreRequired},
+		{name: "zero id", ids: []uint64{1, 0}, userID: 1, wantErr: usecase.ErrRecordIDIsRequired},
+		{name: "too many ids", ids: tooMany, userID: 1, wantErr: usecase.ErrRestoreBatchTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := setup.RecordServiceTest(t)
+			defer suite.Ctrl.Finish()
+
+			_, err := suite.RecordService.RestoreMany(suite.Ctx, tt.ids, tt.userID)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_RestoreMany_RepositoryError(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	suite.RecordRepository.EXPECT().
+		ListDeletedByIDs(gomock.Any(), uint64(1), []uint64{2}).
+		Return([]domain.Record{{ID: 2, UserID: 1}}, nil)
+	suite.RecordRepository.EXPECT().
+		Restore(gomock.Any(), uint64(1), []uint64{2}, gomock.Any()).
+		Return(errors.New("db down"))
+
+	_, err := suite.RecordService.RestoreMany(suite.Ctx, []uint64{2}, 1)
+	require.ErrorIs(t, err, usecase.ErrRestoreRecords)
+}
+
+func TestService_Restore_NotInTrash(t *testing.T) {
+	suite := setup.RecordServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	suite.RecordRepository.EXPECT().
+		ListDeletedByIDs(gomock.Any(), uint64(1), []uint64{2}).
+		Return(nil, nil)
+
+	_, err := suite.RecordService.Restore(suite.Ctx, 2, 1)
+	require.ErrorIs(t, err, usecase.ErrRecordNotFound)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	"github.com/lechitz/aion-api/internal/record/core/domain"
@@ -46,7 +47,7 @@ func (s *Service) Update(ctx context.Context, recordID uint64, userID uint64, cm
 		}
 	}
 
-	var updated domain.Record
+	var before, updated domain.Record
 	if err := s.runWithinRecordOutboxTransaction(ctx, func(recordRepo output.RecordRepository, outboxService eventoutboxinput.Service) error {
 		span.AddEvent(EventRepositoryGet)
 		existing, getErr := recordRepo.GetByID(ctx, recordID, userID)
@@ -59,6 +60,7 @@ func (s *Service) Update(ctx context.Context, recordID uint64, userID uint64, cm
 			finalTagID = *cmd.TagID
 		}
 
+		before = existing
 		existing = applyRecordPatch(existing, cmd, finalTagID)
 
 		span.AddEvent(EventRepositoryUpdate)
@@ -68,11 +70,14 @@ func (s *Service) Update(ctx context.Context, recordID uint64, userID uint64, cm
 			return updateErr
 		}
 
-		if outboxService != nil {
-			s.enqueueRecordOutboxEventWithService(ctx, outboxService, RecordEventTypeUpdatedV1, updated)
+		if revision, changed := newRecordRevision(ctx, domain.RevisionOperationUpdated, before, updated, time.Now().UTC()); changed {
+			span.AddEvent(EventRepositoryRevision)
+			if revisionErr := recordRepo.CreateRevisions(ctx, []domain.RecordRevision{revision}); revisionErr != nil {
+				return revisionErr
+			}
 		}
 
-		return nil
+		return s.enqueueRecordOutboxEventWithService(ctx, outboxService, RecordEventTypeUpdatedV1, updated)
 	}); err != nil {
 		span.RecordError(err)
 		if isGetRecordError(err) {
@@ -94,6 +99,7 @@ func (s *Service) Update(ctx context.Context, recordID uint64, userID uint64, cm
 
 	// Invalidate all related caches
 	s.invalidateRecordCaches(ctx, span, updated)
+	s.publishDashboardChanges(ctx, span, userID, []recordChange{{Before: &before, After: &updated}})
 
 	span.AddEvent(EventSuccess)
 	span.SetStatus(codes.Ok, StatusUpdated)
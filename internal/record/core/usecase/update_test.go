@@ -1,6 +1,7 @@
 package usecase_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -95,6 +96,16 @@ func TestService_Update_Success(t *testing.T) {
 		Update(gomock.Any(), gomock.Any()).
 		Return(updated, nil)
 
+	suite.RecordRepository.EXPECT().
+		CreateRevisions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, revisions []domain.RecordRevision) error {
+			require.Len(t, revisions, 1)
+			require.Equal(t, domain.RevisionOperationUpdated, revisions[0].Operation)
+			require.Equal(t, domain.RevisionSourceWeb, revisions[0].Source)
+			require.Equal(t, []domain.RecordFieldChange{{Field: usecase.RecordFieldDescription, NewValue: stringPtr("updated")}}, revisions[0].Changes)
+			return nil
+		})
+
 	suite.TagRepository.EXPECT().
 		GetByID(gomock.Any(), updated.TagID, userID).
 		Return(tagdomain.Tag{ID: updated.TagID, CategoryID: 10}, nil)
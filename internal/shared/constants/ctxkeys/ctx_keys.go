@@ -13,4 +13,5 @@ const (
 	SpanID         contextKey = "span_id"         // Context key for span ID.
 	Claims         contextKey = "claims"          // Context key for claims.
 	ServiceAccount contextKey = "service_account" // Context key for service account calls.
+	ServiceName    contextKey = "service_name"    // Context key for the calling service, named after the key it presented.
 )
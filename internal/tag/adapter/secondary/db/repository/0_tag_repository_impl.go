@@ -4,6 +4,7 @@ package repository
 import (
 	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	"github.com/lechitz/aion-api/internal/tag/core/ports/output"
 )
 
 // TagRepository manages database operations related to tag entities.
@@ -20,3 +21,11 @@ func New(database db.DB, logger logger.ContextLogger) *TagRepository {
 		logger: logger,
 	}
 }
+
+// WithDB clones the repository with a transaction-bound database handle.
+func (r TagRepository) WithDB(database db.DB) output.TagRepository {
+	return TagRepository{
+		db:     database,
+		logger: r.logger,
+	}
+}
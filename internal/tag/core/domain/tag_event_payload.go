@@ -0,0 +1,13 @@
+package domain
+
+// TagEventPayloadV1 is the outbox payload of tag.created, tag.updated, and tag.deleted at v1.
+// Its shape is pinned by the tag.v1 JSON Schema in the event schema registry.
+type TagEventPayloadV1 struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Icon         string `json:"icon"`
+	UpdatedAtUTC string `json:"updated_at_utc"`
+	TagID        uint64 `json:"tag_id"`
+	UserID       uint64 `json:"user_id"`
+	CategoryID   uint64 `json:"category_id"`
+}
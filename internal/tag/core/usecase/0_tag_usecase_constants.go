@@ -62,6 +62,9 @@ const (
 	// EventInvalidateCache marks the cache invalidation step.
 	EventInvalidateCache = "tag.cache.invalidate"
 
+	// EventPublishRealtime marks announcing the committed change to the user's realtime streams.
+	EventPublishRealtime = "tag.realtime.publish"
+
 	// EventSuccess marks the successful completion of the usecase logic.
 	EventSuccess = "tag.success"
 )
@@ -198,3 +201,27 @@ var (
 	// ErrTagIconInvalid is a sentinel error when icon is invalid.
 	ErrTagIconInvalid = errors.New(TagIconInvalid)
 )
+
+// =============================================================================
+// OUTBOX EVENTS - Canonical tag events
+// =============================================================================
+
+const (
+	// TagAggregateType identifies the tag aggregate in canonical outbox events.
+	TagAggregateType = "tag"
+	// TagEventVersionV1 identifies the first canonical tag event contract version.
+	TagEventVersionV1 = "v1"
+	// TagEventTypeCreatedV1 is emitted after tag creation succeeds.
+	TagEventTypeCreatedV1 = "tag.created"
+	// TagEventTypeUpdatedV1 is emitted after a tag update succeeds, including renames.
+	TagEventTypeUpdatedV1 = "tag.updated"
+	// TagEventTypeDeletedV1 is emitted after tag soft deletion succeeds.
+	TagEventTypeDeletedV1 = "tag.deleted"
+)
+
+const (
+	// FailedToEnqueueTagEvent indicates the outbox rejected the event, which rolls the tag write back.
+	FailedToEnqueueTagEvent = "failed to enqueue tag outbox event"
+	// FailedToMarshalTagEventPayload indicates payload serialization failed before enqueue.
+	FailedToMarshalTagEventPayload = "failed to marshal tag outbox payload"
+)
@@ -5,15 +5,21 @@
 package usecase
 
 import (
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
+	realtimeinput "github.com/lechitz/aion-api/internal/realtime/core/ports/input"
 	"github.com/lechitz/aion-api/internal/tag/core/ports/output"
 )
 
 // Service provides operations for managing tags including creation, retrieval, updates, and soft deletion, using a repository and contextlogger.
 type Service struct {
-	TagRepository output.TagRepository
-	TagCache      output.TagCache
-	Logger        logger.ContextLogger
+	TagRepository      output.TagRepository
+	TagCache           output.TagCache
+	OutboxService      eventoutboxinput.Service
+	TransactionManager dbport.DB
+	Realtime           realtimeinput.Publisher
+	Logger             logger.ContextLogger
 }
 
 // NewService creates and returns a new instance of Service with the given repository and contextlogger dependencies.
@@ -24,3 +30,21 @@ func NewService(tagRepository output.TagRepository, tagCache output.TagCache, lo
 		Logger:        logger,
 	}
 }
+
+// WithOutbox attaches an optional outbox service without breaking existing constructor call sites.
+func (s *Service) WithOutbox(outboxService eventoutboxinput.Service) *Service {
+	s.OutboxService = outboxService
+	return s
+}
+
+// WithTransactionManager attaches an optional transaction manager without breaking constructor call sites.
+func (s *Service) WithTransactionManager(database dbport.DB) *Service {
+	s.TransactionManager = database
+	return s
+}
+
+// WithRealtime attaches an optional realtime publisher that announces committed tag changes to the user's streams.
+func (s *Service) WithRealtime(publisher realtimeinput.Publisher) *Service {
+	s.Realtime = publisher
+	return s
+}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"strconv"
 
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"github.com/lechitz/aion-api/internal/tag/core/domain"
 	"github.com/lechitz/aion-api/internal/tag/core/ports/input"
+	"github.com/lechitz/aion-api/internal/tag/core/ports/output"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -53,8 +56,17 @@ func (s *Service) Create(ctx context.Context, cmd input.CreateTagCommand) (domai
 		return domain.Tag{}, ErrTagAlreadyExists
 	}
 
-	span.AddEvent(EventRepositoryCreate)
-	createdTag, err := s.TagRepository.Create(ctx, newTag)
+	var createdTag domain.Tag
+	err = s.runWithinTagOutboxTransaction(ctx, func(tagRepo output.TagRepository, outboxService eventoutboxinput.Service) error {
+		span.AddEvent(EventRepositoryCreate)
+		var createErr error
+		createdTag, createErr = tagRepo.Create(ctx, newTag)
+		if createErr != nil {
+			return createErr
+		}
+
+		return s.enqueueTagOutboxEventWithService(ctx, outboxService, TagEventTypeCreatedV1, createdTag)
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, FailedToCreateTag)
@@ -81,6 +93,8 @@ func (s *Service) Create(ctx context.Context, cmd input.CreateTagCommand) (domai
 		)
 	}
 
+	s.publishTagChanged(ctx, span, realtimedomain.ActionCreated, createdTag)
+
 	span.AddEvent(EventSuccess)
 	span.SetStatus(codes.Ok, StatusCreated)
 	s.Logger.InfowCtx(ctx, fmt.Sprintf(SuccessfullyCreatedTag, createdTag.Name))
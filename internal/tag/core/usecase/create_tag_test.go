@@ -1,13 +1,16 @@
 package usecase_test
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
 
+	eventoutboxdomain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
 	"github.com/lechitz/aion-api/internal/tag/core/domain"
 	"github.com/lechitz/aion-api/internal/tag/core/ports/input"
 	"github.com/lechitz/aion-api/internal/tag/core/usecase"
+	"github.com/lechitz/aion-api/tests/mocks"
 	"github.com/lechitz/aion-api/tests/setup"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -206,3 +209,56 @@ func TestCreateTag_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tag, created)
 }
+
+func TestCreateTag_EnqueuesTagCreatedEvent(t *testing.T) {
+	suite := setup.TagServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	outbox := mocks.NewMockOutboxService(suite.Ctrl)
+	suite.TagService.WithOutbox(outbox)
+
+	tag := perfectTag()
+	tag.ID = 7
+	cmd := makeCreateTagCmdFromDomain(tag)
+
+	suite.TagRepository.EXPECT().GetByName(gomock.Any(), tag.Name, tag.UserID).Return(domain.Tag{}, nil)
+	suite.TagRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tag, nil)
+	suite.TagCache.EXPECT().DeleteTagList(gomock.Any(), tag.UserID).Return(nil)
+	suite.TagCache.EXPECT().DeleteTagsByCategory(gomock.Any(), tag.CategoryID, tag.UserID).Return(nil)
+
+	var enqueued eventoutboxdomain.Event
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event eventoutboxdomain.Event) error {
+		enqueued = event
+		return nil
+	})
+
+	_, err := suite.TagService.Create(suite.Ctx, cmd)
+
+	require.NoError(t, err)
+	require.Equal(t, usecase.TagAggregateType, enqueued.AggregateType)
+	require.Equal(t, "7", enqueued.AggregateID)
+	require.Equal(t, usecase.TagEventTypeCreatedV1, enqueued.EventType)
+	require.Equal(t, usecase.TagEventVersionV1, enqueued.EventVersion)
+	require.JSONEq(t, `{"tag_id":7,"user_id":1,"category_id":2,"name":"Read","description":"Daily reading practice","icon":"📚","updated_at_utc":""}`, string(enqueued.PayloadJSON))
+}
+
+func TestCreateTag_FailedEnqueueFailsTheWrite(t *testing.T) {
+	suite := setup.TagServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	outbox := mocks.NewMockOutboxService(suite.Ctrl)
+	suite.TagService.WithOutbox(outbox)
+
+	tag := perfectTag()
+	tag.ID = 7
+	enqueueErr := errors.New("payload rejected by schema")
+
+	suite.TagRepository.EXPECT().GetByName(gomock.Any(), tag.Name, tag.UserID).Return(domain.Tag{}, nil)
+	suite.TagRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tag, nil)
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(enqueueErr)
+
+	_, err := suite.TagService.Create(suite.Ctx, makeCreateTagCmdFromDomain(tag))
+
+	require.ErrorIs(t, err, usecase.ErrCreateTag)
+	require.ErrorIs(t, err, enqueueErr)
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/outboxtx"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	"github.com/lechitz/aion-api/internal/tag/core/domain"
+)
+
+func (s *Service) enqueueTagOutboxEventWithService(ctx context.Context, outboxService eventoutboxinput.Service, eventType string, tag domain.Tag) error {
+	if outboxService == nil {
+		return nil
+	}
+
+	event, err := outboxtx.NewEvent(ctx, TagAggregateType, tag.ID, eventType, TagEventVersionV1, domain.TagEventPayloadV1{
+		TagID:        tag.ID,
+		UserID:       tag.UserID,
+		CategoryID:   tag.CategoryID,
+		Name:         tag.Name,
+		Description:  tag.Description,
+		Icon:         tag.Icon,
+		UpdatedAtUTC: outboxtx.FormatTime(tag.UpdatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", FailedToMarshalTagEventPayload, err)
+	}
+
+	if err := outboxService.Enqueue(ctx, event); err != nil {
+		return fmt.Errorf("%s: %w", FailedToEnqueueTagEvent, err)
+	}
+	return nil
+}
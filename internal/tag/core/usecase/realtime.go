@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/lechitz/aion-api/internal/tag/core/domain"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// publishTagChanged announces a committed tag change to the user's realtime streams. A deleted tag
+// only carries its id, so its category and name stay empty.
+func (s *Service) publishTagChanged(ctx context.Context, span trace.Span, action string, tag domain.Tag) {
+	if s.Realtime == nil {
+		return
+	}
+
+	changedAt := tag.UpdatedAt.UTC()
+	if tag.UpdatedAt.IsZero() {
+		changedAt = time.Now().UTC()
+	}
+
+	span.AddEvent(EventPublishRealtime)
+	s.Realtime.Publish(ctx, realtimedomain.NewTagChangedEvent(tag.UserID, action, realtimedomain.TagChanged{
+		TagID:        tag.ID,
+		CategoryID:   tag.CategoryID,
+		Name:         tag.Name,
+		ChangedAtUTC: changedAt,
+	}))
+}
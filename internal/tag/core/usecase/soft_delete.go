@@ -5,7 +5,11 @@ import (
 	"errors"
 	"strconv"
 
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"github.com/lechitz/aion-api/internal/tag/core/domain"
+	"github.com/lechitz/aion-api/internal/tag/core/ports/output"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -27,7 +31,13 @@ func (s *Service) SoftDelete(ctx context.Context, tagID uint64, userID uint64) e
 		return errors.New(FailedToSoftDeleteTag)
 	}
 
-	if err := s.TagRepository.SoftDelete(ctx, tagID, userID); err != nil {
+	if err := s.runWithinTagOutboxTransaction(ctx, func(tagRepo output.TagRepository, outboxService eventoutboxinput.Service) error {
+		if deleteErr := tagRepo.SoftDelete(ctx, tagID, userID); deleteErr != nil {
+			return deleteErr
+		}
+
+		return s.enqueueTagOutboxEventWithService(ctx, outboxService, TagEventTypeDeletedV1, domain.Tag{ID: tagID, UserID: userID})
+	}); err != nil {
 		span.SetStatus(codes.Error, FailedToSoftDeleteTag)
 		span.RecordError(err)
 		return errors.New(FailedToSoftDeleteTag)
@@ -53,6 +63,8 @@ func (s *Service) SoftDelete(ctx context.Context, tagID uint64, userID uint64) e
 	// Note: We can't invalidate by category here as we don't have the categoryID
 	// The cache will expire naturally or be invalidated when the category is modified
 
+	s.publishTagChanged(ctx, span, realtimedomain.ActionDeleted, domain.Tag{ID: tagID, UserID: userID})
+
 	span.SetStatus(codes.Ok, StatusSoftDeleted)
 	s.Logger.InfowCtx(ctx, SuccessfullySoftDeletedTag, commonkeys.TagID, strconv.FormatUint(tagID, 10))
 	return nil
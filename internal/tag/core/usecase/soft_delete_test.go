@@ -1,10 +1,14 @@
 package usecase_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
+	eventoutboxdomain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/internal/tag/core/usecase"
+	"github.com/lechitz/aion-api/tests/mocks"
 	"github.com/lechitz/aion-api/tests/setup"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -95,3 +99,57 @@ func TestSoftDelete_Success_CacheInvalidationFails(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestSoftDelete_EnqueuesTagDeletedEvent(t *testing.T) {
+	suite := setup.TagServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	outbox := mocks.NewMockOutboxService(suite.Ctrl)
+	suite.TagService.WithOutbox(outbox)
+
+	suite.TagRepository.EXPECT().SoftDelete(gomock.Any(), uint64(1), uint64(100)).Return(nil)
+	suite.TagCache.EXPECT().DeleteTag(gomock.Any(), uint64(1), uint64(100)).Return(nil)
+	suite.TagCache.EXPECT().DeleteTagList(gomock.Any(), uint64(100)).Return(nil)
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event eventoutboxdomain.Event) error {
+		require.Equal(t, usecase.TagEventTypeDeletedV1, event.EventType)
+		require.Equal(t, "1", event.AggregateID)
+		return nil
+	})
+
+	require.NoError(t, suite.TagService.SoftDelete(suite.Ctx, 1, 100))
+}
+
+func TestSoftDelete_RepositoryErrorSkipsOutbox(t *testing.T) {
+	suite := setup.TagServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	outbox := mocks.NewMockOutboxService(suite.Ctrl)
+	suite.TagService.WithOutbox(outbox)
+
+	suite.TagRepository.EXPECT().SoftDelete(gomock.Any(), uint64(1), uint64(100)).Return(errors.New("tag not found"))
+
+	err := suite.TagService.SoftDelete(suite.Ctx, 1, 100)
+
+	require.Error(t, err)
+}
+
+func TestSoftDelete_PublishesTagDeleted(t *testing.T) {
+	suite := setup.TagServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.TagService.WithRealtime(publisher)
+
+	suite.TagRepository.EXPECT().SoftDelete(gomock.Any(), uint64(1), uint64(100)).Return(nil)
+	suite.TagCache.EXPECT().DeleteTag(gomock.Any(), uint64(1), uint64(100)).Return(nil)
+	suite.TagCache.EXPECT().DeleteTagList(gomock.Any(), uint64(100)).Return(nil)
+
+	require.NoError(t, suite.TagService.SoftDelete(suite.Ctx, 1, 100))
+
+	events := publisher.Events()
+	require.Len(t, events, 1)
+	require.Equal(t, realtimedomain.EventTypeTagChanged, events[0].Type)
+	require.Equal(t, realtimedomain.ActionDeleted, events[0].Action)
+	require.Equal(t, uint64(1), events[0].TagID)
+	require.Equal(t, uint64(100), events[0].UserID)
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/outboxtx"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	"github.com/lechitz/aion-api/internal/tag/core/ports/output"
+)
+
+func (s *Service) runWithinTagOutboxTransaction(ctx context.Context, fn func(tagRepo output.TagRepository, outboxService eventoutboxinput.Service) error) error {
+	return outboxtx.Run(ctx, s.TransactionManager, s.TagRepository, s.OutboxService, fn)
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"strconv"
 
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"github.com/lechitz/aion-api/internal/tag/core/domain"
 	"github.com/lechitz/aion-api/internal/tag/core/ports/input"
+	"github.com/lechitz/aion-api/internal/tag/core/ports/output"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -36,8 +39,17 @@ func (s *Service) Update(ctx context.Context, cmd input.UpdateTagCommand) (domai
 
 	fieldsToUpdate := extractUpdateFields(cmd)
 
-	span.AddEvent(EventRepositoryUpdate)
-	updatedTag, err := s.TagRepository.UpdateTag(ctx, cmd.ID, cmd.UserID, fieldsToUpdate)
+	var updatedTag domain.Tag
+	err := s.runWithinTagOutboxTransaction(ctx, func(tagRepo output.TagRepository, outboxService eventoutboxinput.Service) error {
+		span.AddEvent(EventRepositoryUpdate)
+		var updateErr error
+		updatedTag, updateErr = tagRepo.UpdateTag(ctx, cmd.ID, cmd.UserID, fieldsToUpdate)
+		if updateErr != nil {
+			return updateErr
+		}
+
+		return s.enqueueTagOutboxEventWithService(ctx, outboxService, TagEventTypeUpdatedV1, updatedTag)
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, FailedToUpdateTag)
@@ -86,6 +98,8 @@ func (s *Service) Update(ctx context.Context, cmd input.UpdateTagCommand) (domai
 		)
 	}
 
+	s.publishTagChanged(ctx, span, realtimedomain.ActionUpdated, updatedTag)
+
 	span.AddEvent(EventSuccess)
 	span.SetStatus(codes.Ok, StatusUpdated)
 	s.Logger.InfowCtx(
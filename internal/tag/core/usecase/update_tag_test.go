@@ -3,7 +3,9 @@ package usecase_test
 import (
 	"errors"
 	"testing"
+	"time"
 
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"github.com/lechitz/aion-api/internal/tag/core/domain"
 	"github.com/lechitz/aion-api/internal/tag/core/ports/input"
@@ -218,3 +220,47 @@ func TestUpdate_Success_CacheInvalidationFails(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedTag, tag)
 }
+
+func TestUpdate_PublishesTagChanged(t *testing.T) {
+	suite := setup.TagServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.TagService.WithRealtime(publisher)
+
+	newName := "Deep Work"
+	updated := domain.Tag{ID: 1, UserID: 100, CategoryID: 20, Name: newName, UpdatedAt: time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)}
+
+	suite.TagRepository.EXPECT().UpdateTag(gomock.Any(), updated.ID, updated.UserID, gomock.Any()).Return(updated, nil)
+	suite.TagCache.EXPECT().DeleteTag(gomock.Any(), updated.ID, updated.UserID).Return(nil)
+	suite.TagCache.EXPECT().DeleteTagByName(gomock.Any(), newName, updated.UserID).Return(nil)
+	suite.TagCache.EXPECT().DeleteTagList(gomock.Any(), updated.UserID).Return(nil)
+	suite.TagCache.EXPECT().DeleteTagsByCategory(gomock.Any(), updated.CategoryID, updated.UserID).Return(nil)
+
+	_, err := suite.TagService.Update(suite.Ctx, input.UpdateTagCommand{ID: updated.ID, UserID: updated.UserID, Name: &newName})
+	require.NoError(t, err)
+
+	events := publisher.Events()
+	require.Len(t, events, 1)
+	require.Equal(t, realtimedomain.EventTypeTagChanged, events[0].Type)
+	require.Equal(t, realtimedomain.ActionUpdated, events[0].Action)
+	require.Equal(t, updated.UserID, events[0].UserID)
+	require.Equal(t, updated.CategoryID, events[0].CategoryID)
+	require.Equal(t, newName, events[0].Tag.Name)
+	require.Equal(t, updated.UpdatedAt, events[0].Tag.ChangedAtUTC)
+}
+
+func TestUpdate_FailedUpdatePublishesNothing(t *testing.T) {
+	suite := setup.TagServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	publisher := &setup.RealtimePublisherSpy{}
+	suite.TagService.WithRealtime(publisher)
+
+	newName := "Deep Work"
+	suite.TagRepository.EXPECT().UpdateTag(gomock.Any(), uint64(1), uint64(100), gomock.Any()).Return(domain.Tag{}, errors.New("db down"))
+
+	_, err := suite.TagService.Update(suite.Ctx, input.UpdateTagCommand{ID: 1, UserID: 100, Name: &newName})
+	require.Error(t, err)
+	require.Empty(t, publisher.Events())
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	"github.com/lechitz/aion-api/internal/user/core/ports/output"
 )
 
 // UserRepository handles interactions with the user database.
@@ -28,6 +29,15 @@ func New(database db.DB, logger logger.ContextLogger, roleAssigner adminoutput.R
 	}
 }
 
+// WithDB clones the repository with a transaction-bound database handle.
+func (up UserRepository) WithDB(database db.DB) output.UserRepository {
+	return UserRepository{
+		db:           database,
+		logger:       up.logger,
+		roleAssigner: up.roleAssigner,
+	}
+}
+
 // isUniqueViolation detects a Postgres unique constraint violation and returns the affected field.
 func isUniqueViolation(err error) (string, bool) {
 	if err == nil {
@@ -0,0 +1,14 @@
+package domain
+
+// UserEventPayloadV1 is the outbox payload of user.created, user.updated, and user.deleted at v1.
+// It carries the public profile only; email and password hash are deliberately absent.
+// Its shape is pinned by the user.v1 JSON Schema in the event schema registry.
+type UserEventPayloadV1 struct {
+	Locale              *string `json:"locale"`
+	Timezone            *string `json:"timezone"`
+	Username            string  `json:"username"`
+	Name                string  `json:"name"`
+	UpdatedAtUTC        string  `json:"updated_at_utc"`
+	UserID              uint64  `json:"user_id"`
+	OnboardingCompleted bool    `json:"onboarding_completed"`
+}
@@ -306,3 +306,27 @@ var (
 	// ErrSoftDeleteUser is a sentinel error for soft delete failures.
 	ErrSoftDeleteUser = errors.New(ErrorToSoftDeleteUser)
 )
+
+// =============================================================================
+// OUTBOX EVENTS - Canonical user events
+// =============================================================================
+
+const (
+	// UserAggregateType identifies the user aggregate in canonical outbox events.
+	UserAggregateType = "user"
+	// UserEventVersionV1 identifies the first canonical user event contract version.
+	UserEventVersionV1 = "v1"
+	// UserEventTypeCreatedV1 is emitted after a user is created, directly or by completing registration.
+	UserEventTypeCreatedV1 = "user.created"
+	// UserEventTypeUpdatedV1 is emitted after a profile update or avatar removal succeeds.
+	UserEventTypeUpdatedV1 = "user.updated"
+	// UserEventTypeDeletedV1 is emitted after user soft deletion succeeds.
+	UserEventTypeDeletedV1 = "user.deleted"
+)
+
+const (
+	// FailedToEnqueueUserEvent indicates the outbox rejected the event, which rolls the user write back.
+	FailedToEnqueueUserEvent = "failed to enqueue user outbox event"
+	// FailedToMarshalUserEventPayload indicates payload serialization failed before enqueue.
+	FailedToMarshalUserEventPayload = "failed to marshal user outbox payload"
+)
@@ -3,6 +3,8 @@ package usecase
 
 import (
 	authOutput "github.com/lechitz/aion-api/internal/auth/core/ports/output"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	dbport "github.com/lechitz/aion-api/internal/platform/ports/output/db"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/hasher"
 	"github.com/lechitz/aion-api/internal/platform/ports/output/logger"
 	userOutput "github.com/lechitz/aion-api/internal/user/core/ports/output"
@@ -10,14 +12,16 @@ import (
 
 // Service provides an abstraction for user management, including creating, retrieving, updating, and deleting users, plus authentication handling.
 type Service struct {
-	userRepository   userOutput.UserRepository
-	registrationRepo userOutput.RegistrationSessionRepository
-	userCache        userOutput.UserCache
-	avatarStorage    userOutput.AvatarStorage
-	authStore        authOutput.AuthStore
-	tokenProvider    authOutput.AuthProvider
-	hasher           hasher.Hasher
-	logger           logger.ContextLogger
+	userRepository     userOutput.UserRepository
+	registrationRepo   userOutput.RegistrationSessionRepository
+	userCache          userOutput.UserCache
+	avatarStorage      userOutput.AvatarStorage
+	authStore          authOutput.AuthStore
+	tokenProvider      authOutput.AuthProvider
+	hasher             hasher.Hasher
+	outboxService      eventoutboxinput.Service
+	transactionManager dbport.DB
+	logger             logger.ContextLogger
 }
 
 // NewService creates and returns a new Service instance with the provided dependencies for handling user-related operations.
@@ -42,3 +46,15 @@ func NewService(
 		logger:           logger,
 	}
 }
+
+// WithOutbox attaches an optional outbox service without breaking existing constructor call sites.
+func (s *Service) WithOutbox(outboxService eventoutboxinput.Service) *Service {
+	s.outboxService = outboxService
+	return s
+}
+
+// WithTransactionManager attaches an optional transaction manager without breaking constructor call sites.
+func (s *Service) WithTransactionManager(database dbport.DB) *Service {
+	s.transactionManager = database
+	return s
+}
@@ -6,10 +6,12 @@ import (
 	"strconv"
 	"strings"
 
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	"github.com/lechitz/aion-api/internal/platform/server/http/utils/sharederrors"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"github.com/lechitz/aion-api/internal/user/core/domain"
 	"github.com/lechitz/aion-api/internal/user/core/ports/input"
+	userOutput "github.com/lechitz/aion-api/internal/user/core/ports/output"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -67,7 +69,16 @@ func (s *Service) Create(ctx context.Context, cmd input.CreateUserCommand) (doma
 		AvatarURL: cmd.AvatarURL,
 	}
 
-	userDomain, err := s.userRepository.Create(ctx, user)
+	var userDomain domain.User
+	err = s.runWithinUserOutboxTransaction(ctx, func(userRepo userOutput.UserRepository, outboxService eventoutboxinput.Service) error {
+		var createErr error
+		userDomain, createErr = userRepo.Create(ctx, user)
+		if createErr != nil {
+			return createErr
+		}
+
+		return s.enqueueUserOutboxEventWithService(ctx, outboxService, UserEventTypeCreatedV1, userDomain)
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetAttributes(attribute.String(commonkeys.Status, StatusDBErrorCreateUser))
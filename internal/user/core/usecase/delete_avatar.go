@@ -6,8 +6,10 @@ import (
 	"strconv"
 	"time"
 
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"github.com/lechitz/aion-api/internal/user/core/domain"
+	userOutput "github.com/lechitz/aion-api/internal/user/core/ports/output"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -29,7 +31,16 @@ func (s *Service) RemoveAvatar(ctx context.Context, userID uint64) (domain.User,
 		commonkeys.UserUpdatedAt: time.Now().UTC(),
 	}
 
-	updatedUser, err := s.userRepository.Update(ctx, userID, fields)
+	var updatedUser domain.User
+	err := s.runWithinUserOutboxTransaction(ctx, func(userRepo userOutput.UserRepository, outboxService eventoutboxinput.Service) error {
+		var updateErr error
+		updatedUser, updateErr = userRepo.Update(ctx, userID, fields)
+		if updateErr != nil {
+			return updateErr
+		}
+
+		return s.enqueueUserOutboxEventWithService(ctx, outboxService, UserEventTypeUpdatedV1, updatedUser)
+	})
 	if err != nil {
 		span.SetStatus(codes.Error, ErrorToDeleteAvatar)
 		span.RecordError(err)
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/outboxtx"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	"github.com/lechitz/aion-api/internal/user/core/domain"
+)
+
+// enqueueUserOutboxEventWithService publishes the public profile only; email and password hash
+// never leave the users table through the outbox.
+func (s *Service) enqueueUserOutboxEventWithService(ctx context.Context, outboxService eventoutboxinput.Service, eventType string, user domain.User) error {
+	if outboxService == nil {
+		return nil
+	}
+
+	event, err := outboxtx.NewEvent(ctx, UserAggregateType, user.ID, eventType, UserEventVersionV1, domain.UserEventPayloadV1{
+		UserID:              user.ID,
+		Username:            user.Username,
+		Name:                user.Name,
+		Locale:              user.Locale,
+		Timezone:            user.Timezone,
+		OnboardingCompleted: user.OnboardingCompleted,
+		UpdatedAtUTC:        outboxtx.FormatTime(user.UpdatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", FailedToMarshalUserEventPayload, err)
+	}
+
+	if err := outboxService.Enqueue(ctx, event); err != nil {
+		return fmt.Errorf("%s: %w", FailedToEnqueueUserEvent, err)
+	}
+	return nil
+}
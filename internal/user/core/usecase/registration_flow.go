@@ -10,10 +10,12 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	"github.com/lechitz/aion-api/internal/platform/server/http/utils/sharederrors"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	"github.com/lechitz/aion-api/internal/user/core/domain"
 	"github.com/lechitz/aion-api/internal/user/core/ports/input"
+	userOutput "github.com/lechitz/aion-api/internal/user/core/ports/output"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -235,16 +237,25 @@ func (s *Service) CompleteRegistration(ctx context.Context, registrationID strin
 		return domain.User{}, sharederrors.NewValidationError(commonkeys.Email, sharederrors.ErrEmailInUse)
 	}
 
-	user, err := s.userRepository.Create(ctx, domain.User{
-		Name:      session.Name,
-		Username:  session.Username,
-		Email:     session.Email,
-		Password:  session.PasswordHash,
-		Locale:    session.Locale,
-		Timezone:  session.Timezone,
-		Location:  session.Location,
-		Bio:       session.Bio,
-		AvatarURL: session.AvatarURL,
+	var user domain.User
+	err = s.runWithinUserOutboxTransaction(ctx, func(userRepo userOutput.UserRepository, outboxService eventoutboxinput.Service) error {
+		var createErr error
+		user, createErr = userRepo.Create(ctx, domain.User{
+			Name:      session.Name,
+			Username:  session.Username,
+			Email:     session.Email,
+			Password:  session.PasswordHash,
+			Locale:    session.Locale,
+			Timezone:  session.Timezone,
+			Location:  session.Location,
+			Bio:       session.Bio,
+			AvatarURL: session.AvatarURL,
+		})
+		if createErr != nil {
+			return createErr
+		}
+
+		return s.enqueueUserOutboxEventWithService(ctx, outboxService, UserEventTypeCreatedV1, user)
 	})
 	if err != nil {
 		span.RecordError(err)
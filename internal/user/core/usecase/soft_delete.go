@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"strconv"
 
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	"github.com/lechitz/aion-api/internal/platform/server/http/utils/sharederrors"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
+	userOutput "github.com/lechitz/aion-api/internal/user/core/ports/output"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -45,7 +47,13 @@ func (s *Service) SoftDeleteUser(ctx context.Context, userID uint64) error {
 		return err
 	}
 
-	if err := s.userRepository.SoftDelete(ctx, userID); err != nil {
+	if err := s.runWithinUserOutboxTransaction(ctx, func(userRepo userOutput.UserRepository, outboxService eventoutboxinput.Service) error {
+		if deleteErr := userRepo.SoftDelete(ctx, userID); deleteErr != nil {
+			return deleteErr
+		}
+
+		return s.enqueueUserOutboxEventWithService(ctx, outboxService, UserEventTypeDeletedV1, user)
+	}); err != nil {
 		span.RecordError(err)
 		span.SetAttributes(attribute.String(commonkeys.Status, ErrorToSoftDeleteUser))
 		s.logger.ErrorwCtx(ctx, ErrorToSoftDeleteUser, commonkeys.Error, err.Error())
@@ -6,10 +6,12 @@ import (
 	"errors"
 	"testing"
 
+	eventoutboxdomain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
 	"github.com/lechitz/aion-api/internal/platform/server/http/utils/sharederrors"
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
 	userdomain "github.com/lechitz/aion-api/internal/user/core/domain"
 	"github.com/lechitz/aion-api/internal/user/core/usecase"
+	"github.com/lechitz/aion-api/tests/mocks"
 	"github.com/lechitz/aion-api/tests/setup"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -180,3 +182,61 @@ func TestSoftDeleteUser_SentinelError(t *testing.T) {
 	require.ErrorIs(t, err, usecase.ErrSoftDeleteUser, "error should wrap ErrSoftDeleteUser sentinel error")
 	require.ErrorContains(t, err, "deletion failed")
 }
+
+func TestSoftDeleteUser_EnqueuesUserDeletedEventWithoutCredentials(t *testing.T) {
+	suite := setup.UserServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	outbox := mocks.NewMockOutboxService(suite.Ctrl)
+	suite.UserService.WithOutbox(outbox)
+
+	userID := uint64(1)
+	user := userdomain.User{
+		ID:       userID,
+		Username: "testuser",
+		Email:    "test@example.com",
+		Password: "hashed",
+	}
+
+	suite.UserRepository.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+	suite.TokenStore.EXPECT().Delete(gomock.Any(), userID, commonkeys.TokenTypeAccess).Return(nil)
+	suite.TokenStore.EXPECT().Delete(gomock.Any(), userID, commonkeys.TokenTypeRefresh).Return(nil)
+	suite.UserRepository.EXPECT().SoftDelete(gomock.Any(), userID).Return(nil)
+	suite.UserCache.EXPECT().DeleteUser(gomock.Any(), userID, user.Username, user.Email).Return(nil)
+
+	var enqueued eventoutboxdomain.Event
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event eventoutboxdomain.Event) error {
+		enqueued = event
+		return nil
+	})
+
+	require.NoError(t, suite.UserService.SoftDeleteUser(suite.Ctx, userID))
+	require.Equal(t, usecase.UserAggregateType, enqueued.AggregateType)
+	require.Equal(t, usecase.UserEventTypeDeletedV1, enqueued.EventType)
+	require.Contains(t, string(enqueued.PayloadJSON), `"username":"testuser"`)
+	require.NotContains(t, string(enqueued.PayloadJSON), user.Email)
+	require.NotContains(t, string(enqueued.PayloadJSON), user.Password)
+}
+
+func TestSoftDeleteUser_FailedEnqueueFailsTheDelete(t *testing.T) {
+	suite := setup.UserServiceTest(t)
+	defer suite.Ctrl.Finish()
+
+	outbox := mocks.NewMockOutboxService(suite.Ctrl)
+	suite.UserService.WithOutbox(outbox)
+
+	userID := uint64(1)
+	user := userdomain.User{ID: userID, Username: "testuser", Email: "test@example.com"}
+	enqueueErr := errors.New("payload rejected by schema")
+
+	suite.UserRepository.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+	suite.TokenStore.EXPECT().Delete(gomock.Any(), userID, commonkeys.TokenTypeAccess).Return(nil)
+	suite.TokenStore.EXPECT().Delete(gomock.Any(), userID, commonkeys.TokenTypeRefresh).Return(nil)
+	suite.UserRepository.EXPECT().SoftDelete(gomock.Any(), userID).Return(nil)
+	outbox.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(enqueueErr)
+
+	err := suite.UserService.SoftDeleteUser(suite.Ctx, userID)
+
+	require.ErrorIs(t, err, usecase.ErrSoftDeleteUser)
+	require.ErrorIs(t, err, enqueueErr)
+}
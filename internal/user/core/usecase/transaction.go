@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/lechitz/aion-api/internal/eventoutbox/core/outboxtx"
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
+	userOutput "github.com/lechitz/aion-api/internal/user/core/ports/output"
+)
+
+func (s *Service) runWithinUserOutboxTransaction(ctx context.Context, fn func(userRepo userOutput.UserRepository, outboxService eventoutboxinput.Service) error) error {
+	return outboxtx.Run(ctx, s.transactionManager, s.userRepository, s.outboxService, fn)
+}
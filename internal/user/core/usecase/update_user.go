@@ -8,8 +8,10 @@ import (
 	"strings"
 	"time"
 
+	eventoutboxinput "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/input"
 	"github.com/lechitz/aion-api/internal/user/core/domain"
 	"github.com/lechitz/aion-api/internal/user/core/ports/input"
+	userOutput "github.com/lechitz/aion-api/internal/user/core/ports/output"
 	"go.opentelemetry.io/otel/codes"
 
 	"github.com/lechitz/aion-api/internal/shared/constants/commonkeys"
@@ -37,7 +39,16 @@ func (s *Service) UpdateUser(ctx context.Context, userID uint64, cmd input.Updat
 
 	fields := buildUpdateFields(cmd)
 
-	updatedUser, err := s.userRepository.Update(ctx, userID, fields)
+	var updatedUser domain.User
+	err := s.runWithinUserOutboxTransaction(ctx, func(userRepo userOutput.UserRepository, outboxService eventoutboxinput.Service) error {
+		var updateErr error
+		updatedUser, updateErr = userRepo.Update(ctx, userID, fields)
+		if updateErr != nil {
+			return updateErr
+		}
+
+		return s.enqueueUserOutboxEventWithService(ctx, outboxService, UserEventTypeUpdatedV1, updatedUser)
+	})
 	if err != nil {
 		span.SetAttributes(attribute.String(commonkeys.Status, ErrorToUpdateUser))
 		span.RecordError(err)
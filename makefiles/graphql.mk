@@ -33,6 +33,8 @@ graphql.queries:
 	@printf 'query ListRecords($$limit: Int) { records(limit: $$limit) { id userId tagId description eventTime recordedAt durationSeconds value source timezone status createdAt updatedAt } }\n' > "$(QUERIES_DIR)/records/list.graphql"
 	@printf 'query RecordById($$id: ID!) { recordById(id: $$id) { id userId tagId description eventTime recordedAt durationSeconds value source timezone status createdAt updatedAt } }\n' > "$(QUERIES_DIR)/records/by-id.graphql"
 	@printf 'query RecordsLatest($$limit: Int) { recordsLatest(limit: $$limit) { id userId tagId description eventTime recordedAt durationSeconds value source timezone status createdAt updatedAt } }\n' > "$(QUERIES_DIR)/records/latest.graphql"
+	@printf 'query DeletedRecords($$limit: Int, $$afterDeletedAt: String, $$afterId: ID) { deletedRecords(limit: $$limit, afterDeletedAt: $$afterDeletedAt, afterId: $$afterId) { id userId tagId description eventTime recordedAt durationSeconds value source timezone status createdAt updatedAt deletedAt } }\n' > "$(QUERIES_DIR)/records/deleted.graphql"
+	@printf 'query RecordHistory($$id: ID!, $$limit: Int) { recordHistory(id: $$id, limit: $$limit) { id recordId operation changes { field oldValue newValue } actorUserId actorService source requestId createdAt } }\n' > "$(QUERIES_DIR)/records/history.graphql"
 	@printf 'query RecordProjectionById($$id: ID!) { recordProjectionById(id: $$id) { recordId userId tagId description eventTimeUTC recordedAtUTC durationSeconds value source timezone status createdAtUTC updatedAtUTC lastEventType } }\n' > "$(QUERIES_DIR)/records/projection-by-id.graphql"
 	@printf 'query RecordProjectionsLatest($$limit: Int) { recordProjectionsLatest(limit: $$limit) { recordId userId tagId description eventTimeUTC recordedAtUTC durationSeconds value source timezone status createdAtUTC updatedAtUTC lastEventType } }\n' > "$(QUERIES_DIR)/records/projections-latest.graphql"
 	@printf 'query RecordProjections($$limit: Int, $$afterEventTime: String, $$afterId: ID) { recordProjections(limit: $$limit, afterEventTime: $$afterEventTime, afterId: $$afterId) { recordId userId tagId description eventTimeUTC recordedAtUTC durationSeconds value source timezone status createdAtUTC updatedAtUTC lastEventType } }\n' > "$(QUERIES_DIR)/records/projections.graphql"
@@ -62,9 +64,12 @@ graphql.queries:
 	@printf 'mutation UpdateTag($$input: UpdateTagInput!) { updateTag(input: $$input) { id userId name categoryId description icon createdAt updatedAt } }\n' > "$(MUTATIONS_DIR)/tags/update.graphql"
 	@printf 'mutation SoftDeleteTag($$input: DeleteTagInput!) { softDeleteTag(input: $$input) }\n' > "$(MUTATIONS_DIR)/tags/delete.graphql"
 	@printf 'mutation CreateRecord($$input: CreateRecordInput!) { createRecord(input: $$input) { id userId tagId description eventTime recordedAt durationSeconds value source timezone status createdAt updatedAt } }\n' > "$(MUTATIONS_DIR)/records/create.graphql"
+	@printf 'mutation CreateRecords($$input: [CreateRecordInput!]!, $$mode: CreateRecordsMode) { createRecords(input: $$input, mode: $$mode) { createdCount failedCount results { index error record { id userId tagId description eventTime recordedAt durationSeconds value source timezone status createdAt updatedAt } } } }\n' > "$(MUTATIONS_DIR)/records/create-many.graphql"
 	@printf 'mutation UpdateRecord($$input: UpdateRecordInput!) { updateRecord(input: $$input) { id userId tagId description eventTime recordedAt durationSeconds value source timezone status createdAt updatedAt } }\n' > "$(MUTATIONS_DIR)/records/update.graphql"
 	@printf 'mutation SoftDeleteRecord($$input: DeleteRecordInput!) { softDeleteRecord(input: $$input) }\n' > "$(MUTATIONS_DIR)/records/delete.graphql"
 	@printf 'mutation SoftDeleteAllRecords { softDeleteAllRecords }\n' > "$(MUTATIONS_DIR)/records/delete-all.graphql"
+	@printf 'mutation RestoreRecord($$input: RestoreRecordInput!) { restoreRecord(input: $$input) { id userId tagId description eventTime recordedAt durationSeconds value source timezone status createdAt updatedAt } }\n' > "$(MUTATIONS_DIR)/records/restore.graphql"
+	@printf 'mutation RestoreRecords($$input: RestoreRecordsInput!) { restoreRecords(input: $$input) { id userId tagId description eventTime recordedAt durationSeconds value source timezone status createdAt updatedAt } }\n' > "$(MUTATIONS_DIR)/records/restore-many.graphql"
 	@printf 'mutation UpsertMetricDefinition($$input: UpsertMetricDefinitionInput!) { upsertMetricDefinition(input: $$input) { id metricKey displayName categoryId tagId tagIds valueSource aggregation unit goalDefault isActive } }\n' > "$(MUTATIONS_DIR)/dashboard/upsert-metric-definition.graphql"
 	@printf 'mutation UpsertGoalTemplate($$input: UpsertGoalTemplateInput!) { upsertGoalTemplate(input: $$input) { id metricKey title targetValue comparison period isActive } }\n' > "$(MUTATIONS_DIR)/dashboard/upsert-goal-template.graphql"
 	@printf 'mutation DeleteGoalTemplate($$input: DeleteGoalTemplateInput!) { deleteGoalTemplate(input: $$input) }\n' > "$(MUTATIONS_DIR)/dashboard/delete-goal-template.graphql"
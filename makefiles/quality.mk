@@ -5,7 +5,7 @@
 GO_CACHE := $(CURDIR)/.cache/go-build
 GOLANGCI_CACHE := $(CURDIR)/.cache/golangci-lint
 
-.PHONY: format lint lint-fix fieldalignment fieldalignment-report go-check govet verify verify-ci
+.PHONY: format lint lint-fix fieldalignment fieldalignment-report go-check govet events.schema-check verify verify-ci
 
 # Run goimports and golines to format code
 format:
@@ -78,14 +78,20 @@ govet:
 	@mkdir -p $(GO_CACHE)
 	@GOCACHE=$(GO_CACHE) go vet ./...
 
+# Fail when an outbox payload struct drifts from its JSON Schema in the event schema registry.
+events.schema-check:
+	@echo "Checking event payload schemas..."
+	@mkdir -p $(GO_CACHE)
+	@GOCACHE=$(GO_CACHE) go run ./hack/tools/event-schema-check
+
 # General verify (checks code quality, but does not enforce committed artifacts)
-verify: go-check lint fieldalignment graphql mocks docs.validate docs-verify test test-cover-detail test-ci test-clean regression-gate-draft
+verify: go-check lint fieldalignment events.schema-check graphql mocks docs.validate docs-verify test test-cover-detail test-ci test-clean regression-gate-draft
 	@echo "Running test checks..."
 	@$(MAKE) -s test-checks
 	@echo "✅  Verify passed successfully!"
 
 # CI-style verify (stricter, enforces committed artifacts)
-verify-ci: tools.check docs.gen docs.check-dirty graphql.queries graphql.manifest graphql.validate graphql.check-dirty lint fieldalignment govet test
+verify-ci: tools.check docs.gen docs.check-dirty graphql.queries graphql.manifest graphql.validate graphql.check-dirty lint fieldalignment events.schema-check govet test
 	@echo "Running test checks..."
 	@$(MAKE) -s test-checks
 	@echo "✅  CI verify passed!"
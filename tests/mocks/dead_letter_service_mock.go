@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/lechitz/Projetos/github/Aion/aion-api/internal/eventoutbox/core/ports/input/service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=/home/lechitz/Projetos/github/Aion/aion-api/internal/eventoutbox/core/ports/input/service.go -destination=/home/lechitz/Projetos/github/Aion/aion-api/tests/mocks/dead_letter_service_mock.go -package=mocks -exclude_interfaces=Service,PublisherService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeadLetterService is a mock of DeadLetterService interface.
+type MockDeadLetterService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeadLetterServiceMockRecorder is the mock recorder for MockDeadLetterService.
+type MockDeadLetterServiceMockRecorder struct {
+	mock *MockDeadLetterService
+}
+
+// NewMockDeadLetterService creates a new mock instance.
+func NewMockDeadLetterService(ctrl *gomock.Controller) *MockDeadLetterService {
+	mock := &MockDeadLetterService{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterService) EXPECT() *MockDeadLetterServiceMockRecorder {
+	return m.recorder
+}
+
+// GetEvent mocks base method.
+func (m *MockDeadLetterService) GetEvent(ctx context.Context, eventID string) (domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, eventID)
+	ret0, _ := ret[0].(domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockDeadLetterServiceMockRecorder) GetEvent(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockDeadLetterService)(nil).GetEvent), ctx, eventID)
+}
+
+// ListDeadLettered mocks base method.
+func (m *MockDeadLetterService) ListDeadLettered(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLettered", ctx, filter)
+	ret0, _ := ret[0].([]domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLettered indicates an expected call of ListDeadLettered.
+func (mr *MockDeadLetterServiceMockRecorder) ListDeadLettered(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLettered", reflect.TypeOf((*MockDeadLetterService)(nil).ListDeadLettered), ctx, filter)
+}
+
+// ReplayDeadLettered mocks base method.
+func (m *MockDeadLetterService) ReplayDeadLettered(ctx context.Context, filter domain.DeadLetterFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLettered", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLettered indicates an expected call of ReplayDeadLettered.
+func (mr *MockDeadLetterServiceMockRecorder) ReplayDeadLettered(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLettered", reflect.TypeOf((*MockDeadLetterService)(nil).ReplayDeadLettered), ctx, filter)
+}
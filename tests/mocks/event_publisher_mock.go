@@ -54,3 +54,17 @@ func (mr *MockEventPublisherMockRecorder) Publish(ctx, event any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventPublisher)(nil).Publish), ctx, event)
 }
+
+// PublishBatch mocks base method.
+func (m *MockEventPublisher) PublishBatch(ctx context.Context, events []domain.Event) []domain.PublishResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishBatch", ctx, events)
+	ret0, _ := ret[0].([]domain.PublishResult)
+	return ret0
+}
+
+// PublishBatch indicates an expected call of PublishBatch.
+func (mr *MockEventPublisherMockRecorder) PublishBatch(ctx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishBatch", reflect.TypeOf((*MockEventPublisher)(nil).PublishBatch), ctx, events)
+}
@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	domain "github.com/lechitz/aion-api/internal/eventoutbox/core/domain"
+	output "github.com/lechitz/aion-api/internal/eventoutbox/core/ports/output"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,47 +43,150 @@ func (m *MockEventRepository) EXPECT() *MockEventRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetByEventID mocks base method.
+func (m *MockEventRepository) GetByEventID(ctx context.Context, eventID string) (domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEventID", ctx, eventID)
+	ret0, _ := ret[0].(domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEventID indicates an expected call of GetByEventID.
+func (mr *MockEventRepositoryMockRecorder) GetByEventID(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEventID", reflect.TypeOf((*MockEventRepository)(nil).GetByEventID), ctx, eventID)
+}
+
+// GetStats mocks base method.
+func (m *MockEventRepository) GetStats(ctx context.Context) (domain.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStats", ctx)
+	ret0, _ := ret[0].(domain.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStats indicates an expected call of GetStats.
+func (mr *MockEventRepositoryMockRecorder) GetStats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockEventRepository)(nil).GetStats), ctx)
+}
+
+// ListDeadLettered mocks base method.
+func (m *MockEventRepository) ListDeadLettered(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLettered", ctx, filter)
+	ret0, _ := ret[0].([]domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLettered indicates an expected call of ListDeadLettered.
+func (mr *MockEventRepositoryMockRecorder) ListDeadLettered(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLettered", reflect.TypeOf((*MockEventRepository)(nil).ListDeadLettered), ctx, filter)
+}
+
 // ListPending mocks base method.
-func (m *MockEventRepository) ListPending(ctx context.Context, limit int) ([]domain.Event, error) {
+func (m *MockEventRepository) ListPending(ctx context.Context, lease domain.Lease, ordering domain.Ordering, limit int) ([]domain.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPending", ctx, limit)
+	ret := m.ctrl.Call(m, "ListPending", ctx, lease, ordering, limit)
 	ret0, _ := ret[0].([]domain.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPending indicates an expected call of ListPending.
-func (mr *MockEventRepositoryMockRecorder) ListPending(ctx, limit any) *gomock.Call {
+func (mr *MockEventRepositoryMockRecorder) ListPending(ctx, lease, ordering, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockEventRepository)(nil).ListPending), ctx, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockEventRepository)(nil).ListPending), ctx, lease, ordering, limit)
 }
 
-// MarkPublished mocks base method.
-func (m *MockEventRepository) MarkPublished(ctx context.Context, eventID string, publishedAt time.Time) error {
+// MarkDeadLettered mocks base method.
+func (m *MockEventRepository) MarkDeadLettered(ctx context.Context, leaseOwner, eventID, lastError string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkPublished", ctx, eventID, publishedAt)
+	ret := m.ctrl.Call(m, "MarkDeadLettered", ctx, leaseOwner, eventID, lastError)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MarkPublished indicates an expected call of MarkPublished.
-func (mr *MockEventRepositoryMockRecorder) MarkPublished(ctx, eventID, publishedAt any) *gomock.Call {
+// MarkDeadLettered indicates an expected call of MarkDeadLettered.
+func (mr *MockEventRepositoryMockRecorder) MarkDeadLettered(ctx, leaseOwner, eventID, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeadLettered", reflect.TypeOf((*MockEventRepository)(nil).MarkDeadLettered), ctx, leaseOwner, eventID, lastError)
+}
+
+// MarkPublishedBatch mocks base method.
+func (m *MockEventRepository) MarkPublishedBatch(ctx context.Context, leaseOwner string, eventIDs []string, publishedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPublishedBatch", ctx, leaseOwner, eventIDs, publishedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPublishedBatch indicates an expected call of MarkPublishedBatch.
+func (mr *MockEventRepositoryMockRecorder) MarkPublishedBatch(ctx, leaseOwner, eventIDs, publishedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublishedBatch", reflect.TypeOf((*MockEventRepository)(nil).MarkPublishedBatch), ctx, leaseOwner, eventIDs, publishedAt)
+}
+
+// NotifyPending mocks base method.
+func (m *MockEventRepository) NotifyPending(ctx context.Context, aggregateType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyPending", ctx, aggregateType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyPending indicates an expected call of NotifyPending.
+func (mr *MockEventRepositoryMockRecorder) NotifyPending(ctx, aggregateType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyPending", reflect.TypeOf((*MockEventRepository)(nil).NotifyPending), ctx, aggregateType)
+}
+
+// PurgePublished mocks base method.
+func (m *MockEventRepository) PurgePublished(ctx context.Context, cutoff time.Time, limit int, archive output.ArchiveFunc) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgePublished", ctx, cutoff, limit, archive)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgePublished indicates an expected call of PurgePublished.
+func (mr *MockEventRepositoryMockRecorder) PurgePublished(ctx, cutoff, limit, archive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgePublished", reflect.TypeOf((*MockEventRepository)(nil).PurgePublished), ctx, cutoff, limit, archive)
+}
+
+// ReplayDeadLettered mocks base method.
+func (m *MockEventRepository) ReplayDeadLettered(ctx context.Context, filter domain.DeadLetterFilter, availableAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLettered", ctx, filter, availableAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLettered indicates an expected call of ReplayDeadLettered.
+func (mr *MockEventRepositoryMockRecorder) ReplayDeadLettered(ctx, filter, availableAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublished", reflect.TypeOf((*MockEventRepository)(nil).MarkPublished), ctx, eventID, publishedAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLettered", reflect.TypeOf((*MockEventRepository)(nil).ReplayDeadLettered), ctx, filter, availableAt)
 }
 
 // Reschedule mocks base method.
-func (m *MockEventRepository) Reschedule(ctx context.Context, eventID string, nextAvailableAt time.Time, lastError string) error {
+func (m *MockEventRepository) Reschedule(ctx context.Context, leaseOwner, eventID string, nextAvailableAt time.Time, lastError string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Reschedule", ctx, eventID, nextAvailableAt, lastError)
+	ret := m.ctrl.Call(m, "Reschedule", ctx, leaseOwner, eventID, nextAvailableAt, lastError)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Reschedule indicates an expected call of Reschedule.
-func (mr *MockEventRepositoryMockRecorder) Reschedule(ctx, eventID, nextAvailableAt, lastError any) *gomock.Call {
+func (mr *MockEventRepositoryMockRecorder) Reschedule(ctx, leaseOwner, eventID, nextAvailableAt, lastError any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockEventRepository)(nil).Reschedule), ctx, eventID, nextAvailableAt, lastError)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockEventRepository)(nil).Reschedule), ctx, leaseOwner, eventID, nextAvailableAt, lastError)
 }
 
 // Save mocks base method.
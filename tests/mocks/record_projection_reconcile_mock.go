@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/lechitz/Projetos/github/Aion/aion-api/internal/record/core/ports/output/record_projection_reconcile.go
+//
+// Generated by this command:
+//
+//	mockgen -source=/home/lechitz/Projetos/github/Aion/aion-api/internal/record/core/ports/output/record_projection_reconcile.go -destination=/home/lechitz/Projetos/github/Aion/aion-api/tests/mocks/record_projection_reconcile_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/lechitz/aion-api/internal/record/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecordProjectionReconcileRepository is a mock of RecordProjectionReconcileRepository interface.
+type MockRecordProjectionReconcileRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordProjectionReconcileRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRecordProjectionReconcileRepositoryMockRecorder is the mock recorder for MockRecordProjectionReconcileRepository.
+type MockRecordProjectionReconcileRepositoryMockRecorder struct {
+	mock *MockRecordProjectionReconcileRepository
+}
+
+// NewMockRecordProjectionReconcileRepository creates a new mock instance.
+func NewMockRecordProjectionReconcileRepository(ctrl *gomock.Controller) *MockRecordProjectionReconcileRepository {
+	mock := &MockRecordProjectionReconcileRepository{ctrl: ctrl}
+	mock.recorder = &MockRecordProjectionReconcileRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordProjectionReconcileRepository) EXPECT() *MockRecordProjectionReconcileRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteUserProjections mocks base method.
+func (m *MockRecordProjectionReconcileRepository) DeleteUserProjections(ctx context.Context, userID uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserProjections", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserProjections indicates an expected call of DeleteUserProjections.
+func (mr *MockRecordProjectionReconcileRepositoryMockRecorder) DeleteUserProjections(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserProjections", reflect.TypeOf((*MockRecordProjectionReconcileRepository)(nil).DeleteUserProjections), ctx, userID)
+}
+
+// ListCanonicalRecords mocks base method.
+func (m *MockRecordProjectionReconcileRepository) ListCanonicalRecords(ctx context.Context, userID uint64) ([]domain.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCanonicalRecords", ctx, userID)
+	ret0, _ := ret[0].([]domain.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCanonicalRecords indicates an expected call of ListCanonicalRecords.
+func (mr *MockRecordProjectionReconcileRepositoryMockRecorder) ListCanonicalRecords(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCanonicalRecords", reflect.TypeOf((*MockRecordProjectionReconcileRepository)(nil).ListCanonicalRecords), ctx, userID)
+}
+
+// ListProjectionUserIDs mocks base method.
+func (m *MockRecordProjectionReconcileRepository) ListProjectionUserIDs(ctx context.Context) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectionUserIDs", ctx)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectionUserIDs indicates an expected call of ListProjectionUserIDs.
+func (mr *MockRecordProjectionReconcileRepositoryMockRecorder) ListProjectionUserIDs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectionUserIDs", reflect.TypeOf((*MockRecordProjectionReconcileRepository)(nil).ListProjectionUserIDs), ctx)
+}
+
+// ListUserProjections mocks base method.
+func (m *MockRecordProjectionReconcileRepository) ListUserProjections(ctx context.Context, userID uint64) ([]domain.RecordProjection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserProjections", ctx, userID)
+	ret0, _ := ret[0].([]domain.RecordProjection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserProjections indicates an expected call of ListUserProjections.
+func (mr *MockRecordProjectionReconcileRepositoryMockRecorder) ListUserProjections(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserProjections", reflect.TypeOf((*MockRecordProjectionReconcileRepository)(nil).ListUserProjections), ctx, userID)
+}
@@ -0,0 +1,177 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/lechitz/Projetos/github/Aion/aion-api/internal/record/core/ports/output/record_projector.go
+//
+// Generated by this command:
+//
+//	mockgen -source=/home/lechitz/Projetos/github/Aion/aion-api/internal/record/core/ports/output/record_projector.go -destination=/home/lechitz/Projetos/github/Aion/aion-api/tests/mocks/record_projector_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/lechitz/aion-api/internal/record/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecordProjectionWriter is a mock of RecordProjectionWriter interface.
+type MockRecordProjectionWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordProjectionWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockRecordProjectionWriterMockRecorder is the mock recorder for MockRecordProjectionWriter.
+type MockRecordProjectionWriterMockRecorder struct {
+	mock *MockRecordProjectionWriter
+}
+
+// NewMockRecordProjectionWriter creates a new mock instance.
+func NewMockRecordProjectionWriter(ctrl *gomock.Controller) *MockRecordProjectionWriter {
+	mock := &MockRecordProjectionWriter{ctrl: ctrl}
+	mock.recorder = &MockRecordProjectionWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordProjectionWriter) EXPECT() *MockRecordProjectionWriterMockRecorder {
+	return m.recorder
+}
+
+// DeleteProjection mocks base method.
+func (m *MockRecordProjectionWriter) DeleteProjection(ctx context.Context, projection domain.RecordProjection) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjection", ctx, projection)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjection indicates an expected call of DeleteProjection.
+func (mr *MockRecordProjectionWriterMockRecorder) DeleteProjection(ctx, projection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjection", reflect.TypeOf((*MockRecordProjectionWriter)(nil).DeleteProjection), ctx, projection)
+}
+
+// UpsertProjection mocks base method.
+func (m *MockRecordProjectionWriter) UpsertProjection(ctx context.Context, projection domain.RecordProjection) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProjection", ctx, projection)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProjection indicates an expected call of UpsertProjection.
+func (mr *MockRecordProjectionWriterMockRecorder) UpsertProjection(ctx, projection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProjection", reflect.TypeOf((*MockRecordProjectionWriter)(nil).UpsertProjection), ctx, projection)
+}
+
+// MockRecordEventReader is a mock of RecordEventReader interface.
+type MockRecordEventReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordEventReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockRecordEventReaderMockRecorder is the mock recorder for MockRecordEventReader.
+type MockRecordEventReaderMockRecorder struct {
+	mock *MockRecordEventReader
+}
+
+// NewMockRecordEventReader creates a new mock instance.
+func NewMockRecordEventReader(ctrl *gomock.Controller) *MockRecordEventReader {
+	mock := &MockRecordEventReader{ctrl: ctrl}
+	mock.recorder = &MockRecordEventReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordEventReader) EXPECT() *MockRecordEventReaderMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockRecordEventReader) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockRecordEventReaderMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockRecordEventReader)(nil).Close))
+}
+
+// Commit mocks base method.
+func (m *MockRecordEventReader) Commit(ctx context.Context, event domain.RecordEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockRecordEventReaderMockRecorder) Commit(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockRecordEventReader)(nil).Commit), ctx, event)
+}
+
+// Read mocks base method.
+func (m *MockRecordEventReader) Read(ctx context.Context) (domain.RecordEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx)
+	ret0, _ := ret[0].(domain.RecordEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockRecordEventReaderMockRecorder) Read(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockRecordEventReader)(nil).Read), ctx)
+}
+
+// MockProjectionReadyPublisher is a mock of ProjectionReadyPublisher interface.
+type MockProjectionReadyPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectionReadyPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectionReadyPublisherMockRecorder is the mock recorder for MockProjectionReadyPublisher.
+type MockProjectionReadyPublisherMockRecorder struct {
+	mock *MockProjectionReadyPublisher
+}
+
+// NewMockProjectionReadyPublisher creates a new mock instance.
+func NewMockProjectionReadyPublisher(ctrl *gomock.Controller) *MockProjectionReadyPublisher {
+	mock := &MockProjectionReadyPublisher{ctrl: ctrl}
+	mock.recorder = &MockProjectionReadyPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectionReadyPublisher) EXPECT() *MockProjectionReadyPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishProjectionReady mocks base method.
+func (m *MockProjectionReadyPublisher) PublishProjectionReady(ctx context.Context, event domain.ProjectionReady) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishProjectionReady", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishProjectionReady indicates an expected call of PublishProjectionReady.
+func (mr *MockProjectionReadyPublisherMockRecorder) PublishProjectionReady(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProjectionReady", reflect.TypeOf((*MockProjectionReadyPublisher)(nil).PublishProjectionReady), ctx, event)
+}
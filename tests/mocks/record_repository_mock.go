@@ -87,32 +87,47 @@ func (mr *MockRecordRepositoryMockRecorder) CreateDashboardView(ctx, view any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDashboardView", reflect.TypeOf((*MockRecordRepository)(nil).CreateDashboardView), ctx, view)
 }
 
-// Delete mocks base method.
-func (m *MockRecordRepository) Delete(ctx context.Context, id, userID uint64) error {
+// CreateMany mocks base method.
+func (m *MockRecordRepository) CreateMany(ctx context.Context, records []domain.Record) ([]domain.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMany", ctx, records)
+	ret0, _ := ret[0].([]domain.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMany indicates an expected call of CreateMany.
+func (mr *MockRecordRepositoryMockRecorder) CreateMany(ctx, records any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockRecordRepository)(nil).CreateMany), ctx, records)
+}
+
+// CreateRevisions mocks base method.
+func (m *MockRecordRepository) CreateRevisions(ctx context.Context, revisions []domain.RecordRevision) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id, userID)
+	ret := m.ctrl.Call(m, "CreateRevisions", ctx, revisions)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockRecordRepositoryMockRecorder) Delete(ctx, id, userID any) *gomock.Call {
+// CreateRevisions indicates an expected call of CreateRevisions.
+func (mr *MockRecordRepositoryMockRecorder) CreateRevisions(ctx, revisions any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecordRepository)(nil).Delete), ctx, id, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRevisions", reflect.TypeOf((*MockRecordRepository)(nil).CreateRevisions), ctx, revisions)
 }
 
-// DeleteAllByUser mocks base method.
-func (m *MockRecordRepository) DeleteAllByUser(ctx context.Context, userID uint64) error {
+// Delete mocks base method.
+func (m *MockRecordRepository) Delete(ctx context.Context, id, userID uint64, deletedAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAllByUser", ctx, userID)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, userID, deletedAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteAllByUser indicates an expected call of DeleteAllByUser.
-func (mr *MockRecordRepositoryMockRecorder) DeleteAllByUser(ctx, userID any) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockRecordRepositoryMockRecorder) Delete(ctx, id, userID, deletedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllByUser", reflect.TypeOf((*MockRecordRepository)(nil).DeleteAllByUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecordRepository)(nil).Delete), ctx, id, userID, deletedAt)
 }
 
 // DeleteDashboardWidget mocks base method.
@@ -143,6 +158,20 @@ func (mr *MockRecordRepositoryMockRecorder) DeleteGoalTemplate(ctx, userID, goal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoalTemplate", reflect.TypeOf((*MockRecordRepository)(nil).DeleteGoalTemplate), ctx, userID, goalTemplateID)
 }
 
+// DeleteMany mocks base method.
+func (m *MockRecordRepository) DeleteMany(ctx context.Context, userID uint64, ids []uint64, deletedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", ctx, userID, ids, deletedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockRecordRepositoryMockRecorder) DeleteMany(ctx, userID, ids, deletedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockRecordRepository)(nil).DeleteMany), ctx, userID, ids, deletedAt)
+}
+
 // GetByID mocks base method.
 func (m *MockRecordRepository) GetByID(ctx context.Context, recordID, userID uint64) (domain.Record, error) {
 	m.ctrl.T.Helper()
@@ -248,6 +277,21 @@ func (mr *MockRecordRepositoryMockRecorder) ListByDay(ctx, userID, date any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDay", reflect.TypeOf((*MockRecordRepository)(nil).ListByDay), ctx, userID, date)
 }
 
+// ListByIdempotencyKeys mocks base method.
+func (m *MockRecordRepository) ListByIdempotencyKeys(ctx context.Context, userID uint64, keys []string) ([]domain.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIdempotencyKeys", ctx, userID, keys)
+	ret0, _ := ret[0].([]domain.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIdempotencyKeys indicates an expected call of ListByIdempotencyKeys.
+func (mr *MockRecordRepositoryMockRecorder) ListByIdempotencyKeys(ctx, userID, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIdempotencyKeys", reflect.TypeOf((*MockRecordRepository)(nil).ListByIdempotencyKeys), ctx, userID, keys)
+}
+
 // ListByTag mocks base method.
 func (m *MockRecordRepository) ListByTag(ctx context.Context, tagID, userID uint64, limit int) ([]domain.Record, error) {
 	m.ctrl.T.Helper()
@@ -308,6 +352,36 @@ func (mr *MockRecordRepositoryMockRecorder) ListDashboardWidgetsByView(ctx, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDashboardWidgetsByView", reflect.TypeOf((*MockRecordRepository)(nil).ListDashboardWidgetsByView), ctx, userID, viewID)
 }
 
+// ListDeleted mocks base method.
+func (m *MockRecordRepository) ListDeleted(ctx context.Context, userID uint64, limit int, afterDeletedAt *string, afterID *int64) ([]domain.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, userID, limit, afterDeletedAt, afterID)
+	ret0, _ := ret[0].([]domain.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockRecordRepositoryMockRecorder) ListDeleted(ctx, userID, limit, afterDeletedAt, afterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockRecordRepository)(nil).ListDeleted), ctx, userID, limit, afterDeletedAt, afterID)
+}
+
+// ListDeletedByIDs mocks base method.
+func (m *MockRecordRepository) ListDeletedByIDs(ctx context.Context, userID uint64, ids []uint64) ([]domain.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedByIDs", ctx, userID, ids)
+	ret0, _ := ret[0].([]domain.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedByIDs indicates an expected call of ListDeletedByIDs.
+func (mr *MockRecordRepositoryMockRecorder) ListDeletedByIDs(ctx, userID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedByIDs", reflect.TypeOf((*MockRecordRepository)(nil).ListDeletedByIDs), ctx, userID, ids)
+}
+
 // ListGoalTemplates mocks base method.
 func (m *MockRecordRepository) ListGoalTemplates(ctx context.Context, userID uint64) ([]domain.GoalTemplate, error) {
 	m.ctrl.T.Helper()
@@ -353,6 +427,36 @@ func (mr *MockRecordRepositoryMockRecorder) ListMetricDefinitions(ctx, userID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetricDefinitions", reflect.TypeOf((*MockRecordRepository)(nil).ListMetricDefinitions), ctx, userID)
 }
 
+// ListRevisions mocks base method.
+func (m *MockRecordRepository) ListRevisions(ctx context.Context, userID, recordID uint64, limit int) ([]domain.RecordRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", ctx, userID, recordID, limit)
+	ret0, _ := ret[0].([]domain.RecordRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockRecordRepositoryMockRecorder) ListRevisions(ctx, userID, recordID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockRecordRepository)(nil).ListRevisions), ctx, userID, recordID, limit)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockRecordRepository) PurgeDeleted(ctx context.Context, before time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockRecordRepositoryMockRecorder) PurgeDeleted(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockRecordRepository)(nil).PurgeDeleted), ctx, before, limit)
+}
+
 // ReorderDashboardWidgets mocks base method.
 func (m *MockRecordRepository) ReorderDashboardWidgets(ctx context.Context, userID, viewID uint64, items []domain.DashboardWidget) ([]domain.DashboardWidget, error) {
 	m.ctrl.T.Helper()
@@ -368,6 +472,20 @@ func (mr *MockRecordRepositoryMockRecorder) ReorderDashboardWidgets(ctx, userID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderDashboardWidgets", reflect.TypeOf((*MockRecordRepository)(nil).ReorderDashboardWidgets), ctx, userID, viewID, items)
 }
 
+// Restore mocks base method.
+func (m *MockRecordRepository) Restore(ctx context.Context, userID uint64, ids []uint64, restoredAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, userID, ids, restoredAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRecordRepositoryMockRecorder) Restore(ctx, userID, ids, restoredAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRecordRepository)(nil).Restore), ctx, userID, ids, restoredAt)
+}
+
 // SearchRecords mocks base method.
 func (m *MockRecordRepository) SearchRecords(ctx context.Context, userID uint64, filters domain.SearchFilters) ([]domain.Record, error) {
 	m.ctrl.T.Helper()
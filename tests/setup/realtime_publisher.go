@@ -0,0 +1,39 @@
+package setup
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	realtimedomain "github.com/lechitz/aion-api/internal/realtime/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+// RealtimePublisherSpy records the realtime events a service publishes, in order.
+type RealtimePublisherSpy struct {
+	mu     sync.Mutex
+	events []realtimedomain.Event
+}
+
+// Publish records event.
+func (p *RealtimePublisherSpy) Publish(_ context.Context, event realtimedomain.Event) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+}
+
+// Events returns the events published so far.
+func (p *RealtimePublisherSpy) Events() []realtimedomain.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]realtimedomain.Event(nil), p.events...)
+}
+
+// AwaitEvents waits until n events were published, for publishers called in the background, and
+// returns them. It fails t if they do not arrive within a second.
+func (p *RealtimePublisherSpy) AwaitEvents(t *testing.T, n int) []realtimedomain.Event {
+	t.Helper()
+	require.Eventually(t, func() bool { return len(p.Events()) >= n }, time.Second, time.Millisecond)
+	return p.Events()
+}